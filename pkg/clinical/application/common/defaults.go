@@ -44,6 +44,9 @@ const (
 	// SegmentationTopicName topic sends patient segmentation information to slade advantage
 	SegmentationTopicName = "patient.segmentation.create"
 
+	// EarlyWarningAlertTopicName is the topic where alerts are published when a patient's early warning score calls for a clinical response
+	EarlyWarningAlertTopicName = "early.warning.alert.create"
+
 	// MedicalDataCount is the count of medical records
 	MedicalDataCount = "3"
 
@@ -89,6 +92,9 @@ const (
 	// CD4CountCIELTerminologyCode is the terminology code for CD$ Count
 	CD4CountCIELTerminologyCode = "5497"
 
+	// MedicationAdherenceCIELTerminologyCode is the terminology code for an assessment of adherence to medication
+	MedicationAdherenceCIELTerminologyCode = "1658"
+
 	// ClinicalServiceName defines the service where the topic is created
 	ClinicalServiceName = "clinical"
 
@@ -134,6 +140,15 @@ const (
 	// LOINCProviderUnspecifiedProgressNote defines LOINC Provider unspecified progress note terminology code
 	LOINCProviderUnspecifiedProgressNote = "11506-3"
 
+	// LOINCBloodPressurePanel defines the LOINC terminology code for a blood pressure reading with systolic and diastolic components
+	LOINCBloodPressurePanel = "85354-9"
+
+	// LOINCSystolicBloodPressure defines the LOINC terminology code for systolic blood pressure
+	LOINCSystolicBloodPressure = "8480-6"
+
+	// LOINCDiastolicBloodPressure defines the LOINC terminology code for diastolic blood pressure
+	LOINCDiastolicBloodPressure = "8462-4"
+
 	// ColposcopyCIELTerminologyCode is the terminology code for colposcopy findings
 	ColposcopyCIELTerminologyCode = "162816"
 
@@ -188,6 +203,36 @@ const (
 	// BreastExaminationCIELTerminologySystem is the terminology code used to represent breast examination concept.
 	// This is more a more general concept code.
 	BreastExaminationCIELTerminologySystem = "162825"
+
+	// MyCareHubUserIdentifierSystem is the identifier system used to link a patient to their myCareHub user
+	MyCareHubUserIdentifierSystem = "mycarehub.user.id"
+
+	// PractitionerUserIdentifierSystem is the identifier system used to link a practitioner to the user they sign in as
+	PractitionerUserIdentifierSystem = "mycarehub.practitioner.user.id"
+
+	// QuestionnaireResponseItemIdentifierSystem is the identifier system used to link a resource to the questionnaire response item it was extracted from
+	QuestionnaireResponseItemIdentifierSystem = "mycarehub.questionnaire-response.item"
+
+	// ServiceAccountClientID is the client ID set on the token introspection response of requests authenticated with a service account key
+	ServiceAccountClientID = "clinical.service-account"
+
+	// ServiceAccountAdminScope is the token scope required to create, list, rotate and revoke service accounts
+	ServiceAccountAdminScope = "clinical.service-accounts.admin"
+
+	// OrganizationTagSystem is the meta tag system used to identify the organisation a resource belongs to
+	OrganizationTagSystem = "http://mycarehub/tenant-identification/organisation"
+
+	// FacilityTagSystem is the meta tag system used to identify the facility a resource belongs to
+	FacilityTagSystem = "http://mycarehub/tenant-identification/facility"
+
+	// GrowthIndicatorSystem is the code system of the growth z-scores and MUAC classifications derived for children
+	GrowthIndicatorSystem = "http://mycarehub/growth-indicators"
+
+	// MUACClassificationCode is the code of the acute malnutrition classified from a child's MUAC
+	MUACClassificationCode = "muac-classification"
+
+	// EarlyWarningScoreSystem is the code system of the early warning scores e.g NEWS2 derived from a patient's vital signs
+	EarlyWarningScoreSystem = "http://mycarehub/early-warning-scores"
 )
 
 // DefaultIdentifier assigns a patient a code to function as their
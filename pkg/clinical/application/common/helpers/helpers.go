@@ -2,7 +2,9 @@ package helpers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -113,6 +115,28 @@ func ParseDate(date string) time.Time {
 	return timeValue
 }
 
+// fhirDateTimeLayouts are the forms a FHIR dateTime may take, from a full instant down to a year
+var fhirDateTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	StringTimeParseMonthNumberLayout,
+	"2006-01",
+	"2006",
+}
+
+// ParseFHIRDateTime parses a FHIR dateTime, which may be a date or a partial date.
+// A partial date is taken to be the start of the month or year it names
+func ParseFHIRDateTime(value string) (time.Time, error) {
+	for _, layout := range fhirDateTimeLayouts {
+		instant, err := time.Parse(layout, value)
+		if err == nil {
+			return instant, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid FHIR date time: %s", value)
+}
+
 // IDToIdentifier translates simple identification
 // document details to FHIR identifiers
 func IDToIdentifier(
@@ -447,3 +471,64 @@ func MaritalStatusEnumToCodeableConceptInput(val domain.MaritalStatus) *domain.F
 
 	return output
 }
+
+// Period is a length of time in days, weeks, months or years written as e.g `6w` or `9m`
+type Period struct {
+	Value int
+	Unit  string
+}
+
+// ParsePeriod reads a period written as e.g `14w`
+func ParsePeriod(value string) (Period, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Period{}, nil
+	}
+
+	unit := strings.ToLower(value[len(value)-1:])
+	if !strings.Contains("dwmy", unit) {
+		return Period{}, fmt.Errorf("invalid period %q: the unit must be one of d, w, m or y", value)
+	}
+
+	amount, err := strconv.Atoi(value[:len(value)-1])
+	if err != nil || amount < 0 {
+		return Period{}, fmt.Errorf("invalid period %q: the amount must be a positive whole number", value)
+	}
+
+	return Period{Value: amount, Unit: unit}, nil
+}
+
+// UnmarshalJSON reads a period from a JSON string
+func (p *Period) UnmarshalJSON(b []byte) error {
+	var value string
+
+	err := json.Unmarshal(b, &value)
+	if err != nil {
+		return fmt.Errorf("a period must be a string e.g `6w`: %w", err)
+	}
+
+	*p, err = ParsePeriod(value)
+
+	return err
+}
+
+// IsZero checks whether the period has been set
+func (p Period) IsZero() bool {
+	return p.Unit == ""
+}
+
+// AddTo returns the date that is the period after the given date
+func (p Period) AddTo(date time.Time) time.Time {
+	switch p.Unit {
+	case "d":
+		return date.AddDate(0, 0, p.Value)
+	case "w":
+		return date.AddDate(0, 0, 7*p.Value)
+	case "m":
+		return date.AddDate(0, p.Value, 0)
+	case "y":
+		return date.AddDate(p.Value, 0, 0)
+	}
+
+	return date
+}
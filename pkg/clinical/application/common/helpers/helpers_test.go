@@ -101,6 +101,74 @@ func TestParseDate(t *testing.T) {
 	}
 }
 
+func TestParseFHIRDateTime(t *testing.T) {
+	type args struct {
+		value string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name: "Happy case: instant",
+			args: args{
+				value: "2023-04-05T10:30:00+03:00",
+			},
+			want: time.Date(2023, 4, 5, 7, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "Happy case: date time without a timezone",
+			args: args{
+				value: "2023-04-05T10:30:00",
+			},
+			want: time.Date(2023, 4, 5, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "Happy case: date",
+			args: args{
+				value: "2023-04-05",
+			},
+			want: time.Date(2023, 4, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Happy case: year and month",
+			args: args{
+				value: "2023-04",
+			},
+			want: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Happy case: year",
+			args: args{
+				value: "2023",
+			},
+			want: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Sad case: invalid date time",
+			args: args{
+				value: "yesterday",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFHIRDateTime(tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseFHIRDateTime() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseFHIRDateTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestIDToIdentifier(t *testing.T) {
 	dummyString := gofakeit.BS()
 	base64String := base64.StdEncoding.EncodeToString([]byte(dummyString))
@@ -471,3 +539,102 @@ func TestMaritalStatusEnumToCodeableConceptInput(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePeriod(t *testing.T) {
+	type args struct {
+		value string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Period
+		wantErr bool
+	}{
+		{
+			name: "Happy case: weeks",
+			args: args{
+				value: "14w",
+			},
+			want: Period{Value: 14, Unit: "w"},
+		},
+		{
+			name: "Happy case: upper case unit",
+			args: args{
+				value: " 9M ",
+			},
+			want: Period{Value: 9, Unit: "m"},
+		},
+		{
+			name: "Happy case: no period",
+			args: args{
+				value: "",
+			},
+			want: Period{},
+		},
+		{
+			name: "Sad case: unknown unit",
+			args: args{
+				value: "6 weeks",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: negative amount",
+			args: args{
+				value: "-2d",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePeriod(tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePeriod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("ParsePeriod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_AddTo(t *testing.T) {
+	date := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		period Period
+		want   time.Time
+	}{
+		{
+			name:   "Happy case: days",
+			period: Period{Value: 3, Unit: "d"},
+			want:   time.Date(2023, 2, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "Happy case: weeks",
+			period: Period{Value: 6, Unit: "w"},
+			want:   time.Date(2023, 3, 14, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "Happy case: years",
+			period: Period{Value: 1, Unit: "y"},
+			want:   time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "Happy case: no period",
+			period: Period{},
+			want:   date,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.AddTo(date); !got.Equal(tt.want) {
+				t.Errorf("Period.AddTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -13,6 +13,11 @@ type Allergy struct {
 	OnsetDateTime     scalarutils.DateTime `json:"onsetDateTime,omitempty"`
 	EncounterID       string               `json:"encounterID"`
 	Reaction          Reaction             `json:"reaction"`
+
+	ClinicalStatus     AllergyClinicalStatusEnum     `json:"clinicalStatus"`
+	VerificationStatus AllergyVerificationStatusEnum `json:"verificationStatus"`
+	Reactions          []Reaction                    `json:"reactions"`
+	LastOccurrence     *scalarutils.DateTime         `json:"lastOccurrence,omitempty"`
 }
 
 // Reaction represents a reaction containing minimal FHIR resources
@@ -0,0 +1,139 @@
+package dto
+
+import "github.com/savannahghi/scalarutils"
+
+// Schedule is the availability of a facility, or of a practitioner at a facility, for appointments
+type Schedule struct {
+	ID               string            `json:"id"`
+	Active           bool              `json:"active"`
+	FacilityID       string            `json:"facilityID,omitempty"`
+	PractitionerName string            `json:"practitionerName,omitempty"`
+	ServiceType      string            `json:"serviceType,omitempty"`
+	Comment          string            `json:"comment,omitempty"`
+	StartDate        *scalarutils.Date `json:"startDate,omitempty"`
+	EndDate          *scalarutils.Date `json:"endDate,omitempty"`
+}
+
+// ScheduleEdge is a schedule edge
+type ScheduleEdge struct {
+	Node   Schedule
+	Cursor string
+}
+
+// ScheduleConnection is a schedule Connection Type
+type ScheduleConnection struct {
+	TotalCount int
+	Edges      []ScheduleEdge
+	PageInfo   PageInfo
+}
+
+// CreateScheduleConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreateScheduleConnection(schedules []*Schedule, pageInfo PageInfo, total int) ScheduleConnection {
+	connection := ScheduleConnection{
+		TotalCount: total,
+		Edges:      []ScheduleEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, schedule := range schedules {
+		edge := ScheduleEdge{
+			Node:   *schedule,
+			Cursor: schedule.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
+
+// Slot is a period of a schedule in which an appointment may be booked
+type Slot struct {
+	ID         string               `json:"id"`
+	ScheduleID string               `json:"scheduleID"`
+	Status     SlotStatusEnum       `json:"status"`
+	Start      scalarutils.DateTime `json:"start"`
+	End        scalarutils.DateTime `json:"end"`
+}
+
+// SlotEdge is a slot edge
+type SlotEdge struct {
+	Node   Slot
+	Cursor string
+}
+
+// SlotConnection is a slot Connection Type
+type SlotConnection struct {
+	TotalCount int
+	Edges      []SlotEdge
+	PageInfo   PageInfo
+}
+
+// CreateSlotConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreateSlotConnection(slots []*Slot, pageInfo PageInfo, total int) SlotConnection {
+	connection := SlotConnection{
+		TotalCount: total,
+		Edges:      []SlotEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, slot := range slots {
+		edge := SlotEdge{
+			Node:   *slot,
+			Cursor: slot.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
+
+// Appointment is a patient's booking into a slot of a facility's or a practitioner's schedule
+type Appointment struct {
+	ID                 string                `json:"id"`
+	Status             AppointmentStatusEnum `json:"status"`
+	PatientID          string                `json:"patientID"`
+	FacilityID         string                `json:"facilityID,omitempty"`
+	PractitionerName   string                `json:"practitionerName,omitempty"`
+	SlotID             string                `json:"slotID,omitempty"`
+	ServiceRequestID   string                `json:"serviceRequestID,omitempty"`
+	Description        string                `json:"description,omitempty"`
+	Comment            string                `json:"comment,omitempty"`
+	Start              scalarutils.DateTime  `json:"start"`
+	End                scalarutils.DateTime  `json:"end"`
+	CancellationReason string                `json:"cancellationReason,omitempty"`
+}
+
+// AppointmentEdge is an appointment edge
+type AppointmentEdge struct {
+	Node   Appointment
+	Cursor string
+}
+
+// AppointmentConnection is an appointment Connection Type
+type AppointmentConnection struct {
+	TotalCount int
+	Edges      []AppointmentEdge
+	PageInfo   PageInfo
+}
+
+// CreateAppointmentConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreateAppointmentConnection(appointments []*Appointment, pageInfo PageInfo, total int) AppointmentConnection {
+	connection := AppointmentConnection{
+		TotalCount: total,
+		Edges:      []AppointmentEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, appointment := range appointments {
+		edge := AppointmentEdge{
+			Node:   *appointment,
+			Cursor: appointment.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
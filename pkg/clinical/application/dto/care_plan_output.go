@@ -0,0 +1,59 @@
+package dto
+
+import "github.com/savannahghi/scalarutils"
+
+// CarePlan describes how a patient's chronic care is delivered in an episode of care through goals and planned activities
+type CarePlan struct {
+	ID              string              `json:"id"`
+	PatientID       string              `json:"patientID"`
+	EpisodeOfCareID string              `json:"episodeOfCareID,omitempty"`
+	Title           string              `json:"title"`
+	Description     string              `json:"description,omitempty"`
+	Status          CarePlanStatusEnum  `json:"status"`
+	StartDate       *scalarutils.Date   `json:"startDate,omitempty"`
+	EndDate         *scalarutils.Date   `json:"endDate,omitempty"`
+	ConditionIDs    []string            `json:"conditionIDs"`
+	GoalIDs         []string            `json:"goalIDs"`
+	Activities      []*CarePlanActivity `json:"activities"`
+}
+
+// CarePlanActivity is an activity planned for a patient e.g a viral load test every 6 months
+type CarePlanActivity struct {
+	Code        string                     `json:"code,omitempty"`
+	Description string                     `json:"description"`
+	Schedule    string                     `json:"schedule,omitempty"`
+	Status      CarePlanActivityStatusEnum `json:"status"`
+}
+
+// CarePlanEdge is a care plan edge
+type CarePlanEdge struct {
+	Node   CarePlan
+	Cursor string
+}
+
+// CarePlanConnection is a care plan Connection Type
+type CarePlanConnection struct {
+	TotalCount int
+	Edges      []CarePlanEdge
+	PageInfo   PageInfo
+}
+
+// CreateCarePlanConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreateCarePlanConnection(carePlans []*CarePlan, pageInfo PageInfo, total int) CarePlanConnection {
+	connection := CarePlanConnection{
+		TotalCount: total,
+		Edges:      []CarePlanEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, carePlan := range carePlans {
+		edge := CarePlanEdge{
+			Node:   *carePlan,
+			Cursor: carePlan.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
@@ -10,10 +10,12 @@ type Condition struct {
 	Code   string          `json:"code"`
 	System string          `json:"system"`
 
-	Category ConditionCategory `json:"category"`
+	Category           ConditionCategory           `json:"category"`
+	VerificationStatus ConditionVerificationStatus `json:"verificationStatus"`
 
-	OnsetDate    *scalarutils.Date `json:"onsetDate"`
-	RecordedDate *scalarutils.Date `json:"recordedDate"`
+	OnsetDate     *scalarutils.Date `json:"onsetDate"`
+	AbatementDate *scalarutils.Date `json:"abatementDate"`
+	RecordedDate  *scalarutils.Date `json:"recordedDate"`
 
 	Note string `json:"note"`
 
@@ -53,3 +55,13 @@ func CreateConditionConnection(conditions []Condition, pageInfo PageInfo, total
 
 	return connection
 }
+
+// ProblemList is the summary of the conditions of a patient across their encounters.
+// Each condition appears once, as it was most recently recorded
+type ProblemList struct {
+	// ProblemListItems are the patient's active long term problems
+	ProblemListItems []*Condition `json:"problemListItems"`
+
+	// EncounterDiagnoses are the conditions diagnosed during encounters that are not on the problem list
+	EncounterDiagnoses []*Condition `json:"encounterDiagnoses"`
+}
@@ -2,14 +2,19 @@ package dto
 
 // Consent models a fhir consent resource.
 type Consent struct {
-	ID        *string            `json:"id,omitempty"`
-	Status    *ConsentStatusEnum `json:"status"`
-	Provision *ConsentProvision  `json:"provision,omitempty"`
-	Patient   *Reference         `json:"patient,omitempty"`
+	ID        *string              `json:"id,omitempty"`
+	Status    *ConsentStatusEnum   `json:"status"`
+	Category  *ConsentCategoryEnum `json:"category,omitempty"`
+	Provision *ConsentProvision    `json:"provision,omitempty"`
+	Patient   *Reference           `json:"patient,omitempty"`
+
+	// ConsentFormURL is a time limited link to download the signed consent form
+	ConsentFormURL *string `json:"consentFormURL,omitempty"`
 }
 
 // ConsentProvision models a  consent provision
 type ConsentProvision struct {
-	ID   *string                   `json:"id,omitempty"`
-	Type *ConsentProvisionTypeEnum `json:"type,omitempty"`
+	ID     *string                   `json:"id,omitempty"`
+	Type   *ConsentProvisionTypeEnum `json:"type,omitempty"`
+	Period *Period                   `json:"period,omitempty"`
 }
@@ -9,4 +9,5 @@ type DiagnosticReport struct {
 	Result      []*Observation    `json:"result,omitempty"`
 	Media       []*Media          `json:"media,omitempty"`
 	Conclusion  string            `json:"conclusion,omitempty"`
+	SpecimenID  string            `json:"specimenID,omitempty"`
 }
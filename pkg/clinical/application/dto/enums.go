@@ -16,6 +16,41 @@ const (
 	Other     OrganizationIdentifierType = "Other"
 )
 
+// IsValid checks if the organization identifier type is valid
+func (c OrganizationIdentifierType) IsValid() bool {
+	switch c {
+	case SladeCode, MFLCode, ProgramID, Other:
+		return true
+	}
+
+	return false
+}
+
+// String converts the organization identifier type to string
+func (c OrganizationIdentifierType) String() string {
+	return string(c)
+}
+
+// MarshalGQL writes the organization identifier type as a quoted string
+func (c OrganizationIdentifierType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to an organization identifier type
+func (c *OrganizationIdentifierType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = OrganizationIdentifierType(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid OrganizationIdentifierType", str)
+	}
+
+	return nil
+}
+
 type EpisodeOfCareStatusEnum string
 
 const (
@@ -59,6 +94,7 @@ const (
 	ResourceTypeObservation         ResourceType = "Observation"
 	ResourceTypeCondition           ResourceType = "Condition"
 	ResourceTypeMedicationStatement ResourceType = "MedicationStatement"
+	ResourceTypeProcedure           ResourceType = "Procedure"
 )
 
 type AllergyIntoleranceReactionSeverityEnum string
@@ -69,6 +105,115 @@ const (
 	AllergyIntoleranceReactionSeverityEnumSevere   AllergyIntoleranceReactionSeverityEnum = "SEVERE"
 )
 
+// IsValid checks if the reaction severity is valid
+func (c AllergyIntoleranceReactionSeverityEnum) IsValid() bool {
+	switch c {
+	case AllergyIntoleranceReactionSeverityEnumMild, AllergyIntoleranceReactionSeverityEnumModerate, AllergyIntoleranceReactionSeverityEnumSevere:
+		return true
+	}
+
+	return false
+}
+
+// AllergyClinicalStatusEnum represents whether an allergy is currently affecting the patient
+type AllergyClinicalStatusEnum string
+
+const (
+	AllergyClinicalStatusActive   AllergyClinicalStatusEnum = "ACTIVE"
+	AllergyClinicalStatusInactive AllergyClinicalStatusEnum = "INACTIVE"
+	AllergyClinicalStatusResolved AllergyClinicalStatusEnum = "RESOLVED"
+)
+
+// IsValid checks if the allergy clinical status is valid
+func (c AllergyClinicalStatusEnum) IsValid() bool {
+	switch c {
+	case AllergyClinicalStatusActive, AllergyClinicalStatusInactive, AllergyClinicalStatusResolved:
+		return true
+	}
+
+	return false
+}
+
+// String converts the allergy clinical status to string
+func (c AllergyClinicalStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the allergy clinical status e.g `resolved`
+func (c AllergyClinicalStatusEnum) Code() string {
+	return strings.ToLower(c.String())
+}
+
+// MarshalGQL writes the allergy clinical status as a quoted string
+func (c AllergyClinicalStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to an allergy clinical status enum
+func (c *AllergyClinicalStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = AllergyClinicalStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid AllergyClinicalStatusEnum", str)
+	}
+
+	return nil
+}
+
+// AllergyVerificationStatusEnum represents how certain a clinician is that a patient has an allergy
+type AllergyVerificationStatusEnum string
+
+const (
+	AllergyVerificationStatusUnconfirmed    AllergyVerificationStatusEnum = "UNCONFIRMED"
+	AllergyVerificationStatusConfirmed      AllergyVerificationStatusEnum = "CONFIRMED"
+	AllergyVerificationStatusRefuted        AllergyVerificationStatusEnum = "REFUTED"
+	AllergyVerificationStatusEnteredInError AllergyVerificationStatusEnum = "ENTERED_IN_ERROR"
+)
+
+// IsValid checks if the allergy verification status is valid
+func (c AllergyVerificationStatusEnum) IsValid() bool {
+	switch c {
+	case AllergyVerificationStatusUnconfirmed, AllergyVerificationStatusConfirmed, AllergyVerificationStatusRefuted, AllergyVerificationStatusEnteredInError:
+		return true
+	}
+
+	return false
+}
+
+// String converts the allergy verification status to string
+func (c AllergyVerificationStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the allergy verification status e.g `entered-in-error`
+func (c AllergyVerificationStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the allergy verification status as a quoted string
+func (c AllergyVerificationStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to an allergy verification status enum
+func (c *AllergyVerificationStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = AllergyVerificationStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid AllergyVerificationStatusEnum", str)
+	}
+
+	return nil
+}
+
 type ObservationStatus string
 
 const (
@@ -79,14 +224,62 @@ const (
 type MedicationStatementStatusEnum string
 
 const (
-	MedicationStatementStatusEnumActive     MedicationStatementStatusEnum = "ACTIVE"
-	MedicationStatementStatusEnumInActive   MedicationStatementStatusEnum = "INACTIVE"
-	MedicationStatementStatusEnumUnknown    MedicationStatementStatusEnum = "UNKNOWN"
-	MedicationStatementStatusEnumRecurrence MedicationStatementStatusEnum = "RECURRENCE"
-	MedicationStatementStatusEnumRelapse    MedicationStatementStatusEnum = "RELAPSE"
-	MedicationStatementStatusEnumRemission  MedicationStatementStatusEnum = "REMISSSION"
+	MedicationStatementStatusEnumActive         MedicationStatementStatusEnum = "ACTIVE"
+	MedicationStatementStatusEnumInActive       MedicationStatementStatusEnum = "INACTIVE"
+	MedicationStatementStatusEnumUnknown        MedicationStatementStatusEnum = "UNKNOWN"
+	MedicationStatementStatusEnumRecurrence     MedicationStatementStatusEnum = "RECURRENCE"
+	MedicationStatementStatusEnumRelapse        MedicationStatementStatusEnum = "RELAPSE"
+	MedicationStatementStatusEnumRemission      MedicationStatementStatusEnum = "REMISSSION"
+	MedicationStatementStatusEnumCompleted      MedicationStatementStatusEnum = "COMPLETED"
+	MedicationStatementStatusEnumEnteredInError MedicationStatementStatusEnum = "ENTERED_IN_ERROR"
+	MedicationStatementStatusEnumIntended       MedicationStatementStatusEnum = "INTENDED"
+	MedicationStatementStatusEnumStopped        MedicationStatementStatusEnum = "STOPPED"
+	MedicationStatementStatusEnumOnHold         MedicationStatementStatusEnum = "ON_HOLD"
+	MedicationStatementStatusEnumNotTaken       MedicationStatementStatusEnum = "NOT_TAKEN"
 )
 
+// IsValid checks if the medication statement status is one of the FHIR medication statement statuses
+func (c MedicationStatementStatusEnum) IsValid() bool {
+	switch c {
+	case MedicationStatementStatusEnumActive, MedicationStatementStatusEnumInActive, MedicationStatementStatusEnumUnknown,
+		MedicationStatementStatusEnumCompleted, MedicationStatementStatusEnumEnteredInError, MedicationStatementStatusEnumIntended,
+		MedicationStatementStatusEnumStopped, MedicationStatementStatusEnumOnHold, MedicationStatementStatusEnumNotTaken:
+		return true
+	}
+
+	return false
+}
+
+// String converts the medication statement status to string
+func (c MedicationStatementStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the medication statement status e.g `on-hold`
+func (c MedicationStatementStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the medication statement status as a quoted string
+func (c MedicationStatementStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a medication statement status enum
+func (c *MedicationStatementStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = MedicationStatementStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid MedicationStatementStatusEnum", str)
+	}
+
+	return nil
+}
+
 type IdentifierType string
 
 const (
@@ -118,12 +311,94 @@ const (
 type ConditionStatus string
 
 const (
-	ConditionStatusActive   ConditionStatus = "ACTIVE"
-	ConditionStatusInactive ConditionStatus = "INACTIVE"
-	ConditionStatusResolved ConditionStatus = "RESOLVED"
-	ConditionStatusUnknown  ConditionStatus = "UNKNOWN"
+	ConditionStatusActive     ConditionStatus = "ACTIVE"
+	ConditionStatusInactive   ConditionStatus = "INACTIVE"
+	ConditionStatusResolved   ConditionStatus = "RESOLVED"
+	ConditionStatusUnknown    ConditionStatus = "UNKNOWN"
+	ConditionStatusRecurrence ConditionStatus = "RECURRENCE"
+	ConditionStatusRelapse    ConditionStatus = "RELAPSE"
+)
+
+// IsValid checks if the condition status is valid
+func (c ConditionStatus) IsValid() bool {
+	switch c {
+	case ConditionStatusActive, ConditionStatusInactive, ConditionStatusResolved, ConditionStatusUnknown,
+		ConditionStatusRecurrence, ConditionStatusRelapse:
+		return true
+	}
+
+	return false
+}
+
+// IsActive checks whether a condition with the status is currently affecting the patient.
+// A recurrence or relapse of a condition is active
+func (c ConditionStatus) IsActive() bool {
+	switch c {
+	case ConditionStatusActive, ConditionStatusRecurrence, ConditionStatusRelapse:
+		return true
+	}
+
+	return false
+}
+
+// Code returns the FHIR code of the condition status e.g `resolved`
+func (c ConditionStatus) Code() string {
+	return strings.ToLower(string(c))
+}
+
+// ConditionVerificationStatus represents how certain a clinician is that a patient has a condition
+type ConditionVerificationStatus string
+
+const (
+	ConditionVerificationStatusUnconfirmed    ConditionVerificationStatus = "UNCONFIRMED"
+	ConditionVerificationStatusProvisional    ConditionVerificationStatus = "PROVISIONAL"
+	ConditionVerificationStatusDifferential   ConditionVerificationStatus = "DIFFERENTIAL"
+	ConditionVerificationStatusConfirmed      ConditionVerificationStatus = "CONFIRMED"
+	ConditionVerificationStatusRefuted        ConditionVerificationStatus = "REFUTED"
+	ConditionVerificationStatusEnteredInError ConditionVerificationStatus = "ENTERED_IN_ERROR"
 )
 
+// IsValid checks if the condition verification status is valid
+func (c ConditionVerificationStatus) IsValid() bool {
+	switch c {
+	case ConditionVerificationStatusUnconfirmed, ConditionVerificationStatusProvisional, ConditionVerificationStatusDifferential,
+		ConditionVerificationStatusConfirmed, ConditionVerificationStatusRefuted, ConditionVerificationStatusEnteredInError:
+		return true
+	}
+
+	return false
+}
+
+// String converts the condition verification status to string
+func (c ConditionVerificationStatus) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the condition verification status e.g `entered-in-error`
+func (c ConditionVerificationStatus) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the condition verification status as a quoted string
+func (c ConditionVerificationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a condition verification status enum
+func (c *ConditionVerificationStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = ConditionVerificationStatus(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid ConditionVerificationStatus", str)
+	}
+
+	return nil
+}
+
 // ConditionCategory represents status of a FHIR condition
 type ConditionCategory string
 
@@ -132,6 +407,21 @@ const (
 	ConditionCategoryDiagnosis   ConditionCategory = "ENCOUNTER_DIAGNOSIS"
 )
 
+// IsValid checks if the condition category is valid
+func (c ConditionCategory) IsValid() bool {
+	switch c {
+	case ConditionCategoryProblemList, ConditionCategoryDiagnosis:
+		return true
+	}
+
+	return false
+}
+
+// Code returns the FHIR code of the condition category e.g `problem-list-item`
+func (c ConditionCategory) Code() string {
+	return strings.ToLower(strings.ReplaceAll(string(c), "_", "-"))
+}
+
 // TerminologySource represents various concept sources
 type TerminologySource string
 
@@ -319,6 +609,78 @@ func (c *ConsentDataMeaningEnum) UnmarshalGQL(v interface{}) error {
 	return nil
 }
 
+// ConsentCategoryEnum represents the kind of activity a consent applies to
+type ConsentCategoryEnum string
+
+const (
+	ConsentCategoryDataSharing ConsentCategoryEnum = "DATA_SHARING"
+	ConsentCategoryScreening   ConsentCategoryEnum = "SCREENING"
+	ConsentCategoryResearch    ConsentCategoryEnum = "RESEARCH"
+)
+
+// IsValid checks if the consent category is valid
+func (c ConsentCategoryEnum) IsValid() bool {
+	switch c {
+	case ConsentCategoryDataSharing, ConsentCategoryScreening, ConsentCategoryResearch:
+		return true
+	}
+
+	return false
+}
+
+// String converts the consent category to string
+func (c ConsentCategoryEnum) String() string {
+	return string(c)
+}
+
+// Code returns the code used to store the consent category on a FHIR consent
+func (c ConsentCategoryEnum) Code() string {
+	switch c {
+	case ConsentCategoryDataSharing:
+		return "data-sharing"
+	case ConsentCategoryScreening:
+		return "screening"
+	case ConsentCategoryResearch:
+		return "research"
+	}
+
+	return ""
+}
+
+// Display returns the human readable name of the consent category
+func (c ConsentCategoryEnum) Display() string {
+	switch c {
+	case ConsentCategoryDataSharing:
+		return "Data Sharing"
+	case ConsentCategoryScreening:
+		return "Screening"
+	case ConsentCategoryResearch:
+		return "Research"
+	}
+
+	return ""
+}
+
+// MarshalGQL writes the consent category as a quoted string
+func (c ConsentCategoryEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a consent category enum
+func (c *ConsentCategoryEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = ConsentCategoryEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid ConsentCategoryEnum", str)
+	}
+
+	return nil
+}
+
 // QuestionnaireResponseStatusEnum a type enum tha represents a questionnaire response status field of questionnaire response
 type QuestionnaireResponseStatusEnum string
 
@@ -540,3 +902,1890 @@ const (
 	// TREATMENT refers to treatment
 	TREATMENT ReferralTypeEnum = "TREATMENT"
 )
+
+// MedicationRequestStatusEnum represents the status of a prescription
+type MedicationRequestStatusEnum string
+
+const (
+	MedicationRequestStatusActive         MedicationRequestStatusEnum = "ACTIVE"
+	MedicationRequestStatusOnHold         MedicationRequestStatusEnum = "ON_HOLD"
+	MedicationRequestStatusCancelled      MedicationRequestStatusEnum = "CANCELLED"
+	MedicationRequestStatusCompleted      MedicationRequestStatusEnum = "COMPLETED"
+	MedicationRequestStatusEnteredInError MedicationRequestStatusEnum = "ENTERED_IN_ERROR"
+	MedicationRequestStatusStopped        MedicationRequestStatusEnum = "STOPPED"
+	MedicationRequestStatusDraft          MedicationRequestStatusEnum = "DRAFT"
+	MedicationRequestStatusUnknown        MedicationRequestStatusEnum = "UNKNOWN"
+)
+
+// IsValid checks if the medication request status is valid
+func (c MedicationRequestStatusEnum) IsValid() bool {
+	switch c {
+	case MedicationRequestStatusActive, MedicationRequestStatusOnHold, MedicationRequestStatusCancelled,
+		MedicationRequestStatusCompleted, MedicationRequestStatusEnteredInError, MedicationRequestStatusStopped,
+		MedicationRequestStatusDraft, MedicationRequestStatusUnknown:
+		return true
+	}
+
+	return false
+}
+
+// String converts the medication request status to string
+func (c MedicationRequestStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the medication request status e.g `on-hold`
+func (c MedicationRequestStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the medication request status as a quoted string
+func (c MedicationRequestStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a medication request status enum
+func (c *MedicationRequestStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = MedicationRequestStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid MedicationRequestStatusEnum", str)
+	}
+
+	return nil
+}
+
+// MedicationDispenseStatusEnum represents the status of a medication dispense
+type MedicationDispenseStatusEnum string
+
+const (
+	MedicationDispenseStatusPreparation    MedicationDispenseStatusEnum = "PREPARATION"
+	MedicationDispenseStatusInProgress     MedicationDispenseStatusEnum = "IN_PROGRESS"
+	MedicationDispenseStatusCancelled      MedicationDispenseStatusEnum = "CANCELLED"
+	MedicationDispenseStatusOnHold         MedicationDispenseStatusEnum = "ON_HOLD"
+	MedicationDispenseStatusCompleted      MedicationDispenseStatusEnum = "COMPLETED"
+	MedicationDispenseStatusEnteredInError MedicationDispenseStatusEnum = "ENTERED_IN_ERROR"
+	MedicationDispenseStatusStopped        MedicationDispenseStatusEnum = "STOPPED"
+	MedicationDispenseStatusDeclined       MedicationDispenseStatusEnum = "DECLINED"
+	MedicationDispenseStatusUnknown        MedicationDispenseStatusEnum = "UNKNOWN"
+)
+
+// IsValid checks if the medication dispense status is valid
+func (c MedicationDispenseStatusEnum) IsValid() bool {
+	switch c {
+	case MedicationDispenseStatusPreparation, MedicationDispenseStatusInProgress, MedicationDispenseStatusCancelled,
+		MedicationDispenseStatusOnHold, MedicationDispenseStatusCompleted, MedicationDispenseStatusEnteredInError,
+		MedicationDispenseStatusStopped, MedicationDispenseStatusDeclined, MedicationDispenseStatusUnknown:
+		return true
+	}
+
+	return false
+}
+
+// String converts the medication dispense status to string
+func (c MedicationDispenseStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the medication dispense status e.g `in-progress`
+func (c MedicationDispenseStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the medication dispense status as a quoted string
+func (c MedicationDispenseStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a medication dispense status enum
+func (c *MedicationDispenseStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = MedicationDispenseStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid MedicationDispenseStatusEnum", str)
+	}
+
+	return nil
+}
+
+// MedicationRouteEnum represents the route through which a medication enters the body
+type MedicationRouteEnum string
+
+const (
+	MedicationRouteOral          MedicationRouteEnum = "ORAL"
+	MedicationRouteSublingual    MedicationRouteEnum = "SUBLINGUAL"
+	MedicationRouteIntravenous   MedicationRouteEnum = "INTRAVENOUS"
+	MedicationRouteIntramuscular MedicationRouteEnum = "INTRAMUSCULAR"
+	MedicationRouteSubcutaneous  MedicationRouteEnum = "SUBCUTANEOUS"
+	MedicationRouteTopical       MedicationRouteEnum = "TOPICAL"
+	MedicationRouteRectal        MedicationRouteEnum = "RECTAL"
+	MedicationRouteVaginal       MedicationRouteEnum = "VAGINAL"
+	MedicationRouteNasal         MedicationRouteEnum = "NASAL"
+	MedicationRouteOphthalmic    MedicationRouteEnum = "OPHTHALMIC"
+	MedicationRouteInhalation    MedicationRouteEnum = "INHALATION"
+	MedicationRouteIntradermal   MedicationRouteEnum = "INTRADERMAL"
+)
+
+// IsValid checks if the medication route is valid
+func (c MedicationRouteEnum) IsValid() bool {
+	return c.Code() != ""
+}
+
+// String converts the medication route to string
+func (c MedicationRouteEnum) String() string {
+	return string(c)
+}
+
+// Code returns the SNOMED CT code of the medication route
+func (c MedicationRouteEnum) Code() string {
+	switch c {
+	case MedicationRouteOral:
+		return "26643006"
+	case MedicationRouteSublingual:
+		return "37839007"
+	case MedicationRouteIntravenous:
+		return "47625008"
+	case MedicationRouteIntramuscular:
+		return "78421000"
+	case MedicationRouteSubcutaneous:
+		return "34206005"
+	case MedicationRouteTopical:
+		return "6064005"
+	case MedicationRouteRectal:
+		return "37161004"
+	case MedicationRouteVaginal:
+		return "16857009"
+	case MedicationRouteNasal:
+		return "46713006"
+	case MedicationRouteOphthalmic:
+		return "54485002"
+	case MedicationRouteInhalation:
+		return "447694001"
+	case MedicationRouteIntradermal:
+		return "372464004"
+	}
+
+	return ""
+}
+
+// Display returns the SNOMED CT display of the medication route
+func (c MedicationRouteEnum) Display() string {
+	switch c {
+	case MedicationRouteInhalation:
+		return "Respiratory tract route"
+	case MedicationRouteSublingual, MedicationRouteIntravenous, MedicationRouteIntramuscular, MedicationRouteSubcutaneous,
+		MedicationRouteOral, MedicationRouteTopical, MedicationRouteRectal, MedicationRouteVaginal, MedicationRouteNasal,
+		MedicationRouteOphthalmic, MedicationRouteIntradermal:
+		name := strings.ToLower(c.String())
+
+		return strings.ToUpper(name[:1]) + name[1:] + " route"
+	}
+
+	return ""
+}
+
+// MarshalGQL writes the medication route as a quoted string
+func (c MedicationRouteEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a medication route enum
+func (c *MedicationRouteEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = MedicationRouteEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid MedicationRouteEnum", str)
+	}
+
+	return nil
+}
+
+// TimeUnitEnum represents a unit of time used in dosage instructions
+type TimeUnitEnum string
+
+const (
+	TimeUnitMinutes TimeUnitEnum = "MINUTES"
+	TimeUnitHours   TimeUnitEnum = "HOURS"
+	TimeUnitDays    TimeUnitEnum = "DAYS"
+	TimeUnitWeeks   TimeUnitEnum = "WEEKS"
+	TimeUnitMonths  TimeUnitEnum = "MONTHS"
+)
+
+// IsValid checks if the time unit is valid
+func (c TimeUnitEnum) IsValid() bool {
+	return c.Code() != ""
+}
+
+// String converts the time unit to string
+func (c TimeUnitEnum) String() string {
+	return string(c)
+}
+
+// Code returns the UCUM code of the time unit
+func (c TimeUnitEnum) Code() string {
+	switch c {
+	case TimeUnitMinutes:
+		return "min"
+	case TimeUnitHours:
+		return "h"
+	case TimeUnitDays:
+		return "d"
+	case TimeUnitWeeks:
+		return "wk"
+	case TimeUnitMonths:
+		return "mo"
+	}
+
+	return ""
+}
+
+// MarshalGQL writes the time unit as a quoted string
+func (c TimeUnitEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a time unit enum
+func (c *TimeUnitEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = TimeUnitEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid TimeUnitEnum", str)
+	}
+
+	return nil
+}
+
+// InteractionSeverityEnum represents how clinically significant a medication interaction is
+type InteractionSeverityEnum string
+
+const (
+	InteractionSeverityLow      InteractionSeverityEnum = "LOW"
+	InteractionSeverityModerate InteractionSeverityEnum = "MODERATE"
+	InteractionSeverityHigh     InteractionSeverityEnum = "HIGH"
+)
+
+// IsValid checks if the interaction severity is valid
+func (c InteractionSeverityEnum) IsValid() bool {
+	switch c {
+	case InteractionSeverityLow, InteractionSeverityModerate, InteractionSeverityHigh:
+		return true
+	}
+
+	return false
+}
+
+// String converts the interaction severity to string
+func (c InteractionSeverityEnum) String() string {
+	return string(c)
+}
+
+// MarshalGQL writes the interaction severity as a quoted string
+func (c InteractionSeverityEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to an interaction severity enum
+func (c *InteractionSeverityEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = InteractionSeverityEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid InteractionSeverityEnum", str)
+	}
+
+	return nil
+}
+
+// InteractionActionEnum represents what an interaction finding requires of the prescriber.
+// A warning is informational while a hard stop blocks the medication unless it is overridden with a reason
+type InteractionActionEnum string
+
+const (
+	InteractionActionWarning  InteractionActionEnum = "WARNING"
+	InteractionActionHardStop InteractionActionEnum = "HARD_STOP"
+)
+
+// IsValid checks if the interaction action is valid
+func (c InteractionActionEnum) IsValid() bool {
+	return c == InteractionActionWarning || c == InteractionActionHardStop
+}
+
+// String converts the interaction action to string
+func (c InteractionActionEnum) String() string {
+	return string(c)
+}
+
+// MarshalGQL writes the interaction action as a quoted string
+func (c InteractionActionEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to an interaction action enum
+func (c *InteractionActionEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = InteractionActionEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid InteractionActionEnum", str)
+	}
+
+	return nil
+}
+
+// InteractionTypeEnum represents what a medication was found to interact with
+type InteractionTypeEnum string
+
+const (
+	InteractionTypeDrugDrug    InteractionTypeEnum = "DRUG_DRUG"
+	InteractionTypeDrugAllergy InteractionTypeEnum = "DRUG_ALLERGY"
+)
+
+// IsValid checks if the interaction type is valid
+func (c InteractionTypeEnum) IsValid() bool {
+	return c == InteractionTypeDrugDrug || c == InteractionTypeDrugAllergy
+}
+
+// String converts the interaction type to string
+func (c InteractionTypeEnum) String() string {
+	return string(c)
+}
+
+// MarshalGQL writes the interaction type as a quoted string
+func (c InteractionTypeEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to an interaction type enum
+func (c *InteractionTypeEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = InteractionTypeEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid InteractionTypeEnum", str)
+	}
+
+	return nil
+}
+
+// AdherenceMethodEnum represents how a patient's adherence to a medication was assessed
+type AdherenceMethodEnum string
+
+const (
+	// AdherenceMethodPillCount compares the pills remaining against the pills dispensed
+	AdherenceMethodPillCount AdherenceMethodEnum = "PILL_COUNT"
+	// AdherenceMethodMissedDoses compares the doses the patient reports missing against the expected doses
+	AdherenceMethodMissedDoses AdherenceMethodEnum = "MISSED_DOSES"
+	// AdherenceMethodMMAS4 is the four item Morisky medication adherence questionnaire
+	AdherenceMethodMMAS4 AdherenceMethodEnum = "MMAS_4"
+)
+
+// IsValid checks if the adherence method is valid
+func (c AdherenceMethodEnum) IsValid() bool {
+	switch c {
+	case AdherenceMethodPillCount, AdherenceMethodMissedDoses, AdherenceMethodMMAS4:
+		return true
+	}
+
+	return false
+}
+
+// String converts the adherence method to string
+func (c AdherenceMethodEnum) String() string {
+	return string(c)
+}
+
+// Code returns the code used to record the adherence method e.g `pill-count`
+func (c AdherenceMethodEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the adherence method as a quoted string
+func (c AdherenceMethodEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to an adherence method enum
+func (c *AdherenceMethodEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = AdherenceMethodEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid AdherenceMethodEnum", str)
+	}
+
+	return nil
+}
+
+// AdherenceLevelEnum represents the outcome of an adherence assessment
+type AdherenceLevelEnum string
+
+const (
+	AdherenceLevelGood AdherenceLevelEnum = "GOOD"
+	AdherenceLevelFair AdherenceLevelEnum = "FAIR"
+	AdherenceLevelPoor AdherenceLevelEnum = "POOR"
+)
+
+// IsValid checks if the adherence level is valid
+func (c AdherenceLevelEnum) IsValid() bool {
+	return c == AdherenceLevelGood || c == AdherenceLevelFair || c == AdherenceLevelPoor
+}
+
+// String converts the adherence level to string
+func (c AdherenceLevelEnum) String() string {
+	return string(c)
+}
+
+// Code returns the code used to record the adherence level e.g `good`
+func (c AdherenceLevelEnum) Code() string {
+	return strings.ToLower(c.String())
+}
+
+// MarshalGQL writes the adherence level as a quoted string
+func (c AdherenceLevelEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to an adherence level enum
+func (c *AdherenceLevelEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = AdherenceLevelEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid AdherenceLevelEnum", str)
+	}
+
+	return nil
+}
+
+// MedicationConflictTypeEnum represents a discrepancy found when reconciling a patient's medications
+type MedicationConflictTypeEnum string
+
+const (
+	// MedicationConflictDuplicatePrescription is a medication with more than one active prescription
+	MedicationConflictDuplicatePrescription MedicationConflictTypeEnum = "DUPLICATE_PRESCRIPTION"
+	// MedicationConflictDosageMismatch is a medication recorded with different dosages
+	MedicationConflictDosageMismatch MedicationConflictTypeEnum = "DOSAGE_MISMATCH"
+	// MedicationConflictNotPrescribed is a medication the patient reports taking without an active prescription
+	MedicationConflictNotPrescribed MedicationConflictTypeEnum = "NOT_PRESCRIBED"
+	// MedicationConflictInteraction is a medication that interacts with another of the patient's medications
+	MedicationConflictInteraction MedicationConflictTypeEnum = "INTERACTION"
+)
+
+// IsValid checks if the medication conflict type is valid
+func (c MedicationConflictTypeEnum) IsValid() bool {
+	switch c {
+	case MedicationConflictDuplicatePrescription, MedicationConflictDosageMismatch, MedicationConflictNotPrescribed,
+		MedicationConflictInteraction:
+		return true
+	}
+
+	return false
+}
+
+// String converts the medication conflict type to string
+func (c MedicationConflictTypeEnum) String() string {
+	return string(c)
+}
+
+// MarshalGQL writes the medication conflict type as a quoted string
+func (c MedicationConflictTypeEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a medication conflict type enum
+func (c *MedicationConflictTypeEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = MedicationConflictTypeEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid MedicationConflictTypeEnum", str)
+	}
+
+	return nil
+}
+
+// ImmunizationStatusEnum represents the status of an immunization
+type ImmunizationStatusEnum string
+
+const (
+	ImmunizationStatusCompleted      ImmunizationStatusEnum = "COMPLETED"
+	ImmunizationStatusEnteredInError ImmunizationStatusEnum = "ENTERED_IN_ERROR"
+	ImmunizationStatusNotDone        ImmunizationStatusEnum = "NOT_DONE"
+)
+
+// IsValid checks if the immunization status is valid
+func (c ImmunizationStatusEnum) IsValid() bool {
+	switch c {
+	case ImmunizationStatusCompleted, ImmunizationStatusEnteredInError, ImmunizationStatusNotDone:
+		return true
+	}
+
+	return false
+}
+
+// String converts the immunization status to string
+func (c ImmunizationStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the immunization status e.g `not-done`
+func (c ImmunizationStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the immunization status as a quoted string
+func (c ImmunizationStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to an immunization status enum
+func (c *ImmunizationStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = ImmunizationStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid ImmunizationStatusEnum", str)
+	}
+
+	return nil
+}
+
+// ImmunizationSiteEnum represents the body site where a vaccine was administered
+type ImmunizationSiteEnum string
+
+const (
+	ImmunizationSiteLeftArm              ImmunizationSiteEnum = "LEFT_ARM"
+	ImmunizationSiteRightArm             ImmunizationSiteEnum = "RIGHT_ARM"
+	ImmunizationSiteLeftDeltoid          ImmunizationSiteEnum = "LEFT_DELTOID"
+	ImmunizationSiteRightDeltoid         ImmunizationSiteEnum = "RIGHT_DELTOID"
+	ImmunizationSiteLeftThigh            ImmunizationSiteEnum = "LEFT_THIGH"
+	ImmunizationSiteRightThigh           ImmunizationSiteEnum = "RIGHT_THIGH"
+	ImmunizationSiteLeftVastusLateralis  ImmunizationSiteEnum = "LEFT_VASTUS_LATERALIS"
+	ImmunizationSiteRightVastusLateralis ImmunizationSiteEnum = "RIGHT_VASTUS_LATERALIS"
+)
+
+// IsValid checks if the immunization site is valid
+func (c ImmunizationSiteEnum) IsValid() bool {
+	return c.Code() != ""
+}
+
+// String converts the immunization site to string
+func (c ImmunizationSiteEnum) String() string {
+	return string(c)
+}
+
+// Code returns the HL7 v3 ActSite code of the immunization site e.g `LA`
+func (c ImmunizationSiteEnum) Code() string {
+	switch c {
+	case ImmunizationSiteLeftArm:
+		return "LA"
+	case ImmunizationSiteRightArm:
+		return "RA"
+	case ImmunizationSiteLeftDeltoid:
+		return "LD"
+	case ImmunizationSiteRightDeltoid:
+		return "RD"
+	case ImmunizationSiteLeftThigh:
+		return "LT"
+	case ImmunizationSiteRightThigh:
+		return "RT"
+	case ImmunizationSiteLeftVastusLateralis:
+		return "LVL"
+	case ImmunizationSiteRightVastusLateralis:
+		return "RVL"
+	}
+
+	return ""
+}
+
+// Display returns the HL7 v3 ActSite display of the immunization site e.g `left arm`
+func (c ImmunizationSiteEnum) Display() string {
+	if !c.IsValid() {
+		return ""
+	}
+
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", " "))
+}
+
+// MarshalGQL writes the immunization site as a quoted string
+func (c ImmunizationSiteEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to an immunization site enum
+func (c *ImmunizationSiteEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = ImmunizationSiteEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid ImmunizationSiteEnum", str)
+	}
+
+	return nil
+}
+
+// ImmunizationRecommendationStatusEnum represents where a patient is in a vaccine series of the immunization schedule
+type ImmunizationRecommendationStatusEnum string
+
+const (
+	// ImmunizationRecommendationStatusUpcoming is a dose the patient is not yet old enough, or not yet far enough from the previous dose, to receive
+	ImmunizationRecommendationStatusUpcoming ImmunizationRecommendationStatusEnum = "UPCOMING"
+	// ImmunizationRecommendationStatusDue is a dose that should be given now
+	ImmunizationRecommendationStatusDue ImmunizationRecommendationStatusEnum = "DUE"
+	// ImmunizationRecommendationStatusOverdue is a dose that is past its due date and grace period
+	ImmunizationRecommendationStatusOverdue ImmunizationRecommendationStatusEnum = "OVERDUE"
+	// ImmunizationRecommendationStatusComplete is a vaccine series whose doses have all been given
+	ImmunizationRecommendationStatusComplete ImmunizationRecommendationStatusEnum = "COMPLETE"
+)
+
+// IsValid checks if the immunization recommendation status is valid
+func (c ImmunizationRecommendationStatusEnum) IsValid() bool {
+	switch c {
+	case ImmunizationRecommendationStatusUpcoming, ImmunizationRecommendationStatusDue, ImmunizationRecommendationStatusOverdue,
+		ImmunizationRecommendationStatusComplete:
+		return true
+	}
+
+	return false
+}
+
+// String converts the immunization recommendation status to string
+func (c ImmunizationRecommendationStatusEnum) String() string {
+	return string(c)
+}
+
+// MarshalGQL writes the immunization recommendation status as a quoted string
+func (c ImmunizationRecommendationStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to an immunization recommendation status enum
+func (c *ImmunizationRecommendationStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = ImmunizationRecommendationStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid ImmunizationRecommendationStatusEnum", str)
+	}
+
+	return nil
+}
+
+// LabOrderStatusEnum represents the status of a laboratory test order
+type LabOrderStatusEnum string
+
+const (
+	LabOrderStatusActive    LabOrderStatusEnum = "ACTIVE"
+	LabOrderStatusCompleted LabOrderStatusEnum = "COMPLETED"
+	LabOrderStatusRevoked   LabOrderStatusEnum = "REVOKED"
+)
+
+// IsValid checks if the lab order status is valid
+func (c LabOrderStatusEnum) IsValid() bool {
+	switch c {
+	case LabOrderStatusActive, LabOrderStatusCompleted, LabOrderStatusRevoked:
+		return true
+	}
+
+	return false
+}
+
+// String converts the lab order status to string
+func (c LabOrderStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR service request status of the lab order status e.g `revoked`
+func (c LabOrderStatusEnum) Code() string {
+	return strings.ToLower(c.String())
+}
+
+// MarshalGQL writes the lab order status as a quoted string
+func (c LabOrderStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a lab order status enum
+func (c *LabOrderStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = LabOrderStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid LabOrderStatusEnum", str)
+	}
+
+	return nil
+}
+
+// LabOrderPriorityEnum represents how quickly a laboratory test order should be carried out
+type LabOrderPriorityEnum string
+
+const (
+	LabOrderPriorityRoutine LabOrderPriorityEnum = "ROUTINE"
+	LabOrderPriorityUrgent  LabOrderPriorityEnum = "URGENT"
+	LabOrderPriorityAsap    LabOrderPriorityEnum = "ASAP"
+	LabOrderPriorityStat    LabOrderPriorityEnum = "STAT"
+)
+
+// IsValid checks if the lab order priority is valid
+func (c LabOrderPriorityEnum) IsValid() bool {
+	switch c {
+	case LabOrderPriorityRoutine, LabOrderPriorityUrgent, LabOrderPriorityAsap, LabOrderPriorityStat:
+		return true
+	}
+
+	return false
+}
+
+// String converts the lab order priority to string
+func (c LabOrderPriorityEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR service request priority of the lab order priority e.g `stat`
+func (c LabOrderPriorityEnum) Code() string {
+	return strings.ToLower(c.String())
+}
+
+// MarshalGQL writes the lab order priority as a quoted string
+func (c LabOrderPriorityEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a lab order priority enum
+func (c *LabOrderPriorityEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = LabOrderPriorityEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid LabOrderPriorityEnum", str)
+	}
+
+	return nil
+}
+
+// SpecimenCustodyStatusEnum represents where a specimen is in its chain of custody, from collection to analysis
+type SpecimenCustodyStatusEnum string
+
+const (
+	SpecimenCustodyStatusCollected SpecimenCustodyStatusEnum = "COLLECTED"
+	SpecimenCustodyStatusInTransit SpecimenCustodyStatusEnum = "IN_TRANSIT"
+	SpecimenCustodyStatusReceived  SpecimenCustodyStatusEnum = "RECEIVED"
+	SpecimenCustodyStatusProcessed SpecimenCustodyStatusEnum = "PROCESSED"
+	SpecimenCustodyStatusRejected  SpecimenCustodyStatusEnum = "REJECTED"
+)
+
+// IsValid checks if the specimen custody status is valid
+func (c SpecimenCustodyStatusEnum) IsValid() bool {
+	switch c {
+	case SpecimenCustodyStatusCollected, SpecimenCustodyStatusInTransit, SpecimenCustodyStatusReceived,
+		SpecimenCustodyStatusProcessed, SpecimenCustodyStatusRejected:
+		return true
+	}
+
+	return false
+}
+
+// String converts the specimen custody status to string
+func (c SpecimenCustodyStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the code the specimen custody status is recorded with e.g `in-transit`
+func (c SpecimenCustodyStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the specimen custody status as a quoted string
+func (c SpecimenCustodyStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a specimen custody status enum
+func (c *SpecimenCustodyStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = SpecimenCustodyStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid SpecimenCustodyStatusEnum", str)
+	}
+
+	return nil
+}
+
+// ProcedureStatusEnum represents whether a procedure was performed
+type ProcedureStatusEnum string
+
+const (
+	ProcedureStatusCompleted ProcedureStatusEnum = "COMPLETED"
+	ProcedureStatusNotDone   ProcedureStatusEnum = "NOT_DONE"
+)
+
+// IsValid checks if the procedure status is valid
+func (c ProcedureStatusEnum) IsValid() bool {
+	switch c {
+	case ProcedureStatusCompleted, ProcedureStatusNotDone:
+		return true
+	}
+
+	return false
+}
+
+// String converts the procedure status to string
+func (c ProcedureStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the procedure status e.g `not-done`
+func (c ProcedureStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the procedure status as a quoted string
+func (c ProcedureStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a procedure status enum
+func (c *ProcedureStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = ProcedureStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid ProcedureStatusEnum", str)
+	}
+
+	return nil
+}
+
+// ProcedureOutcomeEnum represents the outcome of a procedure as described in https://hl7.org/fhir/R4/valueset-procedure-outcome.html
+type ProcedureOutcomeEnum string
+
+const (
+	ProcedureOutcomeSuccessful          ProcedureOutcomeEnum = "SUCCESSFUL"
+	ProcedureOutcomePartiallySuccessful ProcedureOutcomeEnum = "PARTIALLY_SUCCESSFUL"
+	ProcedureOutcomeUnsuccessful        ProcedureOutcomeEnum = "UNSUCCESSFUL"
+)
+
+// IsValid checks if the procedure outcome is valid
+func (c ProcedureOutcomeEnum) IsValid() bool {
+	switch c {
+	case ProcedureOutcomeSuccessful, ProcedureOutcomePartiallySuccessful, ProcedureOutcomeUnsuccessful:
+		return true
+	}
+
+	return false
+}
+
+// String converts the procedure outcome to string
+func (c ProcedureOutcomeEnum) String() string {
+	return string(c)
+}
+
+// MarshalGQL writes the procedure outcome as a quoted string
+func (c ProcedureOutcomeEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a procedure outcome enum
+func (c *ProcedureOutcomeEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = ProcedureOutcomeEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid ProcedureOutcomeEnum", str)
+	}
+
+	return nil
+}
+
+// GoalLifecycleStatusEnum represents the lifecycle status of a goal as described in https://hl7.org/fhir/R4/valueset-goal-status.html
+type GoalLifecycleStatusEnum string
+
+const (
+	GoalLifecycleStatusActive    GoalLifecycleStatusEnum = "ACTIVE"
+	GoalLifecycleStatusOnHold    GoalLifecycleStatusEnum = "ON_HOLD"
+	GoalLifecycleStatusCompleted GoalLifecycleStatusEnum = "COMPLETED"
+	GoalLifecycleStatusCancelled GoalLifecycleStatusEnum = "CANCELLED"
+)
+
+// IsValid checks if the goal lifecycle status is valid
+func (c GoalLifecycleStatusEnum) IsValid() bool {
+	switch c {
+	case GoalLifecycleStatusActive, GoalLifecycleStatusOnHold, GoalLifecycleStatusCompleted, GoalLifecycleStatusCancelled:
+		return true
+	}
+
+	return false
+}
+
+// String converts the goal lifecycle status to string
+func (c GoalLifecycleStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the goal lifecycle status e.g `on-hold`
+func (c GoalLifecycleStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the goal lifecycle status as a quoted string
+func (c GoalLifecycleStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a goal lifecycle status enum
+func (c *GoalLifecycleStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = GoalLifecycleStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid GoalLifecycleStatusEnum", str)
+	}
+
+	return nil
+}
+
+// GoalAchievementStatusEnum represents the progress towards a goal as described in https://hl7.org/fhir/R4/valueset-goal-achievement.html
+type GoalAchievementStatusEnum string
+
+const (
+	GoalAchievementStatusInProgress  GoalAchievementStatusEnum = "IN_PROGRESS"
+	GoalAchievementStatusAchieved    GoalAchievementStatusEnum = "ACHIEVED"
+	GoalAchievementStatusNotAchieved GoalAchievementStatusEnum = "NOT_ACHIEVED"
+)
+
+// IsValid checks if the goal achievement status is valid
+func (c GoalAchievementStatusEnum) IsValid() bool {
+	switch c {
+	case GoalAchievementStatusInProgress, GoalAchievementStatusAchieved, GoalAchievementStatusNotAchieved:
+		return true
+	}
+
+	return false
+}
+
+// String converts the goal achievement status to string
+func (c GoalAchievementStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the goal achievement status e.g `not-achieved`
+func (c GoalAchievementStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the goal achievement status as a quoted string
+func (c GoalAchievementStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a goal achievement status enum
+func (c *GoalAchievementStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = GoalAchievementStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid GoalAchievementStatusEnum", str)
+	}
+
+	return nil
+}
+
+// GoalTargetComparatorEnum represents how an observed value is compared to the value a goal targets
+type GoalTargetComparatorEnum string
+
+const (
+	GoalTargetComparatorLessThan             GoalTargetComparatorEnum = "LESS_THAN"
+	GoalTargetComparatorLessThanOrEqualTo    GoalTargetComparatorEnum = "LESS_THAN_OR_EQUAL_TO"
+	GoalTargetComparatorGreaterThanOrEqualTo GoalTargetComparatorEnum = "GREATER_THAN_OR_EQUAL_TO"
+	GoalTargetComparatorGreaterThan          GoalTargetComparatorEnum = "GREATER_THAN"
+)
+
+// goalTargetComparatorCodes are the FHIR quantity comparators of the goal target comparators
+var goalTargetComparatorCodes = map[GoalTargetComparatorEnum]string{
+	GoalTargetComparatorLessThan:             "<",
+	GoalTargetComparatorLessThanOrEqualTo:    "<=",
+	GoalTargetComparatorGreaterThanOrEqualTo: ">=",
+	GoalTargetComparatorGreaterThan:          ">",
+}
+
+// IsValid checks if the goal target comparator is valid
+func (c GoalTargetComparatorEnum) IsValid() bool {
+	_, ok := goalTargetComparatorCodes[c]
+
+	return ok
+}
+
+// String converts the goal target comparator to string
+func (c GoalTargetComparatorEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR quantity comparator of the goal target comparator e.g `<`
+func (c GoalTargetComparatorEnum) Code() string {
+	return goalTargetComparatorCodes[c]
+}
+
+// Compare checks whether an observed value satisfies the comparator against the target value
+func (c GoalTargetComparatorEnum) Compare(observed, target float64) bool {
+	switch c {
+	case GoalTargetComparatorLessThan:
+		return observed < target
+	case GoalTargetComparatorLessThanOrEqualTo:
+		return observed <= target
+	case GoalTargetComparatorGreaterThanOrEqualTo:
+		return observed >= target
+	case GoalTargetComparatorGreaterThan:
+		return observed > target
+	}
+
+	return false
+}
+
+// GoalTargetComparatorFromCode converts a FHIR quantity comparator e.g `<` to its goal target comparator
+func GoalTargetComparatorFromCode(code string) GoalTargetComparatorEnum {
+	for comparator, comparatorCode := range goalTargetComparatorCodes {
+		if comparatorCode == code {
+			return comparator
+		}
+	}
+
+	return ""
+}
+
+// MarshalGQL writes the goal target comparator as a quoted string
+func (c GoalTargetComparatorEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a goal target comparator enum
+func (c *GoalTargetComparatorEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = GoalTargetComparatorEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid GoalTargetComparatorEnum", str)
+	}
+
+	return nil
+}
+
+// CarePlanStatusEnum represents the status of a care plan as described in https://hl7.org/fhir/R4/valueset-request-status.html
+type CarePlanStatusEnum string
+
+const (
+	CarePlanStatusDraft     CarePlanStatusEnum = "DRAFT"
+	CarePlanStatusActive    CarePlanStatusEnum = "ACTIVE"
+	CarePlanStatusOnHold    CarePlanStatusEnum = "ON_HOLD"
+	CarePlanStatusRevoked   CarePlanStatusEnum = "REVOKED"
+	CarePlanStatusCompleted CarePlanStatusEnum = "COMPLETED"
+)
+
+// IsValid checks if the care plan status is valid
+func (c CarePlanStatusEnum) IsValid() bool {
+	switch c {
+	case CarePlanStatusDraft, CarePlanStatusActive, CarePlanStatusOnHold, CarePlanStatusRevoked, CarePlanStatusCompleted:
+		return true
+	}
+
+	return false
+}
+
+// String converts the care plan status to string
+func (c CarePlanStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the care plan status e.g `on-hold`
+func (c CarePlanStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the care plan status as a quoted string
+func (c CarePlanStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a care plan status enum
+func (c *CarePlanStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = CarePlanStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid CarePlanStatusEnum", str)
+	}
+
+	return nil
+}
+
+// CarePlanActivityStatusEnum represents the status of a planned activity as described in https://hl7.org/fhir/R4/valueset-care-plan-activity-status.html
+type CarePlanActivityStatusEnum string
+
+const (
+	CarePlanActivityStatusNotStarted CarePlanActivityStatusEnum = "NOT_STARTED"
+	CarePlanActivityStatusScheduled  CarePlanActivityStatusEnum = "SCHEDULED"
+	CarePlanActivityStatusInProgress CarePlanActivityStatusEnum = "IN_PROGRESS"
+	CarePlanActivityStatusCompleted  CarePlanActivityStatusEnum = "COMPLETED"
+	CarePlanActivityStatusCancelled  CarePlanActivityStatusEnum = "CANCELLED"
+)
+
+// IsValid checks if the care plan activity status is valid
+func (c CarePlanActivityStatusEnum) IsValid() bool {
+	switch c {
+	case CarePlanActivityStatusNotStarted, CarePlanActivityStatusScheduled, CarePlanActivityStatusInProgress,
+		CarePlanActivityStatusCompleted, CarePlanActivityStatusCancelled:
+		return true
+	}
+
+	return false
+}
+
+// String converts the care plan activity status to string
+func (c CarePlanActivityStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the care plan activity status e.g `not-started`
+func (c CarePlanActivityStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the care plan activity status as a quoted string
+func (c CarePlanActivityStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a care plan activity status enum
+func (c *CarePlanActivityStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = CarePlanActivityStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid CarePlanActivityStatusEnum", str)
+	}
+
+	return nil
+}
+
+// SlotStatusEnum represents the availability of a schedule slot as described in https://hl7.org/fhir/R4/valueset-slotstatus.html
+type SlotStatusEnum string
+
+const (
+	SlotStatusFree SlotStatusEnum = "FREE"
+	SlotStatusBusy SlotStatusEnum = "BUSY"
+)
+
+// IsValid checks if the slot status is valid
+func (c SlotStatusEnum) IsValid() bool {
+	switch c {
+	case SlotStatusFree, SlotStatusBusy:
+		return true
+	}
+
+	return false
+}
+
+// String converts the slot status to string
+func (c SlotStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the slot status e.g `free`
+func (c SlotStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the slot status as a quoted string
+func (c SlotStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a slot status enum
+func (c *SlotStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = SlotStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid SlotStatusEnum", str)
+	}
+
+	return nil
+}
+
+// AppointmentStatusEnum represents the status of an appointment as described in https://hl7.org/fhir/R4/valueset-appointmentstatus.html
+type AppointmentStatusEnum string
+
+const (
+	AppointmentStatusBooked    AppointmentStatusEnum = "BOOKED"
+	AppointmentStatusArrived   AppointmentStatusEnum = "ARRIVED"
+	AppointmentStatusFulfilled AppointmentStatusEnum = "FULFILLED"
+	AppointmentStatusCancelled AppointmentStatusEnum = "CANCELLED"
+	AppointmentStatusNoshow    AppointmentStatusEnum = "NOSHOW"
+)
+
+// IsValid checks if the appointment status is valid
+func (c AppointmentStatusEnum) IsValid() bool {
+	switch c {
+	case AppointmentStatusBooked, AppointmentStatusArrived, AppointmentStatusFulfilled, AppointmentStatusCancelled, AppointmentStatusNoshow:
+		return true
+	}
+
+	return false
+}
+
+// String converts the appointment status to string
+func (c AppointmentStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the appointment status e.g `booked`
+func (c AppointmentStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the appointment status as a quoted string
+func (c AppointmentStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a appointment status enum
+func (c *AppointmentStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = AppointmentStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid AppointmentStatusEnum", str)
+	}
+
+	return nil
+}
+
+// AppointmentFilterEnum represents the appointments listed for a facility. Missed appointments are booked appointments whose time has passed without the patient arriving
+type AppointmentFilterEnum string
+
+const (
+	AppointmentFilterUpcoming AppointmentFilterEnum = "UPCOMING"
+	AppointmentFilterMissed   AppointmentFilterEnum = "MISSED"
+)
+
+// IsValid checks if the appointment filter is valid
+func (c AppointmentFilterEnum) IsValid() bool {
+	switch c {
+	case AppointmentFilterUpcoming, AppointmentFilterMissed:
+		return true
+	}
+
+	return false
+}
+
+// String converts the appointment filter to string
+func (c AppointmentFilterEnum) String() string {
+	return string(c)
+}
+
+// MarshalGQL writes the appointment filter as a quoted string
+func (c AppointmentFilterEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a appointment filter enum
+func (c *AppointmentFilterEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = AppointmentFilterEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid AppointmentFilterEnum", str)
+	}
+
+	return nil
+}
+
+// RegistrationBoardEnum represents the regulatory boards that register practitioners in Kenya
+type RegistrationBoardEnum string
+
+const (
+	// RegistrationBoardKMPDC is the Kenya Medical Practitioners and Dentists Council
+	RegistrationBoardKMPDC RegistrationBoardEnum = "KMPDC"
+	// RegistrationBoardNCK is the Nursing Council of Kenya
+	RegistrationBoardNCK RegistrationBoardEnum = "NCK"
+	// RegistrationBoardCOC is the Clinical Officers Council
+	RegistrationBoardCOC RegistrationBoardEnum = "COC"
+	// RegistrationBoardPPB is the Pharmacy and Poisons Board
+	RegistrationBoardPPB RegistrationBoardEnum = "PPB"
+)
+
+// IsValid checks if the registration board is valid
+func (c RegistrationBoardEnum) IsValid() bool {
+	switch c {
+	case RegistrationBoardKMPDC, RegistrationBoardNCK, RegistrationBoardCOC, RegistrationBoardPPB:
+		return true
+	}
+
+	return false
+}
+
+// String converts the registration board to string
+func (c RegistrationBoardEnum) String() string {
+	return string(c)
+}
+
+// MarshalGQL writes the registration board as a quoted string
+func (c RegistrationBoardEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a registration board enum
+func (c *RegistrationBoardEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = RegistrationBoardEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid RegistrationBoardEnum", str)
+	}
+
+	return nil
+}
+
+// LocationStatusEnum represents the operational status of a location as described in https://hl7.org/fhir/R4/valueset-location-status.html
+type LocationStatusEnum string
+
+const (
+	LocationStatusActive    LocationStatusEnum = "ACTIVE"
+	LocationStatusSuspended LocationStatusEnum = "SUSPENDED"
+	LocationStatusInactive  LocationStatusEnum = "INACTIVE"
+)
+
+// IsValid checks if the location status is valid
+func (c LocationStatusEnum) IsValid() bool {
+	switch c {
+	case LocationStatusActive, LocationStatusSuspended, LocationStatusInactive:
+		return true
+	}
+
+	return false
+}
+
+// String converts the location status to string
+func (c LocationStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the location status e.g `active`
+func (c LocationStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the location status as a quoted string
+func (c LocationStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a location status enum
+func (c *LocationStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = LocationStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid LocationStatusEnum", str)
+	}
+
+	return nil
+}
+
+// LocationTypeEnum represents the kind of location within a facility e.g a ward
+type LocationTypeEnum string
+
+const (
+	LocationTypeDepartment LocationTypeEnum = "DEPARTMENT"
+	LocationTypeWard       LocationTypeEnum = "WARD"
+	LocationTypeClinic     LocationTypeEnum = "CLINIC"
+)
+
+// IsValid checks if the location type is valid
+func (c LocationTypeEnum) IsValid() bool {
+	switch c {
+	case LocationTypeDepartment, LocationTypeWard, LocationTypeClinic:
+		return true
+	}
+
+	return false
+}
+
+// String converts the location type to string
+func (c LocationTypeEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the location type e.g `ward`
+func (c LocationTypeEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the location type as a quoted string
+func (c LocationTypeEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a location type enum
+func (c *LocationTypeEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = LocationTypeEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid LocationTypeEnum", str)
+	}
+
+	return nil
+}
+
+// FamilyMemberHistoryStatusEnum represents how complete the recorded history of a relative is
+type FamilyMemberHistoryStatusEnum string
+
+const (
+	FamilyMemberHistoryStatusPartial        FamilyMemberHistoryStatusEnum = "PARTIAL"
+	FamilyMemberHistoryStatusCompleted      FamilyMemberHistoryStatusEnum = "COMPLETED"
+	FamilyMemberHistoryStatusEnteredInError FamilyMemberHistoryStatusEnum = "ENTERED_IN_ERROR"
+	FamilyMemberHistoryStatusHealthUnknown  FamilyMemberHistoryStatusEnum = "HEALTH_UNKNOWN"
+)
+
+// IsValid checks if the family member history status is valid
+func (c FamilyMemberHistoryStatusEnum) IsValid() bool {
+	switch c {
+	case FamilyMemberHistoryStatusPartial, FamilyMemberHistoryStatusCompleted, FamilyMemberHistoryStatusEnteredInError, FamilyMemberHistoryStatusHealthUnknown:
+		return true
+	}
+
+	return false
+}
+
+// String converts the family member history status to string
+func (c FamilyMemberHistoryStatusEnum) String() string {
+	return string(c)
+}
+
+// Code returns the FHIR code of the family member history status e.g `health-unknown`
+func (c FamilyMemberHistoryStatusEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the family member history status as a quoted string
+func (c FamilyMemberHistoryStatusEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a family member history status enum
+func (c *FamilyMemberHistoryStatusEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = FamilyMemberHistoryStatusEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid FamilyMemberHistoryStatusEnum", str)
+	}
+
+	return nil
+}
+
+// FamilyRelationshipEnum represents how a relative is related to the patient
+type FamilyRelationshipEnum string
+
+const (
+	FamilyRelationshipMother      FamilyRelationshipEnum = "MOTHER"
+	FamilyRelationshipFather      FamilyRelationshipEnum = "FATHER"
+	FamilyRelationshipSister      FamilyRelationshipEnum = "SISTER"
+	FamilyRelationshipBrother     FamilyRelationshipEnum = "BROTHER"
+	FamilyRelationshipDaughter    FamilyRelationshipEnum = "DAUGHTER"
+	FamilyRelationshipSon         FamilyRelationshipEnum = "SON"
+	FamilyRelationshipGrandmother FamilyRelationshipEnum = "GRANDMOTHER"
+	FamilyRelationshipGrandfather FamilyRelationshipEnum = "GRANDFATHER"
+	FamilyRelationshipAunt        FamilyRelationshipEnum = "AUNT"
+	FamilyRelationshipUncle       FamilyRelationshipEnum = "UNCLE"
+	FamilyRelationshipCousin      FamilyRelationshipEnum = "COUSIN"
+	FamilyRelationshipNiece       FamilyRelationshipEnum = "NIECE"
+	FamilyRelationshipNephew      FamilyRelationshipEnum = "NEPHEW"
+)
+
+// familyRelationshipRoleCodes are the HL7 v3 RoleCodes of the family relationships
+var familyRelationshipRoleCodes = map[FamilyRelationshipEnum]string{
+	FamilyRelationshipMother:      "MTH",
+	FamilyRelationshipFather:      "FTH",
+	FamilyRelationshipSister:      "SIS",
+	FamilyRelationshipBrother:     "BRO",
+	FamilyRelationshipDaughter:    "DAU",
+	FamilyRelationshipSon:         "SON",
+	FamilyRelationshipGrandmother: "GRMTH",
+	FamilyRelationshipGrandfather: "GRFTH",
+	FamilyRelationshipAunt:        "AUNT",
+	FamilyRelationshipUncle:       "UNCLE",
+	FamilyRelationshipCousin:      "COUSN",
+	FamilyRelationshipNiece:       "NIECE",
+	FamilyRelationshipNephew:      "NEPHEW",
+}
+
+// IsValid checks if the family relationship is valid
+func (c FamilyRelationshipEnum) IsValid() bool {
+	_, ok := familyRelationshipRoleCodes[c]
+
+	return ok
+}
+
+// String converts the family relationship to string
+func (c FamilyRelationshipEnum) String() string {
+	return string(c)
+}
+
+// RoleCode returns the HL7 v3 RoleCode of the family relationship e.g `MTH` for a mother
+func (c FamilyRelationshipEnum) RoleCode() string {
+	return familyRelationshipRoleCodes[c]
+}
+
+// FamilyRelationshipFromRoleCode reads a family relationship from its HL7 v3 RoleCode
+func FamilyRelationshipFromRoleCode(code string) (FamilyRelationshipEnum, bool) {
+	for relationship, roleCode := range familyRelationshipRoleCodes {
+		if strings.EqualFold(roleCode, code) {
+			return relationship, true
+		}
+	}
+
+	return "", false
+}
+
+// MarshalGQL writes the family relationship as a quoted string
+func (c FamilyRelationshipEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a family relationship enum
+func (c *FamilyRelationshipEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = FamilyRelationshipEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid FamilyRelationshipEnum", str)
+	}
+
+	return nil
+}
+
+// ObservationInterpretationEnum flags how a measurement compares to the reference range of the patient e.g a high temperature
+type ObservationInterpretationEnum string
+
+const (
+	ObservationInterpretationNormal       ObservationInterpretationEnum = "NORMAL"
+	ObservationInterpretationHigh         ObservationInterpretationEnum = "HIGH"
+	ObservationInterpretationLow          ObservationInterpretationEnum = "LOW"
+	ObservationInterpretationCriticalHigh ObservationInterpretationEnum = "CRITICAL_HIGH"
+	ObservationInterpretationCriticalLow  ObservationInterpretationEnum = "CRITICAL_LOW"
+)
+
+// observationInterpretationCodes are the HL7 v3 ObservationInterpretation codes of the interpretations
+var observationInterpretationCodes = map[ObservationInterpretationEnum]string{
+	ObservationInterpretationNormal:       "N",
+	ObservationInterpretationHigh:         "H",
+	ObservationInterpretationLow:          "L",
+	ObservationInterpretationCriticalHigh: "HH",
+	ObservationInterpretationCriticalLow:  "LL",
+}
+
+// IsValid checks if the observation interpretation is valid
+func (c ObservationInterpretationEnum) IsValid() bool {
+	_, ok := observationInterpretationCodes[c]
+
+	return ok
+}
+
+// IsAbnormal checks whether the measurement is outside the reference range
+func (c ObservationInterpretationEnum) IsAbnormal() bool {
+	return c.IsValid() && c != ObservationInterpretationNormal
+}
+
+// String converts the observation interpretation to string
+func (c ObservationInterpretationEnum) String() string {
+	return string(c)
+}
+
+// Code returns the HL7 v3 ObservationInterpretation code of the interpretation e.g `HH`
+func (c ObservationInterpretationEnum) Code() string {
+	return observationInterpretationCodes[c]
+}
+
+// ObservationInterpretationFromCode reads an interpretation from its HL7 v3 ObservationInterpretation code
+func ObservationInterpretationFromCode(code string) (ObservationInterpretationEnum, bool) {
+	for interpretation, interpretationCode := range observationInterpretationCodes {
+		if interpretationCode == code {
+			return interpretation, true
+		}
+	}
+
+	return "", false
+}
+
+// MarshalGQL writes the observation interpretation as a quoted string
+func (c ObservationInterpretationEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to an observation interpretation enum
+func (c *ObservationInterpretationEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = ObservationInterpretationEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid ObservationInterpretationEnum", str)
+	}
+
+	return nil
+}
+
+// GrowthIndicatorEnum is an indicator of the WHO Child Growth Standards e.g weight-for-age
+type GrowthIndicatorEnum string
+
+const (
+	GrowthIndicatorWeightForAge    GrowthIndicatorEnum = "WEIGHT_FOR_AGE"
+	GrowthIndicatorHeightForAge    GrowthIndicatorEnum = "HEIGHT_FOR_AGE"
+	GrowthIndicatorWeightForHeight GrowthIndicatorEnum = "WEIGHT_FOR_HEIGHT"
+)
+
+// IsValid checks if the growth indicator is valid
+func (c GrowthIndicatorEnum) IsValid() bool {
+	switch c {
+	case GrowthIndicatorWeightForAge, GrowthIndicatorHeightForAge, GrowthIndicatorWeightForHeight:
+		return true
+	}
+
+	return false
+}
+
+// String converts the growth indicator to string
+func (c GrowthIndicatorEnum) String() string {
+	return string(c)
+}
+
+// Code returns the code the z-scores of the growth indicator are recorded with e.g `weight-for-age`
+func (c GrowthIndicatorEnum) Code() string {
+	return strings.ToLower(strings.ReplaceAll(c.String(), "_", "-"))
+}
+
+// MarshalGQL writes the growth indicator as a quoted string
+func (c GrowthIndicatorEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a growth indicator enum
+func (c *GrowthIndicatorEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = GrowthIndicatorEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid GrowthIndicatorEnum", str)
+	}
+
+	return nil
+}
+
+// MUACClassificationEnum is the acute malnutrition of a child classified from their mid-upper arm circumference
+type MUACClassificationEnum string
+
+const (
+	// MUACClassificationSAM is severe acute malnutrition
+	MUACClassificationSAM MUACClassificationEnum = "SAM"
+	// MUACClassificationMAM is moderate acute malnutrition
+	MUACClassificationMAM    MUACClassificationEnum = "MAM"
+	MUACClassificationNormal MUACClassificationEnum = "NORMAL"
+)
+
+// IsValid checks if the MUAC classification is valid
+func (c MUACClassificationEnum) IsValid() bool {
+	switch c {
+	case MUACClassificationSAM, MUACClassificationMAM, MUACClassificationNormal:
+		return true
+	}
+
+	return false
+}
+
+// String converts the MUAC classification to string
+func (c MUACClassificationEnum) String() string {
+	return string(c)
+}
+
+// MarshalGQL writes the MUAC classification as a quoted string
+func (c MUACClassificationEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to a MUAC classification enum
+func (c *MUACClassificationEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = MUACClassificationEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid MUACClassificationEnum", str)
+	}
+
+	return nil
+}
+
+// EarlyWarningRiskEnum is the clinical risk of deterioration of a patient indicated by an early warning score
+type EarlyWarningRiskEnum string
+
+const (
+	// EarlyWarningRiskLow calls for ward-based monitoring
+	EarlyWarningRiskLow EarlyWarningRiskEnum = "LOW"
+	// EarlyWarningRiskLowMedium is a low score with an extreme value in a single parameter, which calls for an urgent ward-based review
+	EarlyWarningRiskLowMedium EarlyWarningRiskEnum = "LOW_MEDIUM"
+	// EarlyWarningRiskMedium calls for an urgent review by a clinician competent in acute illness
+	EarlyWarningRiskMedium EarlyWarningRiskEnum = "MEDIUM"
+	// EarlyWarningRiskHigh calls for an emergency assessment by a critical care team
+	EarlyWarningRiskHigh EarlyWarningRiskEnum = "HIGH"
+)
+
+var earlyWarningRiskLevels = map[EarlyWarningRiskEnum]int{
+	EarlyWarningRiskLow:       1,
+	EarlyWarningRiskLowMedium: 2,
+	EarlyWarningRiskMedium:    3,
+	EarlyWarningRiskHigh:      4,
+}
+
+// IsValid checks if the early warning risk is valid
+func (c EarlyWarningRiskEnum) IsValid() bool {
+	_, ok := earlyWarningRiskLevels[c]
+
+	return ok
+}
+
+// String converts the early warning risk to string
+func (c EarlyWarningRiskEnum) String() string {
+	return string(c)
+}
+
+// Level orders the early warning risks from low to high. An invalid risk is below all of them
+func (c EarlyWarningRiskEnum) Level() int {
+	return earlyWarningRiskLevels[c]
+}
+
+// MarshalGQL writes the early warning risk as a quoted string
+func (c EarlyWarningRiskEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(c.String()))
+}
+
+// UnmarshalGQL reads a JSON and converts it to an early warning risk enum
+func (c *EarlyWarningRiskEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*c = EarlyWarningRiskEnum(str)
+	if !c.IsValid() {
+		return fmt.Errorf("%s is not a valid EarlyWarningRiskEnum", str)
+	}
+
+	return nil
+}
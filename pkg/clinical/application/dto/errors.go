@@ -1,5 +1,7 @@
 package dto
 
+import "fmt"
+
 // ErrorDetails contains more details about the error that occurred while making a REST API call to FHIR servers
 type ErrorDetails struct {
 	Text string `json:"text"`
@@ -16,3 +18,15 @@ type ErrorIssue struct {
 type ErrorResponse struct {
 	Issue []ErrorIssue `json:"issue"`
 }
+
+// FieldError is an error in the value of an input field e.g a temperature of 370 °C.
+// It is presented with the name of the field so that clients can show it next to the field
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error implements the error interface
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
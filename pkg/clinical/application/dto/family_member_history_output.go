@@ -0,0 +1,61 @@
+package dto
+
+import "github.com/savannahghi/scalarutils"
+
+// FamilyMemberHistory is the record of the conditions a relative of the patient had e.g a mother who had breast cancer
+type FamilyMemberHistory struct {
+	ID        string                        `json:"id"`
+	Status    FamilyMemberHistoryStatusEnum `json:"status"`
+	PatientID string                        `json:"patientID"`
+	// Relationship is empty when the relationship could not be coded e.g a free text answer in a questionnaire
+	Relationship     FamilyRelationshipEnum   `json:"relationship,omitempty"`
+	RelationshipName string                   `json:"relationshipName"`
+	Name             string                   `json:"name,omitempty"`
+	RecordedAt       *scalarutils.DateTime    `json:"recordedAt,omitempty"`
+	Conditions       []*FamilyMemberCondition `json:"conditions"`
+	// QuestionnaireResponseID is the screening questionnaire response the history was extracted from, if any
+	QuestionnaireResponseID string `json:"questionnaireResponseID,omitempty"`
+	Note                    string `json:"note,omitempty"`
+}
+
+// FamilyMemberCondition is a condition a relative of the patient had
+type FamilyMemberCondition struct {
+	Code               string `json:"code,omitempty"`
+	Name               string `json:"name"`
+	OnsetAge           *int   `json:"onsetAge,omitempty"`
+	ContributedToDeath *bool  `json:"contributedToDeath,omitempty"`
+	Note               string `json:"note,omitempty"`
+}
+
+// FamilyMemberHistoryEdge is a family member history edge
+type FamilyMemberHistoryEdge struct {
+	Node   FamilyMemberHistory
+	Cursor string
+}
+
+// FamilyMemberHistoryConnection is a family member history Connection Type
+type FamilyMemberHistoryConnection struct {
+	TotalCount int
+	Edges      []FamilyMemberHistoryEdge
+	PageInfo   PageInfo
+}
+
+// CreateFamilyMemberHistoryConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreateFamilyMemberHistoryConnection(histories []*FamilyMemberHistory, pageInfo PageInfo, total int) FamilyMemberHistoryConnection {
+	connection := FamilyMemberHistoryConnection{
+		TotalCount: total,
+		Edges:      []FamilyMemberHistoryEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, history := range histories {
+		edge := FamilyMemberHistoryEdge{
+			Node:   *history,
+			Cursor: history.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
@@ -0,0 +1,64 @@
+package dto
+
+import "github.com/savannahghi/scalarutils"
+
+// Goal is an intended outcome of a patient's care e.g viral load suppression.
+// Its achievement status is computed from the latest observations of its targets' measures
+type Goal struct {
+	ID                string                    `json:"id"`
+	PatientID         string                    `json:"patientID"`
+	Description       string                    `json:"description"`
+	LifecycleStatus   GoalLifecycleStatusEnum   `json:"lifecycleStatus"`
+	AchievementStatus GoalAchievementStatusEnum `json:"achievementStatus"`
+	StartDate         *scalarutils.Date         `json:"startDate,omitempty"`
+	ConditionIDs      []string                  `json:"conditionIDs"`
+	Targets           []*GoalTarget             `json:"targets"`
+	Note              string                    `json:"note,omitempty"`
+}
+
+// GoalTarget is the value a measure should reach for a goal to be achieved, together with the latest observed value
+type GoalTarget struct {
+	MeasureCode string                   `json:"measureCode"`
+	MeasureName string                   `json:"measureName"`
+	Comparator  GoalTargetComparatorEnum `json:"comparator"`
+	Value       float64                  `json:"value"`
+	Unit        string                   `json:"unit,omitempty"`
+	DueDate     *scalarutils.Date        `json:"dueDate,omitempty"`
+
+	// LatestValue is the value of the latest observation of the measure, if any
+	LatestValue *string `json:"latestValue,omitempty"`
+	Met         bool    `json:"met"`
+}
+
+// GoalEdge is a goal edge
+type GoalEdge struct {
+	Node   Goal
+	Cursor string
+}
+
+// GoalConnection is a goal Connection Type
+type GoalConnection struct {
+	TotalCount int
+	Edges      []GoalEdge
+	PageInfo   PageInfo
+}
+
+// CreateGoalConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreateGoalConnection(goals []*Goal, pageInfo PageInfo, total int) GoalConnection {
+	connection := GoalConnection{
+		TotalCount: total,
+		Edges:      []GoalEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, goal := range goals {
+		edge := GoalEdge{
+			Node:   *goal,
+			Cursor: goal.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
@@ -0,0 +1,43 @@
+package dto
+
+// GrowthChart is the growth of a child plotted against the z-score lines of the growth standards of an indicator
+type GrowthChart struct {
+	PatientID    string               `json:"patientID"`
+	Indicator    GrowthIndicatorEnum  `json:"indicator"`
+	Sex          string               `json:"sex"`
+	Curves       []*GrowthCurve       `json:"curves"`
+	Measurements []*GrowthMeasurement `json:"measurements"`
+}
+
+// GrowthCurve is the z-score lines of a growth standard. The measure is set for the standards of children whose length
+// is taken lying down (`length`) or whose height is taken standing (`height`)
+type GrowthCurve struct {
+	Measure string              `json:"measure,omitempty"`
+	Points  []*GrowthCurvePoint `json:"points"`
+}
+
+// GrowthCurvePoint is the values of an indicator at -3 to +3 z-scores. X is the age in months, or the length or height in cm
+type GrowthCurvePoint struct {
+	X      float64 `json:"x"`
+	SD3Neg float64 `json:"sd3neg"`
+	SD2Neg float64 `json:"sd2neg"`
+	SD1Neg float64 `json:"sd1neg"`
+	Median float64 `json:"median"`
+	SD1    float64 `json:"sd1"`
+	SD2    float64 `json:"sd2"`
+	SD3    float64 `json:"sd3"`
+}
+
+// GrowthMeasurement is a measurement of a child scored against the growth standards.
+// X is the age in months, or the length or height in cm, and the value is the weight in kg or the length or height in cm
+type GrowthMeasurement struct {
+	ObservationIDs []string                       `json:"observationIDs"`
+	EncounterID    string                         `json:"encounterID"`
+	TimeRecorded   string                         `json:"timeRecorded"`
+	Measure        string                         `json:"measure,omitempty"`
+	X              float64                        `json:"x"`
+	Value          float64                        `json:"value"`
+	ZScore         float64                        `json:"zScore"`
+	Percentile     float64                        `json:"percentile"`
+	Flag           *ObservationInterpretationEnum `json:"flag,omitempty"`
+}
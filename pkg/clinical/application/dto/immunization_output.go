@@ -0,0 +1,66 @@
+package dto
+
+import "github.com/savannahghi/scalarutils"
+
+// Immunization is a record of a vaccine given to a patient
+type Immunization struct {
+	ID                 string                 `json:"id"`
+	Status             ImmunizationStatusEnum `json:"status"`
+	PatientID          string                 `json:"patientID"`
+	EncounterID        string                 `json:"encounterID,omitempty"`
+	Vaccine            Medication             `json:"vaccine"`
+	OccurrenceDateTime *scalarutils.DateTime  `json:"occurrenceDateTime,omitempty"`
+	// Reported is set when the vaccine was given elsewhere and transcribed into the patient's record
+	Reported   bool                 `json:"reported"`
+	LotNumber  string               `json:"lotNumber,omitempty"`
+	Site       ImmunizationSiteEnum `json:"site,omitempty"`
+	Route      MedicationRouteEnum  `json:"route,omitempty"`
+	DoseNumber *int                 `json:"doseNumber,omitempty"`
+	Note       string               `json:"note,omitempty"`
+}
+
+// ImmunizationEdge is an immunization edge
+type ImmunizationEdge struct {
+	Node   Immunization
+	Cursor string
+}
+
+// ImmunizationConnection is an immunization Connection Type
+type ImmunizationConnection struct {
+	TotalCount int
+	Edges      []ImmunizationEdge
+	PageInfo   PageInfo
+}
+
+// CreateImmunizationConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreateImmunizationConnection(immunizations []*Immunization, pageInfo PageInfo, total int) ImmunizationConnection {
+	connection := ImmunizationConnection{
+		TotalCount: total,
+		Edges:      []ImmunizationEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, immunization := range immunizations {
+		edge := ImmunizationEdge{
+			Node:   *immunization,
+			Cursor: immunization.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
+
+// ImmunizationRecommendation is where a patient is in a vaccine series of the national immunization schedule
+type ImmunizationRecommendation struct {
+	VaccineCode string                               `json:"vaccineCode"`
+	VaccineName string                               `json:"vaccineName"`
+	Status      ImmunizationRecommendationStatusEnum `json:"status"`
+	// DoseNumber is the next dose of the series or, once the series is complete, its last dose
+	DoseNumber   int               `json:"doseNumber"`
+	SeriesDoses  int               `json:"seriesDoses"`
+	DosesGiven   int               `json:"dosesGiven"`
+	DueDate      *scalarutils.Date `json:"dueDate,omitempty"`
+	LastDoseDate *scalarutils.Date `json:"lastDoseDate,omitempty"`
+}
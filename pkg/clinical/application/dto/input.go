@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"fmt"
 	"mime/multipart"
 	"time"
 
@@ -17,6 +18,10 @@ type OrganizationInput struct {
 	Name        string                   `json:"name,omitempty"`
 	PhoneNumber string                   `json:"phoneNumber,omitempty"`
 	Identifiers []OrganizationIdentifier `json:"identifiers,omitempty"`
+
+	// PartOf is the ID of the organization a facility belongs to e.g the tenant running a program at the facility
+	PartOf string `json:"partOf,omitempty"`
+	County string `json:"county,omitempty"`
 }
 
 type EpisodeOfCareInput struct {
@@ -25,7 +30,8 @@ type EpisodeOfCareInput struct {
 }
 
 type EncounterInput struct {
-	Status EncounterStatusEnum `json:"status"`
+	Status     EncounterStatusEnum `json:"status"`
+	LocationID *string             `json:"locationID"`
 }
 
 // ObservationInput models the observation input
@@ -34,6 +40,10 @@ type ObservationInput struct {
 	EncounterID string            `json:"encounterID,omitempty" validate:"required"`
 	Note        string            `json:"note,omitempty"`
 	Value       string            `json:"value,omitempty"`
+
+	// Unit is the UCUM unit the value was measured in e.g `[lb_av]` or `mg/dL`. Measurements are converted to the unit
+	// their concept is recorded in. When it is not set the value is taken to be in the recorded unit
+	Unit string `json:"unit,omitempty"`
 }
 
 func (o ObservationInput) Validate() error {
@@ -43,6 +53,36 @@ func (o ObservationInput) Validate() error {
 	return err
 }
 
+// BloodPressurePanelInput is a systolic and a diastolic pressure read together.
+// Unit is the UCUM unit both pressures were measured in e.g `kPa`. When it is not set they are taken to be in `mm[Hg]`
+type BloodPressurePanelInput struct {
+	Status      ObservationStatus `json:"status,omitempty" validate:"required"`
+	EncounterID string            `json:"encounterID,omitempty" validate:"required"`
+	Systolic    float64           `json:"systolic" validate:"gt=0"`
+	Diastolic   float64           `json:"diastolic" validate:"gt=0"`
+	Unit        string            `json:"unit,omitempty"`
+	Note        string            `json:"note,omitempty"`
+}
+
+// Validate ensures the input is complete and the systolic pressure is above the diastolic pressure
+func (b BloodPressurePanelInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(b)
+	if err != nil {
+		return err
+	}
+
+	if b.Systolic <= b.Diastolic {
+		return FieldError{
+			Field:   "diastolic",
+			Message: "the diastolic pressure must be below the systolic pressure",
+		}
+	}
+
+	return nil
+}
+
 type PatientInput struct {
 	FirstName   string            `json:"firstName"`
 	LastName    string            `json:"lastName"`
@@ -74,6 +114,46 @@ type ConditionInput struct {
 	OnsetDate   *scalarutils.Date `json:"onsetDate"`
 }
 
+// ConditionUpdateInput is the input used to update the status, category, onset and abatement of a condition.
+// Only the fields that are provided are updated
+type ConditionUpdateInput struct {
+	Status             *ConditionStatus             `json:"status"`
+	VerificationStatus *ConditionVerificationStatus `json:"verificationStatus"`
+	Category           *ConditionCategory           `json:"category"`
+	OnsetDate          *scalarutils.Date            `json:"onsetDate"`
+	AbatementDate      *scalarutils.Date            `json:"abatementDate"`
+	Note               string                       `json:"note"`
+}
+
+// Validate ensures the input is valid
+func (i ConditionUpdateInput) Validate() error {
+	if i.Status != nil && !i.Status.IsValid() {
+		return fmt.Errorf("invalid condition status: %s", *i.Status)
+	}
+
+	if i.VerificationStatus != nil && !i.VerificationStatus.IsValid() {
+		return fmt.Errorf("invalid condition verification status: %s", *i.VerificationStatus)
+	}
+
+	if i.VerificationStatus != nil && *i.VerificationStatus == ConditionVerificationStatusEnteredInError {
+		return fmt.Errorf("use markConditionEnteredInError to mark a condition as entered in error")
+	}
+
+	if i.Category != nil && !i.Category.IsValid() {
+		return fmt.Errorf("invalid condition category: %s", *i.Category)
+	}
+
+	if i.AbatementDate != nil && i.AbatementDate.AsTime().After(time.Now()) {
+		return fmt.Errorf("abatement date cannot be in the future")
+	}
+
+	if i.AbatementDate != nil && i.Status != nil && i.Status.IsActive() {
+		return fmt.Errorf("an active condition cannot have an abatement date")
+	}
+
+	return nil
+}
+
 // AllergyInput models the allergy input
 type AllergyInput struct {
 	PatientID         string            `json:"patientID"`
@@ -98,6 +178,47 @@ type ReactionInput struct {
 	Severity AllergyIntoleranceReactionSeverityEnum `json:"severity"`
 }
 
+// AllergyUpdateInput is the input used to correct an allergy e.g its reactions or to record that it has resolved.
+// Only the fields that are provided are updated and the reactions replace the recorded reactions
+type AllergyUpdateInput struct {
+	ClinicalStatus     *AllergyClinicalStatusEnum     `json:"clinicalStatus"`
+	VerificationStatus *AllergyVerificationStatusEnum `json:"verificationStatus"`
+	Reactions          []*ReactionInput               `json:"reactions"`
+	LastOccurrence     *scalarutils.DateTime          `json:"lastOccurrence"`
+	Note               string                         `json:"note"`
+}
+
+// Validate ensures the input is valid
+func (i AllergyUpdateInput) Validate() error {
+	if i.ClinicalStatus != nil && !i.ClinicalStatus.IsValid() {
+		return fmt.Errorf("invalid allergy clinical status: %s", *i.ClinicalStatus)
+	}
+
+	if i.VerificationStatus != nil && !i.VerificationStatus.IsValid() {
+		return fmt.Errorf("invalid allergy verification status: %s", *i.VerificationStatus)
+	}
+
+	if i.VerificationStatus != nil && *i.VerificationStatus == AllergyVerificationStatusEnteredInError {
+		return fmt.Errorf("use markAllergyIntoleranceEnteredInError to mark an allergy as entered in error")
+	}
+
+	for _, reaction := range i.Reactions {
+		if reaction == nil {
+			continue
+		}
+
+		if reaction.Code == "" {
+			return fmt.Errorf("a reaction requires the code of its manifestation")
+		}
+
+		if reaction.Severity != "" && !reaction.Severity.IsValid() {
+			return fmt.Errorf("invalid reaction severity: %s", reaction.Severity)
+		}
+	}
+
+	return nil
+}
+
 // MediaInput models the dataclass to upload media to FHIR
 type MediaInput struct {
 	EncounterID string                             `json:"encounterID"`
@@ -138,6 +259,10 @@ type ConsentInput struct {
 	Provision   ConsentProvisionTypeEnum `json:"provision,omitempty"`
 	EncounterID string                   `json:"encounterID,omitempty"`
 	DenyReason  string                   `json:"denyReason,omitempty"`
+	Category    ConsentCategoryEnum      `json:"category"`
+	ExpiresAt   *scalarutils.Date        `json:"expiresAt,omitempty"`
+	// ConsentFormMediaID is the ID of the media created when uploading the scanned, signed consent form
+	ConsentFormMediaID *string `json:"consentFormMediaID,omitempty"`
 }
 
 // QuestionnaireResponse models input for questionnaire response resource in fhir
@@ -243,6 +368,8 @@ type DiagnosticReportInput struct {
 	Note        string `json:"note,omitempty"`
 	Media       *Media `json:"media"`
 	Findings    string `json:"findings,omitempty" validate:"required"`
+	// SpecimenID is the specimen the report was made on e.g the tissue sample of a biopsy
+	SpecimenID string `json:"specimenID,omitempty" validate:"omitempty,uuid4"`
 }
 
 func (d DiagnosticReportInput) Validate() error {
@@ -279,3 +406,796 @@ func (r ReferralInput) Validate() error {
 
 	return err
 }
+
+// ServiceAccountInput is the input used to create a service account
+type ServiceAccountInput struct {
+	Name   string   `json:"name" validate:"required"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,required"`
+}
+
+func (s ServiceAccountInput) Validate() error {
+	v := validator.New()
+	err := v.Struct(s)
+
+	return err
+}
+
+// PrescriptionInput is the input used to prescribe a medication in an encounter
+type PrescriptionInput struct {
+	EncounterID       string            `json:"encounterID" validate:"required,uuid4"`
+	MedicationCode    string            `json:"medicationCode" validate:"required"`
+	TerminologySource TerminologySource `json:"terminologySource" validate:"required"`
+	Dosage            *DosageInput      `json:"dosage" validate:"required"`
+	ConditionIDs      []string          `json:"conditionIDs" validate:"omitempty,dive,uuid4"`
+	Quantity          *float64          `json:"quantity" validate:"omitempty,gt=0"`
+	NumberOfRefills   *int              `json:"numberOfRefills" validate:"omitempty,min=0"`
+	Note              string            `json:"note"`
+	// OverrideReason records why the prescriber went ahead despite interactions that would otherwise block the prescription
+	OverrideReason *string `json:"overrideReason"`
+}
+
+// Validate ensures the input is valid
+func (p PrescriptionInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(p)
+	if err != nil {
+		return err
+	}
+
+	return p.Dosage.Validate()
+}
+
+// MedicationDispenseInput is the input used to record the dispensing of a prescription by the pharmacy.
+// The quantity is expressed in the prescription's dose unit and may be less than what was prescribed
+type MedicationDispenseInput struct {
+	PrescriptionID string  `json:"prescriptionID" validate:"required,uuid4"`
+	Quantity       float64 `json:"quantity" validate:"required,gt=0"`
+	DaysSupply     int     `json:"daysSupply" validate:"required,gt=0"`
+	Note           string  `json:"note"`
+}
+
+// Validate ensures the input is valid
+func (m MedicationDispenseInput) Validate() error {
+	v := validator.New()
+	err := v.Struct(m)
+
+	return err
+}
+
+// MedicationStatementInput is the input used to update a medication the patient is recorded to be taking.
+// Only the supplied fields are changed
+type MedicationStatementInput struct {
+	Status *MedicationStatementStatusEnum `json:"status"`
+	Dosage *DosageInput                   `json:"dosage"`
+	Note   *string                        `json:"note"`
+}
+
+// Validate ensures the input is valid
+func (m MedicationStatementInput) Validate() error {
+	if m.Status != nil && !m.Status.IsValid() {
+		return fmt.Errorf("invalid medication statement status: %s", *m.Status)
+	}
+
+	if m.Dosage != nil {
+		err := validator.New().Struct(m.Dosage)
+		if err != nil {
+			return err
+		}
+
+		return m.Dosage.Validate()
+	}
+
+	return nil
+}
+
+// MedicationAdherenceInput is the input used to record an assessment of how well a patient takes a medication.
+// The details of the assessment must match its method e.g a pill count for the PILL_COUNT method
+type MedicationAdherenceInput struct {
+	MedicationStatementID string                       `json:"medicationStatementID" validate:"required,uuid4"`
+	EncounterID           string                       `json:"encounterID" validate:"required,uuid4"`
+	Method                AdherenceMethodEnum          `json:"method" validate:"required"`
+	PillCount             *PillCountInput              `json:"pillCount"`
+	MissedDoses           *MissedDosesInput            `json:"missedDoses"`
+	Questionnaire         *AdherenceQuestionnaireInput `json:"questionnaire"`
+	Note                  string                       `json:"note"`
+}
+
+// PillCountInput is a count of the pills a patient has left since the medication was last dispensed.
+// PillsExpected is the number of pills the patient should have taken in that time
+type PillCountInput struct {
+	PillsDispensed int `json:"pillsDispensed" validate:"required,gt=0"`
+	PillsRemaining int `json:"pillsRemaining" validate:"min=0,ltefield=PillsDispensed"`
+	PillsExpected  int `json:"pillsExpected" validate:"required,gt=0"`
+}
+
+// MissedDosesInput is the number of doses a patient reports missing out of the doses expected over a period
+type MissedDosesInput struct {
+	MissedDoses   int `json:"missedDoses" validate:"min=0,ltefield=ExpectedDoses"`
+	ExpectedDoses int `json:"expectedDoses" validate:"required,gt=0"`
+}
+
+// AdherenceQuestionnaireInput holds the answers to the four item Morisky medication adherence questionnaire.
+// Each question is answered with a yes (true) or a no (false)
+type AdherenceQuestionnaireInput struct {
+	ForgetsToTake          bool `json:"forgetsToTake"`
+	CarelessAboutTaking    bool `json:"carelessAboutTaking"`
+	StopsWhenFeelingBetter bool `json:"stopsWhenFeelingBetter"`
+	StopsWhenFeelingWorse  bool `json:"stopsWhenFeelingWorse"`
+}
+
+// Validate ensures the input is valid and has the details of its method
+func (m MedicationAdherenceInput) Validate() error {
+	if !m.Method.IsValid() {
+		return fmt.Errorf("invalid adherence method: %s", m.Method)
+	}
+
+	v := validator.New()
+
+	err := v.Struct(m)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case m.Method == AdherenceMethodPillCount && m.PillCount == nil:
+		return fmt.Errorf("a pill count is required for the %s method", m.Method)
+	case m.Method == AdherenceMethodMissedDoses && m.MissedDoses == nil:
+		return fmt.Errorf("the missed doses are required for the %s method", m.Method)
+	case m.Method == AdherenceMethodMMAS4 && m.Questionnaire == nil:
+		return fmt.Errorf("the questionnaire answers are required for the %s method", m.Method)
+	}
+
+	return nil
+}
+
+// DosageInput is the structured dosage of a prescribed medication
+// e.g. 500 mg, orally, 3 times every 1 day, for 5 days
+type DosageInput struct {
+	Dose               float64             `json:"dose" validate:"required,gt=0"`
+	DoseUnit           string              `json:"doseUnit" validate:"required"`
+	Route              MedicationRouteEnum `json:"route" validate:"required"`
+	Frequency          int                 `json:"frequency" validate:"required,gt=0"`
+	Period             float64             `json:"period" validate:"required,gt=0"`
+	PeriodUnit         TimeUnitEnum        `json:"periodUnit" validate:"required"`
+	Duration           float64             `json:"duration" validate:"required,gt=0"`
+	DurationUnit       TimeUnitEnum        `json:"durationUnit" validate:"required"`
+	AsNeeded           bool                `json:"asNeeded"`
+	PatientInstruction string              `json:"patientInstruction"`
+}
+
+// Validate ensures the dosage enums are valid
+func (d DosageInput) Validate() error {
+	if !d.Route.IsValid() {
+		return fmt.Errorf("invalid medication route: %s", d.Route)
+	}
+
+	if !d.PeriodUnit.IsValid() {
+		return fmt.Errorf("invalid dosage period unit: %s", d.PeriodUnit)
+	}
+
+	if !d.DurationUnit.IsValid() {
+		return fmt.Errorf("invalid dosage duration unit: %s", d.DurationUnit)
+	}
+
+	return nil
+}
+
+// ImmunizationInput is the input used to record a vaccine given to a patient.
+// Vaccines given elsewhere, e.g. transcribed from a child health card, are recorded as reported together with the date they were given
+type ImmunizationInput struct {
+	EncounterID       string                `json:"encounterID" validate:"required,uuid4"`
+	VaccineCode       string                `json:"vaccineCode" validate:"required"`
+	TerminologySource TerminologySource     `json:"terminologySource" validate:"required"`
+	OccurrenceDate    *scalarutils.Date     `json:"occurrenceDate"`
+	Reported          bool                  `json:"reported"`
+	LotNumber         string                `json:"lotNumber"`
+	Site              *ImmunizationSiteEnum `json:"site"`
+	Route             *MedicationRouteEnum  `json:"route"`
+	DoseNumber        *int                  `json:"doseNumber" validate:"omitempty,gt=0"`
+	Note              string                `json:"note"`
+}
+
+// Validate ensures the input is valid
+func (i ImmunizationInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	if i.Site != nil && !i.Site.IsValid() {
+		return fmt.Errorf("invalid immunization site: %s", *i.Site)
+	}
+
+	if i.Route != nil && !i.Route.IsValid() {
+		return fmt.Errorf("invalid immunization route: %s", *i.Route)
+	}
+
+	if i.OccurrenceDate != nil && i.OccurrenceDate.AsTime().After(time.Now()) {
+		return fmt.Errorf("an immunization cannot be recorded for a future date")
+	}
+
+	return nil
+}
+
+// LabOrderInput is the input used to order a laboratory test for the patient of an encounter.
+// The test is identified by its CIEL concept, the terminology that test results are published with
+type LabOrderInput struct {
+	EncounterID string                `json:"encounterID" validate:"required,uuid4"`
+	TestCode    string                `json:"testCode" validate:"required"`
+	Priority    *LabOrderPriorityEnum `json:"priority"`
+	Note        string                `json:"note"`
+}
+
+// Validate ensures the input is valid
+func (i LabOrderInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	if i.Priority != nil && !i.Priority.IsValid() {
+		return fmt.Errorf("invalid lab order priority: %s", *i.Priority)
+	}
+
+	return nil
+}
+
+// SpecimenInput is the input used to record a specimen collected for a lab order.
+// The specimen type and body site are identified by their CIEL concepts
+type SpecimenInput struct {
+	OrderID         string                `json:"orderID" validate:"required,uuid4"`
+	TypeCode        string                `json:"typeCode" validate:"required"`
+	BodySiteCode    string                `json:"bodySiteCode"`
+	Container       string                `json:"container"`
+	AccessionNumber string                `json:"accessionNumber"`
+	CollectedAt     *scalarutils.DateTime `json:"collectedAt"`
+	Note            string                `json:"note"`
+}
+
+// Validate ensures the input is valid
+func (i SpecimenInput) Validate() error {
+	v := validator.New()
+	err := v.Struct(i)
+
+	return err
+}
+
+// SpecimenCustodyInput is the input used to record a change in the custody of a specimen e.g its receipt at the lab.
+// The accession number is usually assigned by the lab when the specimen is received
+type SpecimenCustodyInput struct {
+	SpecimenID      string                    `json:"specimenID" validate:"required,uuid4"`
+	Status          SpecimenCustodyStatusEnum `json:"status" validate:"required"`
+	AccessionNumber string                    `json:"accessionNumber"`
+	Note            string                    `json:"note"`
+}
+
+// Validate ensures the input is valid
+func (i SpecimenCustodyInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	if !i.Status.IsValid() {
+		return fmt.Errorf("invalid specimen custody status: %s", i.Status)
+	}
+
+	if i.Status == SpecimenCustodyStatusRejected && i.Note == "" {
+		return fmt.Errorf("a note on why the specimen was rejected is required")
+	}
+
+	return nil
+}
+
+// ProcedureInput is the input used to record a procedure performed on the patient of an encounter.
+// The procedure, body site and complications are identified by their CIEL concepts.
+// The finding that led to the procedure e.g a positive VIA screening is referenced by its observation or diagnostic report
+type ProcedureInput struct {
+	EncounterID              string                `json:"encounterID" validate:"required,uuid4"`
+	ProcedureCode            string                `json:"procedureCode" validate:"required"`
+	Status                   *ProcedureStatusEnum  `json:"status"`
+	StatusReason             string                `json:"statusReason"`
+	PerformedAt              *scalarutils.DateTime `json:"performedAt"`
+	Performer                string                `json:"performer"`
+	BodySiteCode             string                `json:"bodySiteCode"`
+	Outcome                  *ProcedureOutcomeEnum `json:"outcome"`
+	ComplicationCodes        []string              `json:"complicationCodes"`
+	ReasonObservationID      string                `json:"reasonObservationID" validate:"omitempty,uuid4"`
+	ReasonDiagnosticReportID string                `json:"reasonDiagnosticReportID" validate:"omitempty,uuid4"`
+	Note                     string                `json:"note"`
+}
+
+// Validate ensures the input is valid
+func (i ProcedureInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	if i.Status != nil && !i.Status.IsValid() {
+		return fmt.Errorf("invalid procedure status: %s", *i.Status)
+	}
+
+	if i.Status != nil && *i.Status == ProcedureStatusNotDone && i.StatusReason == "" {
+		return fmt.Errorf("a reason is required for a procedure that was not done")
+	}
+
+	if i.Outcome != nil && !i.Outcome.IsValid() {
+		return fmt.Errorf("invalid procedure outcome: %s", *i.Outcome)
+	}
+
+	return nil
+}
+
+// GoalInput is the input used to set a goal for a patient e.g viral load suppression.
+// Each target is measured by the observations recorded under its CIEL concept
+type GoalInput struct {
+	PatientID    string             `json:"patientID" validate:"required,uuid4"`
+	Description  string             `json:"description" validate:"required"`
+	ConditionIDs []string           `json:"conditionIDs" validate:"dive,uuid4"`
+	Targets      []*GoalTargetInput `json:"targets" validate:"dive"`
+	StartDate    *scalarutils.Date  `json:"startDate"`
+	Note         string             `json:"note"`
+}
+
+// Validate ensures the input is valid
+func (i GoalInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	return validateGoalTargets(i.Targets)
+}
+
+// GoalTargetInput is the value a measure should reach for a goal to be achieved e.g a systolic blood pressure LESS_THAN 140 mmHg
+type GoalTargetInput struct {
+	MeasureCode string                   `json:"measureCode" validate:"required"`
+	Comparator  GoalTargetComparatorEnum `json:"comparator" validate:"required"`
+	Value       float64                  `json:"value"`
+	Unit        string                   `json:"unit"`
+	DueDate     *scalarutils.Date        `json:"dueDate"`
+}
+
+func validateGoalTargets(targets []*GoalTargetInput) error {
+	for _, target := range targets {
+		if target == nil {
+			return fmt.Errorf("a goal target is required")
+		}
+
+		if !target.Comparator.IsValid() {
+			return fmt.Errorf("invalid goal target comparator: %s", target.Comparator)
+		}
+	}
+
+	return nil
+}
+
+// GoalUpdateInput is the input used to update a goal. Only the provided fields are updated,
+// and provided lists replace the goal's existing ones
+type GoalUpdateInput struct {
+	LifecycleStatus *GoalLifecycleStatusEnum `json:"lifecycleStatus"`
+	Description     *string                  `json:"description"`
+	ConditionIDs    []string                 `json:"conditionIDs" validate:"dive,uuid4"`
+	Targets         []*GoalTargetInput       `json:"targets" validate:"dive"`
+	Note            *string                  `json:"note"`
+}
+
+// Validate ensures the input is valid
+func (i GoalUpdateInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	if i.LifecycleStatus != nil && !i.LifecycleStatus.IsValid() {
+		return fmt.Errorf("invalid goal lifecycle status: %s", *i.LifecycleStatus)
+	}
+
+	if i.Description != nil && *i.Description == "" {
+		return fmt.Errorf("a goal description can not be empty")
+	}
+
+	return validateGoalTargets(i.Targets)
+}
+
+// CarePlanInput is the input used to create a care plan for the patient of an episode of care.
+// The plan addresses the patient's conditions through goals and planned activities
+type CarePlanInput struct {
+	EpisodeOfCareID string                   `json:"episodeOfCareID" validate:"required,uuid4"`
+	Title           string                   `json:"title" validate:"required"`
+	Description     string                   `json:"description"`
+	ConditionIDs    []string                 `json:"conditionIDs" validate:"dive,uuid4"`
+	GoalIDs         []string                 `json:"goalIDs" validate:"dive,uuid4"`
+	Activities      []*CarePlanActivityInput `json:"activities" validate:"dive"`
+	StartDate       *scalarutils.Date        `json:"startDate"`
+	EndDate         *scalarutils.Date        `json:"endDate"`
+}
+
+// Validate ensures the input is valid
+func (i CarePlanInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	if i.StartDate != nil && i.EndDate != nil && i.EndDate.AsTime().Before(i.StartDate.AsTime()) {
+		return fmt.Errorf("a care plan can not end before it starts")
+	}
+
+	return validateCarePlanActivities(i.Activities)
+}
+
+// CarePlanActivityInput is an activity planned for a patient. The activity may be identified by its CIEL concept
+type CarePlanActivityInput struct {
+	Code        string                      `json:"code"`
+	Description string                      `json:"description" validate:"required"`
+	Schedule    string                      `json:"schedule"`
+	Status      *CarePlanActivityStatusEnum `json:"status"`
+}
+
+func validateCarePlanActivities(activities []*CarePlanActivityInput) error {
+	for _, activity := range activities {
+		if activity == nil {
+			return fmt.Errorf("a care plan activity is required")
+		}
+
+		if activity.Status != nil && !activity.Status.IsValid() {
+			return fmt.Errorf("invalid care plan activity status: %s", *activity.Status)
+		}
+	}
+
+	return nil
+}
+
+// CarePlanUpdateInput is the input used to update a care plan. Only the provided fields are updated,
+// and provided lists replace the plan's existing ones
+type CarePlanUpdateInput struct {
+	Status       *CarePlanStatusEnum      `json:"status"`
+	Title        *string                  `json:"title"`
+	Description  *string                  `json:"description"`
+	ConditionIDs []string                 `json:"conditionIDs" validate:"dive,uuid4"`
+	GoalIDs      []string                 `json:"goalIDs" validate:"dive,uuid4"`
+	Activities   []*CarePlanActivityInput `json:"activities" validate:"dive"`
+	EndDate      *scalarutils.Date        `json:"endDate"`
+}
+
+// Validate ensures the input is valid
+func (i CarePlanUpdateInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	if i.Status != nil && !i.Status.IsValid() {
+		return fmt.Errorf("invalid care plan status: %s", *i.Status)
+	}
+
+	if i.Title != nil && *i.Title == "" {
+		return fmt.Errorf("a care plan title can not be empty")
+	}
+
+	return validateCarePlanActivities(i.Activities)
+}
+
+// ScheduleInput is the input used to create the schedule of a facility, or of a practitioner at a facility.
+// The schedule is created for the current facility unless a facility is given
+type ScheduleInput struct {
+	FacilityID       string            `json:"facilityID" validate:"omitempty,uuid4"`
+	PractitionerName string            `json:"practitionerName"`
+	ServiceType      string            `json:"serviceType"`
+	Comment          string            `json:"comment"`
+	StartDate        *scalarutils.Date `json:"startDate"`
+	EndDate          *scalarutils.Date `json:"endDate"`
+}
+
+// Validate ensures the input is valid
+func (i ScheduleInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	if i.StartDate != nil && i.EndDate != nil && i.EndDate.AsTime().Before(i.StartDate.AsTime()) {
+		return fmt.Errorf("a schedule can not end before it starts")
+	}
+
+	return nil
+}
+
+// maxScheduleSlots is the most slots that can be created in a schedule at once e.g a day of 15 minute slots
+const maxScheduleSlots = 96
+
+// SlotsInput is the input used to divide a period of a schedule into slots of the given duration
+type SlotsInput struct {
+	ScheduleID      string               `json:"scheduleID" validate:"required,uuid4"`
+	Start           scalarutils.DateTime `json:"start" validate:"required"`
+	End             scalarutils.DateTime `json:"end" validate:"required"`
+	DurationMinutes int                  `json:"durationMinutes" validate:"required,min=5"`
+}
+
+// Validate ensures the input is valid
+func (i SlotsInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	start, end, err := i.Period()
+	if err != nil {
+		return err
+	}
+
+	if !end.After(start) {
+		return fmt.Errorf("slots must end after they start")
+	}
+
+	if int(end.Sub(start)/time.Minute)/i.DurationMinutes > maxScheduleSlots {
+		return fmt.Errorf("at most %d slots can be created at once", maxScheduleSlots)
+	}
+
+	return nil
+}
+
+// Period reads the start and end of the period divided into slots
+func (i SlotsInput) Period() (time.Time, time.Time, error) {
+	start, err := time.Parse(time.RFC3339, string(i.Start))
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid slots start: %w", err)
+	}
+
+	end, err := time.Parse(time.RFC3339, string(i.End))
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid slots end: %w", err)
+	}
+
+	return start, end, nil
+}
+
+// AppointmentInput is the input used to book a patient into a free slot.
+// The appointment may be linked to the service request it fulfils e.g a referral
+type AppointmentInput struct {
+	PatientID        string `json:"patientID" validate:"required,uuid4"`
+	SlotID           string `json:"slotID" validate:"required,uuid4"`
+	ServiceRequestID string `json:"serviceRequestID" validate:"omitempty,uuid4"`
+	Description      string `json:"description"`
+	Comment          string `json:"comment"`
+}
+
+// Validate ensures the input is valid
+func (i AppointmentInput) Validate() error {
+	v := validator.New()
+
+	return v.Struct(i)
+}
+
+// QualificationInput is a qualification obtained by a practitioner e.g a Diploma in Clinical Medicine
+type QualificationInput struct {
+	Name      string            `json:"name" validate:"required"`
+	Issuer    string            `json:"issuer"`
+	AwardedOn *scalarutils.Date `json:"awardedOn"`
+}
+
+// PractitionerRegistrationInput is the number a practitioner is registered under by their regulatory board
+type PractitionerRegistrationInput struct {
+	Board  RegistrationBoardEnum `json:"board" validate:"required"`
+	Number string                `json:"number" validate:"required"`
+}
+
+// validatePractitionerRegistrations ensures a practitioner is registered at most once with each board
+func validatePractitionerRegistrations(registrations []*PractitionerRegistrationInput) error {
+	boards := map[RegistrationBoardEnum]bool{}
+
+	for _, registration := range registrations {
+		if registration == nil {
+			continue
+		}
+
+		if !registration.Board.IsValid() {
+			return fmt.Errorf("invalid registration board: %s", registration.Board)
+		}
+
+		if boards[registration.Board] {
+			return fmt.Errorf("a practitioner can only have one %s registration number", registration.Board)
+		}
+
+		boards[registration.Board] = true
+	}
+
+	return nil
+}
+
+// PractitionerInput is the input used by an administrator to register a practitioner.
+// The user ID links the practitioner to the user they sign in as
+type PractitionerInput struct {
+	Name           string                           `json:"name" validate:"required"`
+	PhoneNumber    string                           `json:"phoneNumber"`
+	Email          string                           `json:"email" validate:"omitempty,email"`
+	UserID         string                           `json:"userID"`
+	Qualifications []*QualificationInput            `json:"qualifications" validate:"dive"`
+	Registrations  []*PractitionerRegistrationInput `json:"registrations" validate:"dive"`
+}
+
+// Validate ensures the input is valid
+func (i PractitionerInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	return validatePractitionerRegistrations(i.Registrations)
+}
+
+// PractitionerProfileInput is the input used by a signed in user to register themselves as a practitioner.
+// Their name and contacts are read from their sign in identity
+type PractitionerProfileInput struct {
+	Qualifications []*QualificationInput            `json:"qualifications" validate:"dive"`
+	Registrations  []*PractitionerRegistrationInput `json:"registrations" validate:"dive"`
+}
+
+// Validate ensures the input is valid
+func (i PractitionerProfileInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	return validatePractitionerRegistrations(i.Registrations)
+}
+
+// PractitionerUpdateInput is the input used to update a practitioner. Qualifications and registrations, when given, replace the existing ones
+type PractitionerUpdateInput struct {
+	Active         *bool                            `json:"active"`
+	PhoneNumber    *string                          `json:"phoneNumber"`
+	Email          *string                          `json:"email" validate:"omitempty,email"`
+	Qualifications []*QualificationInput            `json:"qualifications" validate:"omitempty,dive"`
+	Registrations  []*PractitionerRegistrationInput `json:"registrations" validate:"omitempty,dive"`
+}
+
+// Validate ensures the input is valid
+func (i PractitionerUpdateInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	return validatePractitionerRegistrations(i.Registrations)
+}
+
+// PractitionerRoleInput is the input used to assign a practitioner to a facility in a role e.g a Clinical Officer.
+// The practitioner is assigned to the current facility unless a facility is given
+type PractitionerRoleInput struct {
+	PractitionerID string            `json:"practitionerID" validate:"required,uuid4"`
+	FacilityID     string            `json:"facilityID" validate:"omitempty,uuid4"`
+	Role           string            `json:"role" validate:"required"`
+	StartDate      *scalarutils.Date `json:"startDate"`
+}
+
+// Validate ensures the input is valid
+func (i PractitionerRoleInput) Validate() error {
+	v := validator.New()
+
+	return v.Struct(i)
+}
+
+// LocationInput is the input used to create a department, ward or clinic of a facility.
+// The location is created in the current facility unless a facility is given
+type LocationInput struct {
+	Name        string           `json:"name" validate:"required"`
+	Description string           `json:"description"`
+	Type        LocationTypeEnum `json:"type" validate:"required"`
+	FacilityID  string           `json:"facilityID" validate:"omitempty,uuid4"`
+	PartOf      string           `json:"partOf" validate:"omitempty,uuid4"`
+	County      string           `json:"county"`
+	SubCounty   string           `json:"subCounty"`
+	Ward        string           `json:"ward"`
+}
+
+// Validate ensures the input is valid
+func (i LocationInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	if !i.Type.IsValid() {
+		return fmt.Errorf("invalid location type: %s", i.Type)
+	}
+
+	return nil
+}
+
+// LocationUpdateInput is the input used to rename a location, change its status or update its admin units
+type LocationUpdateInput struct {
+	Name        *string             `json:"name"`
+	Description *string             `json:"description"`
+	Status      *LocationStatusEnum `json:"status"`
+	County      *string             `json:"county"`
+	SubCounty   *string             `json:"subCounty"`
+	Ward        *string             `json:"ward"`
+}
+
+// Validate ensures the input is valid
+func (i LocationUpdateInput) Validate() error {
+	if i.Name != nil && *i.Name == "" {
+		return fmt.Errorf("a location name can not be empty")
+	}
+
+	if i.Status != nil && !i.Status.IsValid() {
+		return fmt.Errorf("invalid location status: %s", *i.Status)
+	}
+
+	return nil
+}
+
+// FamilyMemberHistoryInput is the input used to record the conditions a relative of the patient had.
+// Each condition is identified by its CIEL concept
+type FamilyMemberHistoryInput struct {
+	PatientID    string                         `json:"patientID" validate:"required,uuid4"`
+	Relationship FamilyRelationshipEnum         `json:"relationship" validate:"required"`
+	Name         string                         `json:"name"`
+	Status       *FamilyMemberHistoryStatusEnum `json:"status"`
+	Conditions   []*FamilyMemberConditionInput  `json:"conditions" validate:"dive"`
+	Note         string                         `json:"note"`
+}
+
+// FamilyMemberConditionInput is a condition a relative of the patient had and the age in years it started at
+type FamilyMemberConditionInput struct {
+	ConditionCode      string `json:"conditionCode" validate:"required"`
+	OnsetAge           *int   `json:"onsetAge" validate:"omitempty,min=0,max=150"`
+	ContributedToDeath *bool  `json:"contributedToDeath"`
+	Note               string `json:"note"`
+}
+
+// Validate ensures the input is valid
+func (i FamilyMemberHistoryInput) Validate() error {
+	v := validator.New()
+
+	err := v.Struct(i)
+	if err != nil {
+		return err
+	}
+
+	if !i.Relationship.IsValid() {
+		return fmt.Errorf("invalid family relationship: %s", i.Relationship)
+	}
+
+	if i.Status != nil && !i.Status.IsValid() {
+		return fmt.Errorf("invalid family member history status: %s", *i.Status)
+	}
+
+	if i.Status != nil && *i.Status == FamilyMemberHistoryStatusEnteredInError {
+		return fmt.Errorf("a family member history can not be recorded as entered in error")
+	}
+
+	return nil
+}
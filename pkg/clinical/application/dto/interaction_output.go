@@ -0,0 +1,11 @@
+package dto
+
+// InteractionFinding is a potential interaction between a medication and the patient's allergies or current medications
+type InteractionFinding struct {
+	Type        InteractionTypeEnum     `json:"type"`
+	Severity    InteractionSeverityEnum `json:"severity"`
+	Action      InteractionActionEnum   `json:"action"`
+	Description string                  `json:"description"`
+	// InteractsWith is the name of the allergy or medication that the medication interacts with
+	InteractsWith string `json:"interactsWith"`
+}
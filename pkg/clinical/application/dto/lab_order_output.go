@@ -0,0 +1,51 @@
+package dto
+
+import "github.com/savannahghi/scalarutils"
+
+// LabOrder is a laboratory test ordered for a patient together with the results received for it
+type LabOrder struct {
+	ID          string                `json:"id"`
+	Status      LabOrderStatusEnum    `json:"status"`
+	Priority    LabOrderPriorityEnum  `json:"priority"`
+	TestCode    string                `json:"testCode"`
+	TestName    string                `json:"testName"`
+	PatientID   string                `json:"patientID"`
+	EncounterID string                `json:"encounterID,omitempty"`
+	AuthoredOn  *scalarutils.DateTime `json:"authoredOn,omitempty"`
+	Note        string                `json:"note,omitempty"`
+	Results     []*Observation        `json:"results"`
+	SpecimenIDs []string              `json:"specimenIDs"`
+}
+
+// LabOrderEdge is a lab order edge
+type LabOrderEdge struct {
+	Node   LabOrder
+	Cursor string
+}
+
+// LabOrderConnection is a lab order Connection Type
+type LabOrderConnection struct {
+	TotalCount int
+	Edges      []LabOrderEdge
+	PageInfo   PageInfo
+}
+
+// CreateLabOrderConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreateLabOrderConnection(orders []*LabOrder, pageInfo PageInfo, total int) LabOrderConnection {
+	connection := LabOrderConnection{
+		TotalCount: total,
+		Edges:      []LabOrderEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, order := range orders {
+		edge := LabOrderEdge{
+			Node:   *order,
+			Cursor: order.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
@@ -0,0 +1,48 @@
+package dto
+
+// Location is a department, ward or clinic of a facility
+type Location struct {
+	ID          string             `json:"id"`
+	Status      LocationStatusEnum `json:"status"`
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Type        LocationTypeEnum   `json:"type,omitempty"`
+	FacilityID  string             `json:"facilityID,omitempty"`
+	PartOf      string             `json:"partOf,omitempty"`
+	County      string             `json:"county,omitempty"`
+	SubCounty   string             `json:"subCounty,omitempty"`
+	Ward        string             `json:"ward,omitempty"`
+}
+
+// LocationEdge is a location edge
+type LocationEdge struct {
+	Node   Location
+	Cursor string
+}
+
+// LocationConnection is a location Connection Type
+type LocationConnection struct {
+	TotalCount int
+	Edges      []LocationEdge
+	PageInfo   PageInfo
+}
+
+// CreateLocationConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreateLocationConnection(locations []*Location, pageInfo PageInfo, total int) LocationConnection {
+	connection := LocationConnection{
+		TotalCount: total,
+		Edges:      []LocationEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, location := range locations {
+		edge := LocationEdge{
+			Node:   *location,
+			Cursor: location.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
@@ -0,0 +1,62 @@
+package dto
+
+import "github.com/savannahghi/scalarutils"
+
+// MedicationDispense is a record of a medication supplied to a patient against a prescription
+type MedicationDispense struct {
+	ID             string                       `json:"id"`
+	Status         MedicationDispenseStatusEnum `json:"status"`
+	PrescriptionID string                       `json:"prescriptionID"`
+	PatientID      string                       `json:"patientID"`
+	Medication     Medication                   `json:"medication"`
+	Quantity       float64                      `json:"quantity"`
+	QuantityUnit   string                       `json:"quantityUnit"`
+	DaysSupply     int                          `json:"daysSupply"`
+	// Partial is set when the dispense supplies less than a full fill of the prescription
+	Partial        bool                  `json:"partial"`
+	Refill         bool                  `json:"refill"`
+	WhenHandedOver *scalarutils.DateTime `json:"whenHandedOver,omitempty"`
+	Note           string                `json:"note,omitempty"`
+}
+
+// PharmacyWorklistItem is a prescription awaiting dispensing together with what has been dispensed so far
+type PharmacyWorklistItem struct {
+	Prescription      Prescription `json:"prescription"`
+	DispensedQuantity float64      `json:"dispensedQuantity"`
+	// RemainingQuantity is not set when the prescription does not specify a quantity
+	RemainingQuantity *float64              `json:"remainingQuantity,omitempty"`
+	LastDispensedOn   *scalarutils.DateTime `json:"lastDispensedOn,omitempty"`
+}
+
+// PharmacyWorklistEdge is a pharmacy worklist edge
+type PharmacyWorklistEdge struct {
+	Node   PharmacyWorklistItem
+	Cursor string
+}
+
+// PharmacyWorklistConnection is a pharmacy worklist Connection Type
+type PharmacyWorklistConnection struct {
+	TotalCount int
+	Edges      []PharmacyWorklistEdge
+	PageInfo   PageInfo
+}
+
+// CreatePharmacyWorklistConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreatePharmacyWorklistConnection(items []*PharmacyWorklistItem, pageInfo PageInfo, total int) PharmacyWorklistConnection {
+	connection := PharmacyWorklistConnection{
+		TotalCount: total,
+		Edges:      []PharmacyWorklistEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, item := range items {
+		edge := PharmacyWorklistEdge{
+			Node:   *item,
+			Cursor: item.Prescription.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
@@ -0,0 +1,70 @@
+package dto
+
+// MedicationStatementEdge is a medication statement edge
+type MedicationStatementEdge struct {
+	Node   MedicationStatement
+	Cursor string
+}
+
+// MedicationStatementConnection is a MedicationStatement Connection Type
+type MedicationStatementConnection struct {
+	TotalCount int
+	Edges      []MedicationStatementEdge
+	PageInfo   PageInfo
+}
+
+// CreateMedicationStatementConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreateMedicationStatementConnection(statements []*MedicationStatement, pageInfo PageInfo, total int) MedicationStatementConnection {
+	connection := MedicationStatementConnection{
+		TotalCount: total,
+		Edges:      []MedicationStatementEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, statement := range statements {
+		edge := MedicationStatementEdge{
+			Node:   *statement,
+			Cursor: statement.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
+
+// MedicationAdherence is an assessment of how well a patient takes a medication.
+// The score is the percentage of doses taken for pill counts and missed doses, and the number of yes answers for the questionnaire
+type MedicationAdherence struct {
+	ID                    string              `json:"id"`
+	MedicationStatementID string              `json:"medicationStatementID"`
+	PatientID             string              `json:"patientID"`
+	EncounterID           string              `json:"encounterID"`
+	Method                AdherenceMethodEnum `json:"method"`
+	Score                 float64             `json:"score"`
+	Level                 AdherenceLevelEnum  `json:"level"`
+	TimeRecorded          string              `json:"timeRecorded,omitempty"`
+	Note                  string              `json:"note,omitempty"`
+}
+
+// MedicationReconciliation is a patient's current medication list compiled from their prescriptions and medication statements
+type MedicationReconciliation struct {
+	PatientID    string                  `json:"patientID"`
+	Medications  []*ReconciledMedication `json:"medications"`
+	HasConflicts bool                    `json:"hasConflicts"`
+}
+
+// ReconciledMedication is a medication in the patient's current medication list and the records it was compiled from
+type ReconciledMedication struct {
+	Medication             Medication           `json:"medication"`
+	PrescriptionIDs        []string             `json:"prescriptionIDs"`
+	MedicationStatementIDs []string             `json:"medicationStatementIDs"`
+	Dosages                []string             `json:"dosages"`
+	Conflicts              []MedicationConflict `json:"conflicts"`
+}
+
+// MedicationConflict is a discrepancy in a patient's medication records that needs to be reviewed
+type MedicationConflict struct {
+	Type        MedicationConflictTypeEnum `json:"type"`
+	Description string                     `json:"description"`
+}
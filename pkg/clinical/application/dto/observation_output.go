@@ -11,6 +11,40 @@ type Observation struct {
 	TimeRecorded   string            `json:"timeRecorded,omitempty"`
 	Interpretation []string          `json:"interpretation,omitempty"`
 	Note           string            `json:"note,omitempty"`
+
+	// Quantity and Unit are set for measurements e.g a weight of 70 kg. Unit is the UCUM code of the unit
+	Quantity *float64 `json:"quantity,omitempty"`
+	Unit     string   `json:"unit,omitempty"`
+
+	// Flag is how a measurement compares to the reference range of the patient. It is not set for observations that are not measured
+	Flag     *ObservationInterpretationEnum `json:"flag,omitempty"`
+	Abnormal bool                           `json:"abnormal"`
+
+	// DerivedFrom are the IDs of the observations a derived observation was worked out from e.g the height and weight of a BMI
+	DerivedFrom []string `json:"derivedFrom,omitempty"`
+}
+
+// BloodPressureReading is a systolic and a diastolic pressure read together. Readings that were recorded as separate systolic
+// and diastolic observations are paired by encounter and time, and either pressure is not set when only one of them was captured
+type BloodPressureReading struct {
+	ID             string   `json:"id"`
+	ObservationIDs []string `json:"observationIDs"`
+	PatientID      string   `json:"patientID"`
+	EncounterID    string   `json:"encounterID,omitempty"`
+	TimeRecorded   string   `json:"timeRecorded,omitempty"`
+	Note           string   `json:"note,omitempty"`
+
+	// Systolic and Diastolic are in Unit, the UCUM code of the unit e.g `mm[Hg]`
+	Systolic  *float64 `json:"systolic,omitempty"`
+	Diastolic *float64 `json:"diastolic,omitempty"`
+	Unit      string   `json:"unit,omitempty"`
+
+	SystolicFlag  *ObservationInterpretationEnum `json:"systolicFlag,omitempty"`
+	DiastolicFlag *ObservationInterpretationEnum `json:"diastolicFlag,omitempty"`
+	Abnormal      bool                           `json:"abnormal"`
+
+	// Paired is set for readings that were recorded as separate systolic and diastolic observations
+	Paired bool `json:"paired"`
 }
 
 // ObservationEdge is an observation edge
@@ -18,6 +18,8 @@ type Organization struct {
 	Name         string                   `json:"name"`
 	Identifiers  []OrganizationIdentifier `json:"identifiers"`
 	PhoneNumbers []string                 `json:"phoneNumbers"`
+	PartOf       string                   `json:"partOf,omitempty"`
+	County       string                   `json:"county,omitempty"`
 }
 
 type EpisodeOfCare struct {
@@ -39,9 +41,14 @@ type TimelineResource struct {
 	ResourceType ResourceType     `json:"resourceType"`
 	Name         string           `json:"name"`
 	Value        string           `json:"value"`
+	Unit         string           `json:"unit,omitempty"`
 	Status       string           `json:"status"`
 	Date         scalarutils.Date `json:"date"`
 	TimeRecorded time.Time        `json:"timeRecorded"`
+
+	// Flag and Abnormal are set for observations that were compared to a reference range
+	Flag     *ObservationInterpretationEnum `json:"flag,omitempty"`
+	Abnormal bool                           `json:"abnormal"`
 }
 
 // HealthTimeline represents a health timeline containing various FHIR resources
@@ -58,20 +65,26 @@ type Medication struct {
 
 // MedicationStatement is a minimal representation of a fhir MedicationStatement
 type MedicationStatement struct {
-	ID         string                        `json:"id"`
-	Status     MedicationStatementStatusEnum `json:"status"`
-	Medication Medication                    `json:"medication"`
-	PatientID  string                        `json:"patientID"`
+	ID           string                        `json:"id"`
+	Status       MedicationStatementStatusEnum `json:"status"`
+	StatusReason string                        `json:"statusReason,omitempty"`
+	Medication   Medication                    `json:"medication"`
+	Dosage       *Dosage                       `json:"dosage,omitempty"`
+	Note         string                        `json:"note,omitempty"`
+	PatientID    string                        `json:"patientID"`
 }
 
 // MedicalData is a minimal representation of a fhir MedicalData
 type MedicalData struct {
-	Regimen   []*MedicationStatement
-	Allergies []*Allergy
-	Weight    []*Observation
-	BMI       []*Observation
-	ViralLoad []*Observation
-	CD4Count  []*Observation
+	Regimen    []*MedicationStatement
+	Allergies  []*Allergy
+	Weight     []*Observation
+	BMI        []*Observation
+	ViralLoad  []*Observation
+	CD4Count   []*Observation
+	Procedures []*Procedure
+	// FamilyHistory is the recorded conditions of the patient's relatives
+	FamilyHistory []*FamilyMemberHistory
 }
 
 type Patient struct {
@@ -158,7 +171,11 @@ type Section struct {
 
 // Consent models a fhir consent resource
 type ConsentOutput struct {
-	Status *ConsentStatusEnum `json:"status"`
+	ID             string               `json:"id"`
+	Status         *ConsentStatusEnum   `json:"status"`
+	Category       *ConsentCategoryEnum `json:"category,omitempty"`
+	Provision      *ConsentProvision    `json:"provision,omitempty"`
+	ConsentFormURL *string              `json:"consentFormURL,omitempty"`
 }
 
 // PatientEverythingConnection return a paginated collection of patient information
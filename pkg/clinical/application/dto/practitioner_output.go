@@ -0,0 +1,73 @@
+package dto
+
+import "github.com/savannahghi/scalarutils"
+
+// Qualification is a qualification obtained by a practitioner
+type Qualification struct {
+	Name      string            `json:"name"`
+	Issuer    string            `json:"issuer,omitempty"`
+	AwardedOn *scalarutils.Date `json:"awardedOn,omitempty"`
+}
+
+// PractitionerRegistration is the number a practitioner is registered under by their regulatory board
+type PractitionerRegistration struct {
+	Board  RegistrationBoardEnum `json:"board"`
+	Number string                `json:"number"`
+}
+
+// Practitioner is a health worker who provides care to patients
+type Practitioner struct {
+	ID             string                      `json:"id"`
+	Active         bool                        `json:"active"`
+	Name           string                      `json:"name"`
+	PhoneNumber    string                      `json:"phoneNumber,omitempty"`
+	Email          string                      `json:"email,omitempty"`
+	UserID         string                      `json:"userID,omitempty"`
+	Qualifications []*Qualification            `json:"qualifications,omitempty"`
+	Registrations  []*PractitionerRegistration `json:"registrations,omitempty"`
+}
+
+// PractitionerRole is the role a practitioner is assigned at a facility
+type PractitionerRole struct {
+	ID               string            `json:"id"`
+	Active           bool              `json:"active"`
+	PractitionerID   string            `json:"practitionerID"`
+	PractitionerName string            `json:"practitionerName,omitempty"`
+	FacilityID       string            `json:"facilityID"`
+	Role             string            `json:"role"`
+	StartDate        *scalarutils.Date `json:"startDate,omitempty"`
+	EndDate          *scalarutils.Date `json:"endDate,omitempty"`
+}
+
+// PractitionerRoleEdge is a practitioner role edge
+type PractitionerRoleEdge struct {
+	Node   PractitionerRole
+	Cursor string
+}
+
+// PractitionerRoleConnection is a practitioner role Connection Type
+type PractitionerRoleConnection struct {
+	TotalCount int
+	Edges      []PractitionerRoleEdge
+	PageInfo   PageInfo
+}
+
+// CreatePractitionerRoleConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreatePractitionerRoleConnection(roles []*PractitionerRole, pageInfo PageInfo, total int) PractitionerRoleConnection {
+	connection := PractitionerRoleConnection{
+		TotalCount: total,
+		Edges:      []PractitionerRoleEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, role := range roles {
+		edge := PractitionerRoleEdge{
+			Node:   *role,
+			Cursor: role.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
@@ -0,0 +1,70 @@
+package dto
+
+import "github.com/savannahghi/scalarutils"
+
+// Prescription is a minimal representation of a FHIR MedicationRequest
+type Prescription struct {
+	ID                  string                      `json:"id"`
+	Status              MedicationRequestStatusEnum `json:"status"`
+	StatusReason        string                      `json:"statusReason,omitempty"`
+	Medication          Medication                  `json:"medication"`
+	Dosage              *Dosage                     `json:"dosage,omitempty"`
+	Quantity            *float64                    `json:"quantity,omitempty"`
+	NumberOfRefills     *int                        `json:"numberOfRefills,omitempty"`
+	ConditionIDs        []string                    `json:"conditionIDs"`
+	PriorPrescriptionID string                      `json:"priorPrescriptionID,omitempty"`
+	AuthoredOn          *scalarutils.DateTime       `json:"authoredOn,omitempty"`
+	Note                string                      `json:"note,omitempty"`
+	PatientID           string                      `json:"patientID"`
+	EncounterID         string                      `json:"encounterID"`
+	Interactions        []InteractionFinding        `json:"interactions"`
+	OverrideReason      string                      `json:"overrideReason,omitempty"`
+}
+
+// Dosage is the structured dosage of a prescription
+type Dosage struct {
+	Text               string              `json:"text"`
+	Dose               float64             `json:"dose"`
+	DoseUnit           string              `json:"doseUnit"`
+	Route              MedicationRouteEnum `json:"route"`
+	Frequency          int                 `json:"frequency"`
+	Period             float64             `json:"period"`
+	PeriodUnit         TimeUnitEnum        `json:"periodUnit"`
+	Duration           float64             `json:"duration"`
+	DurationUnit       TimeUnitEnum        `json:"durationUnit"`
+	AsNeeded           bool                `json:"asNeeded"`
+	PatientInstruction string              `json:"patientInstruction,omitempty"`
+}
+
+// PrescriptionEdge is a prescription edge
+type PrescriptionEdge struct {
+	Node   Prescription
+	Cursor string
+}
+
+// PrescriptionConnection is a Prescription Connection Type
+type PrescriptionConnection struct {
+	TotalCount int
+	Edges      []PrescriptionEdge
+	PageInfo   PageInfo
+}
+
+// CreatePrescriptionConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreatePrescriptionConnection(prescriptions []*Prescription, pageInfo PageInfo, total int) PrescriptionConnection {
+	connection := PrescriptionConnection{
+		TotalCount: total,
+		Edges:      []PrescriptionEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, prescription := range prescriptions {
+		edge := PrescriptionEdge{
+			Node:   *prescription,
+			Cursor: prescription.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
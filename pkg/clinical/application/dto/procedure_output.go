@@ -0,0 +1,55 @@
+package dto
+
+import "github.com/savannahghi/scalarutils"
+
+// Procedure is an action performed on a patient e.g cryotherapy after a positive VIA screening
+type Procedure struct {
+	ID            string                `json:"id"`
+	Status        ProcedureStatusEnum   `json:"status"`
+	StatusReason  string                `json:"statusReason,omitempty"`
+	Code          string                `json:"code"`
+	Name          string                `json:"name"`
+	PatientID     string                `json:"patientID"`
+	EncounterID   string                `json:"encounterID,omitempty"`
+	PerformedAt   *scalarutils.DateTime `json:"performedAt,omitempty"`
+	Performer     string                `json:"performer,omitempty"`
+	BodySite      string                `json:"bodySite,omitempty"`
+	Outcome       ProcedureOutcomeEnum  `json:"outcome,omitempty"`
+	Complications []string              `json:"complications"`
+	// ReasonIDs are the IDs of the observations and diagnostic reports that led to the procedure
+	ReasonIDs []string `json:"reasonIDs"`
+	Note      string   `json:"note,omitempty"`
+}
+
+// ProcedureEdge is a procedure edge
+type ProcedureEdge struct {
+	Node   Procedure
+	Cursor string
+}
+
+// ProcedureConnection is a procedure Connection Type
+type ProcedureConnection struct {
+	TotalCount int
+	Edges      []ProcedureEdge
+	PageInfo   PageInfo
+}
+
+// CreateProcedureConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreateProcedureConnection(procedures []*Procedure, pageInfo PageInfo, total int) ProcedureConnection {
+	connection := ProcedureConnection{
+		TotalCount: total,
+		Edges:      []ProcedureEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, procedure := range procedures {
+		edge := ProcedureEdge{
+			Node:   *procedure,
+			Cursor: procedure.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
@@ -94,12 +94,14 @@ type MedicationDrug struct {
 	ConceptID *string `json:"conceptId"`
 }
 
-// PatientTestResultPubSubMessage models details that are published to the test results topic
+// PatientTestResultPubSubMessage models details that are published to the test results topic.
+// OrderID is the ID of the lab order the result is for, if any
 type PatientTestResultPubSubMessage struct {
 	Name      string     `json:"name"`
 	ConceptID *string    `json:"conceptId"`
 	Date      time.Time  `json:"date"`
 	Result    TestResult `json:"result"`
+	OrderID   string     `json:"orderID"`
 
 	PatientID string `json:"patientID"`
 
@@ -107,6 +109,43 @@ type PatientTestResultPubSubMessage struct {
 	FacilityID     string `json:"facilityID"`
 }
 
+// PatientTestOrderPubSubMessage models details that are published to the test orders topic.
+// Orders placed in this service are published with their ID so that laboratory systems can send back results for them,
+// and with this service as their source so that they are not recorded again when received
+type PatientTestOrderPubSubMessage struct {
+	ID        string               `json:"id"`
+	Source    string               `json:"source"`
+	Name      string               `json:"name"`
+	ConceptID *string              `json:"conceptId"`
+	Date      time.Time            `json:"date"`
+	Priority  LabOrderPriorityEnum `json:"priority"`
+	Note      string               `json:"note"`
+
+	PatientID   string `json:"patientID"`
+	EncounterID string `json:"encounterID"`
+
+	OrganizationID string `json:"organizationID"`
+	FacilityID     string `json:"facilityID"`
+}
+
+// EarlyWarningAlertPubSubMessage models details that are published to the early warning alerts topic when the early warning
+// score of a patient rises to a risk that calls for a clinical response
+type EarlyWarningAlertPubSubMessage struct {
+	ID           string               `json:"id"`
+	Code         string               `json:"code"`
+	Name         string               `json:"name"`
+	Score        int                  `json:"score"`
+	Risk         EarlyWarningRiskEnum `json:"risk"`
+	PreviousRisk EarlyWarningRiskEnum `json:"previousRisk,omitempty"`
+	Date         time.Time            `json:"date"`
+
+	PatientID   string `json:"patientID"`
+	EncounterID string `json:"encounterID"`
+
+	OrganizationID string `json:"organizationID"`
+	FacilityID     string `json:"facilityID"`
+}
+
 // TestResult ...
 type TestResult struct {
 	Name      string  `json:"name"`
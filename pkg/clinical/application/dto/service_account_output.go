@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// ServiceAccount models a tenant bound machine client e.g a lab system or device gateway
+type ServiceAccount struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Scopes         []string  `json:"scopes"`
+	Active         bool      `json:"active"`
+	OrganizationID string    `json:"organizationID"`
+	FacilityID     string    `json:"facilityID"`
+	KeyRotatedAt   time.Time `json:"keyRotatedAt"`
+}
+
+// ServiceAccountCredential is returned when a service account key is issued or rotated.
+// The key is only ever returned at this point since only its hash is stored
+type ServiceAccountCredential struct {
+	ServiceAccount ServiceAccount `json:"serviceAccount"`
+	Key            string         `json:"key"`
+}
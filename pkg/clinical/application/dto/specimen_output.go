@@ -0,0 +1,61 @@
+package dto
+
+import "github.com/savannahghi/scalarutils"
+
+// Specimen is a sample collected from a patient for a lab order together with its chain of custody
+type Specimen struct {
+	ID              string                    `json:"id"`
+	AccessionNumber string                    `json:"accessionNumber,omitempty"`
+	Status          SpecimenCustodyStatusEnum `json:"status"`
+	TypeCode        string                    `json:"typeCode"`
+	TypeName        string                    `json:"typeName"`
+	BodySite        string                    `json:"bodySite,omitempty"`
+	Container       string                    `json:"container,omitempty"`
+	PatientID       string                    `json:"patientID"`
+	OrderID         string                    `json:"orderID,omitempty"`
+	CollectedAt     *scalarutils.DateTime     `json:"collectedAt,omitempty"`
+	ReceivedAt      *scalarutils.DateTime     `json:"receivedAt,omitempty"`
+	Note            string                    `json:"note,omitempty"`
+	CustodyHistory  []*SpecimenCustodyEvent   `json:"custodyHistory"`
+}
+
+// SpecimenCustodyEvent is a change in the custody of a specimen, oldest first in a specimen's custody history
+type SpecimenCustodyEvent struct {
+	Status     SpecimenCustodyStatusEnum `json:"status"`
+	Time       scalarutils.DateTime      `json:"time"`
+	FacilityID string                    `json:"facilityID,omitempty"`
+	Note       string                    `json:"note,omitempty"`
+}
+
+// SpecimenEdge is a specimen edge
+type SpecimenEdge struct {
+	Node   Specimen
+	Cursor string
+}
+
+// SpecimenConnection is a specimen Connection Type
+type SpecimenConnection struct {
+	TotalCount int
+	Edges      []SpecimenEdge
+	PageInfo   PageInfo
+}
+
+// CreateSpecimenConnection creates a connection that follows the GraphQl Cursor Connection Specification
+func CreateSpecimenConnection(specimens []*Specimen, pageInfo PageInfo, total int) SpecimenConnection {
+	connection := SpecimenConnection{
+		TotalCount: total,
+		Edges:      []SpecimenEdge{},
+		PageInfo:   pageInfo,
+	}
+
+	for _, specimen := range specimens {
+		edge := SpecimenEdge{
+			Node:   *specimen,
+			Cursor: specimen.ID,
+		}
+
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	return connection
+}
@@ -25,6 +25,9 @@ const (
 
 	// FacilityIDContextKey is the key used to add a facility to the context
 	FacilityIDContextKey = ContextKey("FacilityID")
+
+	// PatientIDContextKey is the key used to add the ID of the patient that a patient-scoped token is restricted to
+	PatientIDContextKey = ContextKey("PatientID")
 )
 
 // ValidateEmail returns an error if the supplied string does not have a
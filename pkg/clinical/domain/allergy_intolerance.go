@@ -87,8 +87,8 @@ type FHIRAllergyIntoleranceInput struct {
 	// Business identifiers assigned to this AllergyIntolerance by the performer or other systems which remain constant as the resource is updated and propagates from server to server.
 	Identifier []*FHIRIdentifierInput `json:"identifier,omitempty"`
 
-	// The clinical status of the allergy or intolerance.
-	ClinicalStatus FHIRCodeableConceptInput `json:"clinicalStatus,omitempty"`
+	// The clinical status of the allergy or intolerance. It is absent when the allergy was entered in error
+	ClinicalStatus *FHIRCodeableConceptInput `json:"clinicalStatus,omitempty"`
 
 	// Assertion about certainty associated with the propensity, or potential risk, of a reaction to the identified substance (including pharmaceutical product).
 	VerificationStatus FHIRCodeableConceptInput `json:"verificationStatus,omitempty"`
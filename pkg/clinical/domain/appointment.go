@@ -0,0 +1,47 @@
+package domain
+
+import "github.com/savannahghi/scalarutils"
+
+// FHIRAppointment models a fhir appointment resource.
+// It books a patient into a slot of a facility's or a practitioner's schedule e.g a follow-up visit after a referral
+type FHIRAppointment struct {
+	ID                 *string                `json:"id,omitempty"`
+	Status             *scalarutils.Code      `json:"status,omitempty"`
+	CancelationReason  *FHIRCodeableConcept   `json:"cancelationReason,omitempty"`
+	ServiceType        []*FHIRCodeableConcept `json:"serviceType,omitempty"`
+	Description        *string                `json:"description,omitempty"`
+	Start              *string                `json:"start,omitempty"`
+	End                *string                `json:"end,omitempty"`
+	Slot               []*FHIRReference       `json:"slot,omitempty"`
+	Created            *string                `json:"created,omitempty"`
+	Comment            *string                `json:"comment,omitempty"`
+	PatientInstruction *string                `json:"patientInstruction,omitempty"`
+
+	// BasedOn is the service request the appointment fulfils e.g the referral that prompted a follow-up visit
+	BasedOn     []*FHIRReference              `json:"basedOn,omitempty"`
+	Participant []*FHIRAppointmentParticipant `json:"participant,omitempty"`
+	Meta        *FHIRMetaInput                `json:"meta,omitempty"`
+	Extension   []*FHIRExtension              `json:"extension,omitempty"`
+}
+
+// FHIRAppointmentParticipant models a participant of an appointment i.e the patient, the practitioner or the facility
+type FHIRAppointmentParticipant struct {
+	Actor    *FHIRReference    `json:"actor,omitempty"`
+	Required *scalarutils.Code `json:"required,omitempty"`
+	Status   *scalarutils.Code `json:"status,omitempty"`
+}
+
+// FHIRAppointmentRelayPayload is used to return single instances of Appointment
+type FHIRAppointmentRelayPayload struct {
+	Resource *FHIRAppointment `json:"resource,omitempty"`
+}
+
+// PagedFHIRAppointment is a paged list of appointment resources
+type PagedFHIRAppointment struct {
+	Appointments    []FHIRAppointment
+	HasNextPage     bool
+	NextCursor      string
+	HasPreviousPage bool
+	PreviousCursor  string
+	TotalCount      int
+}
@@ -0,0 +1,38 @@
+package domain
+
+// FHIRAuditEvent is a record of an event relevant for purposes such as operations, privacy, security, maintenance, and performance analysis.
+// http://hl7.org/fhir/StructureDefinition/AuditEvent
+type FHIRAuditEvent struct {
+	ID          *string                `json:"id,omitempty"`
+	Meta        *FHIRMetaInput         `json:"meta,omitempty"`
+	Type        FHIRCoding             `json:"type"`
+	Subtype     []FHIRCoding           `json:"subtype,omitempty"`
+	Action      string                 `json:"action,omitempty"`
+	Recorded    string                 `json:"recorded"`
+	Outcome     string                 `json:"outcome,omitempty"`
+	OutcomeDesc string                 `json:"outcomeDesc,omitempty"`
+	Agent       []FHIRAuditEventAgent  `json:"agent"`
+	Source      FHIRAuditEventSource   `json:"source"`
+	Entity      []FHIRAuditEventEntity `json:"entity,omitempty"`
+}
+
+// FHIRAuditEventAgent is an actor taking an active role in the event or activity that is logged
+type FHIRAuditEventAgent struct {
+	Type      *FHIRCodeableConcept `json:"type,omitempty"`
+	Who       *FHIRReference       `json:"who,omitempty"`
+	Name      string               `json:"name,omitempty"`
+	Requestor bool                 `json:"requestor"`
+}
+
+// FHIRAuditEventSource is the system that is reporting the event
+type FHIRAuditEventSource struct {
+	Site     string        `json:"site,omitempty"`
+	Observer FHIRReference `json:"observer"`
+}
+
+// FHIRAuditEventEntity is a data or object that was used during the event
+type FHIRAuditEventEntity struct {
+	What        *FHIRReference `json:"what,omitempty"`
+	Name        string         `json:"name,omitempty"`
+	Description string         `json:"description,omitempty"`
+}
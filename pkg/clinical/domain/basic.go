@@ -0,0 +1,25 @@
+package domain
+
+// FHIRBasic is used for handling concepts not yet defined in FHIR, narrative-only resources that don't map to an existing resource,
+// and custom resources not appropriate for inclusion in the FHIR specification.
+// http://hl7.org/fhir/StructureDefinition/Basic
+type FHIRBasic struct {
+	ID         *string              `json:"id,omitempty"`
+	Meta       *FHIRMetaInput       `json:"meta,omitempty"`
+	Extension  []Extension          `json:"extension,omitempty"`
+	Identifier []FHIRIdentifier     `json:"identifier,omitempty"`
+	Code       *FHIRCodeableConcept `json:"code,omitempty"`
+	Subject    *FHIRReference       `json:"subject,omitempty"`
+	Created    *string              `json:"created,omitempty"`
+	Author     *FHIRReference       `json:"author,omitempty"`
+}
+
+// PagedFHIRBasic is a paged list of basic resources
+type PagedFHIRBasic struct {
+	Basics          []FHIRBasic
+	HasNextPage     bool
+	NextCursor      string
+	HasPreviousPage bool
+	PreviousCursor  string
+	TotalCount      int
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "github.com/savannahghi/scalarutils"
+
+// FHIRCarePlan models a fhir care plan resource.
+// It describes how a patient's chronic care will be delivered through goals and planned activities
+type FHIRCarePlan struct {
+	ID          *string           `json:"id,omitempty"`
+	Status      *scalarutils.Code `json:"status,omitempty"`
+	Intent      *scalarutils.Code `json:"intent,omitempty"`
+	Title       *string           `json:"title,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Subject     *FHIRReference    `json:"subject,omitempty"`
+	Period      *FHIRPeriod       `json:"period,omitempty"`
+
+	// Addresses are the conditions the care plan is set for
+	Addresses []*FHIRReference `json:"addresses,omitempty"`
+
+	// SupportingInfo references the episode of care the plan is delivered in
+	SupportingInfo []*FHIRReference        `json:"supportingInfo,omitempty"`
+	Goal           []*FHIRReference        `json:"goal,omitempty"`
+	Activity       []*FHIRCarePlanActivity `json:"activity,omitempty"`
+	Note           []*FHIRAnnotation       `json:"note,omitempty"`
+	Meta           *FHIRMetaInput          `json:"meta,omitempty"`
+	Extension      []*FHIRExtension        `json:"extension,omitempty"`
+}
+
+// FHIRCarePlanActivity models an activity planned for a patient e.g a quarterly viral load test
+type FHIRCarePlanActivity struct {
+	Detail *FHIRCarePlanActivityDetail `json:"detail,omitempty"`
+}
+
+// FHIRCarePlanActivityDetail describes a planned activity
+type FHIRCarePlanActivityDetail struct {
+	Code            *FHIRCodeableConcept `json:"code,omitempty"`
+	Status          *scalarutils.Code    `json:"status,omitempty"`
+	ScheduledString *string              `json:"scheduledString,omitempty"`
+	Description     *string              `json:"description,omitempty"`
+}
+
+// FHIRCarePlanRelayPayload is used to return single instances of CarePlan
+type FHIRCarePlanRelayPayload struct {
+	Resource *FHIRCarePlan `json:"resource,omitempty"`
+}
+
+// PagedFHIRCarePlan is a paged list of care plan resources
+type PagedFHIRCarePlan struct {
+	CarePlans       []FHIRCarePlan
+	HasNextPage     bool
+	NextCursor      string
+	HasPreviousPage bool
+	PreviousCursor  string
+	TotalCount      int
+}
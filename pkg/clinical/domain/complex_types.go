@@ -477,7 +477,7 @@ type FHIRDuration struct {
 	ID *string `json:"id,omitempty"`
 
 	// The value of the measured amount. The value includes an implicit precision in the presentation of the value.
-	Value *scalarutils.Decimal `json:"value,omitempty"`
+	Value *float64 `json:"value,omitempty"`
 
 	// How the value should be understood and represented - whether the actual value is greater or less than the stated value due to measurement issues; e.g. if the comparator is "<" , then the real value is < stated value.
 	Comparator *DurationComparatorEnum `json:"comparator,omitempty"`
@@ -498,7 +498,7 @@ type FHIRDurationInput struct {
 	ID *string `json:"id,omitempty"`
 
 	// The value of the measured amount. The value includes an implicit precision in the presentation of the value.
-	Value *scalarutils.Decimal `json:"value,omitempty"`
+	Value *float64 `json:"value,omitempty"`
 
 	// How the value should be understood and represented - whether the actual value is greater or less than the stated value due to measurement issues; e.g. if the comparator is "<" , then the real value is < stated value.
 	Comparator *DurationComparatorEnum `json:"comparator,omitempty"`
@@ -892,31 +892,31 @@ type FHIRTimingRepeat struct {
 	BoundsPeriod *FHIRPeriod `json:"boundsPeriod,omitempty"`
 
 	// A total count of the desired number of repetitions across the duration of the entire timing specification. If countMax is present, this element indicates the lower bound of the allowed range of count values.
-	Count *string `json:"count,omitempty"`
+	Count *int `json:"count,omitempty"`
 
 	// If present, indicates that the count is a range - so to perform the action between [count] and [countMax] times.
-	CountMax *string `json:"countMax,omitempty"`
+	CountMax *int `json:"countMax,omitempty"`
 
 	// How long this thing happens for when it happens. If durationMax is present, this element indicates the lower bound of the allowed range of the duration.
-	Duration *scalarutils.Decimal `json:"duration,omitempty"`
+	Duration *float64 `json:"duration,omitempty"`
 
 	// If present, indicates that the duration is a range - so to perform the action between [duration] and [durationMax] time length.
-	DurationMax *scalarutils.Decimal `json:"durationMax,omitempty"`
+	DurationMax *float64 `json:"durationMax,omitempty"`
 
 	// The units of time for the duration, in UCUM units.
 	DurationUnit *TimingRepeatDurationUnitEnum `json:"durationUnit,omitempty"`
 
 	// The number of times to repeat the action within the specified period. If frequencyMax is present, this element indicates the lower bound of the allowed range of the frequency.
-	Frequency *string `json:"frequency,omitempty"`
+	Frequency *int `json:"frequency,omitempty"`
 
 	// If present, indicates that the frequency is a range - so to repeat between [frequency] and [frequencyMax] times within the period or period range.
-	FrequencyMax *string `json:"frequencyMax,omitempty"`
+	FrequencyMax *int `json:"frequencyMax,omitempty"`
 
 	// Indicates the duration of time over which repetitions are to occur; e.g. to express "3 times per day", 3 would be the frequency and "1 day" would be the period. If periodMax is present, this element indicates the lower bound of the allowed range of the period length.
-	Period *scalarutils.Decimal `json:"period,omitempty"`
+	Period *float64 `json:"period,omitempty"`
 
 	// If present, indicates that the period is a range from [period] to [periodMax], allowing expressing concepts such as "do this once every 3-5 days.
-	PeriodMax *scalarutils.Decimal `json:"periodMax,omitempty"`
+	PeriodMax *float64 `json:"periodMax,omitempty"`
 
 	// The units of time for the period in UCUM units.
 	PeriodUnit *TimingRepeatPeriodUnitEnum `json:"periodUnit,omitempty"`
@@ -949,31 +949,31 @@ type FHIRTimingRepeatInput struct {
 	BoundsPeriod *FHIRPeriodInput `json:"boundsPeriod,omitempty"`
 
 	// A total count of the desired number of repetitions across the duration of the entire timing specification. If countMax is present, this element indicates the lower bound of the allowed range of count values.
-	Count *string `json:"count,omitempty"`
+	Count *int `json:"count,omitempty"`
 
 	// If present, indicates that the count is a range - so to perform the action between [count] and [countMax] times.
-	CountMax *string `json:"countMax,omitempty"`
+	CountMax *int `json:"countMax,omitempty"`
 
 	// How long this thing happens for when it happens. If durationMax is present, this element indicates the lower bound of the allowed range of the duration.
-	Duration *scalarutils.Decimal `json:"duration,omitempty"`
+	Duration *float64 `json:"duration,omitempty"`
 
 	// If present, indicates that the duration is a range - so to perform the action between [duration] and [durationMax] time length.
-	DurationMax *scalarutils.Decimal `json:"durationMax,omitempty"`
+	DurationMax *float64 `json:"durationMax,omitempty"`
 
 	// The units of time for the duration, in UCUM units.
 	DurationUnit *TimingRepeatDurationUnitEnum `json:"durationUnit,omitempty"`
 
 	// The number of times to repeat the action within the specified period. If frequencyMax is present, this element indicates the lower bound of the allowed range of the frequency.
-	Frequency *string `json:"frequency,omitempty"`
+	Frequency *int `json:"frequency,omitempty"`
 
 	// If present, indicates that the frequency is a range - so to repeat between [frequency] and [frequencyMax] times within the period or period range.
-	FrequencyMax *string `json:"frequencyMax,omitempty"`
+	FrequencyMax *int `json:"frequencyMax,omitempty"`
 
 	// Indicates the duration of time over which repetitions are to occur; e.g. to express "3 times per day", 3 would be the frequency and "1 day" would be the period. If periodMax is present, this element indicates the lower bound of the allowed range of the period length.
-	Period *scalarutils.Decimal `json:"period,omitempty"`
+	Period *float64 `json:"period,omitempty"`
 
 	// If present, indicates that the period is a range from [period] to [periodMax], allowing expressing concepts such as "do this once every 3-5 days.
-	PeriodMax *scalarutils.Decimal `json:"periodMax,omitempty"`
+	PeriodMax *float64 `json:"periodMax,omitempty"`
 
 	// The units of time for the period in UCUM units.
 	PeriodUnit *TimingRepeatPeriodUnitEnum `json:"periodUnit,omitempty"`
@@ -24,6 +24,17 @@ type Concept struct {
 	VersionCreatedOn string  `mapstructure:"version_created_on" json:"version_created_on"`
 	VersionURL       string  `mapstructure:"version_url" json:"version_url"`
 	VersionsURL      string  `mapstructure:"versions_url" json:"versions_url"`
+
+	Mappings []*ConceptMapping `mapstructure:"mappings" json:"mappings,omitempty"`
+}
+
+// ConceptMapping models an OCL mapping from a concept to a related concept e.g a drug's ingredient or drug class
+type ConceptMapping struct {
+	MapType         string `mapstructure:"map_type" json:"map_type"`
+	FromConceptCode string `mapstructure:"from_concept_code" json:"from_concept_code"`
+	ToConceptCode   string `mapstructure:"to_concept_code" json:"to_concept_code"`
+	ToConceptName   string `mapstructure:"to_concept_name" json:"to_concept_name"`
+	ToSourceName    string `mapstructure:"to_source_name" json:"to_source_name"`
 }
 
 // ConceptPage models the output of ocl concepts with pagination
@@ -4,22 +4,25 @@ import "github.com/savannahghi/clinical/pkg/clinical/application/dto"
 
 // Consent models a fhir consent resource.
 type FHIRConsent struct {
-	ID         *string                `json:"id,omitempty"`
-	Status     *dto.ConsentStatusEnum `json:"status"`
-	Scope      *FHIRCodeableConcept   `json:"scope"`
-	Category   []*FHIRCodeableConcept `json:"category"`
-	PolicyRule *FHIRCodeableConcept   `json:"policyRule,omitempty"`
-	Provision  *FHIRConsentProvision  `json:"provision,omitempty"`
-	Patient    *FHIRReference         `json:"patient,omitempty"`
-	Meta       *FHIRMetaInput         `json:"meta,omitempty"`
-	Extension  []Extension            `json:"extension,omitempty"`
+	ID               *string                `json:"id,omitempty"`
+	Status           *dto.ConsentStatusEnum `json:"status"`
+	Scope            *FHIRCodeableConcept   `json:"scope"`
+	Category         []*FHIRCodeableConcept `json:"category"`
+	PolicyRule       *FHIRCodeableConcept   `json:"policyRule,omitempty"`
+	Provision        *FHIRConsentProvision  `json:"provision,omitempty"`
+	Patient          *FHIRReference         `json:"patient,omitempty"`
+	DateTime         *string                `json:"dateTime,omitempty"`
+	SourceAttachment *FHIRAttachment        `json:"sourceAttachment,omitempty"`
+	Meta             *FHIRMetaInput         `json:"meta,omitempty"`
+	Extension        []Extension            `json:"extension,omitempty"`
 }
 
 // FHIRConsentProvision models a fhir consent provision
 type FHIRConsentProvision struct {
-	ID   *string                       `json:"id,omitempty"`
-	Type *dto.ConsentProvisionTypeEnum `json:"type,omitempty"`
-	Data []FHIRConsentProvisionData    `json:"data,omitempty"`
+	ID     *string                       `json:"id,omitempty"`
+	Type   *dto.ConsentProvisionTypeEnum `json:"type,omitempty"`
+	Period *FHIRPeriod                   `json:"period,omitempty"`
+	Data   []FHIRConsentProvisionData    `json:"data,omitempty"`
 }
 
 // FHIRConsentProvisionData models a consent provision data
@@ -30,3 +33,18 @@ type FHIRConsentProvisionData struct {
 	Meaning           dto.ConsentDataMeaningEnum `json:"meaning,omitempty"`
 	Reference         *FHIRReference             `json:"reference,omitempty"`
 }
+
+// FHIRConsentRelayPayload is used to return single instances of Consent
+type FHIRConsentRelayPayload struct {
+	Resource *FHIRConsent `json:"resource,omitempty"`
+}
+
+// PagedFHIRConsent is a paged list of consent resources
+type PagedFHIRConsent struct {
+	Consents        []FHIRConsent
+	HasNextPage     bool
+	NextCursor      string
+	HasPreviousPage bool
+	PreviousCursor  string
+	TotalCount      int
+}
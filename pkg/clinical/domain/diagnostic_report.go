@@ -32,6 +32,11 @@ type FHIRDiagnosticReport struct {
 	PresentedForm      []*FHIRAttachment            `json:"presentedForm,omitempty"`
 }
 
+// FHIRDiagnosticReportRelayPayload is used to return single instances of DiagnosticReport
+type FHIRDiagnosticReportRelayPayload struct {
+	Resource *FHIRDiagnosticReport `json:"resource,omitempty"`
+}
+
 // FHIRDiagnosticReportMedia represents the key images associated with this report
 type FHIRDiagnosticReportMedia struct {
 	ID                *string        `json:"id,omitempty"`
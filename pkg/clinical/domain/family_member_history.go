@@ -0,0 +1,47 @@
+package domain
+
+import "github.com/savannahghi/scalarutils"
+
+// FHIRFamilyMemberHistory models a fhir family member history resource.
+// It records the significant health conditions of a relative of the patient e.g a mother who had breast cancer
+type FHIRFamilyMemberHistory struct {
+	ID           *string              `json:"id,omitempty"`
+	Identifier   []*FHIRIdentifier    `json:"identifier,omitempty"`
+	Status       *scalarutils.Code    `json:"status,omitempty"`
+	Patient      *FHIRReference       `json:"patient,omitempty"`
+	Date         *string              `json:"date,omitempty"`
+	Name         *string              `json:"name,omitempty"`
+	Relationship *FHIRCodeableConcept `json:"relationship,omitempty"`
+
+	// ReasonReference is the questionnaire response the history was extracted from, if any
+	ReasonReference []*FHIRReference                    `json:"reasonReference,omitempty"`
+	Condition       []*FHIRFamilyMemberHistoryCondition `json:"condition,omitempty"`
+	Note            []*FHIRAnnotation                   `json:"note,omitempty"`
+	Meta            *FHIRMetaInput                      `json:"meta,omitempty"`
+	Extension       []*FHIRExtension                    `json:"extension,omitempty"`
+}
+
+// FHIRFamilyMemberHistoryCondition models a condition that a relative of the patient had and the age it started at
+type FHIRFamilyMemberHistoryCondition struct {
+	Code               *FHIRCodeableConcept `json:"code,omitempty"`
+	ContributedToDeath *bool                `json:"contributedToDeath,omitempty"`
+
+	// OnsetAge is the age in years the relative was when the condition started. FHIR ages are quantities in UCUM units
+	OnsetAge *FHIRQuantity     `json:"onsetAge,omitempty"`
+	Note     []*FHIRAnnotation `json:"note,omitempty"`
+}
+
+// FHIRFamilyMemberHistoryRelayPayload is used to return single instances of FamilyMemberHistory
+type FHIRFamilyMemberHistoryRelayPayload struct {
+	Resource *FHIRFamilyMemberHistory `json:"resource,omitempty"`
+}
+
+// PagedFHIRFamilyMemberHistory is a paged list of family member history resources
+type PagedFHIRFamilyMemberHistory struct {
+	FamilyMemberHistories []FHIRFamilyMemberHistory
+	HasNextPage           bool
+	NextCursor            string
+	HasPreviousPage       bool
+	PreviousCursor        string
+	TotalCount            int
+}
@@ -0,0 +1,46 @@
+package domain
+
+import "github.com/savannahghi/scalarutils"
+
+// FHIRGoal models a fhir goal resource.
+// It records an intended outcome of a patient's care e.g a suppressed viral load or a blood pressure below 140/90
+type FHIRGoal struct {
+	ID              *string           `json:"id,omitempty"`
+	LifecycleStatus *scalarutils.Code `json:"lifecycleStatus,omitempty"`
+
+	// AchievementStatus is the progress towards the goal as last computed from the patient's observations
+	AchievementStatus *FHIRCodeableConcept `json:"achievementStatus,omitempty"`
+	Description       *FHIRCodeableConcept `json:"description,omitempty"`
+	Subject           *FHIRReference       `json:"subject,omitempty"`
+	StartDate         *string              `json:"startDate,omitempty"`
+	Target            []*FHIRGoalTarget    `json:"target,omitempty"`
+	StatusDate        *string              `json:"statusDate,omitempty"`
+
+	// Addresses are the conditions the goal is set for
+	Addresses []*FHIRReference  `json:"addresses,omitempty"`
+	Note      []*FHIRAnnotation `json:"note,omitempty"`
+	Meta      *FHIRMetaInput    `json:"meta,omitempty"`
+	Extension []*FHIRExtension  `json:"extension,omitempty"`
+}
+
+// FHIRGoalTarget models the value a measure should reach for a goal to be achieved e.g a viral load below 1000 copies/ml
+type FHIRGoalTarget struct {
+	Measure        *FHIRCodeableConcept `json:"measure,omitempty"`
+	DetailQuantity *FHIRQuantity        `json:"detailQuantity,omitempty"`
+	DueDate        *string              `json:"dueDate,omitempty"`
+}
+
+// FHIRGoalRelayPayload is used to return single instances of Goal
+type FHIRGoalRelayPayload struct {
+	Resource *FHIRGoal `json:"resource,omitempty"`
+}
+
+// PagedFHIRGoal is a paged list of goal resources
+type PagedFHIRGoal struct {
+	Goals           []FHIRGoal
+	HasNextPage     bool
+	NextCursor      string
+	HasPreviousPage bool
+	PreviousCursor  string
+	TotalCount      int
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "github.com/savannahghi/scalarutils"
+
+// FHIRImmunization models a fhir immunization resource.
+// It records the administration of a vaccine to a patient, or a record of one reported from e.g a child health card
+type FHIRImmunization struct {
+	ID                 *string                      `json:"id,omitempty"`
+	Status             *scalarutils.Code            `json:"status,omitempty"`
+	StatusReason       *FHIRCodeableConcept         `json:"statusReason,omitempty"`
+	VaccineCode        *FHIRCodeableConcept         `json:"vaccineCode,omitempty"`
+	Patient            *FHIRReference               `json:"patient,omitempty"`
+	Encounter          *FHIRReference               `json:"encounter,omitempty"`
+	OccurrenceDateTime *string                      `json:"occurrenceDateTime,omitempty"`
+	Recorded           *string                      `json:"recorded,omitempty"`
+	LotNumber          *string                      `json:"lotNumber,omitempty"`
+	Site               *FHIRCodeableConcept         `json:"site,omitempty"`
+	Route              *FHIRCodeableConcept         `json:"route,omitempty"`
+	Performer          []*FHIRImmunizationPerformer `json:"performer,omitempty"`
+	Note               []*FHIRAnnotation            `json:"note,omitempty"`
+
+	// PrimarySource is false when the immunization was reported rather than given at the facility
+	PrimarySource   *bool                              `json:"primarySource,omitempty"`
+	ProtocolApplied []*FHIRImmunizationProtocolApplied `json:"protocolApplied,omitempty"`
+	Meta            *FHIRMetaInput                     `json:"meta,omitempty"`
+	Extension       []Extension                        `json:"extension,omitempty"`
+}
+
+// FHIRImmunizationPerformer models who performed an immunization
+type FHIRImmunizationPerformer struct {
+	ID    *string        `json:"id,omitempty"`
+	Actor *FHIRReference `json:"actor,omitempty"`
+}
+
+// FHIRImmunizationProtocolApplied models the dose of a vaccine series that an immunization was given as
+type FHIRImmunizationProtocolApplied struct {
+	Series                 *string `json:"series,omitempty"`
+	DoseNumberPositiveInt  *int    `json:"doseNumberPositiveInt,omitempty"`
+	SeriesDosesPositiveInt *int    `json:"seriesDosesPositiveInt,omitempty"`
+}
+
+// FHIRImmunizationRelayPayload is used to return single instances of Immunization
+type FHIRImmunizationRelayPayload struct {
+	Resource *FHIRImmunization `json:"resource,omitempty"`
+}
+
+// PagedFHIRImmunization is a paged list of immunization resources
+type PagedFHIRImmunization struct {
+	Immunizations   []FHIRImmunization
+	HasNextPage     bool
+	NextCursor      string
+	HasPreviousPage bool
+	PreviousCursor  string
+	TotalCount      int
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "github.com/savannahghi/scalarutils"
+
+// FHIRLocation models a fhir location resource.
+// It records a department, ward or clinic of a facility, and the geographic admin units it is in
+type FHIRLocation struct {
+	ID          *string           `json:"id,omitempty"`
+	Status      *scalarutils.Code `json:"status,omitempty"`
+	Name        *string           `json:"name,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Mode        *scalarutils.Code `json:"mode,omitempty"`
+
+	// Type is the kind of location e.g a ward
+	Type    []*FHIRCodeableConcept `json:"type,omitempty"`
+	Telecom []*FHIRContactPoint    `json:"telecom,omitempty"`
+
+	// Address holds the county, sub-county and ward of the location in its state, district and city respectively
+	Address *FHIRAddress `json:"address,omitempty"`
+
+	// ManagingOrganization is the facility the location belongs to
+	ManagingOrganization *FHIRReference `json:"managingOrganization,omitempty"`
+
+	// PartOf is the location this location is a part of e.g the department of a clinic
+	PartOf    *FHIRReference   `json:"partOf,omitempty"`
+	Meta      *FHIRMetaInput   `json:"meta,omitempty"`
+	Extension []*FHIRExtension `json:"extension,omitempty"`
+}
+
+// FHIRLocationRelayPayload is used to return single instances of Location
+type FHIRLocationRelayPayload struct {
+	Resource *FHIRLocation `json:"resource,omitempty"`
+}
+
+// PagedFHIRLocation is a paged list of location resources
+type PagedFHIRLocation struct {
+	Locations       []FHIRLocation
+	HasNextPage     bool
+	NextCursor      string
+	HasPreviousPage bool
+	PreviousCursor  string
+	TotalCount      int
+}
@@ -37,3 +37,8 @@ type PagedFHIRMedia struct {
 	PreviousCursor  string
 	TotalCount      int
 }
+
+// FHIRMediaRelayPayload is used to return single instances of Media
+type FHIRMediaRelayPayload struct {
+	Resource *FHIRMedia `json:"resource,omitempty"`
+}
@@ -0,0 +1,47 @@
+package domain
+
+import "github.com/savannahghi/scalarutils"
+
+// FHIRMedicationDispense models a fhir medication dispense resource.
+// It records the supply of a medication to a patient, usually against a prescription
+type FHIRMedicationDispense struct {
+	ID     *string           `json:"id,omitempty"`
+	Status *scalarutils.Code `json:"status,omitempty"`
+
+	// Type indicates whether the dispense is a first fill or a refill and whether it is partial
+	Type                      *FHIRCodeableConcept               `json:"type,omitempty"`
+	MedicationCodeableConcept *FHIRCodeableConcept               `json:"medicationCodeableConcept,omitempty"`
+	Subject                   *FHIRReference                     `json:"subject,omitempty"`
+	Context                   *FHIRReference                     `json:"context,omitempty"`
+	Performer                 []*FHIRMedicationDispensePerformer `json:"performer,omitempty"`
+	AuthorizingPrescription   []*FHIRReference                   `json:"authorizingPrescription,omitempty"`
+	Quantity                  *FHIRQuantity                      `json:"quantity,omitempty"`
+	DaysSupply                *FHIRQuantity                      `json:"daysSupply,omitempty"`
+	WhenPrepared              *string                            `json:"whenPrepared,omitempty"`
+	WhenHandedOver            *string                            `json:"whenHandedOver,omitempty"`
+	Note                      []*FHIRAnnotation                  `json:"note,omitempty"`
+	DosageInstruction         []*FHIRDosage                      `json:"dosageInstruction,omitempty"`
+	Meta                      *FHIRMetaInput                     `json:"meta,omitempty"`
+	Extension                 []Extension                        `json:"extension,omitempty"`
+}
+
+// FHIRMedicationDispensePerformer models who performed a medication dispense
+type FHIRMedicationDispensePerformer struct {
+	ID    *string        `json:"id,omitempty"`
+	Actor *FHIRReference `json:"actor,omitempty"`
+}
+
+// FHIRMedicationDispenseRelayPayload is used to return single instances of MedicationDispense
+type FHIRMedicationDispenseRelayPayload struct {
+	Resource *FHIRMedicationDispense `json:"resource,omitempty"`
+}
+
+// PagedFHIRMedicationDispense is a paged list of medication dispense resources
+type PagedFHIRMedicationDispense struct {
+	MedicationDispenses []FHIRMedicationDispense
+	HasNextPage         bool
+	NextCursor          string
+	HasPreviousPage     bool
+	PreviousCursor      string
+	TotalCount          int
+}
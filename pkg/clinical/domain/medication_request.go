@@ -251,7 +251,7 @@ type FHIRMedicationrequestDispenserequest struct {
 	ValidityPeriod *FHIRPeriod `json:"validityPeriod,omitempty"`
 
 	// An integer indicating the number of times, in addition to the original dispense, (aka refills or repeats) that the patient can receive the prescribed medication. Usage Notes: This integer does not include the original order dispense. This means that if an order indicates dispense 30 tablets plus "3 repeats", then the order can be dispensed a total of 4 times and the patient can receive a total of 120 tablets.  A prescriber may explicitly say that zero refills are permitted after the initial dispense.
-	NumberOfRepeatsAllowed *string `json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowed *int `json:"numberOfRepeatsAllowed,omitempty"`
 
 	// The amount that is to be dispensed for one fill.
 	Quantity *FHIRQuantity `json:"quantity,omitempty"`
@@ -278,7 +278,7 @@ type FHIRMedicationrequestDispenserequestInput struct {
 	ValidityPeriod *FHIRPeriodInput `json:"validityPeriod,omitempty"`
 
 	// An integer indicating the number of times, in addition to the original dispense, (aka refills or repeats) that the patient can receive the prescribed medication. Usage Notes: This integer does not include the original order dispense. This means that if an order indicates dispense 30 tablets plus "3 repeats", then the order can be dispensed a total of 4 times and the patient can receive a total of 120 tablets.  A prescriber may explicitly say that zero refills are permitted after the initial dispense.
-	NumberOfRepeatsAllowed *string `json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowed *int `json:"numberOfRepeatsAllowed,omitempty"`
 
 	// The amount that is to be dispensed for one fill.
 	Quantity *FHIRQuantityInput `json:"quantity,omitempty"`
@@ -362,3 +362,13 @@ type FHIRMedicationRequestRelayEdge struct {
 type FHIRMedicationRequestRelayPayload struct {
 	Resource *FHIRMedicationRequest `json:"resource,omitempty"`
 }
+
+// PagedFHIRMedicationRequest is a paged list of medication request resources
+type PagedFHIRMedicationRequest struct {
+	MedicationRequests []FHIRMedicationRequest
+	HasNextPage        bool
+	NextCursor         string
+	HasPreviousPage    bool
+	PreviousCursor     string
+	TotalCount         int
+}
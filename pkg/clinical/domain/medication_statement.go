@@ -113,6 +113,8 @@ type FHIRMedicationStatementRelayConnection struct {
 	Edges []*FHIRMedicationStatementRelayEdge `json:"edges,omitempty"`
 
 	PageInfo *firebasetools.PageInfo `json:"pageInfo,omitempty"`
+
+	TotalCount int `json:"totalCount,omitempty"`
 }
 
 // FHIRMedicationStatementRelayEdge is a Relay edge for MedicationStatement
@@ -24,6 +24,9 @@ type FHIROrganization struct {
 
 	// An address for the organization.
 	Address []*FHIRAddress `json:"address,omitempty"`
+
+	// The organization of which this organization forms a part e.g the tenant a facility belongs to
+	PartOf *FHIRReference `json:"partOf,omitempty"`
 }
 
 // FHIROrganizationInput definition: The organization (facility) responsible for this organization
@@ -46,6 +49,9 @@ type FHIROrganizationInput struct {
 
 	// An address for the organization.
 	Address []*FHIRAddressInput `json:"address,omitempty"`
+
+	// The organization of which this organization forms a part e.g the tenant a facility belongs to
+	PartOf *FHIRReferenceInput `json:"partOf,omitempty"`
 }
 
 // FHIROrganizationRelayPayload is used to return single instances of Organization
@@ -0,0 +1,78 @@
+package domain
+
+// FHIRPractitioner models a fhir practitioner resource.
+// It records a health worker's identity, contacts, qualifications and the registration numbers issued to them by their regulatory boards
+type FHIRPractitioner struct {
+	ID *string `json:"id,omitempty"`
+
+	// Identifier holds the practitioner's registration-board numbers and the user they sign in as
+	Identifier    []*FHIRIdentifier                `json:"identifier,omitempty"`
+	Active        *bool                            `json:"active,omitempty"`
+	Name          []*FHIRHumanName                 `json:"name,omitempty"`
+	Telecom       []*FHIRContactPoint              `json:"telecom,omitempty"`
+	Qualification []*FHIRPractitionerQualification `json:"qualification,omitempty"`
+	Meta          *FHIRMetaInput                   `json:"meta,omitempty"`
+	Extension     []*FHIRExtension                 `json:"extension,omitempty"`
+}
+
+// FHIRPractitionerQualification models a qualification obtained by a practitioner e.g a Diploma in Clinical Medicine
+type FHIRPractitionerQualification struct {
+	Code   *FHIRCodeableConcept `json:"code,omitempty"`
+	Period *FHIRPeriod          `json:"period,omitempty"`
+	Issuer *FHIRReference       `json:"issuer,omitempty"`
+}
+
+// Names returns the name of the practitioner
+func (p FHIRPractitioner) Names() string {
+	for _, name := range p.Name {
+		if name != nil && name.Text != "" {
+			return name.Text
+		}
+	}
+
+	return ""
+}
+
+// FHIRPractitionerRelayPayload is used to return single instances of Practitioner
+type FHIRPractitionerRelayPayload struct {
+	Resource *FHIRPractitioner `json:"resource,omitempty"`
+}
+
+// PagedFHIRPractitioner is a paged list of practitioner resources
+type PagedFHIRPractitioner struct {
+	Practitioners   []FHIRPractitioner
+	HasNextPage     bool
+	NextCursor      string
+	HasPreviousPage bool
+	PreviousCursor  string
+	TotalCount      int
+}
+
+// FHIRPractitionerRole models a fhir practitioner role resource.
+// It assigns a practitioner to a facility in a role e.g a clinical officer at a health centre
+type FHIRPractitionerRole struct {
+	ID           *string                `json:"id,omitempty"`
+	Active       *bool                  `json:"active,omitempty"`
+	Period       *FHIRPeriod            `json:"period,omitempty"`
+	Practitioner *FHIRReference         `json:"practitioner,omitempty"`
+	Organization *FHIRReference         `json:"organization,omitempty"`
+	Code         []*FHIRCodeableConcept `json:"code,omitempty"`
+	Telecom      []*FHIRContactPoint    `json:"telecom,omitempty"`
+	Meta         *FHIRMetaInput         `json:"meta,omitempty"`
+	Extension    []*FHIRExtension       `json:"extension,omitempty"`
+}
+
+// FHIRPractitionerRoleRelayPayload is used to return single instances of PractitionerRole
+type FHIRPractitionerRoleRelayPayload struct {
+	Resource *FHIRPractitionerRole `json:"resource,omitempty"`
+}
+
+// PagedFHIRPractitionerRole is a paged list of practitioner role resources
+type PagedFHIRPractitionerRole struct {
+	PractitionerRoles []FHIRPractitionerRole
+	HasNextPage       bool
+	NextCursor        string
+	HasPreviousPage   bool
+	PreviousCursor    string
+	TotalCount        int
+}
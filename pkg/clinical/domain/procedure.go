@@ -0,0 +1,49 @@
+package domain
+
+import "github.com/savannahghi/scalarutils"
+
+// FHIRProcedure models a fhir procedure resource.
+// It records an action performed on a patient e.g cryotherapy of the cervix after a positive VIA screening
+type FHIRProcedure struct {
+	ID           *string                   `json:"id,omitempty"`
+	Status       *scalarutils.Code         `json:"status,omitempty"`
+	StatusReason *FHIRCodeableConcept      `json:"statusReason,omitempty"`
+	Category     *FHIRCodeableConcept      `json:"category,omitempty"`
+	Code         *FHIRCodeableConcept      `json:"code,omitempty"`
+	Subject      *FHIRReference            `json:"subject,omitempty"`
+	Encounter    *FHIRReference            `json:"encounter,omitempty"`
+	Performer    []*FHIRProcedurePerformer `json:"performer,omitempty"`
+
+	// PerformedDateTime is when the procedure was performed
+	PerformedDateTime *string `json:"performedDateTime,omitempty"`
+
+	// ReasonReference is the finding that justified the procedure e.g a VIA observation or a biopsy report
+	ReasonReference []*FHIRReference       `json:"reasonReference,omitempty"`
+	BodySite        []*FHIRCodeableConcept `json:"bodySite,omitempty"`
+	Outcome         *FHIRCodeableConcept   `json:"outcome,omitempty"`
+	Complication    []*FHIRCodeableConcept `json:"complication,omitempty"`
+	Note            []*FHIRAnnotation      `json:"note,omitempty"`
+	Meta            *FHIRMetaInput         `json:"meta,omitempty"`
+	Extension       []*FHIRExtension       `json:"extension,omitempty"`
+}
+
+// FHIRProcedurePerformer models who performed a procedure and the organization they performed it on behalf of
+type FHIRProcedurePerformer struct {
+	Actor      *FHIRReference `json:"actor,omitempty"`
+	OnBehalfOf *FHIRReference `json:"onBehalfOf,omitempty"`
+}
+
+// FHIRProcedureRelayPayload is used to return single instances of Procedure
+type FHIRProcedureRelayPayload struct {
+	Resource *FHIRProcedure `json:"resource,omitempty"`
+}
+
+// PagedFHIRProcedure is a paged list of procedure resources
+type PagedFHIRProcedure struct {
+	Procedures      []FHIRProcedure
+	HasNextPage     bool
+	NextCursor      string
+	HasPreviousPage bool
+	PreviousCursor  string
+	TotalCount      int
+}
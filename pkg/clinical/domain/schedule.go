@@ -0,0 +1,64 @@
+package domain
+
+import "github.com/savannahghi/scalarutils"
+
+// FHIRSchedule models a fhir schedule resource.
+// It is the container for the slots in which a facility or a practitioner is available for appointments
+type FHIRSchedule struct {
+	ID          *string                `json:"id,omitempty"`
+	Active      *bool                  `json:"active,omitempty"`
+	ServiceType []*FHIRCodeableConcept `json:"serviceType,omitempty"`
+
+	// Actor is the facility or the practitioner whose availability the schedule records
+	Actor           []*FHIRReference `json:"actor,omitempty"`
+	PlanningHorizon *FHIRPeriod      `json:"planningHorizon,omitempty"`
+	Comment         *string          `json:"comment,omitempty"`
+	Meta            *FHIRMetaInput   `json:"meta,omitempty"`
+	Extension       []*FHIRExtension `json:"extension,omitempty"`
+}
+
+// FHIRScheduleRelayPayload is used to return single instances of Schedule
+type FHIRScheduleRelayPayload struct {
+	Resource *FHIRSchedule `json:"resource,omitempty"`
+}
+
+// PagedFHIRSchedule is a paged list of schedule resources
+type PagedFHIRSchedule struct {
+	Schedules       []FHIRSchedule
+	HasNextPage     bool
+	NextCursor      string
+	HasPreviousPage bool
+	PreviousCursor  string
+	TotalCount      int
+}
+
+// FHIRSlot models a fhir slot resource.
+// It is a period of a schedule in which an appointment may be booked
+type FHIRSlot struct {
+	ID          *string                `json:"id,omitempty"`
+	ServiceType []*FHIRCodeableConcept `json:"serviceType,omitempty"`
+	Schedule    *FHIRReference         `json:"schedule,omitempty"`
+
+	// Status is either free or busy once an appointment has been booked in the slot
+	Status    *scalarutils.Code `json:"status,omitempty"`
+	Start     *string           `json:"start,omitempty"`
+	End       *string           `json:"end,omitempty"`
+	Comment   *string           `json:"comment,omitempty"`
+	Meta      *FHIRMetaInput    `json:"meta,omitempty"`
+	Extension []*FHIRExtension  `json:"extension,omitempty"`
+}
+
+// FHIRSlotRelayPayload is used to return single instances of Slot
+type FHIRSlotRelayPayload struct {
+	Resource *FHIRSlot `json:"resource,omitempty"`
+}
+
+// PagedFHIRSlot is a paged list of slot resources
+type PagedFHIRSlot struct {
+	Slots           []FHIRSlot
+	HasNextPage     bool
+	NextCursor      string
+	HasPreviousPage bool
+	PreviousCursor  string
+	TotalCount      int
+}
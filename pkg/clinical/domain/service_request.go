@@ -278,3 +278,13 @@ type FHIRServiceRequestRelayEdge struct {
 type FHIRServiceRequestRelayPayload struct {
 	Resource *FHIRServiceRequest `json:"resource,omitempty"`
 }
+
+// PagedFHIRServiceRequest is a paged list of service request resources
+type PagedFHIRServiceRequest struct {
+	ServiceRequests []FHIRServiceRequest
+	HasNextPage     bool
+	NextCursor      string
+	HasPreviousPage bool
+	PreviousCursor  string
+	TotalCount      int
+}
@@ -0,0 +1,51 @@
+package domain
+
+import "github.com/savannahghi/scalarutils"
+
+// FHIRSpecimen models a fhir specimen resource.
+// It records a sample collected from a patient for analysis, e.g a biopsy or a blood sample
+type FHIRSpecimen struct {
+	ID *string `json:"id,omitempty"`
+
+	// AccessionIdentifier is the identifier assigned to the specimen by the laboratory that received it
+	AccessionIdentifier *FHIRIdentifier          `json:"accessionIdentifier,omitempty"`
+	Status              *scalarutils.Code        `json:"status,omitempty"`
+	Type                *FHIRCodeableConcept     `json:"type,omitempty"`
+	Subject             *FHIRReference           `json:"subject,omitempty"`
+	ReceivedTime        *string                  `json:"receivedTime,omitempty"`
+	Request             []*FHIRReference         `json:"request,omitempty"`
+	Collection          *FHIRSpecimenCollection  `json:"collection,omitempty"`
+	Container           []*FHIRSpecimenContainer `json:"container,omitempty"`
+	Note                []*FHIRAnnotation        `json:"note,omitempty"`
+	Meta                *FHIRMetaInput           `json:"meta,omitempty"`
+	Extension           []*FHIRExtension         `json:"extension,omitempty"`
+}
+
+// FHIRSpecimenCollection models the details of how and when a specimen was collected
+type FHIRSpecimenCollection struct {
+	Collector         *FHIRReference       `json:"collector,omitempty"`
+	CollectedDateTime *string              `json:"collectedDateTime,omitempty"`
+	BodySite          *FHIRCodeableConcept `json:"bodySite,omitempty"`
+}
+
+// FHIRSpecimenContainer models the container holding a specimen
+type FHIRSpecimenContainer struct {
+	Identifier  []*FHIRIdentifier    `json:"identifier,omitempty"`
+	Description *string              `json:"description,omitempty"`
+	Type        *FHIRCodeableConcept `json:"type,omitempty"`
+}
+
+// FHIRSpecimenRelayPayload is used to return single instances of Specimen
+type FHIRSpecimenRelayPayload struct {
+	Resource *FHIRSpecimen `json:"resource,omitempty"`
+}
+
+// PagedFHIRSpecimen is a paged list of specimen resources
+type PagedFHIRSpecimen struct {
+	Specimens       []FHIRSpecimen
+	HasNextPage     bool
+	NextCursor      string
+	HasPreviousPage bool
+	PreviousCursor  string
+	TotalCount      int
+}
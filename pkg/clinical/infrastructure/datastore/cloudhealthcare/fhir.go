@@ -12,6 +12,7 @@ import (
 	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 	"github.com/savannahghi/clinical/pkg/clinical/domain"
 	"github.com/savannahghi/converterandformatter"
+	"github.com/savannahghi/firebasetools"
 	"github.com/savannahghi/scalarutils"
 )
 
@@ -42,6 +43,21 @@ const (
 	riskAssessmentResourceType        = "RiskAssessment"
 	diagnosticReportResourceType      = "DiagnosticReport"
 	subscriptionResourceType          = "Subscription"
+	basicResourceType                 = "Basic"
+	auditEventResourceType            = "AuditEvent"
+	medicationDispenseResourceType    = "MedicationDispense"
+	immunizationResourceType          = "Immunization"
+	specimenResourceType              = "Specimen"
+	procedureResourceType             = "Procedure"
+	goalResourceType                  = "Goal"
+	carePlanResourceType              = "CarePlan"
+	scheduleResourceType              = "Schedule"
+	slotResourceType                  = "Slot"
+	appointmentResourceType           = "Appointment"
+	practitionerResourceType          = "Practitioner"
+	practitionerRoleResourceType      = "PractitionerRole"
+	locationResourceType              = "Location"
+	familyMemberHistoryResourceType   = "FamilyMemberHistory"
 )
 
 // Dataset ...
@@ -51,7 +67,10 @@ type Dataset interface {
 	DeleteFHIRResource(resourceType, fhirResourceID string) error
 	PatchFHIRResource(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error
 	UpdateFHIRResource(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error
+	UpdateFHIRResourceIfMatch(resourceType, fhirResourceID, versionID string, payload map[string]interface{}, resource interface{}) error
 	SearchFHIRResource(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error)
+	SearchFHIRSharedResource(resourceType string, params map[string]interface{}, pagination dto.Pagination) (*domain.PagedFHIRResource, error)
+	SearchFHIROrganisationResource(resourceType string, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRResource, error)
 
 	GetFHIRPatientAllData(fhirResourceID string, params map[string]interface{}) ([]byte, error)
 }
@@ -296,11 +315,12 @@ func (fh StoreImpl) CreateFHIROrganization(_ context.Context, input domain.FHIRO
 	return output, nil
 }
 
-// SearchFHIROrganization provides a search API for FHIROrganization
-func (fh StoreImpl) SearchFHIROrganization(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIROrganizationRelayConnection, error) {
+// SearchFHIROrganization provides a search API for FHIROrganization.
+// Organisations and their facilities are the tenants themselves so they are searched without tenant tags
+func (fh StoreImpl) SearchFHIROrganization(_ context.Context, params map[string]interface{}, pagination dto.Pagination) (*domain.FHIROrganizationRelayConnection, error) {
 	output := domain.FHIROrganizationRelayConnection{}
 
-	resources, err := fh.Dataset.SearchFHIRResource(organizationResource, params, tenant, pagination)
+	resources, err := fh.Dataset.SearchFHIRSharedResource(organizationResource, params, pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -679,14 +699,21 @@ func (fh StoreImpl) GetActiveEpisode(_ context.Context, episodeID string, tenant
 }
 
 // SearchFHIRServiceRequest provides a search API for FHIRServiceRequest
-func (fh StoreImpl) SearchFHIRServiceRequest(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRServiceRequestRelayConnection, error) {
-	output := domain.FHIRServiceRequestRelayConnection{}
-
+func (fh StoreImpl) SearchFHIRServiceRequest(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRServiceRequest, error) {
 	resources, err := fh.Dataset.SearchFHIRResource(serviceRequestResourceType, params, tenant, pagination)
 	if err != nil {
 		return nil, err
 	}
 
+	output := domain.PagedFHIRServiceRequest{
+		ServiceRequests: []domain.FHIRServiceRequest{},
+		HasNextPage:     resources.HasNextPage,
+		NextCursor:      resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		PreviousCursor:  resources.PreviousCursor,
+		TotalCount:      resources.TotalCount,
+	}
+
 	for _, result := range resources.Resources {
 		var resource domain.FHIRServiceRequest
 
@@ -701,9 +728,7 @@ func (fh StoreImpl) SearchFHIRServiceRequest(_ context.Context, params map[strin
 				"server error: Unable to unmarshal %s: %w", serviceRequestResourceType, err)
 		}
 
-		output.Edges = append(output.Edges, &domain.FHIRServiceRequestRelayEdge{
-			Node: &resource,
-		})
+		output.ServiceRequests = append(output.ServiceRequests, resource)
 	}
 
 	return &output, nil
@@ -730,6 +755,32 @@ func (fh StoreImpl) CreateFHIRServiceRequest(_ context.Context, input domain.FHI
 	return output, nil
 }
 
+// UpdateFHIRServiceRequest updates a FHIRServiceRequest instance
+// The resource must have its ID set.
+func (fh StoreImpl) UpdateFHIRServiceRequest(_ context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", serviceRequestResourceType, err)
+	}
+
+	resource := &domain.FHIRServiceRequest{}
+
+	err = fh.Dataset.UpdateFHIRResource(serviceRequestResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", serviceRequestResourceType, err)
+	}
+
+	output := &domain.FHIRServiceRequestRelayPayload{
+		Resource: resource,
+	}
+
+	return output, nil
+}
+
 // SearchFHIRAllergyIntolerance provides a search API for FHIRAllergyIntolerance
 func (fh StoreImpl) SearchFHIRAllergyIntolerance(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error) {
 	resources, err := fh.Dataset.SearchFHIRResource(allergyIntoleranceResourceType, params, tenant, pagination)
@@ -1007,6 +1058,22 @@ func (fh StoreImpl) UpdateFHIRCondition(_ context.Context, input domain.FHIRCond
 	return output, nil
 }
 
+// GetFHIRCondition retrieves instances of FHIRCondition by ID
+func (fh StoreImpl) GetFHIRCondition(_ context.Context, id string) (*domain.FHIRConditionRelayPayload, error) {
+	resource := &domain.FHIRCondition{}
+
+	err := fh.Dataset.GetFHIRResource(conditionResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", conditionResourceType, id, err)
+	}
+
+	payload := &domain.FHIRConditionRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
 // GetFHIREncounter retrieves instances of FHIREncounter by ID
 func (fh StoreImpl) GetFHIREncounter(_ context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
 	resource := &domain.FHIREncounter{}
@@ -1079,14 +1146,21 @@ func (fh StoreImpl) SearchFHIREncounterAllData(_ context.Context, params map[str
 }
 
 // SearchFHIRMedicationRequest provides a search API for FHIRMedicationRequest
-func (fh StoreImpl) SearchFHIRMedicationRequest(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationRequestRelayConnection, error) {
-	output := domain.FHIRMedicationRequestRelayConnection{}
-
+func (fh StoreImpl) SearchFHIRMedicationRequest(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationRequest, error) {
 	resources, err := fh.Dataset.SearchFHIRResource(medicationRequestResourceType, params, tenant, pagination)
 	if err != nil {
 		return nil, err
 	}
 
+	output := domain.PagedFHIRMedicationRequest{
+		MedicationRequests: []domain.FHIRMedicationRequest{},
+		HasNextPage:        resources.HasNextPage,
+		NextCursor:         resources.NextCursor,
+		HasPreviousPage:    resources.HasPreviousPage,
+		PreviousCursor:     resources.PreviousCursor,
+		TotalCount:         resources.TotalCount,
+	}
+
 	for _, result := range resources.Resources {
 		var resource domain.FHIRMedicationRequest
 
@@ -1101,9 +1175,7 @@ func (fh StoreImpl) SearchFHIRMedicationRequest(_ context.Context, params map[st
 				"server error: Unable to unmarshal %s: %w", medicationRequestResourceType, err)
 		}
 
-		output.Edges = append(output.Edges, &domain.FHIRMedicationRequestRelayEdge{
-			Node: &resource,
-		})
+		output.MedicationRequests = append(output.MedicationRequests, resource)
 	}
 
 	return &output, nil
@@ -1466,6 +1538,48 @@ func (fh StoreImpl) CreateFHIRMedicationStatement(_ context.Context, input domai
 	return output, nil
 }
 
+// GetFHIRMedicationStatement retrieves a FHIR medication statement using its ID
+func (fh StoreImpl) GetFHIRMedicationStatement(_ context.Context, id string) (*domain.FHIRMedicationStatementRelayPayload, error) {
+	resource := &domain.FHIRMedicationStatement{}
+
+	err := fh.Dataset.GetFHIRResource(medicationStatementResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", medicationStatementResourceType, id, err)
+	}
+
+	payload := &domain.FHIRMedicationStatementRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// UpdateFHIRMedicationStatement updates a FHIR medication statement instance
+// The resource must have its ID set.
+func (fh StoreImpl) UpdateFHIRMedicationStatement(_ context.Context, input domain.FHIRMedicationStatementInput) (*domain.FHIRMedicationStatementRelayPayload, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", medicationStatementResourceType, err)
+	}
+
+	resource := &domain.FHIRMedicationStatement{}
+
+	err = fh.Dataset.UpdateFHIRResource(medicationStatementResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", medicationStatementResourceType, err)
+	}
+
+	output := &domain.FHIRMedicationStatementRelayPayload{
+		Resource: resource,
+	}
+
+	return output, nil
+}
+
 // CreateFHIRMedication creates a new FHIR Medication instance
 func (fh StoreImpl) CreateFHIRMedication(_ context.Context, input domain.FHIRMedicationInput) (*domain.FHIRMedicationRelayPayload, error) {
 	payload, err := converterandformatter.StructToMap(input)
@@ -1506,13 +1620,21 @@ func (fh StoreImpl) CreateFHIRMedia(_ context.Context, input domain.FHIRMedia) (
 
 // SearchFHIRMedicationStatement used to search for a fhir medication statement
 func (fh StoreImpl) SearchFHIRMedicationStatement(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationStatementRelayConnection, error) {
-	output := domain.FHIRMedicationStatementRelayConnection{}
-
 	resources, err := fh.Dataset.SearchFHIRResource(medicationStatementResourceType, params, tenant, pagination)
 	if err != nil {
 		return nil, err
 	}
 
+	output := domain.FHIRMedicationStatementRelayConnection{
+		PageInfo: &firebasetools.PageInfo{
+			HasNextPage:     resources.HasNextPage,
+			HasPreviousPage: resources.HasPreviousPage,
+			StartCursor:     &resources.PreviousCursor,
+			EndCursor:       &resources.NextCursor,
+		},
+		TotalCount: resources.TotalCount,
+	}
+
 	for _, result := range resources.Resources {
 		var resource domain.FHIRMedicationStatement
 
@@ -1617,6 +1739,25 @@ func (fh StoreImpl) SearchFHIRPatient(_ context.Context, searchParams string, te
 		return nil, err
 	}
 
+	return mapPatientResources(resources)
+}
+
+// SearchFHIRPatientByIdentifier searches for FHIR patients using an identifier token in the `system|value` format
+func (fh StoreImpl) SearchFHIRPatientByIdentifier(_ context.Context, identifier string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error) {
+	params := map[string]interface{}{
+		"identifier": identifier,
+	}
+
+	resources, err := fh.Dataset.SearchFHIRResource(patientResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapPatientResources(resources)
+}
+
+// mapPatientResources converts raw patient search results into a patient connection
+func mapPatientResources(resources *domain.PagedFHIRResource) (*domain.PatientConnection, error) {
 	output := domain.PatientConnection{}
 
 	for _, resource := range resources.Resources {
@@ -1709,6 +1850,28 @@ func (fh StoreImpl) PatchFHIRObservation(_ context.Context, id string, input dom
 	return resource, nil
 }
 
+// UpdateFHIRObservation replaces an observation resource e.g when its value is converted from a string to a quantity.
+// The resource must have its ID set.
+func (fh StoreImpl) UpdateFHIRObservation(_ context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", observationResourceType, err)
+	}
+
+	resource := &domain.FHIRObservation{}
+
+	err = fh.Dataset.UpdateFHIRResource(observationResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update %s resource: %w", observationResourceType, err)
+	}
+
+	return resource, nil
+}
+
 // ListFHIRQuestionnaire is used to list questionnaire resource using the name or the title of the resource.
 func (fh StoreImpl) ListFHIRQuestionnaire(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRQuestionnaires, error) {
 	results, err := fh.Dataset.SearchFHIRResource(questionnaireResourceType, params, tenant, pagination)
@@ -1896,6 +2059,22 @@ func (fh StoreImpl) CreateFHIRDiagnosticReport(_ context.Context, input *domain.
 	return resource, nil
 }
 
+// GetFHIRDiagnosticReport retrieves instances of FHIR diagnostic report by ID
+func (fh StoreImpl) GetFHIRDiagnosticReport(_ context.Context, id string) (*domain.FHIRDiagnosticReportRelayPayload, error) {
+	resource := &domain.FHIRDiagnosticReport{}
+
+	err := fh.Dataset.GetFHIRResource(diagnosticReportResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", diagnosticReportResourceType, id, err)
+	}
+
+	payload := &domain.FHIRDiagnosticReportRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
 // GetFHIRPatientEverything is used to retrieve all patient related information
 func (fh StoreImpl) GetFHIRPatientEverything(ctx context.Context, id string, params map[string]interface{}) (*domain.PagedFHIRResource, error) {
 	patientEverythingBs, err := fh.Dataset.GetFHIRPatientAllData(id, params)
@@ -2069,3 +2248,1420 @@ func (fh StoreImpl) CreateFHIRSubscription(_ context.Context, subscription *doma
 
 	return fhirSubscription, nil
 }
+
+// CreateFHIRBasic creates a FHIR basic resource
+func (fh StoreImpl) CreateFHIRBasic(_ context.Context, input domain.FHIRBasic) (*domain.FHIRBasic, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", basicResourceType, err)
+	}
+
+	resource := &domain.FHIRBasic{}
+
+	err = fh.Dataset.CreateFHIRResource(basicResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", basicResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRBasic updates a FHIR basic resource
+func (fh StoreImpl) UpdateFHIRBasic(_ context.Context, input domain.FHIRBasic) (*domain.FHIRBasic, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", basicResourceType, err)
+	}
+
+	resource := &domain.FHIRBasic{}
+
+	err = fh.Dataset.UpdateFHIRResource(basicResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", basicResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRBasic provides a search API for FHIR basic resources
+func (fh StoreImpl) SearchFHIRBasic(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRBasic, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(basicResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	output := domain.PagedFHIRBasic{
+		Basics:          []domain.FHIRBasic{},
+		HasNextPage:     resources.HasNextPage,
+		NextCursor:      resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		PreviousCursor:  resources.PreviousCursor,
+		TotalCount:      resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRBasic
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", basicResourceType, err)
+		}
+
+		output.Basics = append(output.Basics, resource)
+	}
+
+	return &output, nil
+}
+
+// CreateFHIRAuditEvent creates a FHIR audit event resource
+func (fh StoreImpl) CreateFHIRAuditEvent(_ context.Context, input domain.FHIRAuditEvent) (*domain.FHIRAuditEvent, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", auditEventResourceType, err)
+	}
+
+	resource := &domain.FHIRAuditEvent{}
+
+	err = fh.Dataset.CreateFHIRResource(auditEventResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", auditEventResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRConsent updates a FHIR consent resource
+func (fh StoreImpl) UpdateFHIRConsent(_ context.Context, input domain.FHIRConsent) (*domain.FHIRConsent, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", consentResourceType, err)
+	}
+
+	resource := &domain.FHIRConsent{}
+
+	err = fh.Dataset.UpdateFHIRResource(consentResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", consentResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRConsent provides a search API for FHIR consent resources
+func (fh StoreImpl) SearchFHIRConsent(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRConsent, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(consentResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	output := domain.PagedFHIRConsent{
+		Consents:        []domain.FHIRConsent{},
+		HasNextPage:     resources.HasNextPage,
+		NextCursor:      resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		PreviousCursor:  resources.PreviousCursor,
+		TotalCount:      resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRConsent
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", consentResourceType, err)
+		}
+
+		output.Consents = append(output.Consents, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRConsent retrieves instances of FHIR consent by ID
+func (fh StoreImpl) GetFHIRConsent(_ context.Context, id string) (*domain.FHIRConsentRelayPayload, error) {
+	resource := &domain.FHIRConsent{}
+
+	err := fh.Dataset.GetFHIRResource(consentResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", consentResourceType, id, err)
+	}
+
+	payload := &domain.FHIRConsentRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// GetFHIRMedia retrieves instances of FHIR media by ID
+func (fh StoreImpl) GetFHIRMedia(_ context.Context, id string) (*domain.FHIRMediaRelayPayload, error) {
+	resource := &domain.FHIRMedia{}
+
+	err := fh.Dataset.GetFHIRResource(mediaResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", mediaResourceType, id, err)
+	}
+
+	payload := &domain.FHIRMediaRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// GetFHIRMedicationRequest retrieves instances of FHIR medication request by ID
+func (fh StoreImpl) GetFHIRMedicationRequest(_ context.Context, id string) (*domain.FHIRMedicationRequestRelayPayload, error) {
+	resource := &domain.FHIRMedicationRequest{}
+
+	err := fh.Dataset.GetFHIRResource(medicationRequestResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", medicationRequestResourceType, id, err)
+	}
+
+	payload := &domain.FHIRMedicationRequestRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// CreateFHIRMedicationDispense creates a FHIR medication dispense resource
+func (fh StoreImpl) CreateFHIRMedicationDispense(_ context.Context, input domain.FHIRMedicationDispense) (*domain.FHIRMedicationDispense, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", medicationDispenseResourceType, err)
+	}
+
+	resource := &domain.FHIRMedicationDispense{}
+
+	err = fh.Dataset.CreateFHIRResource(medicationDispenseResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", medicationDispenseResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRMedicationDispense updates a FHIR medication dispense resource
+func (fh StoreImpl) UpdateFHIRMedicationDispense(_ context.Context, input domain.FHIRMedicationDispense) (*domain.FHIRMedicationDispense, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", medicationDispenseResourceType, err)
+	}
+
+	resource := &domain.FHIRMedicationDispense{}
+
+	err = fh.Dataset.UpdateFHIRResource(medicationDispenseResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", medicationDispenseResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRMedicationDispense provides a search API for FHIR medication dispense resources
+func (fh StoreImpl) SearchFHIRMedicationDispense(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationDispense, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(medicationDispenseResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	output := domain.PagedFHIRMedicationDispense{
+		MedicationDispenses: []domain.FHIRMedicationDispense{},
+		HasNextPage:         resources.HasNextPage,
+		NextCursor:          resources.NextCursor,
+		HasPreviousPage:     resources.HasPreviousPage,
+		PreviousCursor:      resources.PreviousCursor,
+		TotalCount:          resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRMedicationDispense
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", medicationDispenseResourceType, err)
+		}
+
+		output.MedicationDispenses = append(output.MedicationDispenses, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRMedicationDispense retrieves instances of FHIR medication dispense by ID
+func (fh StoreImpl) GetFHIRMedicationDispense(_ context.Context, id string) (*domain.FHIRMedicationDispenseRelayPayload, error) {
+	resource := &domain.FHIRMedicationDispense{}
+
+	err := fh.Dataset.GetFHIRResource(medicationDispenseResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", medicationDispenseResourceType, id, err)
+	}
+
+	payload := &domain.FHIRMedicationDispenseRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// CreateFHIRImmunization creates a FHIR immunization resource
+func (fh StoreImpl) CreateFHIRImmunization(_ context.Context, input domain.FHIRImmunization) (*domain.FHIRImmunization, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", immunizationResourceType, err)
+	}
+
+	resource := &domain.FHIRImmunization{}
+
+	err = fh.Dataset.CreateFHIRResource(immunizationResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", immunizationResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRImmunization updates a FHIR immunization resource
+func (fh StoreImpl) UpdateFHIRImmunization(_ context.Context, input domain.FHIRImmunization) (*domain.FHIRImmunization, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", immunizationResourceType, err)
+	}
+
+	resource := &domain.FHIRImmunization{}
+
+	err = fh.Dataset.UpdateFHIRResource(immunizationResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", immunizationResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRImmunization provides a search API for FHIR immunization resources
+func (fh StoreImpl) SearchFHIRImmunization(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(immunizationResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagedFHIRImmunizations(resources)
+}
+
+// SearchFHIROrganisationImmunization searches the FHIR immunization resources recorded at any facility of an organisation
+func (fh StoreImpl) SearchFHIROrganisationImmunization(_ context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error) {
+	resources, err := fh.Dataset.SearchFHIROrganisationResource(immunizationResourceType, params, organisationID, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagedFHIRImmunizations(resources)
+}
+
+// pagedFHIRImmunizations maps the results of an immunization search
+func pagedFHIRImmunizations(resources *domain.PagedFHIRResource) (*domain.PagedFHIRImmunization, error) {
+	output := domain.PagedFHIRImmunization{
+		Immunizations:   []domain.FHIRImmunization{},
+		HasNextPage:     resources.HasNextPage,
+		NextCursor:      resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		PreviousCursor:  resources.PreviousCursor,
+		TotalCount:      resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRImmunization
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", immunizationResourceType, err)
+		}
+
+		output.Immunizations = append(output.Immunizations, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRImmunization retrieves instances of FHIR immunization by ID
+func (fh StoreImpl) GetFHIRImmunization(_ context.Context, id string) (*domain.FHIRImmunizationRelayPayload, error) {
+	resource := &domain.FHIRImmunization{}
+
+	err := fh.Dataset.GetFHIRResource(immunizationResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", immunizationResourceType, id, err)
+	}
+
+	payload := &domain.FHIRImmunizationRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// CreateFHIRSpecimen creates a FHIR specimen resource
+func (fh StoreImpl) CreateFHIRSpecimen(_ context.Context, input domain.FHIRSpecimen) (*domain.FHIRSpecimen, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", specimenResourceType, err)
+	}
+
+	resource := &domain.FHIRSpecimen{}
+
+	err = fh.Dataset.CreateFHIRResource(specimenResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", specimenResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRSpecimen updates a FHIR specimen resource
+func (fh StoreImpl) UpdateFHIRSpecimen(_ context.Context, input domain.FHIRSpecimen) (*domain.FHIRSpecimen, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", specimenResourceType, err)
+	}
+
+	resource := &domain.FHIRSpecimen{}
+
+	err = fh.Dataset.UpdateFHIRResource(specimenResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", specimenResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRSpecimen provides a search API for FHIR specimen resources
+func (fh StoreImpl) SearchFHIRSpecimen(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSpecimen, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(specimenResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	output := domain.PagedFHIRSpecimen{
+		Specimens:       []domain.FHIRSpecimen{},
+		HasNextPage:     resources.HasNextPage,
+		NextCursor:      resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		PreviousCursor:  resources.PreviousCursor,
+		TotalCount:      resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRSpecimen
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", specimenResourceType, err)
+		}
+
+		output.Specimens = append(output.Specimens, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRSpecimen retrieves instances of FHIR specimen by ID
+func (fh StoreImpl) GetFHIRSpecimen(_ context.Context, id string) (*domain.FHIRSpecimenRelayPayload, error) {
+	resource := &domain.FHIRSpecimen{}
+
+	err := fh.Dataset.GetFHIRResource(specimenResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", specimenResourceType, id, err)
+	}
+
+	payload := &domain.FHIRSpecimenRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// CreateFHIRProcedure creates a FHIR procedure resource
+func (fh StoreImpl) CreateFHIRProcedure(_ context.Context, input domain.FHIRProcedure) (*domain.FHIRProcedure, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", procedureResourceType, err)
+	}
+
+	resource := &domain.FHIRProcedure{}
+
+	err = fh.Dataset.CreateFHIRResource(procedureResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", procedureResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRProcedure updates a FHIR procedure resource
+func (fh StoreImpl) UpdateFHIRProcedure(_ context.Context, input domain.FHIRProcedure) (*domain.FHIRProcedure, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", procedureResourceType, err)
+	}
+
+	resource := &domain.FHIRProcedure{}
+
+	err = fh.Dataset.UpdateFHIRResource(procedureResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", procedureResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRProcedure provides a search API for FHIR procedure resources
+func (fh StoreImpl) SearchFHIRProcedure(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRProcedure, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(procedureResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	output := domain.PagedFHIRProcedure{
+		Procedures:      []domain.FHIRProcedure{},
+		HasNextPage:     resources.HasNextPage,
+		NextCursor:      resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		PreviousCursor:  resources.PreviousCursor,
+		TotalCount:      resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRProcedure
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", procedureResourceType, err)
+		}
+
+		output.Procedures = append(output.Procedures, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRProcedure retrieves instances of FHIR procedure by ID
+func (fh StoreImpl) GetFHIRProcedure(_ context.Context, id string) (*domain.FHIRProcedureRelayPayload, error) {
+	resource := &domain.FHIRProcedure{}
+
+	err := fh.Dataset.GetFHIRResource(procedureResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", procedureResourceType, id, err)
+	}
+
+	payload := &domain.FHIRProcedureRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// CreateFHIRGoal creates a FHIR goal resource
+func (fh StoreImpl) CreateFHIRGoal(_ context.Context, input domain.FHIRGoal) (*domain.FHIRGoal, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", goalResourceType, err)
+	}
+
+	resource := &domain.FHIRGoal{}
+
+	err = fh.Dataset.CreateFHIRResource(goalResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", goalResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRGoal updates a FHIR goal resource
+func (fh StoreImpl) UpdateFHIRGoal(_ context.Context, input domain.FHIRGoal) (*domain.FHIRGoal, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", goalResourceType, err)
+	}
+
+	resource := &domain.FHIRGoal{}
+
+	err = fh.Dataset.UpdateFHIRResource(goalResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", goalResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRGoal provides a search API for FHIR goal resources
+func (fh StoreImpl) SearchFHIRGoal(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRGoal, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(goalResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	output := domain.PagedFHIRGoal{
+		Goals:           []domain.FHIRGoal{},
+		HasNextPage:     resources.HasNextPage,
+		NextCursor:      resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		PreviousCursor:  resources.PreviousCursor,
+		TotalCount:      resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRGoal
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", goalResourceType, err)
+		}
+
+		output.Goals = append(output.Goals, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRGoal retrieves instances of FHIR goal by ID
+func (fh StoreImpl) GetFHIRGoal(_ context.Context, id string) (*domain.FHIRGoalRelayPayload, error) {
+	resource := &domain.FHIRGoal{}
+
+	err := fh.Dataset.GetFHIRResource(goalResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", goalResourceType, id, err)
+	}
+
+	payload := &domain.FHIRGoalRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// CreateFHIRCarePlan creates a FHIR care plan resource
+func (fh StoreImpl) CreateFHIRCarePlan(_ context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", carePlanResourceType, err)
+	}
+
+	resource := &domain.FHIRCarePlan{}
+
+	err = fh.Dataset.CreateFHIRResource(carePlanResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", carePlanResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRCarePlan updates a FHIR care plan resource
+func (fh StoreImpl) UpdateFHIRCarePlan(_ context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", carePlanResourceType, err)
+	}
+
+	resource := &domain.FHIRCarePlan{}
+
+	err = fh.Dataset.UpdateFHIRResource(carePlanResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", carePlanResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRCarePlan provides a search API for FHIR care plan resources
+func (fh StoreImpl) SearchFHIRCarePlan(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCarePlan, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(carePlanResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	output := domain.PagedFHIRCarePlan{
+		CarePlans:       []domain.FHIRCarePlan{},
+		HasNextPage:     resources.HasNextPage,
+		NextCursor:      resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		PreviousCursor:  resources.PreviousCursor,
+		TotalCount:      resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRCarePlan
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", carePlanResourceType, err)
+		}
+
+		output.CarePlans = append(output.CarePlans, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRCarePlan retrieves instances of FHIR care plan by ID
+func (fh StoreImpl) GetFHIRCarePlan(_ context.Context, id string) (*domain.FHIRCarePlanRelayPayload, error) {
+	resource := &domain.FHIRCarePlan{}
+
+	err := fh.Dataset.GetFHIRResource(carePlanResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", carePlanResourceType, id, err)
+	}
+
+	payload := &domain.FHIRCarePlanRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// CreateFHIRSchedule creates a FHIR schedule resource
+func (fh StoreImpl) CreateFHIRSchedule(_ context.Context, input domain.FHIRSchedule) (*domain.FHIRSchedule, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", scheduleResourceType, err)
+	}
+
+	resource := &domain.FHIRSchedule{}
+
+	err = fh.Dataset.CreateFHIRResource(scheduleResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", scheduleResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRSchedule updates a FHIR schedule resource
+func (fh StoreImpl) UpdateFHIRSchedule(_ context.Context, input domain.FHIRSchedule) (*domain.FHIRSchedule, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", scheduleResourceType, err)
+	}
+
+	resource := &domain.FHIRSchedule{}
+
+	err = fh.Dataset.UpdateFHIRResource(scheduleResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", scheduleResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRSchedule provides a search API for FHIR schedule resources
+func (fh StoreImpl) SearchFHIRSchedule(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSchedule, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(scheduleResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	output := domain.PagedFHIRSchedule{
+		Schedules:       []domain.FHIRSchedule{},
+		HasNextPage:     resources.HasNextPage,
+		NextCursor:      resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		PreviousCursor:  resources.PreviousCursor,
+		TotalCount:      resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRSchedule
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", scheduleResourceType, err)
+		}
+
+		output.Schedules = append(output.Schedules, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRSchedule retrieves instances of FHIR schedule by ID
+func (fh StoreImpl) GetFHIRSchedule(_ context.Context, id string) (*domain.FHIRScheduleRelayPayload, error) {
+	resource := &domain.FHIRSchedule{}
+
+	err := fh.Dataset.GetFHIRResource(scheduleResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", scheduleResourceType, id, err)
+	}
+
+	payload := &domain.FHIRScheduleRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// CreateFHIRSlot creates a FHIR slot resource
+func (fh StoreImpl) CreateFHIRSlot(_ context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", slotResourceType, err)
+	}
+
+	resource := &domain.FHIRSlot{}
+
+	err = fh.Dataset.CreateFHIRResource(slotResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", slotResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRSlot updates a FHIR slot resource.
+// A slot that was read with its version is only updated if it has not changed since, so that two bookings cannot both claim it
+func (fh StoreImpl) UpdateFHIRSlot(_ context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", slotResourceType, err)
+	}
+
+	resource := &domain.FHIRSlot{}
+
+	if input.Meta != nil && input.Meta.VersionID != "" {
+		err = fh.Dataset.UpdateFHIRResourceIfMatch(slotResourceType, *input.ID, input.Meta.VersionID, payload, resource)
+	} else {
+		err = fh.Dataset.UpdateFHIRResource(slotResourceType, *input.ID, payload, resource)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", slotResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRSlot provides a search API for FHIR slot resources
+func (fh StoreImpl) SearchFHIRSlot(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSlot, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(slotResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	output := domain.PagedFHIRSlot{
+		Slots:           []domain.FHIRSlot{},
+		HasNextPage:     resources.HasNextPage,
+		NextCursor:      resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		PreviousCursor:  resources.PreviousCursor,
+		TotalCount:      resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRSlot
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", slotResourceType, err)
+		}
+
+		output.Slots = append(output.Slots, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRSlot retrieves instances of FHIR slot by ID
+func (fh StoreImpl) GetFHIRSlot(_ context.Context, id string) (*domain.FHIRSlotRelayPayload, error) {
+	resource := &domain.FHIRSlot{}
+
+	err := fh.Dataset.GetFHIRResource(slotResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", slotResourceType, id, err)
+	}
+
+	payload := &domain.FHIRSlotRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// CreateFHIRAppointment creates a FHIR appointment resource
+func (fh StoreImpl) CreateFHIRAppointment(_ context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", appointmentResourceType, err)
+	}
+
+	resource := &domain.FHIRAppointment{}
+
+	err = fh.Dataset.CreateFHIRResource(appointmentResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", appointmentResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRAppointment updates a FHIR appointment resource
+func (fh StoreImpl) UpdateFHIRAppointment(_ context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", appointmentResourceType, err)
+	}
+
+	resource := &domain.FHIRAppointment{}
+
+	err = fh.Dataset.UpdateFHIRResource(appointmentResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", appointmentResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRAppointment provides a search API for FHIR appointment resources
+func (fh StoreImpl) SearchFHIRAppointment(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAppointment, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(appointmentResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	output := domain.PagedFHIRAppointment{
+		Appointments:    []domain.FHIRAppointment{},
+		HasNextPage:     resources.HasNextPage,
+		NextCursor:      resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		PreviousCursor:  resources.PreviousCursor,
+		TotalCount:      resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRAppointment
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", appointmentResourceType, err)
+		}
+
+		output.Appointments = append(output.Appointments, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRAppointment retrieves instances of FHIR appointment by ID
+func (fh StoreImpl) GetFHIRAppointment(_ context.Context, id string) (*domain.FHIRAppointmentRelayPayload, error) {
+	resource := &domain.FHIRAppointment{}
+
+	err := fh.Dataset.GetFHIRResource(appointmentResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", appointmentResourceType, id, err)
+	}
+
+	payload := &domain.FHIRAppointmentRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// CreateFHIRPractitioner creates a FHIR practitioner resource
+func (fh StoreImpl) CreateFHIRPractitioner(_ context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", practitionerResourceType, err)
+	}
+
+	resource := &domain.FHIRPractitioner{}
+
+	err = fh.Dataset.CreateFHIRResource(practitionerResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", practitionerResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRPractitioner updates a FHIR practitioner resource
+func (fh StoreImpl) UpdateFHIRPractitioner(_ context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", practitionerResourceType, err)
+	}
+
+	resource := &domain.FHIRPractitioner{}
+
+	err = fh.Dataset.UpdateFHIRResource(practitionerResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", practitionerResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRPractitioner provides a search API for FHIR practitioner resources
+func (fh StoreImpl) SearchFHIRPractitioner(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(practitionerResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagedFHIRPractitioners(resources)
+}
+
+// SearchFHIROrganisationPractitioner searches the FHIR practitioner resources registered at any facility of an organisation
+func (fh StoreImpl) SearchFHIROrganisationPractitioner(_ context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+	resources, err := fh.Dataset.SearchFHIROrganisationResource(practitionerResourceType, params, organisationID, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagedFHIRPractitioners(resources)
+}
+
+// pagedFHIRPractitioners maps the results of a practitioner search
+func pagedFHIRPractitioners(resources *domain.PagedFHIRResource) (*domain.PagedFHIRPractitioner, error) {
+	output := domain.PagedFHIRPractitioner{
+		Practitioners:   []domain.FHIRPractitioner{},
+		HasNextPage:     resources.HasNextPage,
+		NextCursor:      resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		PreviousCursor:  resources.PreviousCursor,
+		TotalCount:      resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRPractitioner
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", practitionerResourceType, err)
+		}
+
+		output.Practitioners = append(output.Practitioners, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRPractitioner retrieves instances of FHIR practitioner by ID
+func (fh StoreImpl) GetFHIRPractitioner(_ context.Context, id string) (*domain.FHIRPractitionerRelayPayload, error) {
+	resource := &domain.FHIRPractitioner{}
+
+	err := fh.Dataset.GetFHIRResource(practitionerResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", practitionerResourceType, id, err)
+	}
+
+	payload := &domain.FHIRPractitionerRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// CreateFHIRPractitionerRole creates a FHIR practitioner role resource
+func (fh StoreImpl) CreateFHIRPractitionerRole(_ context.Context, input domain.FHIRPractitionerRole) (*domain.FHIRPractitionerRole, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", practitionerRoleResourceType, err)
+	}
+
+	resource := &domain.FHIRPractitionerRole{}
+
+	err = fh.Dataset.CreateFHIRResource(practitionerRoleResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", practitionerRoleResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRPractitionerRole updates a FHIR practitioner role resource
+func (fh StoreImpl) UpdateFHIRPractitionerRole(_ context.Context, input domain.FHIRPractitionerRole) (*domain.FHIRPractitionerRole, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", practitionerRoleResourceType, err)
+	}
+
+	resource := &domain.FHIRPractitionerRole{}
+
+	err = fh.Dataset.UpdateFHIRResource(practitionerRoleResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", practitionerRoleResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRPractitionerRole provides a search API for FHIR practitioner role resources
+func (fh StoreImpl) SearchFHIRPractitionerRole(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRPractitionerRole, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(practitionerRoleResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagedFHIRPractitionerRoles(resources)
+}
+
+// SearchFHIROrganisationPractitionerRole searches the FHIR practitioner role resources of any facility of an organisation
+func (fh StoreImpl) SearchFHIROrganisationPractitionerRole(_ context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitionerRole, error) {
+	resources, err := fh.Dataset.SearchFHIROrganisationResource(practitionerRoleResourceType, params, organisationID, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagedFHIRPractitionerRoles(resources)
+}
+
+// pagedFHIRPractitionerRoles maps the results of a practitioner role search
+func pagedFHIRPractitionerRoles(resources *domain.PagedFHIRResource) (*domain.PagedFHIRPractitionerRole, error) {
+	output := domain.PagedFHIRPractitionerRole{
+		PractitionerRoles: []domain.FHIRPractitionerRole{},
+		HasNextPage:       resources.HasNextPage,
+		NextCursor:        resources.NextCursor,
+		HasPreviousPage:   resources.HasPreviousPage,
+		PreviousCursor:    resources.PreviousCursor,
+		TotalCount:        resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRPractitionerRole
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", practitionerRoleResourceType, err)
+		}
+
+		output.PractitionerRoles = append(output.PractitionerRoles, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRPractitionerRole retrieves instances of FHIR practitioner role by ID
+func (fh StoreImpl) GetFHIRPractitionerRole(_ context.Context, id string) (*domain.FHIRPractitionerRoleRelayPayload, error) {
+	resource := &domain.FHIRPractitionerRole{}
+
+	err := fh.Dataset.GetFHIRResource(practitionerRoleResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", practitionerRoleResourceType, id, err)
+	}
+
+	payload := &domain.FHIRPractitionerRoleRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// CreateFHIRLocation creates a FHIR location resource
+func (fh StoreImpl) CreateFHIRLocation(_ context.Context, input domain.FHIRLocation) (*domain.FHIRLocation, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", locationResourceType, err)
+	}
+
+	resource := &domain.FHIRLocation{}
+
+	err = fh.Dataset.CreateFHIRResource(locationResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", locationResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRLocation updates a FHIR location resource
+func (fh StoreImpl) UpdateFHIRLocation(_ context.Context, input domain.FHIRLocation) (*domain.FHIRLocation, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", locationResourceType, err)
+	}
+
+	resource := &domain.FHIRLocation{}
+
+	err = fh.Dataset.UpdateFHIRResource(locationResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", locationResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRLocation provides a search API for FHIR location resources
+func (fh StoreImpl) SearchFHIRLocation(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRLocation, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(locationResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	output := domain.PagedFHIRLocation{
+		Locations:       []domain.FHIRLocation{},
+		HasNextPage:     resources.HasNextPage,
+		NextCursor:      resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		PreviousCursor:  resources.PreviousCursor,
+		TotalCount:      resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRLocation
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", locationResourceType, err)
+		}
+
+		output.Locations = append(output.Locations, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRLocation retrieves instances of FHIR location by ID
+func (fh StoreImpl) GetFHIRLocation(_ context.Context, id string) (*domain.FHIRLocationRelayPayload, error) {
+	resource := &domain.FHIRLocation{}
+
+	err := fh.Dataset.GetFHIRResource(locationResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", locationResourceType, id, err)
+	}
+
+	payload := &domain.FHIRLocationRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
+
+// CreateFHIRFamilyMemberHistory creates a FHIR family member history resource
+func (fh StoreImpl) CreateFHIRFamilyMemberHistory(_ context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error) {
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", familyMemberHistoryResourceType, err)
+	}
+
+	resource := &domain.FHIRFamilyMemberHistory{}
+
+	err = fh.Dataset.CreateFHIRResource(familyMemberHistoryResourceType, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", familyMemberHistoryResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// UpdateFHIRFamilyMemberHistory updates a FHIR family member history resource
+func (fh StoreImpl) UpdateFHIRFamilyMemberHistory(_ context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error) {
+	if input.ID == nil {
+		return nil, fmt.Errorf("can't update with a nil ID")
+	}
+
+	payload, err := converterandformatter.StructToMap(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to turn %s input into a map: %w", familyMemberHistoryResourceType, err)
+	}
+
+	resource := &domain.FHIRFamilyMemberHistory{}
+
+	err = fh.Dataset.UpdateFHIRResource(familyMemberHistoryResourceType, *input.ID, payload, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update %s resource: %w", familyMemberHistoryResourceType, err)
+	}
+
+	return resource, nil
+}
+
+// SearchFHIRFamilyMemberHistory provides a search API for FHIR family member history resources
+func (fh StoreImpl) SearchFHIRFamilyMemberHistory(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRFamilyMemberHistory, error) {
+	resources, err := fh.Dataset.SearchFHIRResource(familyMemberHistoryResourceType, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	output := domain.PagedFHIRFamilyMemberHistory{
+		FamilyMemberHistories: []domain.FHIRFamilyMemberHistory{},
+		HasNextPage:           resources.HasNextPage,
+		NextCursor:            resources.NextCursor,
+		HasPreviousPage:       resources.HasPreviousPage,
+		PreviousCursor:        resources.PreviousCursor,
+		TotalCount:            resources.TotalCount,
+	}
+
+	for _, result := range resources.Resources {
+		var resource domain.FHIRFamilyMemberHistory
+
+		resourceBs, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("server error: Unable to marshal map to JSON: %w", err)
+		}
+
+		err = json.Unmarshal(resourceBs, &resource)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"server error: Unable to unmarshal %s: %w", familyMemberHistoryResourceType, err)
+		}
+
+		output.FamilyMemberHistories = append(output.FamilyMemberHistories, resource)
+	}
+
+	return &output, nil
+}
+
+// GetFHIRFamilyMemberHistory retrieves instances of FHIR family member history by ID
+func (fh StoreImpl) GetFHIRFamilyMemberHistory(_ context.Context, id string) (*domain.FHIRFamilyMemberHistoryRelayPayload, error) {
+	resource := &domain.FHIRFamilyMemberHistory{}
+
+	err := fh.Dataset.GetFHIRResource(familyMemberHistoryResourceType, id, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s with ID %s, err: %w", familyMemberHistoryResourceType, id, err)
+	}
+
+	payload := &domain.FHIRFamilyMemberHistoryRelayPayload{
+		Resource: resource,
+	}
+
+	return payload, nil
+}
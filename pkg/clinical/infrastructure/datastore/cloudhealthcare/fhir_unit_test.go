@@ -1129,6 +1129,80 @@ func TestStoreImpl_SearchFHIRPatient(t *testing.T) {
 	}
 }
 
+func TestStoreImpl_SearchFHIRPatientByIdentifier(t *testing.T) {
+
+	type args struct {
+		ctx        context.Context
+		identifier string
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "happy case: search patient by identifier",
+			args: args{
+				ctx:        context.Background(),
+				identifier: fmt.Sprintf("mycarehub.user.id|%s", gofakeit.UUID()),
+			},
+			wantErr: false,
+		},
+		{
+			name: "sad case: search patient by identifier error",
+			args: args{
+				ctx:        context.Background(),
+				identifier: fmt.Sprintf("mycarehub.user.id|%s", gofakeit.UUID()),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "happy case: search patient by identifier" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					patient, err := fakePatient()
+					if err != nil {
+						return nil, err
+					}
+
+					payload, err := converterandformatter.StructToMap(patient)
+					if err != nil {
+						return nil, err
+					}
+
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							payload,
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "sad case: search patient by identifier error" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, fmt.Errorf("failed to find patient")
+				}
+			}
+
+			got, err := fh.SearchFHIRPatientByIdentifier(tt.args.ctx, tt.args.identifier, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRPatientByIdentifier() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a response but got: %v", got)
+				return
+			}
+		})
+	}
+}
+
 func TestStoreImpl_DeleteFHIRPatient(t *testing.T) {
 
 	type args struct {
@@ -2541,7 +2615,7 @@ func TestStoreImpl_SearchFHIRServiceRequest(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		want    *domain.FHIRServiceRequestRelayConnection
+		want    *domain.PagedFHIRServiceRequest
 		wantErr bool
 	}{
 		{
@@ -2586,6 +2660,63 @@ func TestStoreImpl_SearchFHIRServiceRequest(t *testing.T) {
 	}
 }
 
+func TestStoreImpl_UpdateFHIRServiceRequest(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New().String()
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRServiceRequestInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.FHIRServiceRequestRelayPayload
+		wantErr bool
+	}{
+		{
+			name: "Happy Case - successfully update fhir service request",
+			args: args{ctx: ctx, input: domain.FHIRServiceRequestInput{
+				ID: &id,
+			}},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case - fail to update fhir service request",
+			args: args{ctx: ctx, input: domain.FHIRServiceRequestInput{
+				ID: &id,
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "Sad Case - missing ID",
+			args:    args{ctx: ctx, input: domain.FHIRServiceRequestInput{}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad Case - fail to update fhir service request" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return fmt.Errorf("failed to update service request")
+				}
+			}
+
+			got, err := fh.UpdateFHIRServiceRequest(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRServiceRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a response but got: %v", got)
+				return
+			}
+		})
+	}
+}
+
 func TestStoreImpl_SearchFHIRAllergyIntolerance(t *testing.T) {
 	ctx := context.Background()
 	type args struct {
@@ -2823,7 +2954,7 @@ func TestStoreImpl_SearchFHIRMedicationRequest(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		want    *domain.FHIRMedicationRequestRelayConnection
+		want    *domain.PagedFHIRMedicationRequest
 		wantErr bool
 	}{
 		{
@@ -2868,6 +2999,62 @@ func TestStoreImpl_SearchFHIRMedicationRequest(t *testing.T) {
 	}
 }
 
+func TestStoreImpl_UpdateFHIRObservation(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New().String()
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRObservationInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy Case - successfully update fhir observation",
+			args: args{ctx: ctx, input: domain.FHIRObservationInput{
+				ID: &id,
+			}},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case - fail to update fhir observation",
+			args: args{ctx: ctx, input: domain.FHIRObservationInput{
+				ID: &id,
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "Sad Case - missing ID",
+			args:    args{ctx: ctx, input: domain.FHIRObservationInput{}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad Case - fail to update fhir observation" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return fmt.Errorf("failed to update observation")
+				}
+			}
+
+			got, err := fh.UpdateFHIRObservation(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRObservation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a response but got: %v", got)
+				return
+			}
+		})
+	}
+}
+
 func TestStoreImpl_UpdateFHIRCondition(t *testing.T) {
 	ctx := context.Background()
 	id := uuid.New().String()
@@ -2925,6 +3112,53 @@ func TestStoreImpl_UpdateFHIRCondition(t *testing.T) {
 	}
 }
 
+func TestStoreImpl_GetFHIRCondition(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get condition",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get condition",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get condition" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRCondition(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRCondition() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
 func TestStoreImpl_UpdateFHIRMedicationRequest(t *testing.T) {
 	ctx := context.Background()
 	id := uuid.New().String()
@@ -3262,7 +3496,6 @@ func TestStoreImpl_SearchFHIROrganization(t *testing.T) {
 	type args struct {
 		ctx        context.Context
 		params     map[string]interface{}
-		tenant     dto.TenantIdentifiers
 		pagination dto.Pagination
 	}
 	tests := []struct {
@@ -3297,11 +3530,11 @@ func TestStoreImpl_SearchFHIROrganization(t *testing.T) {
 			fh := FHIR.NewFHIRStoreImpl(dataset)
 
 			if tt.name == "Sad case: failed to search FHIR organisation" {
-				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+				dataset.MockSearchFHIRSharedResourceFn = func(resourceType string, params map[string]interface{}, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
 					return nil, fmt.Errorf("an error occurred")
 				}
 			}
-			got, err := fh.SearchFHIROrganization(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			got, err := fh.SearchFHIROrganization(tt.args.ctx, tt.args.params, tt.args.pagination)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("StoreImpl.SearchFHIROrganization() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -4924,6 +5157,53 @@ func TestStoreImpl_CreateFHIRDiagnosticReport(t *testing.T) {
 	}
 }
 
+func TestStoreImpl_GetFHIRDiagnosticReport(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get diagnostic report",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get diagnostic report",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get diagnostic report" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRDiagnosticReport(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRDiagnosticReport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
 func TestStoreImpl_SearchFHIRRiskAssessment(t *testing.T) {
 	ctx := context.Background()
 	type args struct {
@@ -5257,3 +5537,3498 @@ func TestStoreImpl_CreateFHIRSubscription(t *testing.T) {
 		})
 	}
 }
+
+func TestStoreImpl_CreateFHIRBasic(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRBasic
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create basic",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRBasic{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create basic",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRBasic{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create basic" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRBasic(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRBasic() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRBasic(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRBasic
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update basic",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRBasic{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRBasic{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update basic",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRBasic{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update basic" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRBasic(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRBasic() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRBasic(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search basic",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search basic",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search basic" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "Basic",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search basic" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRBasic(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRBasic() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Basics) != 1 {
+				t.Errorf("expected one basic but got %v", len(got.Basics))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRAuditEvent(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRAuditEvent
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create audit event",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRAuditEvent{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create audit event",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRAuditEvent{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create audit event" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRAuditEvent(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRAuditEvent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRConsent(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRConsent
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update consent",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRConsent{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRConsent{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update consent",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRConsent{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update consent" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRConsent(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRConsent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRConsent(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search consent",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search consent",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search consent" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "Consent",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search consent" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRConsent(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRConsent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Consents) != 1 {
+				t.Errorf("expected one consent but got %v", len(got.Consents))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRConsent(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get consent",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get consent",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get consent" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRConsent(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRConsent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRMedia(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get media",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get media",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get media" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRMedia(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRMedia() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRMedicationRequest(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get medication request",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get medication request",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get medication request" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRMedicationRequest(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRMedicationRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRMedicationDispense(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRMedicationDispense
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create medication dispense",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRMedicationDispense{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create medication dispense",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRMedicationDispense{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create medication dispense" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRMedicationDispense(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRMedicationDispense() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRMedicationDispense(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRMedicationDispense
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update medication dispense",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRMedicationDispense{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRMedicationDispense{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update medication dispense",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRMedicationDispense{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update medication dispense" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRMedicationDispense(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRMedicationDispense() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRMedicationDispense(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search medication dispense",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search medication dispense",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search medication dispense" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "MedicationDispense",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search medication dispense" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRMedicationDispense(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRMedicationDispense() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.MedicationDispenses) != 1 {
+				t.Errorf("expected one medication dispense but got %v", len(got.MedicationDispenses))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRMedicationDispense(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get medication dispense",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get medication dispense",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get medication dispense" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRMedicationDispense(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRMedicationDispense() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRMedicationStatement(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get medication statement",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get medication statement",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get medication statement" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRMedicationStatement(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRMedicationStatement() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRMedicationStatement(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New().String()
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRMedicationStatementInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy Case - successfully update fhir medication statement",
+			args: args{ctx: ctx, input: domain.FHIRMedicationStatementInput{
+				ID: &id,
+			}},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case - fail to update fhir medication statement",
+			args: args{ctx: ctx, input: domain.FHIRMedicationStatementInput{
+				ID: &id,
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "Sad Case - missing ID",
+			args:    args{ctx: ctx, input: domain.FHIRMedicationStatementInput{}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad Case - fail to update fhir medication statement" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return fmt.Errorf("failed to update medication statement")
+				}
+			}
+
+			got, err := fh.UpdateFHIRMedicationStatement(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRMedicationStatement() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a response but got: %v", got)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRImmunization(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRImmunization
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create immunization",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRImmunization{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create immunization",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRImmunization{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create immunization" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRImmunization(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRImmunization() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRImmunization(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRImmunization
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update immunization",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRImmunization{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRImmunization{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update immunization",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRImmunization{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update immunization" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRImmunization(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRImmunization() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRImmunization(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search immunization",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search immunization",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search immunization" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "Immunization",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search immunization" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRImmunization(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRImmunization() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Immunizations) != 1 {
+				t.Errorf("expected one immunization but got %v", len(got.Immunizations))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIROrganisationImmunization(t *testing.T) {
+	type args struct {
+		ctx            context.Context
+		params         map[string]interface{}
+		organisationID string
+		pagination     dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search organisation immunization",
+			args: args{
+				ctx:            context.Background(),
+				params:         map[string]interface{}{"patient": gofakeit.UUID()},
+				organisationID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search organisation immunization",
+			args: args{
+				ctx:            context.Background(),
+				params:         map[string]interface{}{"patient": gofakeit.UUID()},
+				organisationID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search organisation immunization" {
+				dataset.MockSearchFHIROrganisationResourceFn = func(resourceType string, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					if organisationID != tt.args.organisationID {
+						return nil, fmt.Errorf("expected organisation %s but got %s", tt.args.organisationID, organisationID)
+					}
+
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "Immunization",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search organisation immunization" {
+				dataset.MockSearchFHIROrganisationResourceFn = func(resourceType string, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIROrganisationImmunization(tt.args.ctx, tt.args.params, tt.args.organisationID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIROrganisationImmunization() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Immunizations) != 1 {
+				t.Errorf("expected one immunization but got %v", len(got.Immunizations))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRImmunization(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get immunization",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get immunization",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get immunization" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRImmunization(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRImmunization() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRSpecimen(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRSpecimen
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create specimen",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSpecimen{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create specimen",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSpecimen{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create specimen" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRSpecimen(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRSpecimen() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRSpecimen(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRSpecimen
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update specimen",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSpecimen{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSpecimen{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update specimen",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSpecimen{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update specimen" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRSpecimen(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRSpecimen() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRSpecimen(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search specimen",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search specimen",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search specimen" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "Specimen",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search specimen" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRSpecimen(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRSpecimen() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Specimens) != 1 {
+				t.Errorf("expected one specimen but got %v", len(got.Specimens))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRSpecimen(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get specimen",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get specimen",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get specimen" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRSpecimen(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRSpecimen() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRProcedure(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRProcedure
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create procedure",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRProcedure{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create procedure",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRProcedure{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create procedure" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRProcedure(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRProcedure() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRProcedure(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRProcedure
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update procedure",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRProcedure{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRProcedure{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update procedure",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRProcedure{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update procedure" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRProcedure(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRProcedure() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRProcedure(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search procedure",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search procedure",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search procedure" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "Procedure",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search procedure" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRProcedure(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRProcedure() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Procedures) != 1 {
+				t.Errorf("expected one procedure but got %v", len(got.Procedures))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRProcedure(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get procedure",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get procedure",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get procedure" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRProcedure(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRProcedure() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRGoal(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRGoal
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create goal",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRGoal{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create goal",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRGoal{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create goal" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRGoal(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRGoal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRGoal(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRGoal
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update goal",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRGoal{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRGoal{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update goal",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRGoal{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update goal" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRGoal(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRGoal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRGoal(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search goal",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search goal",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search goal" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "Goal",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search goal" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRGoal(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRGoal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Goals) != 1 {
+				t.Errorf("expected one goal but got %v", len(got.Goals))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRGoal(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get goal",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get goal",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get goal" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRGoal(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRGoal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRCarePlan(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRCarePlan
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create care plan",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRCarePlan{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create care plan",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRCarePlan{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create care plan" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRCarePlan(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRCarePlan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRCarePlan(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRCarePlan
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update care plan",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRCarePlan{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRCarePlan{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update care plan",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRCarePlan{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update care plan" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRCarePlan(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRCarePlan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRCarePlan(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search care plan",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search care plan",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search care plan" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "CarePlan",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search care plan" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRCarePlan(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRCarePlan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.CarePlans) != 1 {
+				t.Errorf("expected one care plan but got %v", len(got.CarePlans))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRCarePlan(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get care plan",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get care plan",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get care plan" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRCarePlan(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRCarePlan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRSchedule(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRSchedule
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create schedule",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSchedule{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create schedule",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSchedule{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create schedule" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRSchedule(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRSchedule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRSchedule(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRSchedule
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update schedule",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSchedule{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSchedule{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update schedule",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSchedule{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update schedule" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRSchedule(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRSchedule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRSchedule(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search schedule",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search schedule",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search schedule" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "Schedule",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search schedule" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRSchedule(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRSchedule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Schedules) != 1 {
+				t.Errorf("expected one schedule but got %v", len(got.Schedules))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRSchedule(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get schedule",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get schedule",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get schedule" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRSchedule(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRSchedule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRSlot(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRSlot
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create slot",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSlot{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create slot",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSlot{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create slot" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRSlot(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRSlot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRSlot(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRSlot
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update slot",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSlot{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: update a slot at the version it was read",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSlot{ID: &ID, Meta: &domain.FHIRMetaInput{VersionID: "MTY4"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSlot{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update slot",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSlot{ID: &ID},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: slot changed since it was read",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRSlot{ID: &ID, Meta: &domain.FHIRMetaInput{VersionID: "MTY4"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			versionChecked := false
+
+			dataset.MockUpdateFHIRResourceIfMatchFn = func(resourceType, fhirResourceID, versionID string, payload map[string]interface{}, resource interface{}) error {
+				versionChecked = versionID == "MTY4"
+
+				return nil
+			}
+
+			if tt.name == "Sad case: slot changed since it was read" {
+				dataset.MockUpdateFHIRResourceIfMatchFn = func(resourceType, fhirResourceID, versionID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("precondition failed")
+				}
+			}
+
+			if tt.name == "Sad case: unable to update slot" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRSlot(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRSlot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.name == "Happy case: update a slot at the version it was read" && !versionChecked {
+				t.Errorf("expected the slot to be updated at version MTY4")
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRSlot(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search slot",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search slot",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search slot" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "Slot",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search slot" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRSlot(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRSlot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Slots) != 1 {
+				t.Errorf("expected one slot but got %v", len(got.Slots))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRSlot(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get slot",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get slot",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get slot" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRSlot(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRSlot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRAppointment(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRAppointment
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create appointment",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRAppointment{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create appointment",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRAppointment{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create appointment" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRAppointment(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRAppointment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRAppointment(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRAppointment
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update appointment",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRAppointment{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRAppointment{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update appointment",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRAppointment{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update appointment" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRAppointment(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRAppointment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRAppointment(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search appointment",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search appointment",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search appointment" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "Appointment",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search appointment" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRAppointment(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRAppointment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Appointments) != 1 {
+				t.Errorf("expected one appointment but got %v", len(got.Appointments))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRAppointment(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get appointment",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get appointment",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get appointment" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRAppointment(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRAppointment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRPractitioner(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRPractitioner
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create practitioner",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRPractitioner{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create practitioner",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRPractitioner{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create practitioner" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRPractitioner(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRPractitioner() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRPractitioner(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRPractitioner
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update practitioner",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRPractitioner{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRPractitioner{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update practitioner",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRPractitioner{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update practitioner" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRPractitioner(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRPractitioner() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRPractitioner(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search practitioner",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search practitioner",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search practitioner" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "Practitioner",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search practitioner" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRPractitioner(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRPractitioner() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Practitioners) != 1 {
+				t.Errorf("expected one practitioner but got %v", len(got.Practitioners))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRPractitioner(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get practitioner",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get practitioner",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get practitioner" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRPractitioner(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRPractitioner() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRPractitionerRole(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRPractitionerRole
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create practitioner role",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRPractitionerRole{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create practitioner role",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRPractitionerRole{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create practitioner role" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRPractitionerRole(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRPractitionerRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRPractitionerRole(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRPractitionerRole
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update practitioner role",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRPractitionerRole{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRPractitionerRole{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update practitioner role",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRPractitionerRole{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update practitioner role" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRPractitionerRole(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRPractitionerRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRPractitionerRole(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search practitioner role",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search practitioner role",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search practitioner role" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "PractitionerRole",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search practitioner role" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRPractitionerRole(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRPractitionerRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.PractitionerRoles) != 1 {
+				t.Errorf("expected one practitioner role but got %v", len(got.PractitionerRoles))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRPractitionerRole(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get practitioner role",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get practitioner role",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get practitioner role" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRPractitionerRole(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRPractitionerRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRLocation(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRLocation
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create location",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRLocation{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create location",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRLocation{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create location" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRLocation(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRLocation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRLocation(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRLocation
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update location",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRLocation{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRLocation{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update location",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRLocation{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update location" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRLocation(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRLocation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRLocation(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search location",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search location",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search location" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "Location",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search location" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRLocation(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRLocation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Locations) != 1 {
+				t.Errorf("expected one location but got %v", len(got.Locations))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRLocation(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get location",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get location",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get location" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRLocation(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRLocation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_CreateFHIRFamilyMemberHistory(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRFamilyMemberHistory
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create family member history",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRFamilyMemberHistory{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to create family member history",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRFamilyMemberHistory{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to create family member history" {
+				dataset.MockCreateFHIRResourceFn = func(resourceType string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.CreateFHIRFamilyMemberHistory(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.CreateFHIRFamilyMemberHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_UpdateFHIRFamilyMemberHistory(t *testing.T) {
+	ID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input domain.FHIRFamilyMemberHistory
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update family member history",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRFamilyMemberHistory{ID: &ID},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing ID",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRFamilyMemberHistory{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to update family member history",
+			args: args{
+				ctx:   context.Background(),
+				input: domain.FHIRFamilyMemberHistory{ID: &ID},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to update family member history" {
+				dataset.MockUpdateFHIRResourceFn = func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.UpdateFHIRFamilyMemberHistory(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.UpdateFHIRFamilyMemberHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestStoreImpl_SearchFHIRFamilyMemberHistory(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		params     map[string]interface{}
+		tenant     dto.TenantIdentifiers
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: search family member history",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to search family member history",
+			args: args{
+				ctx:    context.Background(),
+				params: map[string]interface{}{"_id": gofakeit.UUID()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Happy case: search family member history" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return &domain.PagedFHIRResource{
+						Resources: []map[string]interface{}{
+							{
+								"resourceType": "FamilyMemberHistory",
+								"id":           gofakeit.UUID(),
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: unable to search family member history" {
+				dataset.MockSearchFHIRResourceFn = func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+					return nil, errors.New("an error occurred")
+				}
+			}
+
+			got, err := fh.SearchFHIRFamilyMemberHistory(tt.args.ctx, tt.args.params, tt.args.tenant, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.SearchFHIRFamilyMemberHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.FamilyMemberHistories) != 1 {
+				t.Errorf("expected one family member history but got %v", len(got.FamilyMemberHistories))
+			}
+		})
+	}
+}
+
+func TestStoreImpl_GetFHIRFamilyMemberHistory(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get family member history",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: unable to get family member history",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := fakeDataset.NewFakeFHIRRepositoryMock()
+			fh := FHIR.NewFHIRStoreImpl(dataset)
+
+			if tt.name == "Sad case: unable to get family member history" {
+				dataset.MockGetFHIRResourceFn = func(resourceType, fhirResourceID string, resource interface{}) error {
+					return errors.New("an error occurred")
+				}
+			}
+
+			_, err := fh.GetFHIRFamilyMemberHistory(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StoreImpl.GetFHIRFamilyMemberHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
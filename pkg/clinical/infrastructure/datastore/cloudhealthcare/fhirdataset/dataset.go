@@ -303,6 +303,23 @@ func (fr Repository) PatchFHIRResource(
 // UpdateFHIRResource updates the entire contents of a resource.
 func (fr Repository) UpdateFHIRResource(
 	resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
+	return fr.updateFHIRResource(resourceType, fhirResourceID, "", payload, resource)
+}
+
+// UpdateFHIRResourceIfMatch updates the entire contents of a resource only if it is still at the given version.
+// The update fails if the resource has been changed since that version was read
+func (fr Repository) UpdateFHIRResourceIfMatch(
+	resourceType, fhirResourceID, versionID string, payload map[string]interface{}, resource interface{}) error {
+	if versionID == "" {
+		return fmt.Errorf("a version is required to update a %s resource conditionally", resourceType)
+	}
+
+	return fr.updateFHIRResource(resourceType, fhirResourceID, versionID, payload, resource)
+}
+
+// updateFHIRResource updates the entire contents of a resource, on condition that it is at the given version if one is given
+func (fr Repository) updateFHIRResource(
+	resourceType, fhirResourceID, versionID string, payload map[string]interface{}, resource interface{}) error {
 	fr.checkPreconditions()
 
 	fhirService := fr.healthcareService.Projects.Locations.Datasets.FhirStores.Fhir
@@ -322,6 +339,10 @@ func (fr Repository) UpdateFHIRResource(
 	call := fhirService.Update(fhirResource, bytes.NewReader(jsonPayload))
 	call.Header().Set("Content-Type", "application/fhir+json;charset=utf-8")
 
+	if versionID != "" {
+		call.Header().Set("If-Match", fmt.Sprintf("W/\"%s\"", versionID))
+	}
+
 	resp, err := call.Do()
 	if err != nil {
 		return fmt.Errorf("update: %w", err)
@@ -449,6 +470,42 @@ func (fr Repository) GetFHIRResource(resourceType, fhirResourceID string, resour
 
 // SearchFHIRResource is used to search for a FHIR resource
 func (fr Repository) SearchFHIRResource(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+	urlParams, err := searchURLParams(params, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	urlParams.Add("_tag", fmt.Sprintf("http://mycarehub/tenant-identification/organisation|%s", tenant.OrganizationID))
+	urlParams.Add("_tag", fmt.Sprintf("http://mycarehub/tenant-identification/facility|%s", tenant.FacilityID))
+
+	return fr.searchFHIRResource(resourceType, params, urlParams)
+}
+
+// SearchFHIROrganisationResource is used to search for FHIR resources across all the facilities of an organisation.
+// Unlike SearchFHIRResource it does not restrict the results to a facility
+func (fr Repository) SearchFHIROrganisationResource(resourceType string, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+	urlParams, err := searchURLParams(params, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	urlParams.Add("_tag", fmt.Sprintf("http://mycarehub/tenant-identification/organisation|%s", organisationID))
+
+	return fr.searchFHIRResource(resourceType, params, urlParams)
+}
+
+// SearchFHIRSharedResource is used to search for FHIR resources that are not tagged with a tenant e.g organisations and facilities
+func (fr Repository) SearchFHIRSharedResource(resourceType string, params map[string]interface{}, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+	urlParams, err := searchURLParams(params, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return fr.searchFHIRResource(resourceType, params, urlParams)
+}
+
+// searchURLParams composes the query params of a search from its params and pagination
+func searchURLParams(params map[string]interface{}, pagination dto.Pagination) (url.Values, error) {
 	err := pagination.Validate()
 	if err != nil {
 		return nil, err
@@ -480,9 +537,11 @@ func (fr Repository) SearchFHIRResource(resourceType string, params map[string]i
 		}
 	}
 
-	urlParams.Add("_tag", fmt.Sprintf("http://mycarehub/tenant-identification/organisation|%s", tenant.OrganizationID))
-	urlParams.Add("_tag", fmt.Sprintf("http://mycarehub/tenant-identification/facility|%s", tenant.FacilityID))
+	return urlParams, nil
+}
 
+// searchFHIRResource runs a search with the composed query params and pages its results
+func (fr Repository) searchFHIRResource(resourceType string, params map[string]interface{}, urlParams url.Values) (*domain.PagedFHIRResource, error) {
 	path := "_search"
 
 	bs, err := fr.POSTRequest(resourceType, path, urlParams, nil)
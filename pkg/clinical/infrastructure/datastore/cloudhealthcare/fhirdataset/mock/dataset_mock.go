@@ -12,13 +12,16 @@ import (
 
 // FakeFHIRRepository is a mock FHIR repository
 type FakeFHIRRepository struct {
-	MockCreateFHIRResourceFn    func(resourceType string, payload map[string]interface{}, resource interface{}) error
-	MockDeleteFHIRResourceFn    func(resourceType, fhirResourceID string) error
-	MockPatchFHIRResourceFn     func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error
-	MockUpdateFHIRResourceFn    func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error
-	MockGetFHIRPatientAllDataFn func(fhirResourceID string, params map[string]interface{}) ([]byte, error)
-	MockGetFHIRResourceFn       func(resourceType, fhirResourceID string, resource interface{}) error
-	MockSearchFHIRResourceFn    func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error)
+	MockCreateFHIRResourceFn             func(resourceType string, payload map[string]interface{}, resource interface{}) error
+	MockDeleteFHIRResourceFn             func(resourceType, fhirResourceID string) error
+	MockPatchFHIRResourceFn              func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error
+	MockUpdateFHIRResourceFn             func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error
+	MockUpdateFHIRResourceIfMatchFn      func(resourceType, fhirResourceID, versionID string, payload map[string]interface{}, resource interface{}) error
+	MockGetFHIRPatientAllDataFn          func(fhirResourceID string, params map[string]interface{}) ([]byte, error)
+	MockGetFHIRResourceFn                func(resourceType, fhirResourceID string, resource interface{}) error
+	MockSearchFHIRResourceFn             func(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error)
+	MockSearchFHIRSharedResourceFn       func(resourceType string, params map[string]interface{}, pagination dto.Pagination) (*domain.PagedFHIRResource, error)
+	MockSearchFHIROrganisationResourceFn func(resourceType string, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRResource, error)
 }
 
 // NewFakeFHIRRepositoryMock initializes a new FakeFHIRRepositoryMock
@@ -36,6 +39,9 @@ func NewFakeFHIRRepositoryMock() *FakeFHIRRepository {
 		MockUpdateFHIRResourceFn: func(resourceType, fhirResourceID string, payload map[string]interface{}, resource interface{}) error {
 			return nil
 		},
+		MockUpdateFHIRResourceIfMatchFn: func(resourceType, fhirResourceID, versionID string, payload map[string]interface{}, resource interface{}) error {
+			return nil
+		},
 		MockGetFHIRPatientAllDataFn: func(fhirResourceID string, params map[string]interface{}) ([]byte, error) {
 			bs, err := json.Marshal(`
 			"getPatientEverything": {
@@ -177,6 +183,30 @@ func NewFakeFHIRRepositoryMock() *FakeFHIRRepository {
 				},
 			}
 
+			return &domain.PagedFHIRResource{
+				Resources: m,
+			}, nil
+		},
+		MockSearchFHIROrganisationResourceFn: func(resourceType string, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+			return &domain.PagedFHIRResource{
+				Resources: []map[string]interface{}{
+					{
+						"resourceType": resourceType,
+						"id":           "test-UUID",
+					},
+				},
+			}, nil
+		},
+		MockSearchFHIRSharedResourceFn: func(resourceType string, params map[string]interface{}, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+			m := []map[string]interface{}{
+				{
+					"resourceType": "Organization",
+					"id":           "test-UUID",
+					"active":       true,
+					"name":         "Test Facility",
+				},
+			}
+
 			return &domain.PagedFHIRResource{
 				Resources: m,
 			}, nil
@@ -204,6 +234,11 @@ func (f *FakeFHIRRepository) UpdateFHIRResource(resourceType, fhirResourceID str
 	return f.MockUpdateFHIRResourceFn(resourceType, fhirResourceID, payload, resource)
 }
 
+// UpdateFHIRResourceIfMatch ...
+func (f *FakeFHIRRepository) UpdateFHIRResourceIfMatch(resourceType, fhirResourceID, versionID string, payload map[string]interface{}, resource interface{}) error {
+	return f.MockUpdateFHIRResourceIfMatchFn(resourceType, fhirResourceID, versionID, payload, resource)
+}
+
 // GetFHIRPatientAllData ...
 func (f *FakeFHIRRepository) GetFHIRPatientAllData(fhirResourceID string, params map[string]interface{}) ([]byte, error) {
 	return f.MockGetFHIRPatientAllDataFn(fhirResourceID, params)
@@ -218,3 +253,13 @@ func (f *FakeFHIRRepository) GetFHIRResource(resourceType, fhirResourceID string
 func (f *FakeFHIRRepository) SearchFHIRResource(resourceType string, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
 	return f.MockSearchFHIRResourceFn(resourceType, params, tenant, pagination)
 }
+
+// SearchFHIRSharedResource ...
+func (f *FakeFHIRRepository) SearchFHIRSharedResource(resourceType string, params map[string]interface{}, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+	return f.MockSearchFHIRSharedResourceFn(resourceType, params, pagination)
+}
+
+// SearchFHIROrganisationResource ...
+func (f *FakeFHIRRepository) SearchFHIROrganisationResource(resourceType string, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRResource, error) {
+	return f.MockSearchFHIROrganisationResourceFn(resourceType, params, organisationID, pagination)
+}
@@ -2,6 +2,8 @@ package mock
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/brianvoe/gofakeit"
@@ -19,7 +21,7 @@ type FHIRMock struct {
 	MockSearchFHIRConditionFn    func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCondition, error)
 	MockCreateFHIRConditionFn    func(ctx context.Context, input domain.FHIRConditionInput) (*domain.FHIRConditionRelayPayload, error)
 	MockCreateFHIROrganizationFn func(ctx context.Context, input domain.FHIROrganizationInput) (*domain.FHIROrganizationRelayPayload, error)
-	MockSearchFHIROrganizationFn func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIROrganizationRelayConnection, error)
+	MockSearchFHIROrganizationFn func(ctx context.Context, params map[string]interface{}, pagination dto.Pagination) (*domain.FHIROrganizationRelayConnection, error)
 	MockGetFHIROrganizationFn    func(ctx context.Context, organisationID string) (*domain.FHIROrganizationRelayPayload, error)
 	MockSearchEpisodesByParamFn  func(ctx context.Context, searchParams map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) ([]*domain.FHIREpisodeOfCare, error)
 	MockHasOpenEpisodeFn         func(
@@ -30,70 +32,924 @@ type FHIRMock struct {
 	) (bool, error)
 	MockOpenEpisodesFn func(
 		ctx context.Context, patientReference string, tenant dto.TenantIdentifiers, pagination dto.Pagination) ([]*domain.FHIREpisodeOfCare, error)
-	MockCreateFHIREncounterFn             func(ctx context.Context, input domain.FHIREncounterInput) (*domain.FHIREncounterRelayPayload, error)
-	MockGetFHIREpisodeOfCareFn            func(ctx context.Context, id string) (*domain.FHIREpisodeOfCareRelayPayload, error)
-	MockSearchPatientEncountersFn         func(ctx context.Context, patientReference string, status *domain.EncounterStatusEnum, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIREncounter, error)
-	MockSearchFHIREpisodeOfCareFn         func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIREpisodeOfCareRelayConnection, error)
-	MockStartEncounterFn                  func(ctx context.Context, episodeID string) (string, error)
-	MockUpgradeEpisodeFn                  func(ctx context.Context, input domain.OTPEpisodeUpgradeInput) (*domain.EpisodeOfCarePayload, error)
-	MockSearchEpisodeEncounterFn          func(ctx context.Context, episodeReference string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIREncounter, error)
-	MockEndEncounterFn                    func(ctx context.Context, encounterID string) (bool, error)
-	MockEndEpisodeFn                      func(ctx context.Context, episodeID string) (bool, error)
-	MockGetActiveEpisodeFn                func(ctx context.Context, episodeID string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIREpisodeOfCare, error)
-	MockSearchFHIRServiceRequestFn        func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRServiceRequestRelayConnection, error)
-	MockCreateFHIRServiceRequestFn        func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error)
-	MockSearchFHIRAllergyIntoleranceFn    func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error)
-	MockCreateFHIRAllergyIntoleranceFn    func(ctx context.Context, input domain.FHIRAllergyIntoleranceInput) (*domain.FHIRAllergyIntoleranceRelayPayload, error)
-	MockUpdateFHIRAllergyIntoleranceFn    func(ctx context.Context, input domain.FHIRAllergyIntoleranceInput) (*domain.FHIRAllergyIntoleranceRelayPayload, error)
-	MockSearchFHIRCompositionFn           func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRComposition, error)
-	MockGetFHIRCompositionFn              func(ctx context.Context, id string) (*domain.FHIRCompositionRelayPayload, error)
-	MockCreateFHIRCompositionFn           func(ctx context.Context, input domain.FHIRCompositionInput) (*domain.FHIRCompositionRelayPayload, error)
-	MockPatchFHIRCompositionFn            func(ctx context.Context, id string, input domain.FHIRCompositionInput) (*domain.FHIRComposition, error)
-	MockUpdateFHIRCompositionFn           func(ctx context.Context, input domain.FHIRCompositionInput) (*domain.FHIRComposition, error)
-	MockDeleteFHIRCompositionFn           func(ctx context.Context, id string) (bool, error)
-	MockUpdateFHIRConditionFn             func(ctx context.Context, input domain.FHIRConditionInput) (*domain.FHIRConditionRelayPayload, error)
-	MockGetFHIREncounterFn                func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error)
-	MockPatchFHIREncounterFn              func(ctx context.Context, encounterID string, input domain.FHIREncounterInput) (*domain.FHIREncounter, error)
-	MockSearchFHIREncounterFn             func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIREncounter, error)
-	MockSearchFHIRMedicationRequestFn     func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationRequestRelayConnection, error)
-	MockCreateFHIRMedicationRequestFn     func(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error)
-	MockUpdateFHIRMedicationRequestFn     func(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error)
-	MockDeleteFHIRMedicationRequestFn     func(ctx context.Context, id string) (bool, error)
-	MockSearchFHIRObservationFn           func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error)
-	MockCreateFHIRObservationFn           func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error)
-	MockGetFHIRObservationFn              func(ctx context.Context, id string) (*domain.FHIRObservationRelayPayload, error)
-	MockPatchFHIRObservationFn            func(ctx context.Context, id string, input domain.FHIRObservationInput) (*domain.FHIRObservation, error)
-	MockDeleteFHIRObservationFn           func(ctx context.Context, id string) (bool, error)
-	MockGetFHIRPatientFn                  func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error)
-	MockDeleteFHIRPatientFn               func(ctx context.Context, id string) (bool, error)
-	MockDeleteFHIRServiceRequestFn        func(ctx context.Context, id string) (bool, error)
-	MockDeleteFHIRResourceTypeFn          func(results []map[string]string) error
-	MockCreateFHIRMedicationStatementFn   func(ctx context.Context, input domain.FHIRMedicationStatementInput) (*domain.FHIRMedicationStatementRelayPayload, error)
-	MockCreateFHIRMedicationFn            func(ctx context.Context, input domain.FHIRMedicationInput) (*domain.FHIRMedicationRelayPayload, error)
-	MockSearchFHIRMedicationStatementFn   func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationStatementRelayConnection, error)
-	MockCreateFHIRPatientFn               func(ctx context.Context, input domain.FHIRPatientInput) (*domain.PatientPayload, error)
-	MockPatchFHIRPatientFn                func(ctx context.Context, id string, input domain.FHIRPatientInput) (*domain.FHIRPatient, error)
-	MockPatchFHIREpisodeOfCareFn          func(ctx context.Context, id string, input domain.FHIREpisodeOfCareInput) (*domain.FHIREpisodeOfCare, error)
-	MockUpdateFHIREpisodeOfCareFn         func(ctx context.Context, fhirResourceID string, payload map[string]interface{}) (*domain.FHIREpisodeOfCare, error)
-	MockSearchFHIRPatientFn               func(ctx context.Context, searchParams string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error)
-	MockSearchPatientObservationsFn       func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error)
-	MockGetFHIRAllergyIntoleranceFn       func(ctx context.Context, id string) (*domain.FHIRAllergyIntoleranceRelayPayload, error)
-	MockSearchPatientAllergyIntoleranceFn func(ctx context.Context, patientReference string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error)
-	MockCreateFHIRMediaFn                 func(ctx context.Context, input domain.FHIRMedia) (*domain.FHIRMedia, error)
-	MockListFHIRQuestionnaireFn           func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRQuestionnaires, error)
-	MockSearchPatientMediaFn              func(ctx context.Context, patientReference string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedia, error)
-	MockCreateFHIRQuestionnaireFn         func(ctx context.Context, input *domain.FHIRQuestionnaire) (*domain.FHIRQuestionnaire, error)
-	MockCreateFHIRConsentFn               func(ctx context.Context, input domain.FHIRConsent) (*domain.FHIRConsent, error)
-	MockCreateFHIRQuestionnaireResponseFn func(ctx context.Context, input *domain.FHIRQuestionnaireResponse) (*domain.FHIRQuestionnaireResponse, error)
-	MockCreateFHIRRiskAssessmentFn        func(ctx context.Context, input *domain.FHIRRiskAssessmentInput) (*domain.FHIRRiskAssessmentRelayPayload, error)
-	MockGetFHIRQuestionnaireFn            func(ctx context.Context, id string) (*domain.FHIRQuestionnaireRelayPayload, error)
-	MockSearchFHIRRiskAssessmentFn        func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRRiskAssessmentRelayConnection, error)
-	MockGetFHIRQuestionnaireResponseFn    func(ctx context.Context, id string) (*domain.FHIRQuestionnaireResponseRelayPayload, error)
-	MockCreateFHIRDiagnosticReportFn      func(_ context.Context, input *domain.FHIRDiagnosticReportInput) (*domain.FHIRDiagnosticReport, error)
-	MockSearchFHIREncounterAllDataFn      func(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error)
-	MockGetFHIRPatientEverythingFn        func(ctx context.Context, id string, params map[string]interface{}) (*domain.PagedFHIRResource, error)
-	MockGetFHIRServiceRequestFn           func(_ context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error)
-	MockCreateFHIRSubscriptionFn          func(_ context.Context, subscription *domain.FHIRSubscriptionInput) (*domain.FHIRSubscription, error)
+	MockCreateFHIREncounterFn                    func(ctx context.Context, input domain.FHIREncounterInput) (*domain.FHIREncounterRelayPayload, error)
+	MockGetFHIREpisodeOfCareFn                   func(ctx context.Context, id string) (*domain.FHIREpisodeOfCareRelayPayload, error)
+	MockSearchPatientEncountersFn                func(ctx context.Context, patientReference string, status *domain.EncounterStatusEnum, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIREncounter, error)
+	MockSearchFHIREpisodeOfCareFn                func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIREpisodeOfCareRelayConnection, error)
+	MockStartEncounterFn                         func(ctx context.Context, episodeID string) (string, error)
+	MockUpgradeEpisodeFn                         func(ctx context.Context, input domain.OTPEpisodeUpgradeInput) (*domain.EpisodeOfCarePayload, error)
+	MockSearchEpisodeEncounterFn                 func(ctx context.Context, episodeReference string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIREncounter, error)
+	MockEndEncounterFn                           func(ctx context.Context, encounterID string) (bool, error)
+	MockEndEpisodeFn                             func(ctx context.Context, episodeID string) (bool, error)
+	MockGetActiveEpisodeFn                       func(ctx context.Context, episodeID string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIREpisodeOfCare, error)
+	MockSearchFHIRServiceRequestFn               func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRServiceRequest, error)
+	MockCreateFHIRServiceRequestFn               func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error)
+	MockSearchFHIRAllergyIntoleranceFn           func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error)
+	MockCreateFHIRAllergyIntoleranceFn           func(ctx context.Context, input domain.FHIRAllergyIntoleranceInput) (*domain.FHIRAllergyIntoleranceRelayPayload, error)
+	MockUpdateFHIRAllergyIntoleranceFn           func(ctx context.Context, input domain.FHIRAllergyIntoleranceInput) (*domain.FHIRAllergyIntoleranceRelayPayload, error)
+	MockSearchFHIRCompositionFn                  func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRComposition, error)
+	MockGetFHIRCompositionFn                     func(ctx context.Context, id string) (*domain.FHIRCompositionRelayPayload, error)
+	MockCreateFHIRCompositionFn                  func(ctx context.Context, input domain.FHIRCompositionInput) (*domain.FHIRCompositionRelayPayload, error)
+	MockPatchFHIRCompositionFn                   func(ctx context.Context, id string, input domain.FHIRCompositionInput) (*domain.FHIRComposition, error)
+	MockUpdateFHIRCompositionFn                  func(ctx context.Context, input domain.FHIRCompositionInput) (*domain.FHIRComposition, error)
+	MockDeleteFHIRCompositionFn                  func(ctx context.Context, id string) (bool, error)
+	MockUpdateFHIRConditionFn                    func(ctx context.Context, input domain.FHIRConditionInput) (*domain.FHIRConditionRelayPayload, error)
+	MockGetFHIRConditionFn                       func(ctx context.Context, id string) (*domain.FHIRConditionRelayPayload, error)
+	MockGetFHIREncounterFn                       func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error)
+	MockPatchFHIREncounterFn                     func(ctx context.Context, encounterID string, input domain.FHIREncounterInput) (*domain.FHIREncounter, error)
+	MockSearchFHIREncounterFn                    func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIREncounter, error)
+	MockSearchFHIRMedicationRequestFn            func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationRequest, error)
+	MockCreateFHIRMedicationRequestFn            func(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error)
+	MockUpdateFHIRMedicationRequestFn            func(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error)
+	MockDeleteFHIRMedicationRequestFn            func(ctx context.Context, id string) (bool, error)
+	MockSearchFHIRObservationFn                  func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error)
+	MockCreateFHIRObservationFn                  func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error)
+	MockGetFHIRObservationFn                     func(ctx context.Context, id string) (*domain.FHIRObservationRelayPayload, error)
+	MockPatchFHIRObservationFn                   func(ctx context.Context, id string, input domain.FHIRObservationInput) (*domain.FHIRObservation, error)
+	MockUpdateFHIRObservationFn                  func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error)
+	MockDeleteFHIRObservationFn                  func(ctx context.Context, id string) (bool, error)
+	MockGetFHIRPatientFn                         func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error)
+	MockDeleteFHIRPatientFn                      func(ctx context.Context, id string) (bool, error)
+	MockDeleteFHIRServiceRequestFn               func(ctx context.Context, id string) (bool, error)
+	MockDeleteFHIRResourceTypeFn                 func(results []map[string]string) error
+	MockCreateFHIRMedicationStatementFn          func(ctx context.Context, input domain.FHIRMedicationStatementInput) (*domain.FHIRMedicationStatementRelayPayload, error)
+	MockCreateFHIRMedicationFn                   func(ctx context.Context, input domain.FHIRMedicationInput) (*domain.FHIRMedicationRelayPayload, error)
+	MockSearchFHIRMedicationStatementFn          func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationStatementRelayConnection, error)
+	MockCreateFHIRPatientFn                      func(ctx context.Context, input domain.FHIRPatientInput) (*domain.PatientPayload, error)
+	MockPatchFHIRPatientFn                       func(ctx context.Context, id string, input domain.FHIRPatientInput) (*domain.FHIRPatient, error)
+	MockPatchFHIREpisodeOfCareFn                 func(ctx context.Context, id string, input domain.FHIREpisodeOfCareInput) (*domain.FHIREpisodeOfCare, error)
+	MockUpdateFHIREpisodeOfCareFn                func(ctx context.Context, fhirResourceID string, payload map[string]interface{}) (*domain.FHIREpisodeOfCare, error)
+	MockSearchFHIRPatientFn                      func(ctx context.Context, searchParams string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error)
+	MockSearchFHIRPatientByIdentifierFn          func(ctx context.Context, identifier string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error)
+	MockSearchPatientObservationsFn              func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error)
+	MockGetFHIRAllergyIntoleranceFn              func(ctx context.Context, id string) (*domain.FHIRAllergyIntoleranceRelayPayload, error)
+	MockSearchPatientAllergyIntoleranceFn        func(ctx context.Context, patientReference string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error)
+	MockCreateFHIRMediaFn                        func(ctx context.Context, input domain.FHIRMedia) (*domain.FHIRMedia, error)
+	MockListFHIRQuestionnaireFn                  func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRQuestionnaires, error)
+	MockSearchPatientMediaFn                     func(ctx context.Context, patientReference string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedia, error)
+	MockCreateFHIRQuestionnaireFn                func(ctx context.Context, input *domain.FHIRQuestionnaire) (*domain.FHIRQuestionnaire, error)
+	MockCreateFHIRConsentFn                      func(ctx context.Context, input domain.FHIRConsent) (*domain.FHIRConsent, error)
+	MockCreateFHIRQuestionnaireResponseFn        func(ctx context.Context, input *domain.FHIRQuestionnaireResponse) (*domain.FHIRQuestionnaireResponse, error)
+	MockCreateFHIRRiskAssessmentFn               func(ctx context.Context, input *domain.FHIRRiskAssessmentInput) (*domain.FHIRRiskAssessmentRelayPayload, error)
+	MockGetFHIRQuestionnaireFn                   func(ctx context.Context, id string) (*domain.FHIRQuestionnaireRelayPayload, error)
+	MockSearchFHIRRiskAssessmentFn               func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRRiskAssessmentRelayConnection, error)
+	MockGetFHIRQuestionnaireResponseFn           func(ctx context.Context, id string) (*domain.FHIRQuestionnaireResponseRelayPayload, error)
+	MockCreateFHIRDiagnosticReportFn             func(_ context.Context, input *domain.FHIRDiagnosticReportInput) (*domain.FHIRDiagnosticReport, error)
+	MockSearchFHIREncounterAllDataFn             func(_ context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRResource, error)
+	MockGetFHIRPatientEverythingFn               func(ctx context.Context, id string, params map[string]interface{}) (*domain.PagedFHIRResource, error)
+	MockGetFHIRServiceRequestFn                  func(_ context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error)
+	MockCreateFHIRSubscriptionFn                 func(_ context.Context, subscription *domain.FHIRSubscriptionInput) (*domain.FHIRSubscription, error)
+	MockCreateFHIRBasicFn                        func(ctx context.Context, input domain.FHIRBasic) (*domain.FHIRBasic, error)
+	MockUpdateFHIRBasicFn                        func(ctx context.Context, input domain.FHIRBasic) (*domain.FHIRBasic, error)
+	MockSearchFHIRBasicFn                        func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRBasic, error)
+	MockCreateFHIRAuditEventFn                   func(ctx context.Context, input domain.FHIRAuditEvent) (*domain.FHIRAuditEvent, error)
+	MockUpdateFHIRConsentFn                      func(ctx context.Context, input domain.FHIRConsent) (*domain.FHIRConsent, error)
+	MockSearchFHIRConsentFn                      func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRConsent, error)
+	MockGetFHIRConsentFn                         func(ctx context.Context, id string) (*domain.FHIRConsentRelayPayload, error)
+	MockGetFHIRMediaFn                           func(ctx context.Context, id string) (*domain.FHIRMediaRelayPayload, error)
+	MockGetFHIRMedicationRequestFn               func(ctx context.Context, id string) (*domain.FHIRMedicationRequestRelayPayload, error)
+	MockCreateFHIRMedicationDispenseFn           func(ctx context.Context, input domain.FHIRMedicationDispense) (*domain.FHIRMedicationDispense, error)
+	MockUpdateFHIRMedicationDispenseFn           func(ctx context.Context, input domain.FHIRMedicationDispense) (*domain.FHIRMedicationDispense, error)
+	MockSearchFHIRMedicationDispenseFn           func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationDispense, error)
+	MockGetFHIRMedicationDispenseFn              func(ctx context.Context, id string) (*domain.FHIRMedicationDispenseRelayPayload, error)
+	MockGetFHIRMedicationStatementFn             func(ctx context.Context, id string) (*domain.FHIRMedicationStatementRelayPayload, error)
+	MockUpdateFHIRMedicationStatementFn          func(ctx context.Context, input domain.FHIRMedicationStatementInput) (*domain.FHIRMedicationStatementRelayPayload, error)
+	MockCreateFHIRImmunizationFn                 func(ctx context.Context, input domain.FHIRImmunization) (*domain.FHIRImmunization, error)
+	MockUpdateFHIRImmunizationFn                 func(ctx context.Context, input domain.FHIRImmunization) (*domain.FHIRImmunization, error)
+	MockSearchFHIRImmunizationFn                 func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error)
+	MockSearchFHIROrganisationImmunizationFn     func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error)
+	MockGetFHIRImmunizationFn                    func(ctx context.Context, id string) (*domain.FHIRImmunizationRelayPayload, error)
+	MockUpdateFHIRServiceRequestFn               func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error)
+	MockCreateFHIRSpecimenFn                     func(ctx context.Context, input domain.FHIRSpecimen) (*domain.FHIRSpecimen, error)
+	MockUpdateFHIRSpecimenFn                     func(ctx context.Context, input domain.FHIRSpecimen) (*domain.FHIRSpecimen, error)
+	MockSearchFHIRSpecimenFn                     func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSpecimen, error)
+	MockGetFHIRSpecimenFn                        func(ctx context.Context, id string) (*domain.FHIRSpecimenRelayPayload, error)
+	MockGetFHIRDiagnosticReportFn                func(ctx context.Context, id string) (*domain.FHIRDiagnosticReportRelayPayload, error)
+	MockCreateFHIRProcedureFn                    func(ctx context.Context, input domain.FHIRProcedure) (*domain.FHIRProcedure, error)
+	MockUpdateFHIRProcedureFn                    func(ctx context.Context, input domain.FHIRProcedure) (*domain.FHIRProcedure, error)
+	MockSearchFHIRProcedureFn                    func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRProcedure, error)
+	MockGetFHIRProcedureFn                       func(ctx context.Context, id string) (*domain.FHIRProcedureRelayPayload, error)
+	MockCreateFHIRGoalFn                         func(ctx context.Context, input domain.FHIRGoal) (*domain.FHIRGoal, error)
+	MockUpdateFHIRGoalFn                         func(ctx context.Context, input domain.FHIRGoal) (*domain.FHIRGoal, error)
+	MockSearchFHIRGoalFn                         func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRGoal, error)
+	MockGetFHIRGoalFn                            func(ctx context.Context, id string) (*domain.FHIRGoalRelayPayload, error)
+	MockCreateFHIRCarePlanFn                     func(ctx context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error)
+	MockUpdateFHIRCarePlanFn                     func(ctx context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error)
+	MockSearchFHIRCarePlanFn                     func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCarePlan, error)
+	MockGetFHIRCarePlanFn                        func(ctx context.Context, id string) (*domain.FHIRCarePlanRelayPayload, error)
+	MockCreateFHIRScheduleFn                     func(ctx context.Context, input domain.FHIRSchedule) (*domain.FHIRSchedule, error)
+	MockUpdateFHIRScheduleFn                     func(ctx context.Context, input domain.FHIRSchedule) (*domain.FHIRSchedule, error)
+	MockSearchFHIRScheduleFn                     func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSchedule, error)
+	MockGetFHIRScheduleFn                        func(ctx context.Context, id string) (*domain.FHIRScheduleRelayPayload, error)
+	MockCreateFHIRSlotFn                         func(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error)
+	MockUpdateFHIRSlotFn                         func(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error)
+	MockSearchFHIRSlotFn                         func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSlot, error)
+	MockGetFHIRSlotFn                            func(ctx context.Context, id string) (*domain.FHIRSlotRelayPayload, error)
+	MockCreateFHIRAppointmentFn                  func(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error)
+	MockUpdateFHIRAppointmentFn                  func(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error)
+	MockSearchFHIRAppointmentFn                  func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAppointment, error)
+	MockGetFHIRAppointmentFn                     func(ctx context.Context, id string) (*domain.FHIRAppointmentRelayPayload, error)
+	MockCreateFHIRPractitionerFn                 func(ctx context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error)
+	MockUpdateFHIRPractitionerFn                 func(ctx context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error)
+	MockSearchFHIRPractitionerFn                 func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error)
+	MockSearchFHIROrganisationPractitionerFn     func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error)
+	MockGetFHIRPractitionerFn                    func(ctx context.Context, id string) (*domain.FHIRPractitionerRelayPayload, error)
+	MockCreateFHIRPractitionerRoleFn             func(ctx context.Context, input domain.FHIRPractitionerRole) (*domain.FHIRPractitionerRole, error)
+	MockUpdateFHIRPractitionerRoleFn             func(ctx context.Context, input domain.FHIRPractitionerRole) (*domain.FHIRPractitionerRole, error)
+	MockSearchFHIRPractitionerRoleFn             func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRPractitionerRole, error)
+	MockSearchFHIROrganisationPractitionerRoleFn func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitionerRole, error)
+	MockGetFHIRPractitionerRoleFn                func(ctx context.Context, id string) (*domain.FHIRPractitionerRoleRelayPayload, error)
+	MockCreateFHIRLocationFn                     func(ctx context.Context, input domain.FHIRLocation) (*domain.FHIRLocation, error)
+	MockUpdateFHIRLocationFn                     func(ctx context.Context, input domain.FHIRLocation) (*domain.FHIRLocation, error)
+	MockSearchFHIRLocationFn                     func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRLocation, error)
+	MockGetFHIRLocationFn                        func(ctx context.Context, id string) (*domain.FHIRLocationRelayPayload, error)
+	MockCreateFHIRFamilyMemberHistoryFn          func(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error)
+	MockUpdateFHIRFamilyMemberHistoryFn          func(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error)
+	MockSearchFHIRFamilyMemberHistoryFn          func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRFamilyMemberHistory, error)
+	MockGetFHIRFamilyMemberHistoryFn             func(ctx context.Context, id string) (*domain.FHIRFamilyMemberHistoryRelayPayload, error)
+}
+
+// fakeMedicationRequest returns an active prescription for the patient of the default encounter
+func fakeMedicationRequest(id string) domain.FHIRMedicationRequest {
+	patientID := "12345678905432345"
+	patientReference := "Patient/" + patientID
+	encounterReference := "Encounter/" + patientID
+	conditionID := gofakeit.UUID()
+	conditionReference := "Condition/" + conditionID
+	status := scalarutils.Code("active")
+	intent := scalarutils.Code("order")
+	medicationCode := scalarutils.Code("71160")
+	routeCode := scalarutils.Code("26643006")
+	text := "500 mg oral route, 3 time(s) every 1 d for 5 d"
+	asNeeded := false
+	frequency := 3
+	period := 1.0
+	periodUnit := domain.TimingRepeatPeriodUnitEnum("d")
+	duration := 5.0
+	durationCode := scalarutils.Code("d")
+	refills := 0
+	authoredOn := scalarutils.DateTime(time.Now().Format(time.RFC3339))
+
+	return domain.FHIRMedicationRequest{
+		ID:     &id,
+		Status: &status,
+		Intent: &intent,
+		MedicationCodeableConcept: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					Code:    &medicationCode,
+					Display: "Amoxicillin",
+				},
+			},
+			Text: "Amoxicillin",
+		},
+		Subject: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+		},
+		Encounter: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &encounterReference,
+		},
+		AuthoredOn: &authoredOn,
+		ReasonReference: []*domain.FHIRReference{
+			{
+				ID:        &conditionID,
+				Reference: &conditionReference,
+			},
+		},
+		DosageInstruction: []*domain.FHIRDosage{
+			{
+				Text:            &text,
+				AsNeededBoolean: &asNeeded,
+				Timing: &domain.FHIRTiming{
+					Repeat: &domain.FHIRTimingRepeat{
+						BoundsDuration: &domain.FHIRDuration{
+							Value: &duration,
+							Code:  &durationCode,
+						},
+						Frequency:  &frequency,
+						Period:     &period,
+						PeriodUnit: &periodUnit,
+					},
+				},
+				Route: &domain.FHIRCodeableConcept{
+					Coding: []*domain.FHIRCoding{
+						{
+							Code:    &routeCode,
+							Display: "Oral route",
+						},
+					},
+					Text: "Oral route",
+				},
+				DoseAndRate: []*domain.FHIRDosageDoseandrate{
+					{
+						DoseQuantity: &domain.FHIRQuantity{
+							Value:  500,
+							Unit:   "mg",
+							System: "http://unitsofmeasure.org",
+							Code:   "mg",
+						},
+					},
+				},
+			},
+		},
+		DispenseRequest: &domain.FHIRMedicationrequestDispenserequest{
+			NumberOfRepeatsAllowed: &refills,
+			Quantity: &domain.FHIRQuantity{
+				Value:  15,
+				Unit:   "tablet",
+				System: "http://unitsofmeasure.org",
+				Code:   "tablet",
+			},
+		},
+	}
+}
+
+// fakeMedicationStatement returns an active medication statement for the patient of the default encounter
+func fakeMedicationStatement(id string) domain.FHIRMedicationStatement {
+	patientID := "12345678905432345"
+	patientReference := "Patient/" + patientID
+	status := domain.MedicationStatementStatusEnumActive
+	medicationCode := scalarutils.Code("71160")
+	text := "500 mg oral route, 3 time(s) every 1 d for 5 d"
+
+	return domain.FHIRMedicationStatement{
+		ID:     &id,
+		Status: &status,
+		MedicationCodeableConcept: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					Code:    &medicationCode,
+					Display: "Amoxicillin",
+				},
+			},
+			Text: "Amoxicillin",
+		},
+		Subject: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+		},
+		Dosage: []*domain.FHIRDosage{
+			{
+				Text: &text,
+			},
+		},
+	}
+}
+
+// fakeImmunization returns a BCG immunization given to the patient of the default encounter
+func fakeImmunization(id string) domain.FHIRImmunization {
+	patientID := "12345678905432345"
+	patientReference := "Patient/" + patientID
+	status := scalarutils.Code("completed")
+	vaccineSystem := scalarutils.URI("/orgs/CIEL/sources/CIEL/concepts/886/")
+	vaccineCode := scalarutils.Code("886")
+	siteSystem := scalarutils.URI("http://terminology.hl7.org/CodeSystem/v3-ActSite")
+	siteCode := scalarutils.Code("LA")
+	routeSystem := scalarutils.URI("http://snomed.info/sct")
+	routeCode := scalarutils.Code("372464004")
+	occurrence := time.Now().Format(time.RFC3339)
+	lotNumber := "BCG-2024-001"
+	primarySource := true
+	series := "BCG"
+	doseNumber := 1
+
+	return domain.FHIRImmunization{
+		ID:     &id,
+		Status: &status,
+		VaccineCode: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &vaccineSystem,
+					Code:    &vaccineCode,
+					Display: "BCG vaccination",
+				},
+			},
+			Text: "BCG vaccination",
+		},
+		Patient: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+		},
+		OccurrenceDateTime: &occurrence,
+		PrimarySource:      &primarySource,
+		LotNumber:          &lotNumber,
+		Site: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &siteSystem,
+					Code:    &siteCode,
+					Display: "left arm",
+				},
+			},
+		},
+		Route: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &routeSystem,
+					Code:    &routeCode,
+					Display: "Intradermal route",
+				},
+			},
+		},
+		ProtocolApplied: []*domain.FHIRImmunizationProtocolApplied{
+			{
+				Series:                &series,
+				DoseNumberPositiveInt: &doseNumber,
+			},
+		},
+	}
+}
+
+// fakeSpecimen returns a whole blood sample collected for a lab order of the patient of the default encounter
+func fakeSpecimen(id string) domain.FHIRSpecimen {
+	patientID := "12345678905432345"
+	patientReference := "Patient/" + patientID
+	orderID := "12345678905432345"
+	orderReference := "ServiceRequest/" + orderID
+	status := scalarutils.Code("available")
+	typeSystem := scalarutils.URI("/orgs/CIEL/sources/CIEL/concepts/1000/")
+	typeCode := scalarutils.Code("1000")
+	collected := time.Now().Format(time.RFC3339)
+	container := "EDTA tube"
+
+	return domain.FHIRSpecimen{
+		ID:     &id,
+		Status: &status,
+		Type: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &typeSystem,
+					Code:    &typeCode,
+					Display: "Whole blood sample",
+				},
+			},
+			Text: "Whole blood sample",
+		},
+		Subject: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+		},
+		Request: []*domain.FHIRReference{
+			{
+				ID:        &orderID,
+				Reference: &orderReference,
+			},
+		},
+		Collection: &domain.FHIRSpecimenCollection{
+			CollectedDateTime: &collected,
+		},
+		Container: []*domain.FHIRSpecimenContainer{
+			{
+				Description: &container,
+			},
+		},
+		Extension: []*domain.FHIRExtension{
+			{
+				URL: "http://savannahghi.org/fhir/StructureDefinition/specimen-custody-event",
+				Extension: []domain.Extension{
+					{
+						URL:       "status",
+						ValueCode: "collected",
+					},
+					{
+						URL:           "time",
+						ValueDateTime: collected,
+					},
+				},
+			},
+		},
+	}
+}
+
+// fakeProcedure returns a cryotherapy of the cervix performed on the patient of the default encounter
+func fakeProcedure(id string) domain.FHIRProcedure {
+	patientID := "12345678905432345"
+	patientReference := "Patient/" + patientID
+	encounterID := "12345678905432345"
+	encounterReference := "Encounter/" + encounterID
+	status := scalarutils.Code("completed")
+	procedureSystem := scalarutils.URI("/orgs/CIEL/sources/CIEL/concepts/162812/")
+	procedureCode := scalarutils.Code("162812")
+	outcomeSystem := scalarutils.URI("http://snomed.info/sct")
+	outcomeCode := scalarutils.Code("385669000")
+	performed := time.Now().Format(time.RFC3339)
+
+	return domain.FHIRProcedure{
+		ID:     &id,
+		Status: &status,
+		Code: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &procedureSystem,
+					Code:    &procedureCode,
+					Display: "Cryotherapy of cervix",
+				},
+			},
+			Text: "Cryotherapy of cervix",
+		},
+		Subject: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+		},
+		Encounter: &domain.FHIRReference{
+			ID:        &encounterID,
+			Reference: &encounterReference,
+		},
+		PerformedDateTime: &performed,
+		Outcome: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &outcomeSystem,
+					Code:    &outcomeCode,
+					Display: "Successful",
+				},
+			},
+			Text: "Successful",
+		},
+	}
+}
+
+// fakeGoal returns an active viral load suppression goal for the patient of the default encounter
+func fakeGoal(id string) domain.FHIRGoal {
+	patientID := "12345678905432345"
+	patientReference := "Patient/" + patientID
+	status := scalarutils.Code("active")
+	measureSystem := scalarutils.URI("/orgs/CIEL/sources/CIEL/concepts/856/")
+	measureCode := scalarutils.Code("856")
+	comparator := domain.QuantityComparatorEnum("<")
+	startDate := time.Now().AddDate(0, -6, 0).Format("2006-01-02")
+
+	return domain.FHIRGoal{
+		ID:              &id,
+		LifecycleStatus: &status,
+		Description: &domain.FHIRCodeableConcept{
+			Text: "Viral load suppression",
+		},
+		Subject: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+		},
+		StartDate: &startDate,
+		Target: []*domain.FHIRGoalTarget{
+			{
+				Measure: &domain.FHIRCodeableConcept{
+					Coding: []*domain.FHIRCoding{
+						{
+							System:  &measureSystem,
+							Code:    &measureCode,
+							Display: "HIV viral load",
+						},
+					},
+					Text: "HIV viral load",
+				},
+				DetailQuantity: &domain.FHIRQuantity{
+					Value:      1000,
+					Comparator: &comparator,
+					Unit:       "copies/ml",
+				},
+			},
+		},
+	}
+}
+
+// fakeCarePlan returns an active HIV care plan, with a viral load suppression goal, for the patient of the default encounter
+func fakeCarePlan(id string) domain.FHIRCarePlan {
+	patientID := "12345678905432345"
+	patientReference := "Patient/" + patientID
+	episodeID := gofakeit.UUID()
+	episodeReference := "EpisodeOfCare/" + episodeID
+	goalID := gofakeit.UUID()
+	goalReference := "Goal/" + goalID
+	status := scalarutils.Code("active")
+	intent := scalarutils.Code("plan")
+	activityStatus := scalarutils.Code("scheduled")
+	title := "HIV care plan"
+	schedule := "Every 6 months"
+	activity := "Viral load test"
+
+	return domain.FHIRCarePlan{
+		ID:     &id,
+		Status: &status,
+		Intent: &intent,
+		Title:  &title,
+		Subject: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+		},
+		Period: &domain.FHIRPeriod{
+			Start: scalarutils.DateTime(time.Now().AddDate(0, -6, 0).Format(time.RFC3339)),
+		},
+		SupportingInfo: []*domain.FHIRReference{
+			{
+				ID:        &episodeID,
+				Reference: &episodeReference,
+			},
+		},
+		Goal: []*domain.FHIRReference{
+			{
+				ID:        &goalID,
+				Reference: &goalReference,
+			},
+		},
+		Activity: []*domain.FHIRCarePlanActivity{
+			{
+				Detail: &domain.FHIRCarePlanActivityDetail{
+					Status:          &activityStatus,
+					ScheduledString: &schedule,
+					Description:     &activity,
+				},
+			},
+		},
+	}
+}
+
+// fakeSchedule returns an active schedule of a practitioner at a facility
+func fakeSchedule(id string) domain.FHIRSchedule {
+	active := true
+	facilityID := gofakeit.UUID()
+	facilityReference := "Organization/" + facilityID
+	practitioner := gofakeit.Name()
+	comment := "Weekday mornings"
+
+	return domain.FHIRSchedule{
+		ID:     &id,
+		Active: &active,
+		Actor: []*domain.FHIRReference{
+			{
+				ID:        &facilityID,
+				Reference: &facilityReference,
+			},
+			{
+				Display: practitioner,
+			},
+		},
+		PlanningHorizon: &domain.FHIRPeriod{
+			Start: scalarutils.DateTime(time.Now().Format(time.DateOnly)),
+			End:   scalarutils.DateTime(time.Now().AddDate(0, 3, 0).Format(time.DateOnly)),
+		},
+		Comment: &comment,
+	}
+}
+
+// fakeSlot returns a free thirty minute slot tomorrow
+func fakeSlot(id string) domain.FHIRSlot {
+	scheduleID := gofakeit.UUID()
+	scheduleReference := "Schedule/" + scheduleID
+	status := scalarutils.Code("free")
+	start := time.Now().AddDate(0, 0, 1).Truncate(time.Hour)
+	startTime := start.Format(time.RFC3339)
+	endTime := start.Add(30 * time.Minute).Format(time.RFC3339)
+
+	return domain.FHIRSlot{
+		ID: &id,
+		Schedule: &domain.FHIRReference{
+			ID:        &scheduleID,
+			Reference: &scheduleReference,
+		},
+		Status: &status,
+		Start:  &startTime,
+		End:    &endTime,
+	}
+}
+
+// fakeAppointment returns an appointment booked tomorrow for the patient of the default encounter
+func fakeAppointment(id string) domain.FHIRAppointment {
+	patientID := "12345678905432345"
+	patientReference := "Patient/" + patientID
+	facilityID := gofakeit.UUID()
+	facilityReference := "Organization/" + facilityID
+	slotID := gofakeit.UUID()
+	slotReference := "Slot/" + slotID
+	status := scalarutils.Code("booked")
+	accepted := scalarutils.Code("accepted")
+	description := "Follow-up visit"
+	start := time.Now().AddDate(0, 0, 1).Truncate(time.Hour)
+	startTime := start.Format(time.RFC3339)
+	endTime := start.Add(30 * time.Minute).Format(time.RFC3339)
+	created := time.Now().Format(time.RFC3339)
+
+	return domain.FHIRAppointment{
+		ID:          &id,
+		Status:      &status,
+		Description: &description,
+		Start:       &startTime,
+		End:         &endTime,
+		Slot: []*domain.FHIRReference{
+			{
+				ID:        &slotID,
+				Reference: &slotReference,
+			},
+		},
+		Created: &created,
+		Participant: []*domain.FHIRAppointmentParticipant{
+			{
+				Actor: &domain.FHIRReference{
+					ID:        &patientID,
+					Reference: &patientReference,
+				},
+				Status: &accepted,
+			},
+			{
+				Actor: &domain.FHIRReference{
+					ID:        &facilityID,
+					Reference: &facilityReference,
+				},
+				Status: &accepted,
+			},
+		},
+	}
+}
+
+// fakePractitioner returns an active clinical officer registered with the Clinical Officers Council
+func fakePractitioner(id string) domain.FHIRPractitioner {
+	active := true
+	registrationSystem := scalarutils.URI("http://savannahghi.org/fhir/NamingSystem/coc-registration-number")
+	userSystem := scalarutils.URI("mycarehub.practitioner.user.id")
+	phoneSystem := domain.ContactPointSystemEnumPhone
+	phone := "+254711223344"
+
+	return domain.FHIRPractitioner{
+		ID:     &id,
+		Active: &active,
+		Identifier: []*domain.FHIRIdentifier{
+			{
+				Use:    domain.IdentifierUseEnumOfficial,
+				System: &registrationSystem,
+				Value:  "CO-" + gofakeit.Numerify("#####"),
+			},
+			{
+				Use:    domain.IdentifierUseEnumSecondary,
+				System: &userSystem,
+				Value:  gofakeit.UUID(),
+			},
+		},
+		Name: []*domain.FHIRHumanName{
+			{
+				Text: gofakeit.Name(),
+			},
+		},
+		Telecom: []*domain.FHIRContactPoint{
+			{
+				System: &phoneSystem,
+				Value:  &phone,
+			},
+		},
+		Qualification: []*domain.FHIRPractitionerQualification{
+			{
+				Code: &domain.FHIRCodeableConcept{
+					Text: "Diploma in Clinical Medicine and Surgery",
+				},
+			},
+		},
+	}
+}
+
+// fakePractitionerRole returns an active assignment of a clinical officer to a facility
+func fakePractitionerRole(id string) domain.FHIRPractitionerRole {
+	active := true
+	practitionerID := gofakeit.UUID()
+	practitionerReference := "Practitioner/" + practitionerID
+	facilityID := gofakeit.UUID()
+	facilityReference := "Organization/" + facilityID
+
+	return domain.FHIRPractitionerRole{
+		ID:     &id,
+		Active: &active,
+		Period: &domain.FHIRPeriod{
+			Start: scalarutils.DateTime(time.Now().AddDate(-1, 0, 0).Format(time.DateOnly)),
+		},
+		Practitioner: &domain.FHIRReference{
+			ID:        &practitionerID,
+			Reference: &practitionerReference,
+			Display:   gofakeit.Name(),
+		},
+		Organization: &domain.FHIRReference{
+			ID:        &facilityID,
+			Reference: &facilityReference,
+		},
+		Code: []*domain.FHIRCodeableConcept{
+			{
+				Text: "Clinical Officer",
+			},
+		},
+	}
+}
+
+// fakeLocation returns an active maternity ward of a facility
+func fakeLocation(id string) domain.FHIRLocation {
+	status := scalarutils.Code("active")
+	wardCode := scalarutils.Code("ward")
+	name := "Maternity Ward"
+	county := "Nairobi"
+	subCounty := "Westlands"
+	ward := "Parklands"
+	facilityID := gofakeit.UUID()
+	facilityReference := "Organization/" + facilityID
+
+	return domain.FHIRLocation{
+		ID:     &id,
+		Status: &status,
+		Name:   &name,
+		Type: []*domain.FHIRCodeableConcept{
+			{
+				Coding: []*domain.FHIRCoding{
+					{
+						Code:    &wardCode,
+						Display: "Ward",
+					},
+				},
+				Text: "Ward",
+			},
+		},
+		Address: &domain.FHIRAddress{
+			State:    &county,
+			District: &subCounty,
+			City:     &ward,
+		},
+		ManagingOrganization: &domain.FHIRReference{
+			ID:        &facilityID,
+			Reference: &facilityReference,
+		},
+	}
+}
+
+// fakeFamilyMemberHistory returns the history of the mother of the default patient, who had breast cancer at 45
+func fakeFamilyMemberHistory(id string) domain.FHIRFamilyMemberHistory {
+	patientID := "12345678905432345"
+	patientReference := "Patient/" + patientID
+	status := scalarutils.Code("completed")
+	relationshipSystem := scalarutils.URI("http://terminology.hl7.org/CodeSystem/v3-RoleCode")
+	relationshipCode := scalarutils.Code("MTH")
+	conditionSystem := scalarutils.URI("/orgs/CIEL/sources/CIEL/concepts/116026/")
+	conditionCode := scalarutils.Code("116026")
+	date := time.Now().Format(time.RFC3339)
+
+	return domain.FHIRFamilyMemberHistory{
+		ID:     &id,
+		Status: &status,
+		Patient: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+		},
+		Date: &date,
+		Relationship: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &relationshipSystem,
+					Code:    &relationshipCode,
+					Display: "Mother",
+				},
+			},
+			Text: "Mother",
+		},
+		Condition: []*domain.FHIRFamilyMemberHistoryCondition{
+			{
+				Code: &domain.FHIRCodeableConcept{
+					Coding: []*domain.FHIRCoding{
+						{
+							System:  &conditionSystem,
+							Code:    &conditionCode,
+							Display: "Breast cancer",
+						},
+					},
+					Text: "Breast cancer",
+				},
+				OnsetAge: &domain.FHIRQuantity{
+					Value:  45,
+					Unit:   "years",
+					System: "http://unitsofmeasure.org",
+					Code:   "a",
+				},
+			},
+		},
+	}
+}
+
+// fakeCondition returns a confirmed and active malaria diagnosis of the patient of the default encounter
+func fakeCondition(id string) domain.FHIRCondition {
+	patientID := "12345678905432345"
+	patientReference := "Patient/" + patientID
+	encounterID := "12345678905432345"
+	encounterReference := "Encounter/" + encounterID
+	clinicalSystem := scalarutils.URI("http://terminology.hl7.org/CodeSystem/condition-clinical")
+	clinicalCode := scalarutils.Code("active")
+	verificationSystem := scalarutils.URI("http://terminology.hl7.org/CodeSystem/condition-ver-status")
+	verificationCode := scalarutils.Code("confirmed")
+	categorySystem := scalarutils.URI("http://terminology.hl7.org/CodeSystem/condition-category")
+	categoryCode := scalarutils.Code("ENCOUNTER_DIAGNOSIS")
+	conditionSystem := scalarutils.URI("/orgs/CIEL/sources/CIEL/concepts/116128/")
+	conditionCode := scalarutils.Code("116128")
+	onsetDate := scalarutils.Date{Year: 2023, Month: 6, Day: 1}
+	recordedDate := scalarutils.Date{Year: 2023, Month: 6, Day: 3}
+
+	return domain.FHIRCondition{
+		ID: &id,
+		ClinicalStatus: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &clinicalSystem,
+					Code:    &clinicalCode,
+					Display: "ACTIVE",
+				},
+			},
+			Text: "ACTIVE",
+		},
+		VerificationStatus: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &verificationSystem,
+					Code:    &verificationCode,
+					Display: "confirmed",
+				},
+			},
+			Text: "confirmed",
+		},
+		Category: []*domain.FHIRCodeableConcept{
+			{
+				Coding: []*domain.FHIRCoding{
+					{
+						System:  &categorySystem,
+						Code:    &categoryCode,
+						Display: "ENCOUNTER_DIAGNOSIS",
+					},
+				},
+				Text: "ENCOUNTER_DIAGNOSIS",
+			},
+		},
+		Code: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &conditionSystem,
+					Code:    &conditionCode,
+					Display: "Malaria",
+				},
+			},
+			Text: "Malaria",
+		},
+		Subject: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+		},
+		Encounter: &domain.FHIRReference{
+			ID:        &encounterID,
+			Reference: &encounterReference,
+		},
+		OnsetDateTime: &onsetDate,
+		RecordedDate:  &recordedDate,
+	}
+}
+
+// fakeLabOrder returns an active full blood count order for the patient of the default encounter
+func fakeLabOrder(id string) domain.FHIRServiceRequest {
+	patientID := "12345678905432345"
+	patientReference := "Patient/" + patientID
+	encounterID := "12345678905432345"
+	encounterReference := "Encounter/" + encounterID
+	categorySystem := scalarutils.URI("http://snomed.info/sct")
+	categoryCode := scalarutils.Code("108252007")
+	testSystem := scalarutils.URI("/orgs/CIEL/sources/CIEL/concepts/1019/")
+	testCode := scalarutils.Code("1019")
+	authoredOn := scalarutils.DateTime(time.Now().Format(time.RFC3339))
+
+	return domain.FHIRServiceRequest{
+		ID:       &id,
+		Status:   domain.ServiceRequestStatusActive,
+		Intent:   domain.ServiceRequestIntentOrder,
+		Priority: domain.ServiceRequestPriorityRoutine,
+		Category: []*domain.FHIRCodeableConcept{
+			{
+				Coding: []*domain.FHIRCoding{
+					{
+						System:  &categorySystem,
+						Code:    &categoryCode,
+						Display: "Laboratory procedure",
+					},
+				},
+				Text: "Laboratory procedure",
+			},
+		},
+		Code: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &testSystem,
+					Code:    &testCode,
+					Display: "Full blood count",
+				},
+			},
+			Text: "Full blood count",
+		},
+		Subject: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+		},
+		Encounter: &domain.FHIRReference{
+			ID:        &encounterID,
+			Reference: &encounterReference,
+		},
+		AuthoredOn: &authoredOn,
+	}
 }
 
 // NewFHIRMock initializes a new instance of FHIR mock
@@ -237,7 +1093,7 @@ func NewFHIRMock() *FHIRMock {
 				},
 			}, nil
 		},
-		MockSearchFHIROrganizationFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIROrganizationRelayConnection, error) {
+		MockSearchFHIROrganizationFn: func(ctx context.Context, params map[string]interface{}, pagination dto.Pagination) (*domain.FHIROrganizationRelayConnection, error) {
 			return &domain.FHIROrganizationRelayConnection{}, nil
 		},
 		MockSearchFHIRRiskAssessmentFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRRiskAssessmentRelayConnection, error) {
@@ -531,8 +1387,13 @@ func NewFHIRMock() *FHIRMock {
 		MockGetActiveEpisodeFn: func(ctx context.Context, episodeID string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIREpisodeOfCare, error) {
 			return &domain.FHIREpisodeOfCare{}, nil
 		},
-		MockSearchFHIRServiceRequestFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRServiceRequestRelayConnection, error) {
-			return &domain.FHIRServiceRequestRelayConnection{}, nil
+		MockSearchFHIRServiceRequestFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRServiceRequest, error) {
+			return &domain.PagedFHIRServiceRequest{
+				ServiceRequests: []domain.FHIRServiceRequest{
+					fakeLabOrder(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
 		},
 		MockCreateFHIRServiceRequestFn: func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
 			ID := gofakeit.UUID()
@@ -719,7 +1580,7 @@ func NewFHIRMock() *FHIRMock {
 					Encounter: &domain.FHIRReference{
 						ID: &UID,
 					},
-					OnsetDateTime: &scalarutils.Date{},
+					OnsetDateTime: &scalarutils.Date{Year: 2020, Month: 9, Day: 24},
 					OnsetAge:      &domain.FHIRAge{},
 					OnsetPeriod: &domain.FHIRPeriod{
 						ID:    new(string),
@@ -728,7 +1589,7 @@ func NewFHIRMock() *FHIRMock {
 					},
 					OnsetRange:   &domain.FHIRRange{},
 					OnsetString:  new(string),
-					RecordedDate: &scalarutils.Date{},
+					RecordedDate: &scalarutils.Date{Year: 2020, Month: 9, Day: 24},
 					Recorder:     &domain.FHIRReference{},
 					Asserter:     &domain.FHIRReference{},
 					Note:         []*domain.FHIRAnnotation{},
@@ -771,7 +1632,21 @@ func NewFHIRMock() *FHIRMock {
 			}, nil
 		},
 		MockUpdateFHIRAllergyIntoleranceFn: func(ctx context.Context, input domain.FHIRAllergyIntoleranceInput) (*domain.FHIRAllergyIntoleranceRelayPayload, error) {
-			return &domain.FHIRAllergyIntoleranceRelayPayload{}, nil
+			bs, err := json.Marshal(input)
+			if err != nil {
+				return nil, err
+			}
+
+			resource := &domain.FHIRAllergyIntolerance{}
+
+			err = json.Unmarshal(bs, resource)
+			if err != nil {
+				return nil, err
+			}
+
+			return &domain.FHIRAllergyIntoleranceRelayPayload{
+				Resource: resource,
+			}, nil
 		},
 		MockSearchFHIRCompositionFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRComposition, error) {
 			id := gofakeit.UUID()
@@ -1195,7 +2070,28 @@ func NewFHIRMock() *FHIRMock {
 			}, nil
 		},
 		MockUpdateFHIRConditionFn: func(ctx context.Context, input domain.FHIRConditionInput) (*domain.FHIRConditionRelayPayload, error) {
-			return &domain.FHIRConditionRelayPayload{}, nil
+			bs, err := json.Marshal(input)
+			if err != nil {
+				return nil, err
+			}
+
+			resource := &domain.FHIRCondition{}
+
+			err = json.Unmarshal(bs, resource)
+			if err != nil {
+				return nil, err
+			}
+
+			return &domain.FHIRConditionRelayPayload{
+				Resource: resource,
+			}, nil
+		},
+		MockGetFHIRConditionFn: func(ctx context.Context, id string) (*domain.FHIRConditionRelayPayload, error) {
+			resource := fakeCondition(id)
+
+			return &domain.FHIRConditionRelayPayload{
+				Resource: &resource,
+			}, nil
 		},
 		MockGetFHIREncounterFn: func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
 			UUID := "12345678905432345"
@@ -1323,14 +2219,47 @@ func NewFHIRMock() *FHIRMock {
 				TotalCount:      0,
 			}, nil
 		},
-		MockSearchFHIRMedicationRequestFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationRequestRelayConnection, error) {
-			return &domain.FHIRMedicationRequestRelayConnection{}, nil
+		MockSearchFHIRMedicationRequestFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationRequest, error) {
+			return &domain.PagedFHIRMedicationRequest{
+				MedicationRequests: []domain.FHIRMedicationRequest{
+					fakeMedicationRequest(gofakeit.UUID()),
+				},
+				HasNextPage:     false,
+				NextCursor:      "",
+				HasPreviousPage: false,
+				PreviousCursor:  "",
+				TotalCount:      1,
+			}, nil
 		},
 		MockCreateFHIRMedicationRequestFn: func(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error) {
-			return &domain.FHIRMedicationRequestRelayPayload{}, nil
+			resource := fakeMedicationRequest(gofakeit.UUID())
+			resource.Status = input.Status
+			resource.Extension = input.Extension
+
+			if input.PriorPrescription != nil {
+				resource.PriorPrescription = &domain.FHIRReference{
+					ID:        input.PriorPrescription.ID,
+					Reference: input.PriorPrescription.Reference,
+				}
+			}
+
+			return &domain.FHIRMedicationRequestRelayPayload{
+				Resource: &resource,
+			}, nil
 		},
 		MockUpdateFHIRMedicationRequestFn: func(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error) {
-			return &domain.FHIRMedicationRequestRelayPayload{}, nil
+			resource := fakeMedicationRequest(*input.ID)
+			resource.Status = input.Status
+
+			if input.StatusReason != nil {
+				resource.StatusReason = &domain.FHIRCodeableConcept{
+					Text: input.StatusReason.Text,
+				}
+			}
+
+			return &domain.FHIRMedicationRequestRelayPayload{
+				Resource: &resource,
+			}, nil
 		},
 		MockDeleteFHIRMedicationRequestFn: func(ctx context.Context, id string) (bool, error) {
 			return true, nil
@@ -1540,6 +2469,21 @@ func NewFHIRMock() *FHIRMock {
 				},
 			}, nil
 		},
+		MockUpdateFHIRObservationFn: func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+			bs, err := json.Marshal(input)
+			if err != nil {
+				return nil, err
+			}
+
+			resource := &domain.FHIRObservation{}
+
+			err = json.Unmarshal(bs, resource)
+			if err != nil {
+				return nil, err
+			}
+
+			return resource, nil
+		},
 		MockDeleteFHIRObservationFn: func(ctx context.Context, id string) (bool, error) {
 			return true, nil
 		},
@@ -1861,6 +2805,19 @@ func NewFHIRMock() *FHIRMock {
 				PageInfo: &firebasetools.PageInfo{},
 			}, nil
 		},
+		MockSearchFHIRPatientByIdentifierFn: func(ctx context.Context, identifier string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error) {
+			patientID := gofakeit.UUID()
+			return &domain.PatientConnection{
+				Edges: []*domain.PatientEdge{
+					{
+						Node: &domain.FHIRPatient{
+							ID: &patientID,
+						},
+					},
+				},
+				PageInfo: &firebasetools.PageInfo{},
+			}, nil
+		},
 		MockSearchPatientObservationsFn: func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
 			uuid := uuid.New().String()
 			instant := gofakeit.TimeZone()
@@ -1968,6 +2925,9 @@ func NewFHIRMock() *FHIRMock {
 			}, nil
 		},
 		MockCreateFHIRConsentFn: func(ctx context.Context, input domain.FHIRConsent) (*domain.FHIRConsent, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
 			return &input, nil
 		},
 		MockCreateFHIRQuestionnaireResponseFn: func(ctx context.Context, input *domain.FHIRQuestionnaireResponse) (*domain.FHIRQuestionnaireResponse, error) {
@@ -2226,15 +3186,497 @@ func NewFHIRMock() *FHIRMock {
 				Channel:           domain.FHIRSubscriptionChannel{},
 			}, nil
 		},
-	}
-}
-
-// CreateEpisodeOfCare is a mock implementation of CreateEpisodeOfCare method
-func (fh *FHIRMock) CreateEpisodeOfCare(ctx context.Context, episode domain.FHIREpisodeOfCareInput) (*domain.EpisodeOfCarePayload, error) {
-	return fh.MockCreateEpisodeOfCareFn(ctx, episode)
-}
+		MockCreateFHIRBasicFn: func(ctx context.Context, input domain.FHIRBasic) (*domain.FHIRBasic, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
 
-// CreateFHIRCondition is a mock implementation of CreateFHIRCondition method
+			return &input, nil
+		},
+		MockUpdateFHIRBasicFn: func(ctx context.Context, input domain.FHIRBasic) (*domain.FHIRBasic, error) {
+			return &input, nil
+		},
+		MockSearchFHIRBasicFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRBasic, error) {
+			id := gofakeit.UUID()
+
+			return &domain.PagedFHIRBasic{
+				Basics: []domain.FHIRBasic{
+					{
+						ID: &id,
+					},
+				},
+			}, nil
+		},
+		MockCreateFHIRAuditEventFn: func(ctx context.Context, input domain.FHIRAuditEvent) (*domain.FHIRAuditEvent, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRConsentFn: func(ctx context.Context, input domain.FHIRConsent) (*domain.FHIRConsent, error) {
+			return &input, nil
+		},
+		MockSearchFHIRConsentFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRConsent, error) {
+			id := gofakeit.UUID()
+			status := dto.ConsentStatusEnum(dto.ConsentStatusActive)
+			provision := dto.ConsentProvisionTypeEnum(dto.ConsentProvisionTypePermit)
+
+			return &domain.PagedFHIRConsent{
+				Consents: []domain.FHIRConsent{
+					{
+						ID:     &id,
+						Status: &status,
+						Provision: &domain.FHIRConsentProvision{
+							Type: &provision,
+						},
+					},
+				},
+			}, nil
+		},
+		MockGetFHIRConsentFn: func(ctx context.Context, id string) (*domain.FHIRConsentRelayPayload, error) {
+			return &domain.FHIRConsentRelayPayload{
+				Resource: &domain.FHIRConsent{
+					ID: &id,
+				},
+			}, nil
+		},
+		MockGetFHIRMediaFn: func(ctx context.Context, id string) (*domain.FHIRMediaRelayPayload, error) {
+			patientID := "12345678905432345"
+			patientReference := "Patient/" + patientID
+			contentType := scalarutils.Code("application/pdf")
+			url := scalarutils.URL("https://storage.googleapis.com/clinical/consent-form.pdf")
+			title := "consent-form.pdf"
+			hash := scalarutils.Base64Binary("2jmj7l5rSw0yVb/vlWAYkK/YBwk=")
+			size := 1024
+
+			return &domain.FHIRMediaRelayPayload{
+				Resource: &domain.FHIRMedia{
+					ID: &id,
+					Subject: &domain.FHIRReferenceInput{
+						ID:        &patientID,
+						Reference: &patientReference,
+					},
+					Content: &domain.FHIRAttachmentInput{
+						ContentType: &contentType,
+						URL:         &url,
+						Title:       &title,
+						Hash:        &hash,
+						Size:        &size,
+					},
+				},
+			}, nil
+		},
+		MockGetFHIRMedicationRequestFn: func(ctx context.Context, id string) (*domain.FHIRMedicationRequestRelayPayload, error) {
+			resource := fakeMedicationRequest(id)
+
+			return &domain.FHIRMedicationRequestRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+		MockCreateFHIRMedicationDispenseFn: func(ctx context.Context, input domain.FHIRMedicationDispense) (*domain.FHIRMedicationDispense, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRMedicationDispenseFn: func(ctx context.Context, input domain.FHIRMedicationDispense) (*domain.FHIRMedicationDispense, error) {
+			return &input, nil
+		},
+		MockSearchFHIRMedicationDispenseFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationDispense, error) {
+			id := gofakeit.UUID()
+			status := scalarutils.Code("completed")
+			prescriptionID := gofakeit.UUID()
+			prescriptionReference := fmt.Sprintf("MedicationRequest/%s", prescriptionID)
+			handedOver := time.Now().Format(time.RFC3339)
+
+			return &domain.PagedFHIRMedicationDispense{
+				MedicationDispenses: []domain.FHIRMedicationDispense{
+					{
+						ID:     &id,
+						Status: &status,
+						AuthorizingPrescription: []*domain.FHIRReference{
+							{
+								ID:        &prescriptionID,
+								Reference: &prescriptionReference,
+							},
+						},
+						Quantity: &domain.FHIRQuantity{
+							Value:  5,
+							Unit:   "tablet",
+							System: "http://unitsofmeasure.org",
+							Code:   "tablet",
+						},
+						WhenHandedOver: &handedOver,
+					},
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockGetFHIRMedicationDispenseFn: func(ctx context.Context, id string) (*domain.FHIRMedicationDispenseRelayPayload, error) {
+			return &domain.FHIRMedicationDispenseRelayPayload{
+				Resource: &domain.FHIRMedicationDispense{
+					ID: &id,
+				},
+			}, nil
+		},
+		MockGetFHIRMedicationStatementFn: func(ctx context.Context, id string) (*domain.FHIRMedicationStatementRelayPayload, error) {
+			statement := fakeMedicationStatement(id)
+
+			return &domain.FHIRMedicationStatementRelayPayload{
+				Resource: &statement,
+			}, nil
+		},
+		MockUpdateFHIRMedicationStatementFn: func(ctx context.Context, input domain.FHIRMedicationStatementInput) (*domain.FHIRMedicationStatementRelayPayload, error) {
+			statement := fakeMedicationStatement(*input.ID)
+			statement.Status = input.Status
+
+			return &domain.FHIRMedicationStatementRelayPayload{
+				Resource: &statement,
+			}, nil
+		},
+		MockCreateFHIRImmunizationFn: func(ctx context.Context, input domain.FHIRImmunization) (*domain.FHIRImmunization, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRImmunizationFn: func(ctx context.Context, input domain.FHIRImmunization) (*domain.FHIRImmunization, error) {
+			return &input, nil
+		},
+		MockSearchFHIRImmunizationFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error) {
+			return &domain.PagedFHIRImmunization{
+				Immunizations: []domain.FHIRImmunization{
+					fakeImmunization(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockSearchFHIROrganisationImmunizationFn: func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error) {
+			return &domain.PagedFHIRImmunization{
+				Immunizations: []domain.FHIRImmunization{
+					fakeImmunization(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockGetFHIRImmunizationFn: func(ctx context.Context, id string) (*domain.FHIRImmunizationRelayPayload, error) {
+			resource := fakeImmunization(id)
+
+			return &domain.FHIRImmunizationRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+		MockCreateFHIRSpecimenFn: func(ctx context.Context, input domain.FHIRSpecimen) (*domain.FHIRSpecimen, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRSpecimenFn: func(ctx context.Context, input domain.FHIRSpecimen) (*domain.FHIRSpecimen, error) {
+			return &input, nil
+		},
+		MockSearchFHIRSpecimenFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSpecimen, error) {
+			return &domain.PagedFHIRSpecimen{
+				Specimens: []domain.FHIRSpecimen{
+					fakeSpecimen(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockGetFHIRSpecimenFn: func(ctx context.Context, id string) (*domain.FHIRSpecimenRelayPayload, error) {
+			resource := fakeSpecimen(id)
+
+			return &domain.FHIRSpecimenRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+		MockGetFHIRDiagnosticReportFn: func(ctx context.Context, id string) (*domain.FHIRDiagnosticReportRelayPayload, error) {
+			patientID := "12345678905432345"
+			conclusion := "Cervical intraepithelial neoplasia grade 2"
+
+			return &domain.FHIRDiagnosticReportRelayPayload{
+				Resource: &domain.FHIRDiagnosticReport{
+					ID:     &id,
+					Status: domain.DiagnosticReportStatusFinal,
+					Subject: &domain.FHIRReference{
+						ID: &patientID,
+					},
+					Conclusion: &conclusion,
+				},
+			}, nil
+		},
+		MockCreateFHIRProcedureFn: func(ctx context.Context, input domain.FHIRProcedure) (*domain.FHIRProcedure, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRProcedureFn: func(ctx context.Context, input domain.FHIRProcedure) (*domain.FHIRProcedure, error) {
+			return &input, nil
+		},
+		MockSearchFHIRProcedureFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRProcedure, error) {
+			return &domain.PagedFHIRProcedure{
+				Procedures: []domain.FHIRProcedure{
+					fakeProcedure(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockGetFHIRProcedureFn: func(ctx context.Context, id string) (*domain.FHIRProcedureRelayPayload, error) {
+			resource := fakeProcedure(id)
+
+			return &domain.FHIRProcedureRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+		MockCreateFHIRGoalFn: func(ctx context.Context, input domain.FHIRGoal) (*domain.FHIRGoal, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRGoalFn: func(ctx context.Context, input domain.FHIRGoal) (*domain.FHIRGoal, error) {
+			return &input, nil
+		},
+		MockSearchFHIRGoalFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRGoal, error) {
+			return &domain.PagedFHIRGoal{
+				Goals: []domain.FHIRGoal{
+					fakeGoal(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockGetFHIRGoalFn: func(ctx context.Context, id string) (*domain.FHIRGoalRelayPayload, error) {
+			resource := fakeGoal(id)
+
+			return &domain.FHIRGoalRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+		MockCreateFHIRCarePlanFn: func(ctx context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRCarePlanFn: func(ctx context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error) {
+			return &input, nil
+		},
+		MockSearchFHIRCarePlanFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCarePlan, error) {
+			return &domain.PagedFHIRCarePlan{
+				CarePlans: []domain.FHIRCarePlan{
+					fakeCarePlan(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockGetFHIRCarePlanFn: func(ctx context.Context, id string) (*domain.FHIRCarePlanRelayPayload, error) {
+			resource := fakeCarePlan(id)
+
+			return &domain.FHIRCarePlanRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+		MockCreateFHIRScheduleFn: func(ctx context.Context, input domain.FHIRSchedule) (*domain.FHIRSchedule, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRScheduleFn: func(ctx context.Context, input domain.FHIRSchedule) (*domain.FHIRSchedule, error) {
+			return &input, nil
+		},
+		MockSearchFHIRScheduleFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSchedule, error) {
+			return &domain.PagedFHIRSchedule{
+				Schedules: []domain.FHIRSchedule{
+					fakeSchedule(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockGetFHIRScheduleFn: func(ctx context.Context, id string) (*domain.FHIRScheduleRelayPayload, error) {
+			resource := fakeSchedule(id)
+
+			return &domain.FHIRScheduleRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+		MockCreateFHIRSlotFn: func(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRSlotFn: func(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error) {
+			return &input, nil
+		},
+		MockSearchFHIRSlotFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSlot, error) {
+			return &domain.PagedFHIRSlot{
+				Slots: []domain.FHIRSlot{
+					fakeSlot(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockGetFHIRSlotFn: func(ctx context.Context, id string) (*domain.FHIRSlotRelayPayload, error) {
+			resource := fakeSlot(id)
+
+			return &domain.FHIRSlotRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+		MockCreateFHIRAppointmentFn: func(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRAppointmentFn: func(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error) {
+			return &input, nil
+		},
+		MockSearchFHIRAppointmentFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAppointment, error) {
+			return &domain.PagedFHIRAppointment{
+				Appointments: []domain.FHIRAppointment{
+					fakeAppointment(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockGetFHIRAppointmentFn: func(ctx context.Context, id string) (*domain.FHIRAppointmentRelayPayload, error) {
+			resource := fakeAppointment(id)
+
+			return &domain.FHIRAppointmentRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+		MockCreateFHIRPractitionerFn: func(ctx context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRPractitionerFn: func(ctx context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error) {
+			return &input, nil
+		},
+		MockSearchFHIRPractitionerFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+			return &domain.PagedFHIRPractitioner{
+				Practitioners: []domain.FHIRPractitioner{
+					fakePractitioner(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockSearchFHIROrganisationPractitionerFn: func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+			return &domain.PagedFHIRPractitioner{
+				Practitioners: []domain.FHIRPractitioner{
+					fakePractitioner(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockGetFHIRPractitionerFn: func(ctx context.Context, id string) (*domain.FHIRPractitionerRelayPayload, error) {
+			resource := fakePractitioner(id)
+
+			return &domain.FHIRPractitionerRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+		MockCreateFHIRPractitionerRoleFn: func(ctx context.Context, input domain.FHIRPractitionerRole) (*domain.FHIRPractitionerRole, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRPractitionerRoleFn: func(ctx context.Context, input domain.FHIRPractitionerRole) (*domain.FHIRPractitionerRole, error) {
+			return &input, nil
+		},
+		MockSearchFHIRPractitionerRoleFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRPractitionerRole, error) {
+			return &domain.PagedFHIRPractitionerRole{
+				PractitionerRoles: []domain.FHIRPractitionerRole{
+					fakePractitionerRole(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockSearchFHIROrganisationPractitionerRoleFn: func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitionerRole, error) {
+			return &domain.PagedFHIRPractitionerRole{
+				PractitionerRoles: []domain.FHIRPractitionerRole{
+					fakePractitionerRole(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockGetFHIRPractitionerRoleFn: func(ctx context.Context, id string) (*domain.FHIRPractitionerRoleRelayPayload, error) {
+			resource := fakePractitionerRole(id)
+
+			return &domain.FHIRPractitionerRoleRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+		MockCreateFHIRLocationFn: func(ctx context.Context, input domain.FHIRLocation) (*domain.FHIRLocation, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRLocationFn: func(ctx context.Context, input domain.FHIRLocation) (*domain.FHIRLocation, error) {
+			return &input, nil
+		},
+		MockSearchFHIRLocationFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRLocation, error) {
+			return &domain.PagedFHIRLocation{
+				Locations: []domain.FHIRLocation{
+					fakeLocation(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockGetFHIRLocationFn: func(ctx context.Context, id string) (*domain.FHIRLocationRelayPayload, error) {
+			resource := fakeLocation(id)
+
+			return &domain.FHIRLocationRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+		MockCreateFHIRFamilyMemberHistoryFn: func(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error) {
+			id := gofakeit.UUID()
+			input.ID = &id
+
+			return &input, nil
+		},
+		MockUpdateFHIRFamilyMemberHistoryFn: func(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error) {
+			return &input, nil
+		},
+		MockSearchFHIRFamilyMemberHistoryFn: func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRFamilyMemberHistory, error) {
+			return &domain.PagedFHIRFamilyMemberHistory{
+				FamilyMemberHistories: []domain.FHIRFamilyMemberHistory{
+					fakeFamilyMemberHistory(gofakeit.UUID()),
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		MockGetFHIRFamilyMemberHistoryFn: func(ctx context.Context, id string) (*domain.FHIRFamilyMemberHistoryRelayPayload, error) {
+			resource := fakeFamilyMemberHistory(id)
+
+			return &domain.FHIRFamilyMemberHistoryRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+		MockUpdateFHIRServiceRequestFn: func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+			resource := fakeLabOrder(*input.ID)
+			resource.Status = input.Status
+
+			return &domain.FHIRServiceRequestRelayPayload{
+				Resource: &resource,
+			}, nil
+		},
+	}
+}
+
+// CreateEpisodeOfCare is a mock implementation of CreateEpisodeOfCare method
+func (fh *FHIRMock) CreateEpisodeOfCare(ctx context.Context, episode domain.FHIREpisodeOfCareInput) (*domain.EpisodeOfCarePayload, error) {
+	return fh.MockCreateEpisodeOfCareFn(ctx, episode)
+}
+
+// CreateFHIRCondition is a mock implementation of CreateFHIRCondition method
 func (fh *FHIRMock) CreateFHIRCondition(ctx context.Context, input domain.FHIRConditionInput) (*domain.FHIRConditionRelayPayload, error) {
 	return fh.MockCreateFHIRConditionFn(ctx, input)
 }
@@ -2245,8 +3687,8 @@ func (fh *FHIRMock) CreateFHIROrganization(ctx context.Context, input domain.FHI
 }
 
 // SearchFHIROrganization is a mock implementation of SearchFHIROrganization method
-func (fh *FHIRMock) SearchFHIROrganization(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIROrganizationRelayConnection, error) {
-	return fh.MockSearchFHIROrganizationFn(ctx, params, tenant, pagination)
+func (fh *FHIRMock) SearchFHIROrganization(ctx context.Context, params map[string]interface{}, pagination dto.Pagination) (*domain.FHIROrganizationRelayConnection, error) {
+	return fh.MockSearchFHIROrganizationFn(ctx, params, pagination)
 }
 
 // SearchEpisodesByParam is a mock implementation of SearchEpisodesByParam method
@@ -2315,7 +3757,7 @@ func (fh *FHIRMock) GetActiveEpisode(ctx context.Context, episodeID string, tena
 }
 
 // SearchFHIRServiceRequest is a mock implementation of SearchFHIRServiceRequest method
-func (fh *FHIRMock) SearchFHIRServiceRequest(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRServiceRequestRelayConnection, error) {
+func (fh *FHIRMock) SearchFHIRServiceRequest(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRServiceRequest, error) {
 	return fh.MockSearchFHIRServiceRequestFn(ctx, params, tenant, pagination)
 }
 
@@ -2379,6 +3821,11 @@ func (fh *FHIRMock) UpdateFHIRCondition(ctx context.Context, input domain.FHIRCo
 	return fh.MockUpdateFHIRConditionFn(ctx, input)
 }
 
+// GetFHIRCondition is a mock implementation of GetFHIRCondition method
+func (fh *FHIRMock) GetFHIRCondition(ctx context.Context, id string) (*domain.FHIRConditionRelayPayload, error) {
+	return fh.MockGetFHIRConditionFn(ctx, id)
+}
+
 // GetFHIREncounter is a mock implementation of GetFHIREncounter method
 func (fh *FHIRMock) GetFHIREncounter(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
 	return fh.MockGetFHIREncounterFn(ctx, id)
@@ -2395,7 +3842,7 @@ func (fh *FHIRMock) SearchFHIREncounter(ctx context.Context, params map[string]i
 }
 
 // SearchFHIRMedicationRequest is a mock implementation of SearchFHIRMedicationRequest method
-func (fh *FHIRMock) SearchFHIRMedicationRequest(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationRequestRelayConnection, error) {
+func (fh *FHIRMock) SearchFHIRMedicationRequest(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationRequest, error) {
 	return fh.MockSearchFHIRMedicationRequestFn(ctx, params, tenant, pagination)
 }
 
@@ -2494,6 +3941,11 @@ func (fh *FHIRMock) SearchFHIRPatient(ctx context.Context, searchParams string,
 	return fh.MockSearchFHIRPatientFn(ctx, searchParams, tenant, pagination)
 }
 
+// SearchFHIRPatientByIdentifier mocks the implementation of searching a FHIR patient by identifier
+func (fh *FHIRMock) SearchFHIRPatientByIdentifier(ctx context.Context, identifier string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error) {
+	return fh.MockSearchFHIRPatientByIdentifierFn(ctx, identifier, tenant, pagination)
+}
+
 // SearchPatientObservations mocks the implementation of searching patient observations
 func (fh *FHIRMock) SearchPatientObservations(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
 	return fh.MockSearchPatientObservationsFn(ctx, searchParameters, tenant, pagination)
@@ -2509,6 +3961,11 @@ func (fh *FHIRMock) PatchFHIRObservation(ctx context.Context, id string, input d
 	return fh.MockPatchFHIRObservationFn(ctx, id, input)
 }
 
+// UpdateFHIRObservation is a mock implementation of UpdateFHIRObservation method
+func (fh *FHIRMock) UpdateFHIRObservation(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+	return fh.MockUpdateFHIRObservationFn(ctx, input)
+}
+
 // GetFHIRAllergyIntolerance mocks the implementation of getting a resource by its ID
 func (fh *FHIRMock) GetFHIRAllergyIntolerance(ctx context.Context, id string) (*domain.FHIRAllergyIntoleranceRelayPayload, error) {
 	return fh.MockGetFHIRAllergyIntoleranceFn(ctx, id)
@@ -2593,3 +4050,343 @@ func (fh *FHIRMock) GetFHIRServiceRequest(ctx context.Context, id string) (*doma
 func (fh *FHIRMock) CreateFHIRSubscription(ctx context.Context, subscription *domain.FHIRSubscriptionInput) (*domain.FHIRSubscription, error) {
 	return fh.MockCreateFHIRSubscriptionFn(ctx, subscription)
 }
+
+// CreateFHIRBasic mocks the implementation of creating a FHIR basic
+func (fh *FHIRMock) CreateFHIRBasic(ctx context.Context, input domain.FHIRBasic) (*domain.FHIRBasic, error) {
+	return fh.MockCreateFHIRBasicFn(ctx, input)
+}
+
+// UpdateFHIRBasic mocks the implementation of updating a FHIR basic
+func (fh *FHIRMock) UpdateFHIRBasic(ctx context.Context, input domain.FHIRBasic) (*domain.FHIRBasic, error) {
+	return fh.MockUpdateFHIRBasicFn(ctx, input)
+}
+
+// SearchFHIRBasic mocks the implementation of searching FHIR basic resources
+func (fh *FHIRMock) SearchFHIRBasic(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRBasic, error) {
+	return fh.MockSearchFHIRBasicFn(ctx, params, tenant, pagination)
+}
+
+// CreateFHIRAuditEvent mocks the implementation of creating a FHIR audit event
+func (fh *FHIRMock) CreateFHIRAuditEvent(ctx context.Context, input domain.FHIRAuditEvent) (*domain.FHIRAuditEvent, error) {
+	return fh.MockCreateFHIRAuditEventFn(ctx, input)
+}
+
+// UpdateFHIRConsent mocks the implementation of updating a FHIR consent
+func (fh *FHIRMock) UpdateFHIRConsent(ctx context.Context, input domain.FHIRConsent) (*domain.FHIRConsent, error) {
+	return fh.MockUpdateFHIRConsentFn(ctx, input)
+}
+
+// SearchFHIRConsent mocks the implementation of searching FHIR consent resources
+func (fh *FHIRMock) SearchFHIRConsent(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRConsent, error) {
+	return fh.MockSearchFHIRConsentFn(ctx, params, tenant, pagination)
+}
+
+// GetFHIRConsent mocks the implementation of retrieving a FHIR consent by ID
+func (fh *FHIRMock) GetFHIRConsent(ctx context.Context, id string) (*domain.FHIRConsentRelayPayload, error) {
+	return fh.MockGetFHIRConsentFn(ctx, id)
+}
+
+// GetFHIRMedia mocks the implementation of retrieving a FHIR media by ID
+func (fh *FHIRMock) GetFHIRMedia(ctx context.Context, id string) (*domain.FHIRMediaRelayPayload, error) {
+	return fh.MockGetFHIRMediaFn(ctx, id)
+}
+
+// GetFHIRMedicationRequest mocks the implementation of retrieving a FHIR medication request by ID
+func (fh *FHIRMock) GetFHIRMedicationRequest(ctx context.Context, id string) (*domain.FHIRMedicationRequestRelayPayload, error) {
+	return fh.MockGetFHIRMedicationRequestFn(ctx, id)
+}
+
+// CreateFHIRMedicationDispense mocks the implementation of creating a FHIR medication dispense
+func (fh *FHIRMock) CreateFHIRMedicationDispense(ctx context.Context, input domain.FHIRMedicationDispense) (*domain.FHIRMedicationDispense, error) {
+	return fh.MockCreateFHIRMedicationDispenseFn(ctx, input)
+}
+
+// UpdateFHIRMedicationDispense mocks the implementation of updating a FHIR medication dispense
+func (fh *FHIRMock) UpdateFHIRMedicationDispense(ctx context.Context, input domain.FHIRMedicationDispense) (*domain.FHIRMedicationDispense, error) {
+	return fh.MockUpdateFHIRMedicationDispenseFn(ctx, input)
+}
+
+// SearchFHIRMedicationDispense mocks the implementation of searching FHIR medication dispense resources
+func (fh *FHIRMock) SearchFHIRMedicationDispense(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationDispense, error) {
+	return fh.MockSearchFHIRMedicationDispenseFn(ctx, params, tenant, pagination)
+}
+
+// GetFHIRMedicationDispense mocks the implementation of retrieving a FHIR medication dispense by ID
+func (fh *FHIRMock) GetFHIRMedicationDispense(ctx context.Context, id string) (*domain.FHIRMedicationDispenseRelayPayload, error) {
+	return fh.MockGetFHIRMedicationDispenseFn(ctx, id)
+}
+
+// GetFHIRMedicationStatement mocks the implementation of retrieving a FHIR medication statement by ID
+func (fh *FHIRMock) GetFHIRMedicationStatement(ctx context.Context, id string) (*domain.FHIRMedicationStatementRelayPayload, error) {
+	return fh.MockGetFHIRMedicationStatementFn(ctx, id)
+}
+
+// UpdateFHIRMedicationStatement mocks the implementation of updating a FHIR medication statement
+func (fh *FHIRMock) UpdateFHIRMedicationStatement(ctx context.Context, input domain.FHIRMedicationStatementInput) (*domain.FHIRMedicationStatementRelayPayload, error) {
+	return fh.MockUpdateFHIRMedicationStatementFn(ctx, input)
+}
+
+// CreateFHIRImmunization mocks the implementation of creating a FHIR immunization
+func (fh *FHIRMock) CreateFHIRImmunization(ctx context.Context, input domain.FHIRImmunization) (*domain.FHIRImmunization, error) {
+	return fh.MockCreateFHIRImmunizationFn(ctx, input)
+}
+
+// UpdateFHIRImmunization mocks the implementation of updating a FHIR immunization
+func (fh *FHIRMock) UpdateFHIRImmunization(ctx context.Context, input domain.FHIRImmunization) (*domain.FHIRImmunization, error) {
+	return fh.MockUpdateFHIRImmunizationFn(ctx, input)
+}
+
+// SearchFHIRImmunization mocks the implementation of searching FHIR immunization resources
+func (fh *FHIRMock) SearchFHIRImmunization(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error) {
+	return fh.MockSearchFHIRImmunizationFn(ctx, params, tenant, pagination)
+}
+
+// SearchFHIROrganisationImmunization mocks the implementation of searching the FHIR immunization resources of an organisation
+func (fh *FHIRMock) SearchFHIROrganisationImmunization(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error) {
+	return fh.MockSearchFHIROrganisationImmunizationFn(ctx, params, organisationID, pagination)
+}
+
+// GetFHIRImmunization mocks the implementation of retrieving a FHIR immunization by ID
+func (fh *FHIRMock) GetFHIRImmunization(ctx context.Context, id string) (*domain.FHIRImmunizationRelayPayload, error) {
+	return fh.MockGetFHIRImmunizationFn(ctx, id)
+}
+
+// UpdateFHIRServiceRequest mocks the implementation of updating a FHIR service request
+func (fh *FHIRMock) UpdateFHIRServiceRequest(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+	return fh.MockUpdateFHIRServiceRequestFn(ctx, input)
+}
+
+// CreateFHIRSpecimen mocks the implementation of creating a FHIR specimen
+func (fh *FHIRMock) CreateFHIRSpecimen(ctx context.Context, input domain.FHIRSpecimen) (*domain.FHIRSpecimen, error) {
+	return fh.MockCreateFHIRSpecimenFn(ctx, input)
+}
+
+// UpdateFHIRSpecimen mocks the implementation of updating a FHIR specimen
+func (fh *FHIRMock) UpdateFHIRSpecimen(ctx context.Context, input domain.FHIRSpecimen) (*domain.FHIRSpecimen, error) {
+	return fh.MockUpdateFHIRSpecimenFn(ctx, input)
+}
+
+// SearchFHIRSpecimen mocks the implementation of searching FHIR specimen resources
+func (fh *FHIRMock) SearchFHIRSpecimen(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSpecimen, error) {
+	return fh.MockSearchFHIRSpecimenFn(ctx, params, tenant, pagination)
+}
+
+// GetFHIRSpecimen mocks the implementation of retrieving a FHIR specimen by ID
+func (fh *FHIRMock) GetFHIRSpecimen(ctx context.Context, id string) (*domain.FHIRSpecimenRelayPayload, error) {
+	return fh.MockGetFHIRSpecimenFn(ctx, id)
+}
+
+// GetFHIRDiagnosticReport mocks the implementation of retrieving a FHIR diagnostic report by ID
+func (fh *FHIRMock) GetFHIRDiagnosticReport(ctx context.Context, id string) (*domain.FHIRDiagnosticReportRelayPayload, error) {
+	return fh.MockGetFHIRDiagnosticReportFn(ctx, id)
+}
+
+// CreateFHIRProcedure mocks the implementation of creating a FHIR procedure
+func (fh *FHIRMock) CreateFHIRProcedure(ctx context.Context, input domain.FHIRProcedure) (*domain.FHIRProcedure, error) {
+	return fh.MockCreateFHIRProcedureFn(ctx, input)
+}
+
+// UpdateFHIRProcedure mocks the implementation of updating a FHIR procedure
+func (fh *FHIRMock) UpdateFHIRProcedure(ctx context.Context, input domain.FHIRProcedure) (*domain.FHIRProcedure, error) {
+	return fh.MockUpdateFHIRProcedureFn(ctx, input)
+}
+
+// SearchFHIRProcedure mocks the implementation of searching FHIR procedure resources
+func (fh *FHIRMock) SearchFHIRProcedure(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRProcedure, error) {
+	return fh.MockSearchFHIRProcedureFn(ctx, params, tenant, pagination)
+}
+
+// GetFHIRProcedure mocks the implementation of retrieving a FHIR procedure by ID
+func (fh *FHIRMock) GetFHIRProcedure(ctx context.Context, id string) (*domain.FHIRProcedureRelayPayload, error) {
+	return fh.MockGetFHIRProcedureFn(ctx, id)
+}
+
+// CreateFHIRGoal mocks the implementation of creating a FHIR goal
+func (fh *FHIRMock) CreateFHIRGoal(ctx context.Context, input domain.FHIRGoal) (*domain.FHIRGoal, error) {
+	return fh.MockCreateFHIRGoalFn(ctx, input)
+}
+
+// UpdateFHIRGoal mocks the implementation of updating a FHIR goal
+func (fh *FHIRMock) UpdateFHIRGoal(ctx context.Context, input domain.FHIRGoal) (*domain.FHIRGoal, error) {
+	return fh.MockUpdateFHIRGoalFn(ctx, input)
+}
+
+// SearchFHIRGoal mocks the implementation of searching FHIR goal resources
+func (fh *FHIRMock) SearchFHIRGoal(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRGoal, error) {
+	return fh.MockSearchFHIRGoalFn(ctx, params, tenant, pagination)
+}
+
+// GetFHIRGoal mocks the implementation of retrieving a FHIR goal by ID
+func (fh *FHIRMock) GetFHIRGoal(ctx context.Context, id string) (*domain.FHIRGoalRelayPayload, error) {
+	return fh.MockGetFHIRGoalFn(ctx, id)
+}
+
+// CreateFHIRCarePlan mocks the implementation of creating a FHIR care plan
+func (fh *FHIRMock) CreateFHIRCarePlan(ctx context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error) {
+	return fh.MockCreateFHIRCarePlanFn(ctx, input)
+}
+
+// UpdateFHIRCarePlan mocks the implementation of updating a FHIR care plan
+func (fh *FHIRMock) UpdateFHIRCarePlan(ctx context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error) {
+	return fh.MockUpdateFHIRCarePlanFn(ctx, input)
+}
+
+// SearchFHIRCarePlan mocks the implementation of searching FHIR care plan resources
+func (fh *FHIRMock) SearchFHIRCarePlan(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCarePlan, error) {
+	return fh.MockSearchFHIRCarePlanFn(ctx, params, tenant, pagination)
+}
+
+// GetFHIRCarePlan mocks the implementation of retrieving a FHIR care plan by ID
+func (fh *FHIRMock) GetFHIRCarePlan(ctx context.Context, id string) (*domain.FHIRCarePlanRelayPayload, error) {
+	return fh.MockGetFHIRCarePlanFn(ctx, id)
+}
+
+// CreateFHIRSchedule mocks the implementation of creating a FHIR schedule
+func (fh *FHIRMock) CreateFHIRSchedule(ctx context.Context, input domain.FHIRSchedule) (*domain.FHIRSchedule, error) {
+	return fh.MockCreateFHIRScheduleFn(ctx, input)
+}
+
+// UpdateFHIRSchedule mocks the implementation of updating a FHIR schedule
+func (fh *FHIRMock) UpdateFHIRSchedule(ctx context.Context, input domain.FHIRSchedule) (*domain.FHIRSchedule, error) {
+	return fh.MockUpdateFHIRScheduleFn(ctx, input)
+}
+
+// SearchFHIRSchedule mocks the implementation of searching FHIR schedule resources
+func (fh *FHIRMock) SearchFHIRSchedule(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSchedule, error) {
+	return fh.MockSearchFHIRScheduleFn(ctx, params, tenant, pagination)
+}
+
+// GetFHIRSchedule mocks the implementation of retrieving a FHIR schedule by ID
+func (fh *FHIRMock) GetFHIRSchedule(ctx context.Context, id string) (*domain.FHIRScheduleRelayPayload, error) {
+	return fh.MockGetFHIRScheduleFn(ctx, id)
+}
+
+// CreateFHIRSlot mocks the implementation of creating a FHIR slot
+func (fh *FHIRMock) CreateFHIRSlot(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error) {
+	return fh.MockCreateFHIRSlotFn(ctx, input)
+}
+
+// UpdateFHIRSlot mocks the implementation of updating a FHIR slot
+func (fh *FHIRMock) UpdateFHIRSlot(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error) {
+	return fh.MockUpdateFHIRSlotFn(ctx, input)
+}
+
+// SearchFHIRSlot mocks the implementation of searching FHIR slot resources
+func (fh *FHIRMock) SearchFHIRSlot(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSlot, error) {
+	return fh.MockSearchFHIRSlotFn(ctx, params, tenant, pagination)
+}
+
+// GetFHIRSlot mocks the implementation of retrieving a FHIR slot by ID
+func (fh *FHIRMock) GetFHIRSlot(ctx context.Context, id string) (*domain.FHIRSlotRelayPayload, error) {
+	return fh.MockGetFHIRSlotFn(ctx, id)
+}
+
+// CreateFHIRAppointment mocks the implementation of creating a FHIR appointment
+func (fh *FHIRMock) CreateFHIRAppointment(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error) {
+	return fh.MockCreateFHIRAppointmentFn(ctx, input)
+}
+
+// UpdateFHIRAppointment mocks the implementation of updating a FHIR appointment
+func (fh *FHIRMock) UpdateFHIRAppointment(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error) {
+	return fh.MockUpdateFHIRAppointmentFn(ctx, input)
+}
+
+// SearchFHIRAppointment mocks the implementation of searching FHIR appointment resources
+func (fh *FHIRMock) SearchFHIRAppointment(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAppointment, error) {
+	return fh.MockSearchFHIRAppointmentFn(ctx, params, tenant, pagination)
+}
+
+// GetFHIRAppointment mocks the implementation of retrieving a FHIR appointment by ID
+func (fh *FHIRMock) GetFHIRAppointment(ctx context.Context, id string) (*domain.FHIRAppointmentRelayPayload, error) {
+	return fh.MockGetFHIRAppointmentFn(ctx, id)
+}
+
+// CreateFHIRPractitioner mocks the implementation of creating a FHIR practitioner
+func (fh *FHIRMock) CreateFHIRPractitioner(ctx context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error) {
+	return fh.MockCreateFHIRPractitionerFn(ctx, input)
+}
+
+// UpdateFHIRPractitioner mocks the implementation of updating a FHIR practitioner
+func (fh *FHIRMock) UpdateFHIRPractitioner(ctx context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error) {
+	return fh.MockUpdateFHIRPractitionerFn(ctx, input)
+}
+
+// SearchFHIRPractitioner mocks the implementation of searching FHIR practitioner resources
+func (fh *FHIRMock) SearchFHIRPractitioner(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+	return fh.MockSearchFHIRPractitionerFn(ctx, params, tenant, pagination)
+}
+
+// SearchFHIROrganisationPractitioner mocks the implementation of searching the FHIR practitioner resources of an organisation
+func (fh *FHIRMock) SearchFHIROrganisationPractitioner(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+	return fh.MockSearchFHIROrganisationPractitionerFn(ctx, params, organisationID, pagination)
+}
+
+// GetFHIRPractitioner mocks the implementation of retrieving a FHIR practitioner by ID
+func (fh *FHIRMock) GetFHIRPractitioner(ctx context.Context, id string) (*domain.FHIRPractitionerRelayPayload, error) {
+	return fh.MockGetFHIRPractitionerFn(ctx, id)
+}
+
+// CreateFHIRPractitionerRole mocks the implementation of creating a FHIR practitioner role
+func (fh *FHIRMock) CreateFHIRPractitionerRole(ctx context.Context, input domain.FHIRPractitionerRole) (*domain.FHIRPractitionerRole, error) {
+	return fh.MockCreateFHIRPractitionerRoleFn(ctx, input)
+}
+
+// UpdateFHIRPractitionerRole mocks the implementation of updating a FHIR practitioner role
+func (fh *FHIRMock) UpdateFHIRPractitionerRole(ctx context.Context, input domain.FHIRPractitionerRole) (*domain.FHIRPractitionerRole, error) {
+	return fh.MockUpdateFHIRPractitionerRoleFn(ctx, input)
+}
+
+// SearchFHIRPractitionerRole mocks the implementation of searching FHIR practitioner role resources
+func (fh *FHIRMock) SearchFHIRPractitionerRole(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRPractitionerRole, error) {
+	return fh.MockSearchFHIRPractitionerRoleFn(ctx, params, tenant, pagination)
+}
+
+// SearchFHIROrganisationPractitionerRole mocks the implementation of searching the FHIR practitioner role resources of an organisation
+func (fh *FHIRMock) SearchFHIROrganisationPractitionerRole(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitionerRole, error) {
+	return fh.MockSearchFHIROrganisationPractitionerRoleFn(ctx, params, organisationID, pagination)
+}
+
+// GetFHIRPractitionerRole mocks the implementation of retrieving a FHIR practitioner role by ID
+func (fh *FHIRMock) GetFHIRPractitionerRole(ctx context.Context, id string) (*domain.FHIRPractitionerRoleRelayPayload, error) {
+	return fh.MockGetFHIRPractitionerRoleFn(ctx, id)
+}
+
+// CreateFHIRLocation mocks the implementation of creating a FHIR location
+func (fh *FHIRMock) CreateFHIRLocation(ctx context.Context, input domain.FHIRLocation) (*domain.FHIRLocation, error) {
+	return fh.MockCreateFHIRLocationFn(ctx, input)
+}
+
+// UpdateFHIRLocation mocks the implementation of updating a FHIR location
+func (fh *FHIRMock) UpdateFHIRLocation(ctx context.Context, input domain.FHIRLocation) (*domain.FHIRLocation, error) {
+	return fh.MockUpdateFHIRLocationFn(ctx, input)
+}
+
+// SearchFHIRLocation mocks the implementation of searching FHIR location resources
+func (fh *FHIRMock) SearchFHIRLocation(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRLocation, error) {
+	return fh.MockSearchFHIRLocationFn(ctx, params, tenant, pagination)
+}
+
+// GetFHIRLocation mocks the implementation of retrieving a FHIR location by ID
+func (fh *FHIRMock) GetFHIRLocation(ctx context.Context, id string) (*domain.FHIRLocationRelayPayload, error) {
+	return fh.MockGetFHIRLocationFn(ctx, id)
+}
+
+// CreateFHIRFamilyMemberHistory mocks the implementation of creating a FHIR family member history
+func (fh *FHIRMock) CreateFHIRFamilyMemberHistory(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error) {
+	return fh.MockCreateFHIRFamilyMemberHistoryFn(ctx, input)
+}
+
+// UpdateFHIRFamilyMemberHistory mocks the implementation of updating a FHIR family member history
+func (fh *FHIRMock) UpdateFHIRFamilyMemberHistory(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error) {
+	return fh.MockUpdateFHIRFamilyMemberHistoryFn(ctx, input)
+}
+
+// SearchFHIRFamilyMemberHistory mocks the implementation of searching FHIR family member history resources
+func (fh *FHIRMock) SearchFHIRFamilyMemberHistory(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRFamilyMemberHistory, error) {
+	return fh.MockSearchFHIRFamilyMemberHistoryFn(ctx, params, tenant, pagination)
+}
+
+// GetFHIRFamilyMemberHistory mocks the implementation of retrieving a FHIR family member history by ID
+func (fh *FHIRMock) GetFHIRFamilyMemberHistory(ctx context.Context, id string) (*domain.FHIRFamilyMemberHistoryRelayPayload, error) {
+	return fh.MockGetFHIRFamilyMemberHistoryFn(ctx, id)
+}
@@ -9,7 +9,12 @@ import (
 	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 	"github.com/savannahghi/clinical/pkg/clinical/domain"
 	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/earlywarning"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/growthstandards"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/immunizationschedule"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/interactions"
 	pubsubmessaging "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/referenceranges"
 	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload"
 	"github.com/savannahghi/clinical/pkg/clinical/repository"
 	"github.com/savannahghi/interserviceclient"
@@ -54,12 +59,17 @@ type BaseExtension interface {
 
 // Infrastructure ...
 type Infrastructure struct {
-	FHIR             repository.FHIR
-	OpenConceptLab   ServiceOCL
-	BaseExtension    BaseExtension
-	Upload           upload.ServiceUpload
-	Pubsub           pubsubmessaging.ServicePubsub
-	AdvantageService advantage.AdvantageService
+	FHIR               repository.FHIR
+	OpenConceptLab     ServiceOCL
+	BaseExtension      BaseExtension
+	Upload             upload.ServiceUpload
+	Pubsub             pubsubmessaging.ServicePubsub
+	AdvantageService   advantage.AdvantageService
+	Interactions       interactions.ServiceInteractions
+	Immunizations      immunizationschedule.ServiceImmunizationSchedule
+	ReferenceRanges    referenceranges.ServiceReferenceRanges
+	GrowthStandards    growthstandards.ServiceGrowthStandards
+	EarlyWarningScores earlywarning.ServiceEarlyWarningScores
 }
 
 // NewInfrastructureInteractor initializes a new Infrastructure
@@ -72,11 +82,16 @@ func NewInfrastructureInteractor(
 	advantage advantage.AdvantageService,
 ) Infrastructure {
 	return Infrastructure{
-		fhir,
-		openconceptlab,
-		ext,
-		upload,
-		pubsub,
-		advantage,
+		FHIR:               fhir,
+		OpenConceptLab:     openconceptlab,
+		BaseExtension:      ext,
+		Upload:             upload,
+		Pubsub:             pubsub,
+		AdvantageService:   advantage,
+		Interactions:       interactions.NewServiceInteractions(),
+		Immunizations:      immunizationschedule.NewServiceImmunizationSchedule(),
+		ReferenceRanges:    referenceranges.NewServiceReferenceRanges(),
+		GrowthStandards:    growthstandards.NewServiceGrowthStandards(),
+		EarlyWarningScores: earlywarning.NewServiceEarlyWarningScores(),
 	}
 }
@@ -0,0 +1,62 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/earlywarning"
+)
+
+// FakeEarlyWarningScores mocks the early warning scores
+type FakeEarlyWarningScores struct {
+	MockLoadFileFn func(path string) error
+	MockRegisterFn func(scorer earlywarning.Scorer)
+	MockConceptsFn func() []string
+	MockScoreFn    func(patient earlywarning.Patient, vitals earlywarning.Vitals, on time.Time) []earlywarning.Result
+}
+
+// NewFakeEarlyWarningScoresMock initializes the early warning scores mock
+func NewFakeEarlyWarningScoresMock() *FakeEarlyWarningScores {
+	return &FakeEarlyWarningScores{
+		MockLoadFileFn: func(path string) error {
+			return nil
+		},
+		MockRegisterFn: func(scorer earlywarning.Scorer) {},
+		MockConceptsFn: func() []string {
+			return []string{"5242", "5092", "5085", "5087", "5088"}
+		},
+		MockScoreFn: func(patient earlywarning.Patient, vitals earlywarning.Vitals, on time.Time) []earlywarning.Result {
+			return []earlywarning.Result{
+				{
+					Code:    "news2",
+					Display: "National Early Warning Score 2 (NEWS2)",
+					Score:   0,
+					Risk:    dto.EarlyWarningRiskLow,
+					Parameters: []earlywarning.Parameter{
+						{Name: "pulse", Display: "Pulse", ConceptID: "5087", Value: 72, Points: 0},
+					},
+				},
+			}
+		},
+	}
+}
+
+// LoadFile mocks the implementation of loading early warning scores from a file
+func (f *FakeEarlyWarningScores) LoadFile(path string) error {
+	return f.MockLoadFileFn(path)
+}
+
+// Register mocks the implementation of registering an early warning scorer
+func (f *FakeEarlyWarningScores) Register(scorer earlywarning.Scorer) {
+	f.MockRegisterFn(scorer)
+}
+
+// Concepts mocks the implementation of listing the concepts the scores are computed from
+func (f *FakeEarlyWarningScores) Concepts() []string {
+	return f.MockConceptsFn()
+}
+
+// Score mocks the implementation of computing the early warning scores of a patient
+func (f *FakeEarlyWarningScores) Score(patient earlywarning.Patient, vitals earlywarning.Vitals, on time.Time) []earlywarning.Result {
+	return f.MockScoreFn(patient, vitals, on)
+}
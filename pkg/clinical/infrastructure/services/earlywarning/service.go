@@ -0,0 +1,346 @@
+package earlywarning
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common/helpers"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+)
+
+// ScoresPathEnvVarName is the environment variable holding the path of the early warning scores to load on startup
+const ScoresPathEnvVarName = "EARLY_WARNING_SCORES_PATH"
+
+// defaultScores is the Royal College of Physicians' NEWS2 for adults, scored on SpO2 Scale 1.
+// Neither supplemental oxygen nor the level of consciousness is recorded, so patients are scored as breathing air and alert
+//
+//go:embed scores.json
+var defaultScores []byte
+
+// Vitals are the latest vital signs of a patient by their CIEL concept, in the unit the concept is recorded in
+type Vitals map[string]float64
+
+// Patient is the patient an early warning score is computed for
+type Patient struct {
+	Sex       string
+	BirthDate *time.Time
+}
+
+// Parameter is the points a vital sign contributed to an early warning score
+type Parameter struct {
+	Name      string
+	Display   string
+	ConceptID string
+	Value     float64
+	Points    int
+}
+
+// Result is an early warning score computed from the vital signs of a patient
+type Result struct {
+	Code       string
+	Display    string
+	Score      int
+	Risk       dto.EarlyWarningRiskEnum
+	Parameters []Parameter
+}
+
+// Scorer computes an early warning score from the vital signs of a patient. Scores that are not a sum of
+// points by parameter e.g with adjustments for pregnancy are plugged in by registering a scorer
+type Scorer interface {
+	// Code identifies the score e.g `news2`. A scorer replaces the scorer registered with the same code
+	Code() string
+
+	// Concepts are the CIEL concepts of the vital signs the score is computed from
+	Concepts() []string
+
+	// Score computes the score. No result is returned when the score does not apply to the patient
+	// or a vital sign it is computed from is missing
+	Score(patient Patient, vitals Vitals, on time.Time) *Result
+}
+
+// Table is a set of early warning scores
+type Table struct {
+	Scores []TableScore `json:"scores"`
+}
+
+// TableScore is an early warning score that sums the points of the band each parameter falls in.
+// It applies to patients whose age is at least the minimum age
+type TableScore struct {
+	ID         string           `json:"code"`
+	Display    string           `json:"display"`
+	MinimumAge helpers.Period   `json:"minimumAge,omitempty"`
+	Parameters []TableParameter `json:"parameters"`
+	Risks      []Risk           `json:"risks"`
+}
+
+// TableParameter is a vital sign of a score and the points of its bands
+type TableParameter struct {
+	Name      string `json:"name"`
+	Display   string `json:"display"`
+	ConceptID string `json:"conceptID"`
+	Bands     []Band `json:"bands"`
+}
+
+// Band is the points of the values up to and including the maximum. The last band has no maximum
+type Band struct {
+	Maximum *float64 `json:"maximum,omitempty"`
+	Points  int      `json:"points"`
+}
+
+// Risk is the risk indicated by a score of at least the minimum score, or by a parameter with at least the minimum points.
+// Risks are checked in order and the first that matches applies
+type Risk struct {
+	Risk          dto.EarlyWarningRiskEnum `json:"risk"`
+	MinimumScore  *int                     `json:"minimumScore,omitempty"`
+	MinimumPoints *int                     `json:"minimumPoints,omitempty"`
+}
+
+// Validate ensures the scores are complete and their bands are ordered
+func (t Table) Validate() error {
+	codes := map[string]bool{}
+
+	for _, score := range t.Scores {
+		if score.ID == "" || len(score.Parameters) == 0 {
+			return fmt.Errorf("an early warning score must specify its code and parameters")
+		}
+
+		if codes[score.ID] {
+			return fmt.Errorf("the early warning score %s is defined more than once", score.ID)
+		}
+
+		codes[score.ID] = true
+
+		for _, parameter := range score.Parameters {
+			if parameter.ConceptID == "" || len(parameter.Bands) == 0 {
+				return fmt.Errorf("the parameters of %s must specify their concept and bands", score.ID)
+			}
+
+			for i, band := range parameter.Bands {
+				last := i == len(parameter.Bands)-1
+
+				if (band.Maximum == nil) != last {
+					return fmt.Errorf("only the last band of %s in %s must have no maximum", parameter.Name, score.ID)
+				}
+
+				if i > 0 && !last && *band.Maximum <= *parameter.Bands[i-1].Maximum {
+					return fmt.Errorf("the bands of %s in %s must be in ascending order", parameter.Name, score.ID)
+				}
+			}
+		}
+
+		if len(score.Risks) == 0 {
+			return fmt.Errorf("the early warning score %s must specify its risks", score.ID)
+		}
+
+		for _, risk := range score.Risks {
+			if !risk.Risk.IsValid() {
+				return fmt.Errorf("%s is not a valid early warning risk of %s", risk.Risk, score.ID)
+			}
+		}
+
+		if last := score.Risks[len(score.Risks)-1]; last.MinimumScore != nil || last.MinimumPoints != nil {
+			return fmt.Errorf("the last risk of %s must apply to any score", score.ID)
+		}
+	}
+
+	return nil
+}
+
+// Code identifies the score
+func (s TableScore) Code() string {
+	return s.ID
+}
+
+// Concepts are the CIEL concepts of the parameters of the score
+func (s TableScore) Concepts() []string {
+	concepts := []string{}
+
+	for _, parameter := range s.Parameters {
+		concepts = append(concepts, parameter.ConceptID)
+	}
+
+	return concepts
+}
+
+// Score sums the points of the parameters of the score
+func (s TableScore) Score(patient Patient, vitals Vitals, on time.Time) *Result {
+	if !s.MinimumAge.IsZero() && patient.BirthDate != nil && on.Before(s.MinimumAge.AddTo(*patient.BirthDate)) {
+		return nil
+	}
+
+	result := &Result{
+		Code:       s.ID,
+		Display:    s.Display,
+		Parameters: []Parameter{},
+	}
+
+	highest := 0
+
+	for _, parameter := range s.Parameters {
+		value, ok := vitals[parameter.ConceptID]
+		if !ok {
+			return nil
+		}
+
+		points := parameter.points(value)
+
+		result.Score += points
+		result.Parameters = append(result.Parameters, Parameter{
+			Name:      parameter.Name,
+			Display:   parameter.Display,
+			ConceptID: parameter.ConceptID,
+			Value:     value,
+			Points:    points,
+		})
+
+		if points > highest {
+			highest = points
+		}
+	}
+
+	for _, risk := range s.Risks {
+		if risk.MinimumScore != nil && result.Score < *risk.MinimumScore {
+			continue
+		}
+
+		if risk.MinimumPoints != nil && highest < *risk.MinimumPoints {
+			continue
+		}
+
+		result.Risk = risk.Risk
+
+		break
+	}
+
+	return result
+}
+
+func (p TableParameter) points(value float64) int {
+	for _, band := range p.Bands {
+		if band.Maximum == nil || value <= *band.Maximum {
+			return band.Points
+		}
+	}
+
+	return 0
+}
+
+// ServiceEarlyWarningScores computes the early warning scores of patients from their vital signs
+type ServiceEarlyWarningScores interface {
+	LoadFile(path string) error
+	Register(scorer Scorer)
+	Concepts() []string
+	Score(patient Patient, vitals Vitals, on time.Time) []Result
+}
+
+// ServiceEarlyWarningScoresImpl holds the early warning scorers in memory
+type ServiceEarlyWarningScoresImpl struct {
+	mu      sync.RWMutex
+	scorers []Scorer
+}
+
+// NewServiceEarlyWarningScores initializes the early warning scores with the default scores
+func NewServiceEarlyWarningScores() *ServiceEarlyWarningScoresImpl {
+	s := &ServiceEarlyWarningScoresImpl{}
+
+	err := s.Load(bytes.NewReader(defaultScores))
+	if err != nil {
+		log.Panicf("unable to load the default early warning scores: %s", err)
+	}
+
+	return s
+}
+
+// Load reads early warning scores from JSON. The scores in it replace the scores with the same code
+func (s *ServiceEarlyWarningScoresImpl) Load(r io.Reader) error {
+	var table Table
+
+	err := json.NewDecoder(r).Decode(&table)
+	if err != nil {
+		return fmt.Errorf("unable to decode early warning scores: %w", err)
+	}
+
+	err = table.Validate()
+	if err != nil {
+		return err
+	}
+
+	for _, score := range table.Scores {
+		s.Register(score)
+	}
+
+	return nil
+}
+
+// LoadFile reads early warning scores from a local JSON file
+func (s *ServiceEarlyWarningScoresImpl) LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to open early warning scores %s: %w", path, err)
+	}
+	defer file.Close()
+
+	return s.Load(file)
+}
+
+// Register adds a scorer, replacing the scorer with the same code
+func (s *ServiceEarlyWarningScoresImpl) Register(scorer Scorer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, existing := range s.scorers {
+		if existing.Code() == scorer.Code() {
+			s.scorers[i] = scorer
+
+			return
+		}
+	}
+
+	s.scorers = append(s.scorers, scorer)
+}
+
+// Concepts are the CIEL concepts of the vital signs that any of the scores are computed from
+func (s *ServiceEarlyWarningScoresImpl) Concepts() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	concepts := []string{}
+	seen := map[string]bool{}
+
+	for _, scorer := range s.scorers {
+		for _, concept := range scorer.Concepts() {
+			if seen[concept] {
+				continue
+			}
+
+			seen[concept] = true
+			concepts = append(concepts, concept)
+		}
+	}
+
+	return concepts
+}
+
+// Score computes the scores that apply to a patient and whose vital signs were all taken
+func (s *ServiceEarlyWarningScoresImpl) Score(patient Patient, vitals Vitals, on time.Time) []Result {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	results := []Result{}
+
+	for _, scorer := range s.scorers {
+		result := scorer.Score(patient, vitals, on)
+		if result != nil {
+			results = append(results, *result)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,341 @@
+package earlywarning_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/earlywarning"
+)
+
+// normalVitals are adult vital signs that score no NEWS2 points
+func normalVitals() earlywarning.Vitals {
+	return earlywarning.Vitals{
+		"5242": 16,
+		"5092": 98,
+		"5085": 120,
+		"5087": 72,
+		"5088": 37,
+	}
+}
+
+func TestServiceEarlyWarningScoresImpl_Score(t *testing.T) {
+	on := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	adult := on.AddDate(-40, 0, 0)
+	child := on.AddDate(-10, 0, 0)
+
+	type args struct {
+		patient earlywarning.Patient
+		vitals  func(vitals earlywarning.Vitals)
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantResult bool
+		wantScore  int
+		wantRisk   dto.EarlyWarningRiskEnum
+	}{
+		{
+			name: "Happy case: normal vital signs",
+			args: args{
+				patient: earlywarning.Patient{Sex: "female", BirthDate: &adult},
+				vitals:  func(vitals earlywarning.Vitals) {},
+			},
+			wantResult: true,
+			wantScore:  0,
+			wantRisk:   dto.EarlyWarningRiskLow,
+		},
+		{
+			name: "Happy case: extreme value in a single parameter",
+			args: args{
+				patient: earlywarning.Patient{Sex: "female", BirthDate: &adult},
+				vitals: func(vitals earlywarning.Vitals) {
+					vitals["5242"] = 26
+				},
+			},
+			wantResult: true,
+			wantScore:  3,
+			wantRisk:   dto.EarlyWarningRiskLowMedium,
+		},
+		{
+			name: "Happy case: band boundaries score the band they close",
+			args: args{
+				patient: earlywarning.Patient{Sex: "male", BirthDate: &adult},
+				vitals: func(vitals earlywarning.Vitals) {
+					vitals["5088"] = 35
+					vitals["5087"] = 50
+				},
+			},
+			wantResult: true,
+			wantScore:  4,
+			wantRisk:   dto.EarlyWarningRiskLowMedium,
+		},
+		{
+			name: "Happy case: medium risk",
+			args: args{
+				patient: earlywarning.Patient{Sex: "male", BirthDate: &adult},
+				vitals: func(vitals earlywarning.Vitals) {
+					vitals["5242"] = 22
+					vitals["5092"] = 94
+					vitals["5085"] = 105
+					vitals["5087"] = 95
+				},
+			},
+			wantResult: true,
+			wantScore:  5,
+			wantRisk:   dto.EarlyWarningRiskMedium,
+		},
+		{
+			name: "Happy case: high risk",
+			args: args{
+				patient: earlywarning.Patient{Sex: "male", BirthDate: &adult},
+				vitals: func(vitals earlywarning.Vitals) {
+					vitals["5242"] = 26
+					vitals["5092"] = 90
+					vitals["5085"] = 95
+					vitals["5087"] = 120
+					vitals["5088"] = 39.5
+				},
+			},
+			wantResult: true,
+			wantScore:  12,
+			wantRisk:   dto.EarlyWarningRiskHigh,
+		},
+		{
+			name: "Happy case: patient of unknown age",
+			args: args{
+				patient: earlywarning.Patient{Sex: "male"},
+				vitals:  func(vitals earlywarning.Vitals) {},
+			},
+			wantResult: true,
+			wantScore:  0,
+			wantRisk:   dto.EarlyWarningRiskLow,
+		},
+		{
+			name: "Happy case: no score for a child",
+			args: args{
+				patient: earlywarning.Patient{Sex: "male", BirthDate: &child},
+				vitals:  func(vitals earlywarning.Vitals) {},
+			},
+			wantResult: false,
+		},
+		{
+			name: "Happy case: no score without all the vital signs",
+			args: args{
+				patient: earlywarning.Patient{Sex: "female", BirthDate: &adult},
+				vitals: func(vitals earlywarning.Vitals) {
+					delete(vitals, "5092")
+				},
+			},
+			wantResult: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := earlywarning.NewServiceEarlyWarningScores()
+
+			vitals := normalVitals()
+			tt.args.vitals(vitals)
+
+			got := s.Score(tt.args.patient, vitals, on)
+			if (len(got) > 0) != tt.wantResult {
+				t.Errorf("ServiceEarlyWarningScoresImpl.Score() = %v, wantResult %v", got, tt.wantResult)
+				return
+			}
+
+			if !tt.wantResult {
+				return
+			}
+
+			if got[0].Code != "news2" || len(got[0].Parameters) != 5 {
+				t.Errorf("expected NEWS2 to be scored from five parameters, got %v", got[0])
+			}
+
+			if got[0].Score != tt.wantScore {
+				t.Errorf("expected a score of %d but got %d", tt.wantScore, got[0].Score)
+			}
+
+			if got[0].Risk != tt.wantRisk {
+				t.Errorf("expected a %s risk but got %s", tt.wantRisk, got[0].Risk)
+			}
+		})
+	}
+}
+
+// fakeScorer is a score computed from the pulse alone
+type fakeScorer struct {
+	code string
+}
+
+func (s fakeScorer) Code() string {
+	return s.code
+}
+
+func (s fakeScorer) Concepts() []string {
+	return []string{"5087", "5086"}
+}
+
+func (s fakeScorer) Score(patient earlywarning.Patient, vitals earlywarning.Vitals, on time.Time) *earlywarning.Result {
+	return &earlywarning.Result{Code: s.code, Score: 1, Risk: dto.EarlyWarningRiskLow}
+}
+
+func TestServiceEarlyWarningScoresImpl_Register(t *testing.T) {
+	tests := []struct {
+		name         string
+		scorer       earlywarning.Scorer
+		wantCodes    []string
+		wantConcepts int
+	}{
+		{
+			name:         "Happy case: register another score",
+			scorer:       fakeScorer{code: "pews"},
+			wantCodes:    []string{"news2", "pews"},
+			wantConcepts: 6,
+		},
+		{
+			name:         "Happy case: replace a score",
+			scorer:       fakeScorer{code: "news2"},
+			wantCodes:    []string{"news2"},
+			wantConcepts: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := earlywarning.NewServiceEarlyWarningScores()
+
+			s.Register(tt.scorer)
+
+			got := s.Score(earlywarning.Patient{}, normalVitals(), time.Now())
+			if len(got) != len(tt.wantCodes) {
+				t.Errorf("expected %d scores but got %v", len(tt.wantCodes), got)
+				return
+			}
+
+			for i, code := range tt.wantCodes {
+				if got[i].Code != code {
+					t.Errorf("expected score %s but got %s", code, got[i].Code)
+				}
+			}
+
+			if concepts := s.Concepts(); len(concepts) != tt.wantConcepts {
+				t.Errorf("expected %d concepts but got %v", tt.wantConcepts, concepts)
+			}
+		})
+	}
+}
+
+func TestServiceEarlyWarningScoresImpl_LoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	validScores := filepath.Join(dir, "scores.json")
+	err := os.WriteFile(validScores, []byte(`{"scores": [{"code": "news2", "display": "NEWS2", "parameters": [{"name": "pulse", "conceptID": "5087", "bands": [{"maximum": 100, "points": 0}, {"points": 5}]}], "risks": [{"risk": "MEDIUM", "minimumScore": 5}, {"risk": "LOW"}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write scores: %s", err)
+	}
+
+	unorderedBands := filepath.Join(dir, "unordered.json")
+	err = os.WriteFile(unorderedBands, []byte(`{"scores": [{"code": "news2", "parameters": [{"name": "pulse", "conceptID": "5087", "bands": [{"maximum": 100, "points": 0}, {"maximum": 50, "points": 1}, {"points": 5}]}], "risks": [{"risk": "LOW"}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write scores: %s", err)
+	}
+
+	openBand := filepath.Join(dir, "open.json")
+	err = os.WriteFile(openBand, []byte(`{"scores": [{"code": "news2", "parameters": [{"name": "pulse", "conceptID": "5087", "bands": [{"points": 0}, {"maximum": 50, "points": 1}]}], "risks": [{"risk": "LOW"}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write scores: %s", err)
+	}
+
+	invalidRisk := filepath.Join(dir, "invalid_risk.json")
+	err = os.WriteFile(invalidRisk, []byte(`{"scores": [{"code": "news2", "parameters": [{"name": "pulse", "conceptID": "5087", "bands": [{"points": 0}]}], "risks": [{"risk": "SEVERE"}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write scores: %s", err)
+	}
+
+	conditionalRisk := filepath.Join(dir, "conditional_risk.json")
+	err = os.WriteFile(conditionalRisk, []byte(`{"scores": [{"code": "news2", "parameters": [{"name": "pulse", "conceptID": "5087", "bands": [{"points": 0}]}], "risks": [{"risk": "HIGH", "minimumScore": 7}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write scores: %s", err)
+	}
+
+	duplicateScores := filepath.Join(dir, "duplicate.json")
+	err = os.WriteFile(duplicateScores, []byte(`{"scores": [{"code": "mews", "parameters": [{"name": "pulse", "conceptID": "5087", "bands": [{"points": 0}]}], "risks": [{"risk": "LOW"}]}, {"code": "mews", "parameters": [{"name": "pulse", "conceptID": "5087", "bands": [{"points": 0}]}], "risks": [{"risk": "LOW"}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write scores: %s", err)
+	}
+
+	malformed := filepath.Join(dir, "malformed.json")
+	err = os.WriteFile(malformed, []byte(`{`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write scores: %s", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: load early warning scores",
+			path:    validScores,
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: missing file",
+			path:    filepath.Join(dir, "missing.json"),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unordered bands",
+			path:    unorderedBands,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: band without a maximum before the last band",
+			path:    openBand,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: invalid risk",
+			path:    invalidRisk,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: no risk for low scores",
+			path:    conditionalRisk,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: score defined more than once",
+			path:    duplicateScores,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: malformed scores",
+			path:    malformed,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := earlywarning.NewServiceEarlyWarningScores()
+
+			err := s.LoadFile(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceEarlyWarningScoresImpl.LoadFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				vitals := normalVitals()
+				vitals["5087"] = 120
+
+				got := s.Score(earlywarning.Patient{}, vitals, time.Now())
+				if len(got) != 1 || got[0].Score != 5 || got[0].Risk != dto.EarlyWarningRiskMedium {
+					t.Errorf("expected the loaded score to replace NEWS2, got %v", got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/growthstandards"
+)
+
+// FakeGrowthStandards mocks the growth standards
+type FakeGrowthStandards struct {
+	MockLoadFileFn     func(path string) error
+	MockCoversFn       func(child growthstandards.Child, on time.Time) bool
+	MockScoreFn        func(indicator dto.GrowthIndicatorEnum, child growthstandards.Child, measurements growthstandards.Measurements, on time.Time) (*growthstandards.Score, error)
+	MockClassifyMUACFn func(circumference float64, unit string, child growthstandards.Child, on time.Time) *dto.MUACClassificationEnum
+	MockCurvesFn       func(indicator dto.GrowthIndicatorEnum, sex string) []growthstandards.Curve
+}
+
+// NewFakeGrowthStandardsMock initializes the growth standards mock
+func NewFakeGrowthStandardsMock() *FakeGrowthStandards {
+	return &FakeGrowthStandards{
+		MockLoadFileFn: func(path string) error {
+			return nil
+		},
+		MockCoversFn: func(child growthstandards.Child, on time.Time) bool {
+			return true
+		},
+		MockScoreFn: func(indicator dto.GrowthIndicatorEnum, child growthstandards.Child, measurements growthstandards.Measurements, on time.Time) (*growthstandards.Score, error) {
+			return &growthstandards.Score{
+				Indicator:  indicator,
+				X:          12,
+				ZScore:     0,
+				Percentile: 50,
+			}, nil
+		},
+		MockClassifyMUACFn: func(circumference float64, unit string, child growthstandards.Child, on time.Time) *dto.MUACClassificationEnum {
+			classification := dto.MUACClassificationNormal
+
+			return &classification
+		},
+		MockCurvesFn: func(indicator dto.GrowthIndicatorEnum, sex string) []growthstandards.Curve {
+			return []growthstandards.Curve{
+				{
+					Points: []growthstandards.CurvePoint{
+						{X: 12, SD3Neg: 6.9, SD2Neg: 7.7, SD1Neg: 8.6, Median: 9.6, SD1: 10.8, SD2: 12, SD3: 13.3},
+					},
+				},
+			}
+		},
+	}
+}
+
+// LoadFile mocks the implementation of loading growth standards from a file
+func (f *FakeGrowthStandards) LoadFile(path string) error {
+	return f.MockLoadFileFn(path)
+}
+
+// Covers mocks the implementation of checking whether a child is monitored against the standards
+func (f *FakeGrowthStandards) Covers(child growthstandards.Child, on time.Time) bool {
+	return f.MockCoversFn(child, on)
+}
+
+// Score mocks the implementation of scoring a child's measurements
+func (f *FakeGrowthStandards) Score(indicator dto.GrowthIndicatorEnum, child growthstandards.Child, measurements growthstandards.Measurements, on time.Time) (*growthstandards.Score, error) {
+	return f.MockScoreFn(indicator, child, measurements, on)
+}
+
+// ClassifyMUAC mocks the implementation of classifying acute malnutrition from MUAC
+func (f *FakeGrowthStandards) ClassifyMUAC(circumference float64, unit string, child growthstandards.Child, on time.Time) *dto.MUACClassificationEnum {
+	return f.MockClassifyMUACFn(circumference, unit, child, on)
+}
+
+// Curves mocks the implementation of listing the z-score lines of a standard
+func (f *FakeGrowthStandards) Curves(indicator dto.GrowthIndicatorEnum, sex string) []growthstandards.Curve {
+	return f.MockCurvesFn(indicator, sex)
+}
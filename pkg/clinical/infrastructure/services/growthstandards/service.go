@@ -0,0 +1,638 @@
+package growthstandards
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common/helpers"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+)
+
+// StandardsPathEnvVarName is the environment variable holding the path of the growth standards to load on startup.
+// It is either a JSON file or a directory holding the reference files of the WHO Anthro software e.g `weianthro.txt`
+const StandardsPathEnvVarName = "GROWTH_STANDARDS_PATH"
+
+const (
+	// MeasureLength is a length taken lying down, which the standards use for children below two years
+	MeasureLength = "length"
+
+	// MeasureHeight is a height taken standing, which the standards use for children from two years
+	MeasureHeight = "height"
+
+	// heightAge is the age in months from which the height standards apply
+	heightAge = 24
+
+	// daysPerMonth is the average length of a month the WHO standards convert ages in days with
+	daysPerMonth = 30.4375
+)
+
+// defaultStandards are the WHO Child Growth Standards at monthly to yearly ages and 5 cm lengths and heights,
+// and the WHO MUAC cut-offs for acute malnutrition. The values in between are interpolated, which is only accurate
+// to about 0.1 z-scores. Deployments are expected to load the daily and 0.1 cm WHO Anthro tables on top of them
+//
+//go:embed standards.json
+var defaultStandards []byte
+
+// Table is a set of growth standards
+type Table struct {
+	Name string `json:"name"`
+
+	// MaximumAge is the age from which a child is no longer monitored against the standards
+	MaximumAge helpers.Period `json:"maximumAge,omitempty"`
+	MUAC       *MUAC          `json:"muac,omitempty"`
+	Standards  []Standard     `json:"standards"`
+}
+
+// MUAC are the mid-upper arm circumferences below which a child is acutely malnourished.
+// They apply to children whose age is at least the minimum age and below the maximum age
+type MUAC struct {
+	Unit       string         `json:"unit"`
+	MinimumAge helpers.Period `json:"minimumAge,omitempty"`
+	MaximumAge helpers.Period `json:"maximumAge,omitempty"`
+	Severe     float64        `json:"severe"`
+	Moderate   float64        `json:"moderate"`
+}
+
+// Standard is the LMS table of a growth indicator for children of a sex e.g `female`.
+// A standard with a measure applies to the children whose length or height is taken that way. The X of its points is
+// the age in months for the indicators by age, and the length or height in cm for weight-for-height
+type Standard struct {
+	Indicator dto.GrowthIndicatorEnum `json:"indicator"`
+	Sex       string                  `json:"sex"`
+	Measure   string                  `json:"measure,omitempty"`
+	Points    []Point                 `json:"points"`
+}
+
+// Point is the Box-Cox power (L), median (M) and coefficient of variation (S) of an indicator at X
+type Point struct {
+	X float64 `json:"x"`
+	L float64 `json:"l"`
+	M float64 `json:"m"`
+	S float64 `json:"s"`
+}
+
+// Validate ensures the table is complete and its points are ordered
+func (t Table) Validate() error {
+	if t.MUAC != nil && (t.MUAC.Unit == "" || t.MUAC.Severe <= 0 || t.MUAC.Severe > t.MUAC.Moderate) {
+		return fmt.Errorf("the MUAC cut-offs must specify their unit and a severe cut-off below the moderate cut-off")
+	}
+
+	standards := map[string]bool{}
+
+	for _, standard := range t.Standards {
+		if !standard.Indicator.IsValid() || standard.Sex == "" {
+			return fmt.Errorf("a growth standard must specify a valid indicator and its sex")
+		}
+
+		if standard.Measure != "" && standard.Measure != MeasureLength && standard.Measure != MeasureHeight {
+			return fmt.Errorf("the measure of a growth standard must be %s or %s", MeasureLength, MeasureHeight)
+		}
+
+		if standards[standard.key()] {
+			return fmt.Errorf("the %s standard of %s is defined more than once", standard.Indicator, standard.Sex)
+		}
+
+		standards[standard.key()] = true
+
+		if len(standard.Points) < 2 {
+			return fmt.Errorf("the %s standard of %s must have at least two points", standard.Indicator, standard.Sex)
+		}
+
+		for i, point := range standard.Points {
+			if point.M <= 0 || point.S <= 0 {
+				return fmt.Errorf("the %s standard of %s must have a positive median and coefficient of variation", standard.Indicator, standard.Sex)
+			}
+
+			if i > 0 && point.X <= standard.Points[i-1].X {
+				return fmt.Errorf("the points of the %s standard of %s must be in ascending order", standard.Indicator, standard.Sex)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s Standard) key() string {
+	return fmt.Sprintf("%s:%s:%s", s.Indicator, strings.ToLower(s.Sex), s.Measure)
+}
+
+// Child is the sex and birth date of the child a measurement is scored for
+type Child struct {
+	Sex       string
+	BirthDate time.Time
+}
+
+// AgeInMonths is the age of the child on a date in the months the standards use
+func (c Child) AgeInMonths(on time.Time) float64 {
+	return on.Sub(c.BirthDate).Hours() / 24 / daysPerMonth
+}
+
+// Measurements are the weight in kg and length or height in cm of a child taken at the same time
+type Measurements struct {
+	Weight *float64
+	Height *float64
+}
+
+// Score is how a measurement compares to the children of the same sex and age, or length or height, in the standards
+type Score struct {
+	Indicator  dto.GrowthIndicatorEnum
+	Measure    string
+	X          float64
+	ZScore     float64
+	Percentile float64
+}
+
+// Curve is a series of the z-score lines of a standard used to plot a growth chart
+type Curve struct {
+	Measure string
+	Points  []CurvePoint
+}
+
+// CurvePoint is the values of an indicator at -3 to +3 z-scores at X
+type CurvePoint struct {
+	X      float64
+	SD3Neg float64
+	SD2Neg float64
+	SD1Neg float64
+	Median float64
+	SD1    float64
+	SD2    float64
+	SD3    float64
+}
+
+// ServiceGrowthStandards scores the growth of children against the growth standards
+type ServiceGrowthStandards interface {
+	LoadFile(path string) error
+	Covers(child Child, on time.Time) bool
+	Score(indicator dto.GrowthIndicatorEnum, child Child, measurements Measurements, on time.Time) (*Score, error)
+	ClassifyMUAC(circumference float64, unit string, child Child, on time.Time) *dto.MUACClassificationEnum
+	Curves(indicator dto.GrowthIndicatorEnum, sex string) []Curve
+}
+
+// ServiceGrowthStandardsImpl holds the growth standards in memory
+type ServiceGrowthStandardsImpl struct {
+	mu         sync.RWMutex
+	maximumAge helpers.Period
+	muac       *MUAC
+	standards  []Standard
+}
+
+// NewServiceGrowthStandards initializes the growth standards with the default standards
+func NewServiceGrowthStandards() *ServiceGrowthStandardsImpl {
+	s := &ServiceGrowthStandardsImpl{}
+
+	err := s.Load(bytes.NewReader(defaultStandards))
+	if err != nil {
+		log.Panicf("unable to load the default growth standards: %s", err)
+	}
+
+	return s
+}
+
+// Load reads growth standards from JSON. The standards in it replace the standards of the same indicator, sex and measure
+func (s *ServiceGrowthStandardsImpl) Load(r io.Reader) error {
+	var table Table
+
+	err := json.NewDecoder(r).Decode(&table)
+	if err != nil {
+		return fmt.Errorf("unable to decode growth standards: %w", err)
+	}
+
+	return s.apply(table)
+}
+
+// apply validates a table and adds its standards. The standards in it replace the standards of the same indicator, sex and measure
+func (s *ServiceGrowthStandardsImpl) apply(table Table) error {
+	err := table.Validate()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !table.MaximumAge.IsZero() {
+		s.maximumAge = table.MaximumAge
+	}
+
+	if table.MUAC != nil {
+		s.muac = table.MUAC
+	}
+
+	for _, standard := range table.Standards {
+		replaced := false
+
+		for i, existing := range s.standards {
+			if existing.key() == standard.key() {
+				s.standards[i] = standard
+				replaced = true
+			}
+		}
+
+		if !replaced {
+			s.standards = append(s.standards, standard)
+		}
+	}
+
+	return nil
+}
+
+// LoadFile reads growth standards from a local JSON file, or from a directory holding the reference files of the WHO Anthro software
+func (s *ServiceGrowthStandardsImpl) LoadFile(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("unable to open growth standards %s: %w", path, err)
+	}
+
+	if info.IsDir() {
+		return s.loadAnthroDirectory(path)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to open growth standards %s: %w", path, err)
+	}
+	defer file.Close()
+
+	return s.Load(file)
+}
+
+// anthroFiles are the reference files of the WHO Anthro software and the indicator of their standards.
+// The files without a measure split their standards by their `loh` or `lorh` column
+var anthroFiles = []struct {
+	name      string
+	indicator dto.GrowthIndicatorEnum
+}{
+	{name: "weianthro.txt", indicator: dto.GrowthIndicatorWeightForAge},
+	{name: "lenanthro.txt", indicator: dto.GrowthIndicatorHeightForAge},
+	{name: "wflanthro.txt", indicator: dto.GrowthIndicatorWeightForHeight},
+	{name: "wfhanthro.txt", indicator: dto.GrowthIndicatorWeightForHeight},
+}
+
+// anthroSexes are the sexes of the children as coded in the WHO Anthro files
+var anthroSexes = map[string]string{
+	"1": "male",
+	"2": "female",
+}
+
+// anthroMeasures are the measures of the lengths and heights as coded in the WHO Anthro files
+var anthroMeasures = map[string]string{
+	"l": MeasureLength,
+	"h": MeasureHeight,
+}
+
+// loadAnthroDirectory reads the standards of the WHO Anthro files in a directory. The files missing from it are skipped
+func (s *ServiceGrowthStandardsImpl) loadAnthroDirectory(dir string) error {
+	table := Table{Name: "WHO Child Growth Standards"}
+
+	for _, anthroFile := range anthroFiles {
+		file, err := os.Open(filepath.Join(dir, anthroFile.name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("unable to open growth standards %s: %w", anthroFile.name, err)
+		}
+
+		standards, err := readAnthroStandards(file, anthroFile.indicator)
+		file.Close()
+
+		if err != nil {
+			return fmt.Errorf("unable to read growth standards %s: %w", anthroFile.name, err)
+		}
+
+		table.Standards = append(table.Standards, standards...)
+	}
+
+	if len(table.Standards) == 0 {
+		return fmt.Errorf("no WHO Anthro growth standards were found in %s", dir)
+	}
+
+	return s.apply(table)
+}
+
+// readAnthroStandards reads the standards of an indicator from a WHO Anthro file. The file is a tab separated table whose
+// header names its columns, with the sex, the age in days or the length or height in cm, and the L, M and S of each row
+func readAnthroStandards(r io.Reader, indicator dto.GrowthIndicatorEnum) ([]Standard, error) {
+	scanner := bufio.NewScanner(r)
+
+	if !scanner.Scan() {
+		return nil, fmt.Errorf("the file has no header")
+	}
+
+	columns := map[string]int{}
+	for i, column := range strings.Fields(scanner.Text()) {
+		columns[strings.ToLower(column)] = i
+	}
+
+	x, measure := "", ""
+
+	for _, column := range []string{"age", "length", "height"} {
+		if _, ok := columns[column]; ok {
+			x = column
+			break
+		}
+	}
+
+	for _, column := range []string{"loh", "lorh"} {
+		if _, ok := columns[column]; ok {
+			measure = column
+			break
+		}
+	}
+
+	for _, column := range []string{"sex", "l", "m", "s"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("the file has no %s column", column)
+		}
+	}
+
+	if x == "" {
+		return nil, fmt.Errorf("the file has no age, length or height column")
+	}
+
+	standards := []Standard{}
+	indices := map[string]int{}
+
+	for line := 2; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(fields) < len(columns) {
+			return nil, fmt.Errorf("line %d has %d of the %d columns", line, len(fields), len(columns))
+		}
+
+		sex, ok := anthroSexes[fields[columns["sex"]]]
+		if !ok {
+			return nil, fmt.Errorf("line %d has an unknown sex %q", line, fields[columns["sex"]])
+		}
+
+		standard := Standard{Indicator: indicator, Sex: sex}
+
+		if measure != "" {
+			standard.Measure, ok = anthroMeasures[strings.ToLower(fields[columns[measure]])]
+			if !ok {
+				return nil, fmt.Errorf("line %d has an unknown measure %q", line, fields[columns[measure]])
+			}
+		}
+
+		values := map[string]float64{}
+
+		for _, column := range []string{x, "l", "m", "s"} {
+			value, err := strconv.ParseFloat(fields[columns[column]], 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d has an invalid %s %q", line, column, fields[columns[column]])
+			}
+
+			values[column] = value
+		}
+
+		point := Point{X: values[x], L: values["l"], M: values["m"], S: values["s"]}
+		if x == "age" {
+			point.X /= daysPerMonth
+		}
+
+		i, ok := indices[standard.key()]
+		if !ok {
+			i = len(standards)
+			indices[standard.key()] = i
+
+			standards = append(standards, standard)
+		}
+
+		standards[i].Points = append(standards[i].Points, point)
+	}
+
+	return standards, scanner.Err()
+}
+
+// Covers checks whether a child is young enough on a date to be monitored against the standards
+func (s *ServiceGrowthStandardsImpl) Covers(child Child, on time.Time) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if on.Before(child.BirthDate) {
+		return false
+	}
+
+	return s.maximumAge.IsZero() || on.Before(s.maximumAge.AddTo(child.BirthDate))
+}
+
+// Score computes the z-score and percentile of a child's measurements for an indicator. Weight-for-age needs the weight,
+// height-for-age the length or height and weight-for-height both. No score is returned when a measurement the indicator needs
+// is missing, or the child's sex, age or length or height is not covered by the standards
+func (s *ServiceGrowthStandardsImpl) Score(indicator dto.GrowthIndicatorEnum, child Child, measurements Measurements, on time.Time) (*Score, error) {
+	var x, value *float64
+
+	age := child.AgeInMonths(on)
+
+	switch indicator {
+	case dto.GrowthIndicatorWeightForAge:
+		x, value = &age, measurements.Weight
+	case dto.GrowthIndicatorHeightForAge:
+		x, value = &age, measurements.Height
+	case dto.GrowthIndicatorWeightForHeight:
+		x, value = measurements.Height, measurements.Weight
+	default:
+		return nil, fmt.Errorf("%s is not a valid growth indicator", indicator)
+	}
+
+	if x == nil || value == nil || age < 0 {
+		return nil, nil
+	}
+
+	if *value <= 0 {
+		return nil, fmt.Errorf("a %s measurement must be positive", strings.ToLower(indicator.String()))
+	}
+
+	standard, ok := s.standard(indicator, child.Sex, age)
+	if !ok {
+		return nil, nil
+	}
+
+	point, ok := standard.at(*x)
+	if !ok {
+		return nil, nil
+	}
+
+	z := point.zScore(*value)
+
+	return &Score{
+		Indicator:  indicator,
+		Measure:    standard.Measure,
+		X:          *x,
+		ZScore:     math.Round(z*100) / 100,
+		Percentile: math.Round(percentile(z)*10) / 10,
+	}, nil
+}
+
+// ClassifyMUAC classifies the acute malnutrition of a child from their mid-upper arm circumference.
+// No classification is returned when the circumference is not in the unit of the cut-offs or the cut-offs do not apply to the child's age
+func (s *ServiceGrowthStandardsImpl) ClassifyMUAC(circumference float64, unit string, child Child, on time.Time) *dto.MUACClassificationEnum {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.muac == nil || s.muac.Unit != unit {
+		return nil
+	}
+
+	if !s.muac.MinimumAge.IsZero() && on.Before(s.muac.MinimumAge.AddTo(child.BirthDate)) {
+		return nil
+	}
+
+	if !s.muac.MaximumAge.IsZero() && !on.Before(s.muac.MaximumAge.AddTo(child.BirthDate)) {
+		return nil
+	}
+
+	classification := dto.MUACClassificationNormal
+
+	switch {
+	case circumference < s.muac.Severe:
+		classification = dto.MUACClassificationSAM
+	case circumference < s.muac.Moderate:
+		classification = dto.MUACClassificationMAM
+	}
+
+	return &classification
+}
+
+// Curves are the z-score lines of the standards of an indicator for children of a sex, at every month or cm they cover
+func (s *ServiceGrowthStandardsImpl) Curves(indicator dto.GrowthIndicatorEnum, sex string) []Curve {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	curves := []Curve{}
+
+	for _, standard := range s.standards {
+		if standard.Indicator != indicator || !strings.EqualFold(standard.Sex, sex) {
+			continue
+		}
+
+		curve := Curve{
+			Measure: standard.Measure,
+			Points:  []CurvePoint{},
+		}
+
+		first, last := standard.Points[0].X, standard.Points[len(standard.Points)-1].X
+
+		for x := first; x <= last; x++ {
+			point, _ := standard.at(x)
+
+			curve.Points = append(curve.Points, CurvePoint{
+				X:      x,
+				SD3Neg: point.value(-3),
+				SD2Neg: point.value(-2),
+				SD1Neg: point.value(-1),
+				Median: point.M,
+				SD1:    point.value(1),
+				SD2:    point.value(2),
+				SD3:    point.value(3),
+			})
+		}
+
+		curves = append(curves, curve)
+	}
+
+	return curves
+}
+
+// standard is the standard of an indicator that applies to a child of a sex and age. A standard without a measure applies at
+// every age, otherwise the length standard applies below two years and the height standard from two years
+func (s *ServiceGrowthStandardsImpl) standard(indicator dto.GrowthIndicatorEnum, sex string, age float64) (Standard, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	measure := MeasureLength
+	if age >= heightAge {
+		measure = MeasureHeight
+	}
+
+	for _, standard := range s.standards {
+		if standard.Indicator != indicator || !strings.EqualFold(standard.Sex, sex) {
+			continue
+		}
+
+		if standard.Measure == "" || standard.Measure == measure {
+			return standard, true
+		}
+	}
+
+	return Standard{}, false
+}
+
+// at interpolates the point of the standard at x. No point is returned when x is outside the standard
+func (s Standard) at(x float64) (Point, bool) {
+	for i := 1; i < len(s.Points); i++ {
+		previous, next := s.Points[i-1], s.Points[i]
+
+		if x < previous.X || x > next.X {
+			continue
+		}
+
+		t := (x - previous.X) / (next.X - previous.X)
+
+		return Point{
+			X: x,
+			L: previous.L + t*(next.L-previous.L),
+			M: previous.M + t*(next.M-previous.M),
+			S: previous.S + t*(next.S-previous.S),
+		}, true
+	}
+
+	return Point{}, false
+}
+
+// zScore computes the z-score of a value. Beyond ±3 the distance between the 2 and 3 z-score lines is used as the unit,
+// as the WHO standards do, so that the skew of the distribution does not compress the extreme z-scores
+func (p Point) zScore(value float64) float64 {
+	var z float64
+
+	if p.L == 0 {
+		z = math.Log(value/p.M) / p.S
+	} else {
+		z = (math.Pow(value/p.M, p.L) - 1) / (p.L * p.S)
+	}
+
+	switch {
+	case z > 3:
+		sd3 := p.value(3)
+
+		return 3 + (value-sd3)/(sd3-p.value(2))
+	case z < -3:
+		sd3 := p.value(-3)
+
+		return -3 + (value-sd3)/(p.value(-2)-sd3)
+	}
+
+	return z
+}
+
+// value is the value of the indicator at a z-score
+func (p Point) value(z float64) float64 {
+	if p.L == 0 {
+		return p.M * math.Exp(p.S*z)
+	}
+
+	return p.M * math.Pow(1+p.L*p.S*z, 1/p.L)
+}
+
+// percentile is the percentage of children in the standards with a lower z-score
+func percentile(z float64) float64 {
+	return 50 * (1 + math.Erf(z/math.Sqrt2))
+}
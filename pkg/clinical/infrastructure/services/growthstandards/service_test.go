@@ -0,0 +1,708 @@
+package growthstandards_test
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/growthstandards"
+)
+
+// monthsOld is the birth date of a child who is a number of months old on a date, in the months the standards use
+func monthsOld(on time.Time, months float64) time.Time {
+	return on.Add(-time.Duration(months * 30.4375 * 24 * float64(time.Hour)))
+}
+
+func TestServiceGrowthStandardsImpl_Score(t *testing.T) {
+	on := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	value := func(v float64) *float64 {
+		return &v
+	}
+
+	type args struct {
+		indicator    dto.GrowthIndicatorEnum
+		child        growthstandards.Child
+		measurements growthstandards.Measurements
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantScore   bool
+		wantZScore  float64
+		wantMeasure string
+		wantErr     bool
+	}{
+		{
+			name: "Happy case: median weight-for-age",
+			args: args{
+				indicator:    dto.GrowthIndicatorWeightForAge,
+				child:        growthstandards.Child{Sex: "male", BirthDate: monthsOld(on, 12)},
+				measurements: growthstandards.Measurements{Weight: value(9.6479)},
+			},
+			wantScore:  true,
+			wantZScore: 0,
+		},
+		{
+			name: "Happy case: underweight",
+			args: args{
+				indicator:    dto.GrowthIndicatorWeightForAge,
+				child:        growthstandards.Child{Sex: "female", BirthDate: monthsOld(on, 12)},
+				measurements: growthstandards.Measurements{Weight: value(7.0)},
+			},
+			wantScore:  true,
+			wantZScore: -2,
+		},
+		{
+			name: "Happy case: weight-for-age between the points of the standard",
+			args: args{
+				indicator:    dto.GrowthIndicatorWeightForAge,
+				child:        growthstandards.Child{Sex: "male", BirthDate: monthsOld(on, 30)},
+				measurements: growthstandards.Measurements{Weight: value(13.24)},
+			},
+			wantScore:  true,
+			wantZScore: 0,
+		},
+		{
+			name: "Happy case: severely stunted length-for-age",
+			args: args{
+				indicator:    dto.GrowthIndicatorHeightForAge,
+				child:        growthstandards.Child{Sex: "female", BirthDate: monthsOld(on, 12)},
+				measurements: growthstandards.Measurements{Height: value(66.3)},
+			},
+			wantScore:   true,
+			wantZScore:  -3,
+			wantMeasure: growthstandards.MeasureLength,
+		},
+		{
+			name: "Happy case: height-for-age from two years",
+			args: args{
+				indicator:    dto.GrowthIndicatorHeightForAge,
+				child:        growthstandards.Child{Sex: "female", BirthDate: monthsOld(on, 24)},
+				measurements: growthstandards.Measurements{Height: value(85.7153)},
+			},
+			wantScore:   true,
+			wantZScore:  0,
+			wantMeasure: growthstandards.MeasureHeight,
+		},
+		{
+			name: "Happy case: weight-for-length",
+			args: args{
+				indicator:    dto.GrowthIndicatorWeightForHeight,
+				child:        growthstandards.Child{Sex: "male", BirthDate: monthsOld(on, 6)},
+				measurements: growthstandards.Measurements{Weight: value(7.43), Height: value(65)},
+			},
+			wantScore:   true,
+			wantZScore:  0,
+			wantMeasure: growthstandards.MeasureLength,
+		},
+		{
+			name: "Happy case: z-scores beyond 3 are measured in the distance between the 2 and 3 z-score lines",
+			args: args{
+				indicator:    dto.GrowthIndicatorWeightForAge,
+				child:        growthstandards.Child{Sex: "male", BirthDate: monthsOld(on, 0)},
+				measurements: growthstandards.Measurements{Weight: value(5.6)},
+			},
+			wantScore:  true,
+			wantZScore: 4,
+		},
+		{
+			name: "Happy case: no score without the measurement of the indicator",
+			args: args{
+				indicator:    dto.GrowthIndicatorWeightForHeight,
+				child:        growthstandards.Child{Sex: "male", BirthDate: monthsOld(on, 6)},
+				measurements: growthstandards.Measurements{Weight: value(7.43)},
+			},
+			wantScore: false,
+		},
+		{
+			name: "Happy case: no score for a child older than the standards",
+			args: args{
+				indicator:    dto.GrowthIndicatorWeightForAge,
+				child:        growthstandards.Child{Sex: "male", BirthDate: monthsOld(on, 72)},
+				measurements: growthstandards.Measurements{Weight: value(20)},
+			},
+			wantScore: false,
+		},
+		{
+			name: "Happy case: no score for a height outside the standards",
+			args: args{
+				indicator:    dto.GrowthIndicatorWeightForHeight,
+				child:        growthstandards.Child{Sex: "female", BirthDate: monthsOld(on, 48)},
+				measurements: growthstandards.Measurements{Weight: value(30), Height: value(130)},
+			},
+			wantScore: false,
+		},
+		{
+			name: "Happy case: no score for a child without a known sex",
+			args: args{
+				indicator:    dto.GrowthIndicatorWeightForAge,
+				child:        growthstandards.Child{Sex: "unknown", BirthDate: monthsOld(on, 12)},
+				measurements: growthstandards.Measurements{Weight: value(9)},
+			},
+			wantScore: false,
+		},
+		{
+			name: "Sad case: weight that is not positive",
+			args: args{
+				indicator:    dto.GrowthIndicatorWeightForAge,
+				child:        growthstandards.Child{Sex: "male", BirthDate: monthsOld(on, 12)},
+				measurements: growthstandards.Measurements{Weight: value(0)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid indicator",
+			args: args{
+				indicator:    dto.GrowthIndicatorEnum("BMI_FOR_AGE"),
+				child:        growthstandards.Child{Sex: "male", BirthDate: monthsOld(on, 12)},
+				measurements: growthstandards.Measurements{Weight: value(9)},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := growthstandards.NewServiceGrowthStandards()
+
+			got, err := s.Score(tt.args.indicator, tt.args.child, tt.args.measurements, on)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceGrowthStandardsImpl.Score() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantScore {
+				if got != nil {
+					t.Errorf("expected no score, got %v", got)
+				}
+
+				return
+			}
+
+			if got == nil {
+				t.Errorf("expected a score")
+				return
+			}
+
+			if math.Abs(got.ZScore-tt.wantZScore) > 0.1 {
+				t.Errorf("ServiceGrowthStandardsImpl.Score() z-score = %v, want %v", got.ZScore, tt.wantZScore)
+			}
+
+			wantPercentile := 50 * (1 + math.Erf(got.ZScore/math.Sqrt2))
+			if math.Abs(got.Percentile-wantPercentile) > 0.5 {
+				t.Errorf("ServiceGrowthStandardsImpl.Score() percentile = %v, want %v", got.Percentile, wantPercentile)
+			}
+
+			if got.Measure != tt.wantMeasure {
+				t.Errorf("expected the %q standard to be used, got %q", tt.wantMeasure, got.Measure)
+			}
+		})
+	}
+}
+
+// TestServiceGrowthStandardsImpl_Score_WHOReference scores the -2, 0 and +2 z-score values published in the WHO Child Growth
+// Standards charts. The published values are rounded to 0.1 kg or cm, and the values between the points of the default
+// standards are interpolated, so the z-scores are only expected to be within 0.15 of the published ones
+func TestServiceGrowthStandardsImpl_Score_WHOReference(t *testing.T) {
+	on := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		indicator dto.GrowthIndicatorEnum
+		sex       string
+		months    float64
+		values    [3]float64
+	}{
+		{
+			name:      "Happy case: weight-for-age of boys at birth",
+			indicator: dto.GrowthIndicatorWeightForAge,
+			sex:       "male",
+			months:    0,
+			values:    [3]float64{2.5, 3.3, 4.4},
+		},
+		{
+			name:      "Happy case: weight-for-age of boys at 8 months",
+			indicator: dto.GrowthIndicatorWeightForAge,
+			sex:       "male",
+			months:    8,
+			values:    [3]float64{6.9, 8.6, 10.7},
+		},
+		{
+			name:      "Happy case: weight-for-age of boys at 12 months",
+			indicator: dto.GrowthIndicatorWeightForAge,
+			sex:       "male",
+			months:    12,
+			values:    [3]float64{7.7, 9.6, 12.0},
+		},
+		{
+			name:      "Happy case: weight-for-age of boys at 60 months",
+			indicator: dto.GrowthIndicatorWeightForAge,
+			sex:       "male",
+			months:    60,
+			values:    [3]float64{14.1, 18.3, 24.2},
+		},
+		{
+			name:      "Happy case: weight-for-age of girls at 8 months",
+			indicator: dto.GrowthIndicatorWeightForAge,
+			sex:       "female",
+			months:    8,
+			values:    [3]float64{6.3, 7.9, 10.2},
+		},
+		{
+			name:      "Happy case: weight-for-age of girls at 12 months",
+			indicator: dto.GrowthIndicatorWeightForAge,
+			sex:       "female",
+			months:    12,
+			values:    [3]float64{7.0, 8.9, 11.5},
+		},
+		{
+			name:      "Happy case: weight-for-age of girls at 60 months",
+			indicator: dto.GrowthIndicatorWeightForAge,
+			sex:       "female",
+			months:    60,
+			values:    [3]float64{13.7, 18.2, 25.0},
+		},
+		{
+			name:      "Happy case: length-for-age of boys at birth",
+			indicator: dto.GrowthIndicatorHeightForAge,
+			sex:       "male",
+			months:    0,
+			values:    [3]float64{46.1, 49.9, 53.7},
+		},
+		{
+			name:      "Happy case: length-for-age of girls at birth",
+			indicator: dto.GrowthIndicatorHeightForAge,
+			sex:       "female",
+			months:    0,
+			values:    [3]float64{45.4, 49.1, 52.9},
+		},
+		{
+			name:      "Happy case: length-for-age of boys at 12 months",
+			indicator: dto.GrowthIndicatorHeightForAge,
+			sex:       "male",
+			months:    12,
+			values:    [3]float64{71.0, 75.7, 80.5},
+		},
+		{
+			name:      "Happy case: length-for-age of girls at 12 months",
+			indicator: dto.GrowthIndicatorHeightForAge,
+			sex:       "female",
+			months:    12,
+			values:    [3]float64{68.9, 74.0, 79.2},
+		},
+		{
+			name:      "Happy case: height-for-age of boys at 60 months",
+			indicator: dto.GrowthIndicatorHeightForAge,
+			sex:       "male",
+			months:    60,
+			values:    [3]float64{100.7, 110.0, 119.2},
+		},
+		{
+			name:      "Happy case: height-for-age of girls at 60 months",
+			indicator: dto.GrowthIndicatorHeightForAge,
+			sex:       "female",
+			months:    60,
+			values:    [3]float64{99.9, 109.4, 118.9},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := growthstandards.NewServiceGrowthStandards()
+			child := growthstandards.Child{Sex: tt.sex, BirthDate: monthsOld(on, tt.months)}
+
+			for i, value := range tt.values {
+				value := value
+				wantZScore := float64(2*i - 2)
+
+				measurements := growthstandards.Measurements{Weight: &value}
+				if tt.indicator == dto.GrowthIndicatorHeightForAge {
+					measurements = growthstandards.Measurements{Height: &value}
+				}
+
+				got, err := s.Score(tt.indicator, child, measurements, on)
+				if err != nil || got == nil {
+					t.Errorf("ServiceGrowthStandardsImpl.Score() = %v, %v, want a score", got, err)
+					return
+				}
+
+				if math.Abs(got.ZScore-wantZScore) > 0.15 {
+					t.Errorf("expected %v to score %v, got %v", value, wantZScore, got.ZScore)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceGrowthStandardsImpl_ClassifyMUAC(t *testing.T) {
+	on := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		circumference float64
+		unit          string
+		child         growthstandards.Child
+	}
+	tests := []struct {
+		name string
+		args args
+		want *dto.MUACClassificationEnum
+	}{
+		{
+			name: "Happy case: severe acute malnutrition",
+			args: args{circumference: 11.2, unit: "cm", child: growthstandards.Child{BirthDate: monthsOld(on, 18)}},
+			want: func() *dto.MUACClassificationEnum {
+				classification := dto.MUACClassificationSAM
+				return &classification
+			}(),
+		},
+		{
+			name: "Happy case: moderate acute malnutrition",
+			args: args{circumference: 11.5, unit: "cm", child: growthstandards.Child{BirthDate: monthsOld(on, 18)}},
+			want: func() *dto.MUACClassificationEnum {
+				classification := dto.MUACClassificationMAM
+				return &classification
+			}(),
+		},
+		{
+			name: "Happy case: normal",
+			args: args{circumference: 12.5, unit: "cm", child: growthstandards.Child{BirthDate: monthsOld(on, 18)}},
+			want: func() *dto.MUACClassificationEnum {
+				classification := dto.MUACClassificationNormal
+				return &classification
+			}(),
+		},
+		{
+			name: "Happy case: no classification for an infant below six months",
+			args: args{circumference: 10, unit: "cm", child: growthstandards.Child{BirthDate: monthsOld(on, 3)}},
+			want: nil,
+		},
+		{
+			name: "Happy case: no classification from five years",
+			args: args{circumference: 10, unit: "cm", child: growthstandards.Child{BirthDate: monthsOld(on, 61)}},
+			want: nil,
+		},
+		{
+			name: "Happy case: no classification for a circumference in a different unit",
+			args: args{circumference: 110, unit: "mm", child: growthstandards.Child{BirthDate: monthsOld(on, 18)}},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := growthstandards.NewServiceGrowthStandards()
+
+			got := s.ClassifyMUAC(tt.args.circumference, tt.args.unit, tt.args.child, on)
+
+			if tt.want == nil && got != nil {
+				t.Errorf("expected no classification, got %v", *got)
+				return
+			}
+
+			if tt.want != nil && (got == nil || *got != *tt.want) {
+				t.Errorf("ServiceGrowthStandardsImpl.ClassifyMUAC() = %v, want %v", got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceGrowthStandardsImpl_Curves(t *testing.T) {
+	tests := []struct {
+		name       string
+		indicator  dto.GrowthIndicatorEnum
+		sex        string
+		wantCurves int
+		wantPoints int
+	}{
+		{
+			name:       "Happy case: weight-for-age curve",
+			indicator:  dto.GrowthIndicatorWeightForAge,
+			sex:        "male",
+			wantCurves: 1,
+			wantPoints: 61,
+		},
+		{
+			name:       "Happy case: length-for-age and height-for-age curves",
+			indicator:  dto.GrowthIndicatorHeightForAge,
+			sex:        "female",
+			wantCurves: 2,
+			wantPoints: 25,
+		},
+		{
+			name:       "Happy case: no curves for an unknown sex",
+			indicator:  dto.GrowthIndicatorWeightForHeight,
+			sex:        "unknown",
+			wantCurves: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := growthstandards.NewServiceGrowthStandards()
+
+			got := s.Curves(tt.indicator, tt.sex)
+			if len(got) != tt.wantCurves {
+				t.Errorf("ServiceGrowthStandardsImpl.Curves() returned %d curves, want %d", len(got), tt.wantCurves)
+				return
+			}
+
+			if tt.wantCurves == 0 {
+				return
+			}
+
+			if len(got[0].Points) != tt.wantPoints {
+				t.Errorf("expected %d points, got %d", tt.wantPoints, len(got[0].Points))
+				return
+			}
+
+			for _, point := range got[0].Points {
+				if !(point.SD3Neg < point.SD2Neg && point.SD2Neg < point.SD1Neg && point.SD1Neg < point.Median &&
+					point.Median < point.SD1 && point.SD1 < point.SD2 && point.SD2 < point.SD3) {
+					t.Errorf("expected the z-score lines at %v to be ordered, got %+v", point.X, point)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceGrowthStandardsImpl_Covers(t *testing.T) {
+	on := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		birthDate time.Time
+		want      bool
+	}{
+		{
+			name:      "Happy case: child below five years",
+			birthDate: on.AddDate(-3, 0, 0),
+			want:      true,
+		},
+		{
+			name:      "Happy case: child from five years",
+			birthDate: on.AddDate(-5, 0, 0),
+			want:      false,
+		},
+		{
+			name:      "Happy case: measurement before birth",
+			birthDate: on.AddDate(0, 0, 1),
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := growthstandards.NewServiceGrowthStandards()
+
+			if got := s.Covers(growthstandards.Child{Sex: "female", BirthDate: tt.birthDate}, on); got != tt.want {
+				t.Errorf("ServiceGrowthStandardsImpl.Covers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceGrowthStandardsImpl_LoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	validStandards := filepath.Join(dir, "valid.json")
+	err := os.WriteFile(validStandards, []byte(`{"name": "test", "muac": {"unit": "cm", "minimumAge": "6m", "maximumAge": "5y", "severe": 11, "moderate": 12}, "standards": [{"indicator": "WEIGHT_FOR_AGE", "sex": "male", "points": [{"x": 0, "l": 1, "m": 3, "s": 0.1}, {"x": 60, "l": 1, "m": 18, "s": 0.1}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write standards: %s", err)
+	}
+
+	unorderedPoints := filepath.Join(dir, "unordered.json")
+	err = os.WriteFile(unorderedPoints, []byte(`{"name": "test", "standards": [{"indicator": "WEIGHT_FOR_AGE", "sex": "male", "points": [{"x": 60, "l": 1, "m": 18, "s": 0.1}, {"x": 0, "l": 1, "m": 3, "s": 0.1}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write standards: %s", err)
+	}
+
+	duplicateStandards := filepath.Join(dir, "duplicate.json")
+	err = os.WriteFile(duplicateStandards, []byte(`{"name": "test", "standards": [{"indicator": "WEIGHT_FOR_AGE", "sex": "male", "points": [{"x": 0, "l": 1, "m": 3, "s": 0.1}, {"x": 60, "l": 1, "m": 18, "s": 0.1}]}, {"indicator": "WEIGHT_FOR_AGE", "sex": "male", "points": [{"x": 0, "l": 1, "m": 3, "s": 0.1}, {"x": 60, "l": 1, "m": 18, "s": 0.1}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write standards: %s", err)
+	}
+
+	invalidMeasure := filepath.Join(dir, "invalid_measure.json")
+	err = os.WriteFile(invalidMeasure, []byte(`{"name": "test", "standards": [{"indicator": "WEIGHT_FOR_HEIGHT", "sex": "male", "measure": "arm", "points": [{"x": 45, "l": 1, "m": 3, "s": 0.1}, {"x": 60, "l": 1, "m": 6, "s": 0.1}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write standards: %s", err)
+	}
+
+	invalidMUAC := filepath.Join(dir, "invalid_muac.json")
+	err = os.WriteFile(invalidMUAC, []byte(`{"name": "test", "muac": {"unit": "cm", "severe": 13, "moderate": 12}, "standards": []}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write standards: %s", err)
+	}
+
+	malformed := filepath.Join(dir, "malformed.json")
+	err = os.WriteFile(malformed, []byte(`{`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write standards: %s", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: load growth standards",
+			path:    validStandards,
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: missing file",
+			path:    filepath.Join(dir, "missing.json"),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unordered points",
+			path:    unorderedPoints,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: standard defined more than once",
+			path:    duplicateStandards,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: invalid measure",
+			path:    invalidMeasure,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: severe MUAC cut-off above the moderate cut-off",
+			path:    invalidMUAC,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: malformed standards",
+			path:    malformed,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := growthstandards.NewServiceGrowthStandards()
+
+			err := s.LoadFile(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceGrowthStandardsImpl.LoadFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				on := time.Now()
+				child := growthstandards.Child{Sex: "male", BirthDate: monthsOld(on, 30)}
+				weight := 10.5
+
+				got, err := s.Score(dto.GrowthIndicatorWeightForAge, child, growthstandards.Measurements{Weight: &weight}, on)
+				if err != nil || got == nil || math.Abs(got.ZScore) > 0.01 {
+					t.Errorf("expected the loaded standard to replace the weight-for-age standard of boys, got %v, %v", got, err)
+				}
+
+				height := 65.0
+				child.BirthDate = monthsOld(on, 6)
+
+				got, err = s.Score(dto.GrowthIndicatorWeightForHeight, child, growthstandards.Measurements{Weight: &weight, Height: &height}, on)
+				if err != nil || got == nil {
+					t.Errorf("expected the default weight-for-length standard to be kept, got %v, %v", got, err)
+				}
+
+				classification := s.ClassifyMUAC(12.2, "cm", growthstandards.Child{BirthDate: monthsOld(on, 18)}, on)
+				if classification == nil || *classification != dto.MUACClassificationNormal {
+					t.Errorf("expected the loaded MUAC cut-offs to replace the defaults, got %v", classification)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceGrowthStandardsImpl_LoadFile_AnthroDirectory(t *testing.T) {
+	writeFiles := func(t *testing.T, files map[string]string) string {
+		dir := t.TempDir()
+
+		for name, content := range files {
+			err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600)
+			if err != nil {
+				t.Fatalf("unable to write standards: %s", err)
+			}
+		}
+
+		return dir
+	}
+
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr bool
+	}{
+		{
+			name: "Happy case: load the WHO Anthro files",
+			files: map[string]string{
+				"weianthro.txt": "sex\tage\tl\tm\ts\n1\t0\t1\t3\t0.1\n1\t1826.25\t1\t18\t0.1\n",
+				"lenanthro.txt": "sex\tage\tl\tm\ts\tloh\n2\t0\t1\t50\t0.04\tL\n2\t730\t1\t86\t0.04\tL\n2\t731\t1\t85\t0.04\tH\n2\t1856\t1\t110\t0.04\tH\n",
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: no WHO Anthro files",
+			files:   map[string]string{"standards.json": "{}"},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: missing column",
+			files:   map[string]string{"weianthro.txt": "sex\tage\tl\tm\n1\t0\t1\t3\n1\t1826.25\t1\t18\n"},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unknown sex",
+			files:   map[string]string{"weianthro.txt": "sex\tage\tl\tm\ts\n3\t0\t1\t3\t0.1\n3\t1826.25\t1\t18\t0.1\n"},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unknown measure",
+			files:   map[string]string{"lenanthro.txt": "sex\tage\tl\tm\ts\tloh\n2\t0\t1\t50\t0.04\tX\n2\t730\t1\t86\t0.04\tX\n"},
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: invalid value",
+			files:   map[string]string{"weianthro.txt": "sex\tage\tl\tm\ts\n1\t0\t1\tthree\t0.1\n1\t1826.25\t1\t18\t0.1\n"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := growthstandards.NewServiceGrowthStandards()
+
+			err := s.LoadFile(writeFiles(t, tt.files))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceGrowthStandardsImpl.LoadFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				on := time.Now()
+				child := growthstandards.Child{Sex: "male", BirthDate: monthsOld(on, 30)}
+				weight := 10.5
+
+				got, err := s.Score(dto.GrowthIndicatorWeightForAge, child, growthstandards.Measurements{Weight: &weight}, on)
+				if err != nil || got == nil || math.Abs(got.ZScore) > 0.01 {
+					t.Errorf("expected the loaded standard to replace the weight-for-age standard of boys, got %v, %v", got, err)
+				}
+
+				height := 85.0
+				child = growthstandards.Child{Sex: "female", BirthDate: on.AddDate(0, 0, -731)}
+
+				got, err = s.Score(dto.GrowthIndicatorHeightForAge, child, growthstandards.Measurements{Height: &height}, on)
+				if err != nil || got == nil || got.Measure != growthstandards.MeasureHeight || math.Abs(got.ZScore) > 0.01 {
+					t.Errorf("expected the loaded height-for-age standard of girls to apply from 731 days, got %v, %v", got, err)
+				}
+
+				curves := s.Curves(dto.GrowthIndicatorHeightForAge, "female")
+				if len(curves) != 2 {
+					t.Errorf("expected the loaded length-for-age and height-for-age curves of girls, got %d", len(curves))
+				}
+			}
+		})
+	}
+}
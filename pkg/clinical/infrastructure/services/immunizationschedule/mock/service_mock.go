@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/immunizationschedule"
+)
+
+// FakeImmunizationSchedule mocks the immunization schedule
+type FakeImmunizationSchedule struct {
+	MockLoadFileFn func(path string) error
+	MockEvaluateFn func(birthDate time.Time, sex string, history []immunizationschedule.AdministeredDose, on time.Time) []immunizationschedule.Recommendation
+}
+
+// NewFakeImmunizationScheduleMock initializes the immunization schedule mock
+func NewFakeImmunizationScheduleMock() *FakeImmunizationSchedule {
+	return &FakeImmunizationSchedule{
+		MockLoadFileFn: func(path string) error {
+			return nil
+		},
+		MockEvaluateFn: func(birthDate time.Time, sex string, history []immunizationschedule.AdministeredDose, on time.Time) []immunizationschedule.Recommendation {
+			return []immunizationschedule.Recommendation{}
+		},
+	}
+}
+
+// LoadFile mocks the implementation of loading an immunization schedule from a file
+func (f *FakeImmunizationSchedule) LoadFile(path string) error {
+	return f.MockLoadFileFn(path)
+}
+
+// Evaluate mocks the implementation of evaluating the immunization schedule
+func (f *FakeImmunizationSchedule) Evaluate(birthDate time.Time, sex string, history []immunizationschedule.AdministeredDose, on time.Time) []immunizationschedule.Recommendation {
+	return f.MockEvaluateFn(birthDate, sex, history, on)
+}
@@ -0,0 +1,290 @@
+package immunizationschedule
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common/helpers"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+)
+
+// SchedulePathEnvVarName is the environment variable holding the path of the immunization schedule to load on startup
+const SchedulePathEnvVarName = "IMMUNIZATION_SCHEDULE_PATH"
+
+// defaultSchedule is the Kenya Expanded Programme on Immunization schedule.
+// Deployments in other countries are expected to load their national schedule in its place
+//
+//go:embed schedule.json
+var defaultSchedule []byte
+
+// Schedule is a national immunization schedule
+type Schedule struct {
+	Name string `json:"name"`
+
+	// GracePeriod is how long after its due date a dose becomes overdue when the dose does not specify its own
+	GracePeriod helpers.Period `json:"gracePeriod"`
+	Vaccines    []Vaccine      `json:"vaccines"`
+}
+
+// Vaccine is a vaccine series of the schedule.
+// The concepts are keys of the form `<SOURCE>:<CODE>` e.g `CIEL:886` or `CVX:19` that identify the immunizations given for the series
+type Vaccine struct {
+	Code     string   `json:"code"`
+	Name     string   `json:"name"`
+	Concepts []string `json:"concepts"`
+
+	// Sex restricts the series to patients of the given administrative gender e.g `female`
+	Sex   string `json:"sex,omitempty"`
+	Doses []Dose `json:"doses"`
+}
+
+// Dose is a dose of a vaccine series
+type Dose struct {
+	// Age is the age at which the dose is recommended
+	Age helpers.Period `json:"age"`
+
+	// MinimumInterval is the time that must pass after the previous dose of the series before the dose can be given
+	MinimumInterval helpers.Period `json:"minimumInterval,omitempty"`
+
+	// MaximumAge is the age after which the dose is no longer given and is skipped
+	MaximumAge  helpers.Period `json:"maximumAge,omitempty"`
+	GracePeriod helpers.Period `json:"gracePeriod,omitempty"`
+}
+
+// Validate ensures the schedule is complete
+func (s Schedule) Validate() error {
+	codes := map[string]bool{}
+
+	for _, vaccine := range s.Vaccines {
+		if vaccine.Code == "" || vaccine.Name == "" {
+			return fmt.Errorf("a vaccine must specify its code and name")
+		}
+
+		if codes[vaccine.Code] {
+			return fmt.Errorf("vaccine %s is defined more than once", vaccine.Code)
+		}
+
+		codes[vaccine.Code] = true
+
+		if len(vaccine.Concepts) == 0 {
+			return fmt.Errorf("vaccine %s must specify the concepts that identify it", vaccine.Code)
+		}
+
+		if len(vaccine.Doses) == 0 {
+			return fmt.Errorf("vaccine %s must have at least one dose", vaccine.Code)
+		}
+	}
+
+	return nil
+}
+
+// AdministeredDose is an immunization the patient has received, identified by the keys of its vaccine concept
+type AdministeredDose struct {
+	Concepts []string
+	Date     time.Time
+}
+
+// Recommendation is the state of the patient in a vaccine series.
+// DoseNumber is the next dose of the series or, once the series is complete, its last dose
+type Recommendation struct {
+	Vaccine      Vaccine
+	DoseNumber   int
+	Status       dto.ImmunizationRecommendationStatusEnum
+	DueDate      *time.Time
+	DosesGiven   int
+	LastDoseDate *time.Time
+}
+
+// ServiceImmunizationSchedule evaluates the immunization schedule against a patient's immunization history
+type ServiceImmunizationSchedule interface {
+	LoadFile(path string) error
+	Evaluate(birthDate time.Time, sex string, history []AdministeredDose, on time.Time) []Recommendation
+}
+
+// ServiceImmunizationScheduleImpl holds the immunization schedule in memory
+type ServiceImmunizationScheduleImpl struct {
+	mu       sync.RWMutex
+	schedule Schedule
+}
+
+// NewServiceImmunizationSchedule initializes the immunization schedule with the default schedule
+func NewServiceImmunizationSchedule() *ServiceImmunizationScheduleImpl {
+	s := &ServiceImmunizationScheduleImpl{}
+
+	err := s.Load(bytes.NewReader(defaultSchedule))
+	if err != nil {
+		log.Panicf("unable to load the default immunization schedule: %s", err)
+	}
+
+	return s
+}
+
+// Load replaces the immunization schedule with the one read from JSON
+func (s *ServiceImmunizationScheduleImpl) Load(r io.Reader) error {
+	var schedule Schedule
+
+	err := json.NewDecoder(r).Decode(&schedule)
+	if err != nil {
+		return fmt.Errorf("unable to decode immunization schedule: %w", err)
+	}
+
+	err = schedule.Validate()
+	if err != nil {
+		return err
+	}
+
+	for i, vaccine := range schedule.Vaccines {
+		for j, concept := range vaccine.Concepts {
+			schedule.Vaccines[i].Concepts[j] = normalizeKey(concept)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.schedule = schedule
+
+	return nil
+}
+
+// LoadFile replaces the immunization schedule with the one in a local JSON file
+func (s *ServiceImmunizationScheduleImpl) LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to open immunization schedule %s: %w", path, err)
+	}
+	defer file.Close()
+
+	return s.Load(file)
+}
+
+// Evaluate works out the next dose of each vaccine series the patient is eligible for on the given date.
+// Administered doses are assigned to the doses of a series in order. A dose that was not given before its maximum age
+// is skipped, and a series none of whose doses were given or can still be given is left out
+func (s *ServiceImmunizationScheduleImpl) Evaluate(birthDate time.Time, sex string, history []AdministeredDose, on time.Time) []Recommendation {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	birthDate = truncateToDay(birthDate)
+	on = truncateToDay(on)
+
+	recommendations := []Recommendation{}
+
+	for _, vaccine := range s.schedule.Vaccines {
+		if vaccine.Sex != "" && !strings.EqualFold(vaccine.Sex, sex) {
+			continue
+		}
+
+		recommendation, ok := s.evaluateVaccine(vaccine, birthDate, administeredDoses(vaccine, history), on)
+		if ok {
+			recommendations = append(recommendations, recommendation)
+		}
+	}
+
+	return recommendations
+}
+
+func (s *ServiceImmunizationScheduleImpl) evaluateVaccine(vaccine Vaccine, birthDate time.Time, given []time.Time, on time.Time) (Recommendation, bool) {
+	recommendation := Recommendation{
+		Vaccine: vaccine,
+	}
+
+	skipped := false
+
+	for i, dose := range vaccine.Doses {
+		if len(given) > 0 && (dose.MaximumAge.IsZero() || given[0].Before(dose.MaximumAge.AddTo(birthDate))) {
+			date := given[0]
+			given = given[1:]
+
+			recommendation.DosesGiven++
+			recommendation.LastDoseDate = &date
+
+			continue
+		}
+
+		if !dose.MaximumAge.IsZero() && !on.Before(dose.MaximumAge.AddTo(birthDate)) {
+			skipped = true
+
+			continue
+		}
+
+		dueDate := dose.Age.AddTo(birthDate)
+		if recommendation.LastDoseDate != nil && !dose.MinimumInterval.IsZero() {
+			earliest := dose.MinimumInterval.AddTo(*recommendation.LastDoseDate)
+			if earliest.After(dueDate) {
+				dueDate = earliest
+			}
+		}
+
+		gracePeriod := dose.GracePeriod
+		if gracePeriod.IsZero() {
+			gracePeriod = s.schedule.GracePeriod
+		}
+
+		recommendation.DoseNumber = i + 1
+		recommendation.DueDate = &dueDate
+
+		switch {
+		case on.Before(dueDate):
+			recommendation.Status = dto.ImmunizationRecommendationStatusUpcoming
+		case on.Before(gracePeriod.AddTo(dueDate)):
+			recommendation.Status = dto.ImmunizationRecommendationStatusDue
+		default:
+			recommendation.Status = dto.ImmunizationRecommendationStatusOverdue
+		}
+
+		return recommendation, true
+	}
+
+	if skipped && recommendation.DosesGiven == 0 {
+		return recommendation, false
+	}
+
+	recommendation.DoseNumber = len(vaccine.Doses)
+	recommendation.Status = dto.ImmunizationRecommendationStatusComplete
+
+	return recommendation, true
+}
+
+// administeredDoses returns the dates, in order, on which doses of the vaccine were given
+func administeredDoses(vaccine Vaccine, history []AdministeredDose) []time.Time {
+	concepts := map[string]bool{}
+	for _, concept := range vaccine.Concepts {
+		concepts[concept] = true
+	}
+
+	dates := []time.Time{}
+
+	for _, dose := range history {
+		for _, concept := range dose.Concepts {
+			if concepts[normalizeKey(concept)] {
+				dates = append(dates, truncateToDay(dose.Date))
+
+				break
+			}
+		}
+	}
+
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+
+	return dates
+}
+
+func truncateToDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func normalizeKey(key string) string {
+	return strings.ToUpper(strings.TrimSpace(key))
+}
@@ -0,0 +1,230 @@
+package immunizationschedule_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/immunizationschedule"
+)
+
+func TestServiceImmunizationScheduleImpl_Evaluate(t *testing.T) {
+	birthDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	bcg := []string{"CIEL:886"}
+	opv := []string{"cvx:02"}
+
+	type args struct {
+		birthDate time.Time
+		sex       string
+		history   []immunizationschedule.AdministeredDose
+		on        time.Time
+	}
+	tests := []struct {
+		name string
+		args args
+		// want maps the vaccines expected in the recommendations to their status and next dose
+		want map[string]struct {
+			status     dto.ImmunizationRecommendationStatusEnum
+			doseNumber int
+			dueDate    time.Time
+		}
+	}{
+		{
+			name: "Happy case: newborn is due for the birth doses",
+			args: args{
+				birthDate: birthDate,
+				sex:       "male",
+				on:        birthDate,
+			},
+			want: map[string]struct {
+				status     dto.ImmunizationRecommendationStatusEnum
+				doseNumber int
+				dueDate    time.Time
+			}{
+				"BCG":   {dto.ImmunizationRecommendationStatusDue, 1, birthDate},
+				"OPV":   {dto.ImmunizationRecommendationStatusDue, 1, birthDate},
+				"IPV":   {dto.ImmunizationRecommendationStatusUpcoming, 1, birthDate.AddDate(0, 0, 98)},
+				"PENTA": {dto.ImmunizationRecommendationStatusUpcoming, 1, birthDate.AddDate(0, 0, 42)},
+				"PCV10": {dto.ImmunizationRecommendationStatusUpcoming, 1, birthDate.AddDate(0, 0, 42)},
+				"ROTA":  {dto.ImmunizationRecommendationStatusUpcoming, 1, birthDate.AddDate(0, 0, 42)},
+				"MR":    {dto.ImmunizationRecommendationStatusUpcoming, 1, birthDate.AddDate(0, 9, 0)},
+			},
+		},
+		{
+			name: "Happy case: missed birth dose is skipped and later doses are overdue",
+			args: args{
+				birthDate: birthDate,
+				sex:       "female",
+				history: []immunizationschedule.AdministeredDose{
+					{Concepts: bcg, Date: birthDate},
+				},
+				on: birthDate.AddDate(0, 3, 0),
+			},
+			want: map[string]struct {
+				status     dto.ImmunizationRecommendationStatusEnum
+				doseNumber int
+				dueDate    time.Time
+			}{
+				"BCG":   {dto.ImmunizationRecommendationStatusComplete, 1, time.Time{}},
+				"OPV":   {dto.ImmunizationRecommendationStatusOverdue, 2, birthDate.AddDate(0, 0, 42)},
+				"IPV":   {dto.ImmunizationRecommendationStatusUpcoming, 1, birthDate.AddDate(0, 0, 98)},
+				"PENTA": {dto.ImmunizationRecommendationStatusOverdue, 1, birthDate.AddDate(0, 0, 42)},
+				"PCV10": {dto.ImmunizationRecommendationStatusOverdue, 1, birthDate.AddDate(0, 0, 42)},
+				"ROTA":  {dto.ImmunizationRecommendationStatusOverdue, 1, birthDate.AddDate(0, 0, 42)},
+				"MR":    {dto.ImmunizationRecommendationStatusUpcoming, 1, birthDate.AddDate(0, 9, 0)},
+				"HPV":   {dto.ImmunizationRecommendationStatusUpcoming, 1, birthDate.AddDate(10, 0, 0)},
+			},
+		},
+		{
+			name: "Happy case: a late dose delays the next dose by the minimum interval",
+			args: args{
+				birthDate: birthDate,
+				sex:       "male",
+				history: []immunizationschedule.AdministeredDose{
+					{Concepts: bcg, Date: birthDate},
+					{Concepts: opv, Date: birthDate},
+					{Concepts: opv, Date: birthDate.AddDate(0, 0, 63)},
+				},
+				on: birthDate.AddDate(0, 0, 70),
+			},
+			want: map[string]struct {
+				status     dto.ImmunizationRecommendationStatusEnum
+				doseNumber int
+				dueDate    time.Time
+			}{
+				"BCG":   {dto.ImmunizationRecommendationStatusComplete, 1, time.Time{}},
+				"OPV":   {dto.ImmunizationRecommendationStatusUpcoming, 3, birthDate.AddDate(0, 0, 91)},
+				"IPV":   {dto.ImmunizationRecommendationStatusUpcoming, 1, birthDate.AddDate(0, 0, 98)},
+				"PENTA": {dto.ImmunizationRecommendationStatusOverdue, 1, birthDate.AddDate(0, 0, 42)},
+				"PCV10": {dto.ImmunizationRecommendationStatusOverdue, 1, birthDate.AddDate(0, 0, 42)},
+				"ROTA":  {dto.ImmunizationRecommendationStatusOverdue, 1, birthDate.AddDate(0, 0, 42)},
+				"MR":    {dto.ImmunizationRecommendationStatusUpcoming, 1, birthDate.AddDate(0, 9, 0)},
+			},
+		},
+		{
+			name: "Happy case: adult with no childhood doses has no recommendations",
+			args: args{
+				birthDate: time.Date(1990, 12, 12, 0, 0, 0, 0, time.UTC),
+				sex:       "male",
+				on:        birthDate,
+			},
+			want: map[string]struct {
+				status     dto.ImmunizationRecommendationStatusEnum
+				doseNumber int
+				dueDate    time.Time
+			}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := immunizationschedule.NewServiceImmunizationSchedule()
+
+			got := s.Evaluate(tt.args.birthDate, tt.args.sex, tt.args.history, tt.args.on)
+			if len(got) != len(tt.want) {
+				t.Errorf("ServiceImmunizationScheduleImpl.Evaluate() got %d recommendations, want %d", len(got), len(tt.want))
+				return
+			}
+
+			for _, recommendation := range got {
+				want, ok := tt.want[recommendation.Vaccine.Code]
+				if !ok {
+					t.Errorf("unexpected recommendation for %s", recommendation.Vaccine.Code)
+					continue
+				}
+
+				if recommendation.Status != want.status || recommendation.DoseNumber != want.doseNumber {
+					t.Errorf(
+						"expected dose %d of %s to be %v, got dose %d %v",
+						want.doseNumber, recommendation.Vaccine.Code, want.status, recommendation.DoseNumber, recommendation.Status,
+					)
+				}
+
+				if !want.dueDate.IsZero() && (recommendation.DueDate == nil || !recommendation.DueDate.Equal(want.dueDate)) {
+					t.Errorf("expected %s to be due on %v, got %v", recommendation.Vaccine.Code, want.dueDate, recommendation.DueDate)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceImmunizationScheduleImpl_LoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	validSchedule := filepath.Join(dir, "valid.json")
+	err := os.WriteFile(validSchedule, []byte(`{"name": "test", "gracePeriod": "2w", "vaccines": [{"code": "HEPB", "name": "Hepatitis B vaccine", "concepts": ["CVX:08"], "doses": [{"age": "0d"}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write schedule: %s", err)
+	}
+
+	invalidPeriod := filepath.Join(dir, "invalid_period.json")
+	err = os.WriteFile(invalidPeriod, []byte(`{"name": "test", "vaccines": [{"code": "HEPB", "name": "Hepatitis B vaccine", "concepts": ["CVX:08"], "doses": [{"age": "6 weeks"}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write schedule: %s", err)
+	}
+
+	noDoses := filepath.Join(dir, "no_doses.json")
+	err = os.WriteFile(noDoses, []byte(`{"name": "test", "vaccines": [{"code": "HEPB", "name": "Hepatitis B vaccine", "concepts": ["CVX:08"]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write schedule: %s", err)
+	}
+
+	malformed := filepath.Join(dir, "malformed.json")
+	err = os.WriteFile(malformed, []byte(`{`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write schedule: %s", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: load a schedule",
+			path:    validSchedule,
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: missing file",
+			path:    filepath.Join(dir, "missing.json"),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: invalid period",
+			path:    invalidPeriod,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: vaccine without doses",
+			path:    noDoses,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: malformed schedule",
+			path:    malformed,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := immunizationschedule.NewServiceImmunizationSchedule()
+
+			err := s.LoadFile(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceImmunizationScheduleImpl.LoadFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				now := time.Now()
+
+				got := s.Evaluate(now, "male", nil, now)
+				if len(got) != 1 || got[0].Vaccine.Code != "HEPB" || got[0].Status != dto.ImmunizationRecommendationStatusDue {
+					t.Errorf("expected the loaded schedule to replace the default schedule, got %v", got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package mock
+
+import (
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/interactions"
+)
+
+// FakeInteractions mocks the interaction table
+type FakeInteractions struct {
+	MockLoadFileFn         func(path string) error
+	MockFindInteractionsFn func(first []string, second []string) []interactions.Rule
+}
+
+// NewFakeInteractionsMock initializes the interaction table mock
+func NewFakeInteractionsMock() *FakeInteractions {
+	return &FakeInteractions{
+		MockLoadFileFn: func(path string) error {
+			return nil
+		},
+		MockFindInteractionsFn: func(first []string, second []string) []interactions.Rule {
+			return []interactions.Rule{}
+		},
+	}
+}
+
+// LoadFile mocks the implementation of loading an interaction table from a file
+func (f *FakeInteractions) LoadFile(path string) error {
+	return f.MockLoadFileFn(path)
+}
+
+// FindInteractions mocks the implementation of looking up interactions
+func (f *FakeInteractions) FindInteractions(first []string, second []string) []interactions.Rule {
+	return f.MockFindInteractionsFn(first, second)
+}
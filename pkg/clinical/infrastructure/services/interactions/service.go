@@ -0,0 +1,156 @@
+package interactions
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+)
+
+// TablePathEnvVarName is the environment variable holding the path of an additional interaction table to load on startup
+const TablePathEnvVarName = "DRUG_INTERACTION_TABLE_PATH"
+
+// defaultTable is a small set of well known interactions keyed by RxNorm ingredient codes.
+// Deployments are expected to load a curated table on top of it
+//
+//go:embed interactions.json
+var defaultTable []byte
+
+// Rule is an entry of the interaction table.
+// The keys are of the form `<SOURCE>:<CODE>` e.g `CIEL:1234` or `RXNORM:11289` and a rule applies in both directions
+type Rule struct {
+	First       string                      `json:"first"`
+	Second      string                      `json:"second"`
+	Severity    dto.InteractionSeverityEnum `json:"severity"`
+	Action      dto.InteractionActionEnum   `json:"action"`
+	Description string                      `json:"description"`
+}
+
+// Validate ensures the rule is complete
+func (r Rule) Validate() error {
+	if r.First == "" || r.Second == "" {
+		return fmt.Errorf("an interaction rule must specify both interacting concepts")
+	}
+
+	if !r.Severity.IsValid() {
+		return fmt.Errorf("invalid interaction severity %q for %s and %s", r.Severity, r.First, r.Second)
+	}
+
+	if !r.Action.IsValid() {
+		return fmt.Errorf("invalid interaction action %q for %s and %s", r.Action, r.First, r.Second)
+	}
+
+	return nil
+}
+
+// ServiceInteractions represents the lookup of known interactions between medications and substances
+type ServiceInteractions interface {
+	LoadFile(path string) error
+	FindInteractions(first []string, second []string) []Rule
+}
+
+// ServiceInteractionsImpl is an in-memory interaction table
+type ServiceInteractionsImpl struct {
+	mu    sync.RWMutex
+	rules map[string][]Rule
+}
+
+// NewServiceInteractions initializes an interaction table with the default interactions
+func NewServiceInteractions() *ServiceInteractionsImpl {
+	s := &ServiceInteractionsImpl{
+		rules: map[string][]Rule{},
+	}
+
+	err := s.Load(bytes.NewReader(defaultTable))
+	if err != nil {
+		log.Panicf("unable to load the default interaction table: %s", err)
+	}
+
+	return s
+}
+
+// Load adds the interaction rules read from a JSON array to the table
+func (s *ServiceInteractionsImpl) Load(r io.Reader) error {
+	var rules []Rule
+
+	err := json.NewDecoder(r).Decode(&rules)
+	if err != nil {
+		return fmt.Errorf("unable to decode interaction table: %w", err)
+	}
+
+	for _, rule := range rules {
+		err := rule.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, rule := range rules {
+		rule.First = normalizeKey(rule.First)
+		rule.Second = normalizeKey(rule.Second)
+
+		s.rules[rule.First] = append(s.rules[rule.First], rule)
+		if rule.First != rule.Second {
+			s.rules[rule.Second] = append(s.rules[rule.Second], rule)
+		}
+	}
+
+	return nil
+}
+
+// LoadFile adds the interaction rules in a local JSON file to the table
+func (s *ServiceInteractionsImpl) LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to open interaction table %s: %w", path, err)
+	}
+	defer file.Close()
+
+	return s.Load(file)
+}
+
+// FindInteractions returns the rules that relate any of the first concepts to any of the second concepts
+func (s *ServiceInteractionsImpl) FindInteractions(first []string, second []string) []Rule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	others := map[string]bool{}
+	for _, key := range second {
+		others[normalizeKey(key)] = true
+	}
+
+	found := []Rule{}
+	seen := map[Rule]bool{}
+
+	for _, key := range first {
+		key = normalizeKey(key)
+
+		for _, rule := range s.rules[key] {
+			other := rule.Second
+			if rule.Second == key {
+				other = rule.First
+			}
+
+			if others[other] && !seen[rule] {
+				seen[rule] = true
+				found = append(found, rule)
+			}
+		}
+	}
+
+	return found
+}
+
+func normalizeKey(key string) string {
+	return strings.ToUpper(strings.TrimSpace(key))
+}
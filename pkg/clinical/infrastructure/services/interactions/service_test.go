@@ -0,0 +1,132 @@
+package interactions_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/interactions"
+)
+
+func TestServiceInteractionsImpl_FindInteractions(t *testing.T) {
+	type args struct {
+		first  []string
+		second []string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantCount  int
+		wantAction dto.InteractionActionEnum
+	}{
+		{
+			name: "Happy case: find an interaction",
+			args: args{
+				first:  []string{"CIEL:1234", "RXNORM:11289"},
+				second: []string{"RXNORM:1191"},
+			},
+			wantCount:  1,
+			wantAction: dto.InteractionActionWarning,
+		},
+		{
+			name: "Happy case: interactions are symmetric and case insensitive",
+			args: args{
+				first:  []string{"rxnorm:4917"},
+				second: []string{"RXNORM:136411"},
+			},
+			wantCount:  1,
+			wantAction: dto.InteractionActionHardStop,
+		},
+		{
+			name: "Happy case: no interaction",
+			args: args{
+				first:  []string{"RXNORM:11289"},
+				second: []string{"RXNORM:723"},
+			},
+			wantCount: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := interactions.NewServiceInteractions()
+
+			got := s.FindInteractions(tt.args.first, tt.args.second)
+			if len(got) != tt.wantCount {
+				t.Errorf("ServiceInteractionsImpl.FindInteractions() got %d interactions, want %d", len(got), tt.wantCount)
+				return
+			}
+
+			if tt.wantCount > 0 && got[0].Action != tt.wantAction {
+				t.Errorf("ServiceInteractionsImpl.FindInteractions() action = %v, want %v", got[0].Action, tt.wantAction)
+			}
+		})
+	}
+}
+
+func TestServiceInteractionsImpl_LoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	validTable := filepath.Join(dir, "valid.json")
+	err := os.WriteFile(validTable, []byte(`[{"first": "CIEL:71160", "second": "CIEL:162298", "severity": "LOW", "action": "WARNING", "description": "test"}]`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write table: %s", err)
+	}
+
+	invalidSeverity := filepath.Join(dir, "invalid_severity.json")
+	err = os.WriteFile(invalidSeverity, []byte(`[{"first": "CIEL:71160", "second": "CIEL:162298", "severity": "EXTREME", "action": "WARNING"}]`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write table: %s", err)
+	}
+
+	malformed := filepath.Join(dir, "malformed.json")
+	err = os.WriteFile(malformed, []byte(`{`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write table: %s", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: load a table",
+			path:    validTable,
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: missing file",
+			path:    filepath.Join(dir, "missing.json"),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: invalid severity",
+			path:    invalidSeverity,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: malformed table",
+			path:    malformed,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := interactions.NewServiceInteractions()
+
+			err := s.LoadFile(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceInteractionsImpl.LoadFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				got := s.FindInteractions([]string{"ciel:162298"}, []string{"ciel:71160"})
+				if len(got) != 1 {
+					t.Errorf("expected the loaded interaction to be found, got %d", len(got))
+				}
+			}
+		})
+	}
+}
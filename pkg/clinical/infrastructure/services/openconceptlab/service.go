@@ -93,7 +93,7 @@ func (s Service) GetConcept(
 
 	params := url.Values{}
 	params.Add("includeMappings", strconv.FormatBool(includeMappings))
-	params.Add("includeMappings", strconv.FormatBool(includeInverseMappings))
+	params.Add("includeInverseMappings", strconv.FormatBool(includeInverseMappings))
 
 	resp, err := s.MakeRequest("GET", path, params, nil)
 
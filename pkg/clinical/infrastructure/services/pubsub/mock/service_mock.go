@@ -12,6 +12,8 @@ type FakeServicePubsub struct {
 	MockNotifyFacilityFHIRIDUpdatefn func(ctx context.Context, data dto.UpdateFacilityFHIRID) error
 	MockNotifyProgramFHIRIDUpdatefn  func(ctx context.Context, data dto.UpdateProgramFHIRID) error
 	MockNotifySegmentationFn         func(ctx context.Context, data dto.SegmentationPayload) error
+	MockNotifyTestOrderFn            func(ctx context.Context, data dto.PatientTestOrderPubSubMessage) error
+	MockNotifyEarlyWarningAlertFn    func(ctx context.Context, data dto.EarlyWarningAlertPubSubMessage) error
 }
 
 // NewPubSubServiceMock mocks the pubsub service implementation
@@ -29,6 +31,12 @@ func NewPubSubServiceMock() *FakeServicePubsub {
 		MockNotifySegmentationFn: func(ctx context.Context, data dto.SegmentationPayload) error {
 			return nil
 		},
+		MockNotifyTestOrderFn: func(ctx context.Context, data dto.PatientTestOrderPubSubMessage) error {
+			return nil
+		},
+		MockNotifyEarlyWarningAlertFn: func(ctx context.Context, data dto.EarlyWarningAlertPubSubMessage) error {
+			return nil
+		},
 	}
 }
 
@@ -53,3 +61,13 @@ func (f *FakeServicePubsub) NotifyProgramFHIRIDUpdate(ctx context.Context, data
 func (f *FakeServicePubsub) NotifySegmentation(ctx context.Context, data dto.SegmentationPayload) error {
 	return f.MockNotifySegmentationFn(ctx, data)
 }
+
+// NotifyTestOrder mocks publishing a lab order to the test orders topic
+func (f *FakeServicePubsub) NotifyTestOrder(ctx context.Context, data dto.PatientTestOrderPubSubMessage) error {
+	return f.MockNotifyTestOrderFn(ctx, data)
+}
+
+// NotifyEarlyWarningAlert mocks publishing an early warning alert
+func (f *FakeServicePubsub) NotifyEarlyWarningAlert(ctx context.Context, data dto.EarlyWarningAlertPubSubMessage) error {
+	return f.MockNotifyEarlyWarningAlertFn(ctx, data)
+}
@@ -48,3 +48,13 @@ func (ps ServicePubSubMessaging) NotifyProgramFHIRIDUpdate(ctx context.Context,
 func (ps ServicePubSubMessaging) NotifySegmentation(ctx context.Context, data dto.SegmentationPayload) error {
 	return ps.newPublish(ctx, data, common.SegmentationTopicName, common.ClinicalServiceName)
 }
+
+// NotifyTestOrder publishes a lab order placed for a patient to the test orders topic for laboratory systems to pick up
+func (ps ServicePubSubMessaging) NotifyTestOrder(ctx context.Context, data dto.PatientTestOrderPubSubMessage) error {
+	return ps.newPublish(ctx, data, common.TestOrderTopicName, common.ClinicalServiceName)
+}
+
+// NotifyEarlyWarningAlert publishes an alert when the early warning score of a patient calls for a clinical response
+func (ps ServicePubSubMessaging) NotifyEarlyWarningAlert(ctx context.Context, data dto.EarlyWarningAlertPubSubMessage) error {
+	return ps.newPublish(ctx, data, common.EarlyWarningAlertTopicName, common.ClinicalServiceName)
+}
@@ -29,6 +29,8 @@ type ServicePubsub interface {
 	NotifyFacilityFHIRIDUpdate(ctx context.Context, data dto.UpdateFacilityFHIRID) error
 	NotifyProgramFHIRIDUpdate(ctx context.Context, data dto.UpdateProgramFHIRID) error
 	NotifySegmentation(ctx context.Context, data dto.SegmentationPayload) error
+	NotifyTestOrder(ctx context.Context, data dto.PatientTestOrderPubSubMessage) error
+	NotifyEarlyWarningAlert(ctx context.Context, data dto.EarlyWarningAlertPubSubMessage) error
 }
 
 // ServicePubSubMessaging is used to send and receive pubsub notifications
@@ -47,7 +49,7 @@ func NewServicePubSubMessaging(
 
 	if err := s.EnsureTopicsExist(
 		ctx,
-		s.TopicIDs(),
+		append(s.TopicIDs(), s.PublishedTopicIDs()...),
 	); err != nil {
 		return nil, err
 	}
@@ -88,6 +90,14 @@ func (ps ServicePubSubMessaging) TopicIDs() []string {
 	}
 }
 
+// PublishedTopicIDs returns the IDs of the topics that the service publishes to but
+// does not consume. They are not subscribed to so that their messages are not pushed back to the service
+func (ps ServicePubSubMessaging) PublishedTopicIDs() []string {
+	return []string{
+		ps.AddPubSubNamespace(common.EarlyWarningAlertTopicName, common.ClinicalServiceName),
+	}
+}
+
 // PublishToPubsub publishes a message to a specified topic
 func (ps ServicePubSubMessaging) PublishToPubsub(
 	ctx context.Context,
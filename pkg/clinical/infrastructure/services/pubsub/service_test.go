@@ -234,3 +234,17 @@ func TestServicePubSubMessaging_SubscriptionIDs(t *testing.T) {
 		})
 	}
 }
+
+func TestServicePubSubMessaging_PublishedTopicIDs(t *testing.T) {
+	ps := pubsubmessaging.ServicePubSubMessaging{}
+	alertTopic := ps.AddPubSubNamespace(common.EarlyWarningAlertTopicName, common.ClinicalServiceName)
+
+	published := ps.PublishedTopicIDs()
+	if len(published) != 1 || published[0] != alertTopic {
+		t.Errorf("expected the early warning alert topic to be published, got %v", published)
+	}
+
+	if _, ok := ps.SubscriptionIDs()[alertTopic]; ok {
+		t.Errorf("expected the service not to subscribe to the early warning alert topic")
+	}
+}
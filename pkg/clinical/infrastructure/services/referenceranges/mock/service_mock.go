@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/referenceranges"
+)
+
+// FakeReferenceRanges mocks the reference ranges
+type FakeReferenceRanges struct {
+	MockLoadFileFn  func(path string) error
+	MockInterpretFn func(concept string, value float64, unit string, patient referenceranges.Patient, on time.Time) (*referenceranges.Interpretation, error)
+}
+
+// NewFakeReferenceRangesMock initializes the reference ranges mock
+func NewFakeReferenceRangesMock() *FakeReferenceRanges {
+	return &FakeReferenceRanges{
+		MockLoadFileFn: func(path string) error {
+			return nil
+		},
+		MockInterpretFn: func(concept string, value float64, unit string, patient referenceranges.Patient, on time.Time) (*referenceranges.Interpretation, error) {
+			return nil, nil
+		},
+	}
+}
+
+// LoadFile mocks the implementation of loading reference ranges from a file
+func (f *FakeReferenceRanges) LoadFile(path string) error {
+	return f.MockLoadFileFn(path)
+}
+
+// Interpret mocks the implementation of interpreting a measurement
+func (f *FakeReferenceRanges) Interpret(concept string, value float64, unit string, patient referenceranges.Patient, on time.Time) (*referenceranges.Interpretation, error) {
+	return f.MockInterpretFn(concept, value, unit, patient, on)
+}
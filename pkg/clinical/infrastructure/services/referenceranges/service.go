@@ -0,0 +1,256 @@
+package referenceranges
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common/helpers"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+)
+
+// RangesPathEnvVarName is the environment variable holding the path of the reference ranges to load on startup
+const RangesPathEnvVarName = "REFERENCE_RANGES_PATH"
+
+// defaultRanges are general adult and paediatric vital sign ranges and the common HIV laboratory results.
+// Deployments are expected to load the ranges used by their laboratories on top of them
+//
+//go:embed ranges.json
+var defaultRanges []byte
+
+// Table is a set of reference ranges
+type Table struct {
+	Name     string    `json:"name"`
+	Concepts []Concept `json:"concepts"`
+}
+
+// Concept is the reference ranges of the measurements of a CIEL concept e.g temperature.
+// The values are in the UCUM unit the concept is recorded in e.g `Cel`
+type Concept struct {
+	Concept string `json:"concept"`
+	Name    string `json:"name"`
+	Unit    string `json:"unit"`
+
+	// Limits are the lowest and highest values that are physiologically possible. Values outside them are errors in entry
+	Limits Limits  `json:"limits"`
+	Ranges []Range `json:"ranges,omitempty"`
+}
+
+// Limits are the bounds of the values of a concept that are possible
+type Limits struct {
+	Low  *float64 `json:"low,omitempty"`
+	High *float64 `json:"high,omitempty"`
+}
+
+// Range is the reference range of a band of patients. A band applies to the patients of its sex e.g `female`, if set,
+// whose age is at least its minimum age and below its maximum age. The first band of a concept that a patient falls in applies
+type Range struct {
+	Sex        string         `json:"sex,omitempty"`
+	MinimumAge helpers.Period `json:"minimumAge,omitempty"`
+	MaximumAge helpers.Period `json:"maximumAge,omitempty"`
+
+	Low          *float64 `json:"low,omitempty"`
+	High         *float64 `json:"high,omitempty"`
+	CriticalLow  *float64 `json:"criticalLow,omitempty"`
+	CriticalHigh *float64 `json:"criticalHigh,omitempty"`
+}
+
+// Validate ensures the table is complete and its ranges are ordered
+func (t Table) Validate() error {
+	concepts := map[string]bool{}
+
+	for _, concept := range t.Concepts {
+		if concept.Concept == "" || concept.Name == "" || concept.Unit == "" {
+			return fmt.Errorf("a reference range concept must specify its concept, name and unit")
+		}
+
+		if concepts[concept.Concept] {
+			return fmt.Errorf("concept %s is defined more than once", concept.Concept)
+		}
+
+		concepts[concept.Concept] = true
+
+		if !ordered(concept.Limits.Low, concept.Limits.High) {
+			return fmt.Errorf("the low limit of %s is above its high limit", concept.Name)
+		}
+
+		for _, band := range concept.Ranges {
+			if !ordered(band.CriticalLow, band.Low, band.High, band.CriticalHigh) {
+				return fmt.Errorf("the ranges of %s must be ordered as critical low, low, high and critical high", concept.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ordered checks that the values that are set are in ascending order
+func ordered(values ...*float64) bool {
+	var previous *float64
+
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+
+		if previous != nil && *value < *previous {
+			return false
+		}
+
+		previous = value
+	}
+
+	return true
+}
+
+// Patient is the sex and age of the patient a measurement is interpreted for. BirthDate is not set when the age is not known
+type Patient struct {
+	Sex       string
+	BirthDate *time.Time
+}
+
+// Interpretation is how a measurement compares to the reference range of the patient's band
+type Interpretation struct {
+	Flag  dto.ObservationInterpretationEnum
+	Range Range
+}
+
+// ServiceReferenceRanges interprets measurements against the reference ranges of their concepts
+type ServiceReferenceRanges interface {
+	LoadFile(path string) error
+	Interpret(concept string, value float64, unit string, patient Patient, on time.Time) (*Interpretation, error)
+}
+
+// ServiceReferenceRangesImpl holds the reference ranges in memory
+type ServiceReferenceRangesImpl struct {
+	mu       sync.RWMutex
+	concepts map[string]Concept
+}
+
+// NewServiceReferenceRanges initializes the reference ranges with the default ranges
+func NewServiceReferenceRanges() *ServiceReferenceRangesImpl {
+	s := &ServiceReferenceRangesImpl{
+		concepts: map[string]Concept{},
+	}
+
+	err := s.Load(bytes.NewReader(defaultRanges))
+	if err != nil {
+		log.Panicf("unable to load the default reference ranges: %s", err)
+	}
+
+	return s
+}
+
+// Load reads reference ranges from JSON. The ranges of the concepts in it replace the ranges those concepts had
+func (s *ServiceReferenceRangesImpl) Load(r io.Reader) error {
+	var table Table
+
+	err := json.NewDecoder(r).Decode(&table)
+	if err != nil {
+		return fmt.Errorf("unable to decode reference ranges: %w", err)
+	}
+
+	err = table.Validate()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, concept := range table.Concepts {
+		s.concepts[concept.Concept] = concept
+	}
+
+	return nil
+}
+
+// LoadFile reads reference ranges from a local JSON file
+func (s *ServiceReferenceRangesImpl) LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to open reference ranges %s: %w", path, err)
+	}
+	defer file.Close()
+
+	return s.Load(file)
+}
+
+// Interpret checks a measurement against the limits of its concept and the reference range of the patient's band.
+// A value that is not physiologically possible is returned as a field error. No interpretation is returned when the concept
+// has no reference ranges in the unit of the measurement, or none of its bands apply to the patient
+func (s *ServiceReferenceRangesImpl) Interpret(concept string, value float64, unit string, patient Patient, on time.Time) (*Interpretation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ranges, ok := s.concepts[concept]
+	if !ok || ranges.Unit != unit {
+		return nil, nil
+	}
+
+	if (ranges.Limits.Low != nil && value < *ranges.Limits.Low) || (ranges.Limits.High != nil && value > *ranges.Limits.High) {
+		return nil, dto.FieldError{
+			Field:   "value",
+			Message: fmt.Sprintf("%v %s is not a possible %s", value, unit, ranges.Name),
+		}
+	}
+
+	for _, band := range ranges.Ranges {
+		if !band.appliesTo(patient, on) {
+			continue
+		}
+
+		return &Interpretation{
+			Flag:  band.interpret(value),
+			Range: band,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func (r Range) appliesTo(patient Patient, on time.Time) bool {
+	if r.Sex != "" && !strings.EqualFold(r.Sex, patient.Sex) {
+		return false
+	}
+
+	if r.MinimumAge.IsZero() && r.MaximumAge.IsZero() {
+		return true
+	}
+
+	if patient.BirthDate == nil {
+		return false
+	}
+
+	if !r.MinimumAge.IsZero() && on.Before(r.MinimumAge.AddTo(*patient.BirthDate)) {
+		return false
+	}
+
+	if !r.MaximumAge.IsZero() && !on.Before(r.MaximumAge.AddTo(*patient.BirthDate)) {
+		return false
+	}
+
+	return true
+}
+
+func (r Range) interpret(value float64) dto.ObservationInterpretationEnum {
+	switch {
+	case r.CriticalLow != nil && value < *r.CriticalLow:
+		return dto.ObservationInterpretationCriticalLow
+	case r.CriticalHigh != nil && value > *r.CriticalHigh:
+		return dto.ObservationInterpretationCriticalHigh
+	case r.Low != nil && value < *r.Low:
+		return dto.ObservationInterpretationLow
+	case r.High != nil && value > *r.High:
+		return dto.ObservationInterpretationHigh
+	}
+
+	return dto.ObservationInterpretationNormal
+}
@@ -0,0 +1,275 @@
+package referenceranges_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/referenceranges"
+)
+
+func TestServiceReferenceRangesImpl_Interpret(t *testing.T) {
+	on := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	adult := on.AddDate(-30, 0, 0)
+	infant := on.AddDate(0, -3, 0)
+
+	type args struct {
+		concept string
+		value   float64
+		unit    string
+		patient referenceranges.Patient
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *dto.ObservationInterpretationEnum
+		wantErr   bool
+		wantField bool
+	}{
+		{
+			name: "Happy case: normal temperature",
+			args: args{
+				concept: "5088",
+				value:   36.8,
+				unit:    "Cel",
+				patient: referenceranges.Patient{Sex: "male", BirthDate: &adult},
+			},
+			want: func() *dto.ObservationInterpretationEnum {
+				flag := dto.ObservationInterpretationNormal
+				return &flag
+			}(),
+		},
+		{
+			name: "Happy case: high temperature",
+			args: args{
+				concept: "5088",
+				value:   38.5,
+				unit:    "Cel",
+				patient: referenceranges.Patient{Sex: "female"},
+			},
+			want: func() *dto.ObservationInterpretationEnum {
+				flag := dto.ObservationInterpretationHigh
+				return &flag
+			}(),
+		},
+		{
+			name: "Happy case: critically low oxygen saturation",
+			args: args{
+				concept: "5092",
+				value:   85,
+				unit:    "%",
+				patient: referenceranges.Patient{Sex: "female", BirthDate: &adult},
+			},
+			want: func() *dto.ObservationInterpretationEnum {
+				flag := dto.ObservationInterpretationCriticalLow
+				return &flag
+			}(),
+		},
+		{
+			name: "Happy case: pulse rate is interpreted in the band of the patient's age",
+			args: args{
+				concept: "5087",
+				value:   130,
+				unit:    "/min",
+				patient: referenceranges.Patient{Sex: "male", BirthDate: &infant},
+			},
+			want: func() *dto.ObservationInterpretationEnum {
+				flag := dto.ObservationInterpretationNormal
+				return &flag
+			}(),
+		},
+		{
+			name: "Happy case: adult pulse rate band",
+			args: args{
+				concept: "5087",
+				value:   130,
+				unit:    "/min",
+				patient: referenceranges.Patient{Sex: "male", BirthDate: &adult},
+			},
+			want: func() *dto.ObservationInterpretationEnum {
+				flag := dto.ObservationInterpretationHigh
+				return &flag
+			}(),
+		},
+		{
+			name: "Happy case: no band applies to a patient without a birth date",
+			args: args{
+				concept: "5087",
+				value:   130,
+				unit:    "/min",
+				patient: referenceranges.Patient{Sex: "male"},
+			},
+		},
+		{
+			name: "Happy case: concept without reference ranges",
+			args: args{
+				concept: "5089",
+				value:   70,
+				unit:    "kg",
+				patient: referenceranges.Patient{Sex: "male", BirthDate: &adult},
+			},
+		},
+		{
+			name: "Happy case: unknown concept",
+			args: args{
+				concept: "12345",
+				value:   70,
+				unit:    "kg",
+			},
+		},
+		{
+			name: "Happy case: measurement in a different unit",
+			args: args{
+				concept: "5088",
+				value:   98.6,
+				unit:    "[degF]",
+			},
+		},
+		{
+			name: "Sad case: impossible temperature",
+			args: args{
+				concept: "5088",
+				value:   370,
+				unit:    "Cel",
+				patient: referenceranges.Patient{Sex: "male", BirthDate: &adult},
+			},
+			wantErr:   true,
+			wantField: true,
+		},
+		{
+			name: "Sad case: impossible weight",
+			args: args{
+				concept: "5089",
+				value:   0,
+				unit:    "kg",
+			},
+			wantErr:   true,
+			wantField: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := referenceranges.NewServiceReferenceRanges()
+
+			got, err := s.Interpret(tt.args.concept, tt.args.value, tt.args.unit, tt.args.patient, on)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceReferenceRangesImpl.Interpret() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			var fieldErr dto.FieldError
+			if tt.wantField && (!errors.As(err, &fieldErr) || fieldErr.Field != "value") {
+				t.Errorf("expected a field error on the value, got %v", err)
+				return
+			}
+
+			if tt.want == nil && got != nil {
+				t.Errorf("expected no interpretation, got %v", got.Flag)
+				return
+			}
+
+			if tt.want != nil && (got == nil || got.Flag != *tt.want) {
+				t.Errorf("ServiceReferenceRangesImpl.Interpret() = %v, want %v", got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceReferenceRangesImpl_LoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	validRanges := filepath.Join(dir, "valid.json")
+	err := os.WriteFile(validRanges, []byte(`{"name": "test", "concepts": [{"concept": "5089", "name": "weight", "unit": "kg", "limits": {"low": 1, "high": 300}, "ranges": [{"sex": "female", "low": 45, "high": 90}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write ranges: %s", err)
+	}
+
+	unorderedRanges := filepath.Join(dir, "unordered.json")
+	err = os.WriteFile(unorderedRanges, []byte(`{"name": "test", "concepts": [{"concept": "5089", "name": "weight", "unit": "kg", "ranges": [{"low": 90, "high": 45}]}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write ranges: %s", err)
+	}
+
+	duplicateConcepts := filepath.Join(dir, "duplicate.json")
+	err = os.WriteFile(duplicateConcepts, []byte(`{"name": "test", "concepts": [{"concept": "5089", "name": "weight", "unit": "kg"}, {"concept": "5089", "name": "weight", "unit": "kg"}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write ranges: %s", err)
+	}
+
+	noUnit := filepath.Join(dir, "no_unit.json")
+	err = os.WriteFile(noUnit, []byte(`{"name": "test", "concepts": [{"concept": "5089", "name": "weight"}]}`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write ranges: %s", err)
+	}
+
+	malformed := filepath.Join(dir, "malformed.json")
+	err = os.WriteFile(malformed, []byte(`{`), 0600)
+	if err != nil {
+		t.Fatalf("unable to write ranges: %s", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: load reference ranges",
+			path:    validRanges,
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: missing file",
+			path:    filepath.Join(dir, "missing.json"),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: unordered ranges",
+			path:    unorderedRanges,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: concept defined more than once",
+			path:    duplicateConcepts,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: concept without a unit",
+			path:    noUnit,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: malformed ranges",
+			path:    malformed,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := referenceranges.NewServiceReferenceRanges()
+
+			err := s.LoadFile(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceReferenceRangesImpl.LoadFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				now := time.Now()
+
+				got, err := s.Interpret("5089", 95, "kg", referenceranges.Patient{Sex: "female"}, now)
+				if err != nil || got == nil || got.Flag != dto.ObservationInterpretationHigh {
+					t.Errorf("expected the loaded ranges to replace the weight ranges, got %v, %v", got, err)
+				}
+
+				got, err = s.Interpret("5088", 38.5, "Cel", referenceranges.Patient{Sex: "female"}, now)
+				if err != nil || got == nil || got.Flag != dto.ObservationInterpretationHigh {
+					t.Errorf("expected the default temperature ranges to be kept, got %v, %v", got, err)
+				}
+			}
+		})
+	}
+}
@@ -3,13 +3,17 @@ package mock
 import (
 	"context"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 )
 
 // FakeUpload is a mock of the fake upload
 type FakeUpload struct {
-	MockUploadMediaFn func(ctx context.Context, name string, file io.Reader, contentType string) (*dto.Media, error)
+	MockUploadMediaFn  func(ctx context.Context, name string, file io.Reader, contentType string) (*dto.Media, error)
+	MockGetSignedURLFn func(ctx context.Context, name string, expiry time.Duration) (string, error)
+	MockReadMediaFn    func(ctx context.Context, name string) (io.ReadCloser, error)
 }
 
 // NewFakeUploadMock initializes a new instance of upload mock
@@ -20,6 +24,12 @@ func NewFakeUploadMock() *FakeUpload {
 				URL: "https://google.com",
 			}, nil
 		},
+		MockGetSignedURLFn: func(ctx context.Context, name string, expiry time.Duration) (string, error) {
+			return "https://storage.googleapis.com/clinical/" + name, nil
+		},
+		MockReadMediaFn: func(ctx context.Context, name string) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("signed consent form")), nil
+		},
 	}
 }
 
@@ -27,3 +37,13 @@ func NewFakeUploadMock() *FakeUpload {
 func (u *FakeUpload) UploadMedia(ctx context.Context, name string, file io.Reader, contentType string) (*dto.Media, error) {
 	return u.MockUploadMediaFn(ctx, name, file, contentType)
 }
+
+// GetSignedURL is a mock implementation of generating a signed URL for an uploaded object
+func (u *FakeUpload) GetSignedURL(ctx context.Context, name string, expiry time.Duration) (string, error) {
+	return u.MockGetSignedURLFn(ctx, name, expiry)
+}
+
+// ReadMedia is a mock implementation of reading an uploaded object
+func (u *FakeUpload) ReadMedia(ctx context.Context, name string) (io.ReadCloser, error) {
+	return u.MockReadMediaFn(ctx, name)
+}
@@ -3,6 +3,7 @@ package upload
 import (
 	"context"
 	"io"
+	"net/http"
 	"time"
 
 	"cloud.google.com/go/storage"
@@ -14,6 +15,8 @@ import (
 // ServiceUpload holds the upload service methods
 type ServiceUpload interface {
 	UploadMedia(ctx context.Context, name string, file io.Reader, contentType string) (*dto.Media, error)
+	GetSignedURL(ctx context.Context, name string, expiry time.Duration) (string, error)
+	ReadMedia(ctx context.Context, name string) (io.ReadCloser, error)
 }
 
 // ServiceUploadImpl represents upload service implementations
@@ -77,3 +80,33 @@ func (u *ServiceUploadImpl) UploadMedia(ctx context.Context, name string, file i
 
 	return output, nil
 }
+
+// GetSignedURL generates a time limited URL that can be used to download an uploaded object
+func (u *ServiceUploadImpl) GetSignedURL(_ context.Context, name string, expiry time.Duration) (string, error) {
+	bucketName := serverutils.MustGetEnvVar("CLINICAL_BUCKET_NAME")
+
+	opts := &storage.SignedURLOptions{
+		Scheme:  storage.SigningSchemeV4,
+		Method:  http.MethodGet,
+		Expires: time.Now().Add(expiry),
+	}
+
+	url, err := u.Client.Bucket(bucketName).SignedURL(name, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return url, nil
+}
+
+// ReadMedia opens an uploaded object for reading. The caller must close the returned reader
+func (u *ServiceUploadImpl) ReadMedia(ctx context.Context, name string) (io.ReadCloser, error) {
+	bucketName := serverutils.MustGetEnvVar("CLINICAL_BUCKET_NAME")
+
+	reader, err := u.Client.Bucket(bucketName).Object(name).NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return reader, nil
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload"
@@ -47,3 +48,77 @@ func TestServiceUploadImpl_UploadMedia(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceUploadImpl_GetSignedURL(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		name   string
+		expiry time.Duration
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get signed url",
+			args: args{
+				ctx:    context.Background(),
+				name:   gofakeit.BeerName(),
+				expiry: 15 * time.Minute,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUpload := upload.NewServiceUpload(context.Background())
+
+			got, err := fakeUpload.GetSignedURL(tt.args.ctx, tt.args.name, tt.args.expiry)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceUploadImpl.GetSignedURL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got == "" {
+				t.Errorf("expected a signed url")
+			}
+		})
+	}
+}
+
+func TestServiceUploadImpl_ReadMedia(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		name string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Sad case: object does not exist",
+			args: args{
+				ctx:  context.Background(),
+				name: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUpload := upload.NewServiceUpload(context.Background())
+
+			got, err := fakeUpload.ReadMedia(tt.args.ctx, tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceUploadImpl.ReadMedia() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				got.Close()
+			}
+		})
+	}
+}
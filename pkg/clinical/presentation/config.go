@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/pubsub"
@@ -14,19 +15,26 @@ import (
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/savannahghi/authutils"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
 	"github.com/savannahghi/clinical/pkg/clinical/application/extensions"
 	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
 	fhir "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare"
 	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/fhirdataset"
 	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/earlywarning"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/growthstandards"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/immunizationschedule"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/interactions"
 	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab"
 	pubsubmessaging "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/referenceranges"
 	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload"
 	"github.com/savannahghi/clinical/pkg/clinical/presentation/graph"
 	"github.com/savannahghi/clinical/pkg/clinical/presentation/graph/generated"
 	"github.com/savannahghi/clinical/pkg/clinical/presentation/rest"
 	"github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
 	"github.com/savannahghi/serverutils"
+	"github.com/vektah/gqlparser/v2/ast"
 	"google.golang.org/api/healthcare/v1"
 )
 
@@ -125,6 +133,46 @@ func StartServer(
 
 	infrastructure := infrastructure.NewInfrastructureInteractor(baseExtension, fhir, ocl, upload, pubsubSvc, advantageSvc)
 
+	interactionTablePath, err := baseExtension.GetEnvVar(interactions.TablePathEnvVarName)
+	if err == nil && interactionTablePath != "" {
+		err = infrastructure.Interactions.LoadFile(interactionTablePath)
+		if err != nil {
+			serverutils.LogStartupError(ctx, fmt.Errorf("failed to load the interaction table: %w", err))
+		}
+	}
+
+	immunizationSchedulePath, err := baseExtension.GetEnvVar(immunizationschedule.SchedulePathEnvVarName)
+	if err == nil && immunizationSchedulePath != "" {
+		err = infrastructure.Immunizations.LoadFile(immunizationSchedulePath)
+		if err != nil {
+			serverutils.LogStartupError(ctx, fmt.Errorf("failed to load the immunization schedule: %w", err))
+		}
+	}
+
+	referenceRangesPath, err := baseExtension.GetEnvVar(referenceranges.RangesPathEnvVarName)
+	if err == nil && referenceRangesPath != "" {
+		err = infrastructure.ReferenceRanges.LoadFile(referenceRangesPath)
+		if err != nil {
+			serverutils.LogStartupError(ctx, fmt.Errorf("failed to load the reference ranges: %w", err))
+		}
+	}
+
+	growthStandardsPath, err := baseExtension.GetEnvVar(growthstandards.StandardsPathEnvVarName)
+	if err == nil && growthStandardsPath != "" {
+		err = infrastructure.GrowthStandards.LoadFile(growthStandardsPath)
+		if err != nil {
+			serverutils.LogStartupError(ctx, fmt.Errorf("failed to load the growth standards: %w", err))
+		}
+	}
+
+	earlyWarningScoresPath, err := baseExtension.GetEnvVar(earlywarning.ScoresPathEnvVarName)
+	if err == nil && earlyWarningScoresPath != "" {
+		err = infrastructure.EarlyWarningScores.LoadFile(earlyWarningScoresPath)
+		if err != nil {
+			serverutils.LogStartupError(ctx, fmt.Errorf("failed to load the early warning scores: %w", err))
+		}
+	}
+
 	usecases := clinical.NewUseCasesClinicalImpl(infrastructure)
 
 	r := gin.Default()
@@ -158,6 +206,7 @@ func SetupRoutes(r *gin.Engine, cacheStore persist.CacheStore, authclient *authu
 			"X-Authorization",
 			"Clinical-Organization-ID",
 			"Clinical-Facility-ID",
+			rest.ServiceAccountKeyHeader,
 		},
 		ExposeHeaders:    []string{"Content-Length", "Link"},
 		AllowCredentials: true,
@@ -171,8 +220,9 @@ func SetupRoutes(r *gin.Engine, cacheStore persist.CacheStore, authclient *authu
 	handlers := rest.NewPresentationHandlers(usecases, infra.BaseExtension, infra.AdvantageService)
 
 	graphQL := r.Group("/graphql")
-	graphQL.Use(rest.AuthenticationGinMiddleware(cacheStore, *authclient))
+	graphQL.Use(rest.AuthenticationGinMiddleware(cacheStore, *authclient, &usecases))
 	graphQL.Use(rest.TenantIdentifierExtractionMiddleware(infra.FHIR))
+	graphQL.Use(rest.PatientScopeMiddleware(infra.FHIR))
 	graphQL.Any("", GQLHandler(usecases))
 
 	// Unauthenticated routes
@@ -182,7 +232,9 @@ func SetupRoutes(r *gin.Engine, cacheStore persist.CacheStore, authclient *authu
 	r.POST("/pubsub", handlers.ReceivePubSubPushMessage)
 
 	apis := r.Group("/api")
-	apis.Use(rest.AuthenticationGinMiddleware(cacheStore, *authclient))
+	apis.Use(rest.AuthenticationGinMiddleware(cacheStore, *authclient, &usecases))
+	apis.Use(rest.DenyPatientScopedTokenMiddleware())
+	apis.Use(rest.ServiceAccountScopeMiddleware(&usecases))
 
 	v1 := apis.Group("/v1")
 
@@ -193,24 +245,64 @@ func SetupRoutes(r *gin.Engine, cacheStore persist.CacheStore, authclient *authu
 	facilities.POST("", handlers.RegisterFacility)
 
 	upload := v1.Group("/media")
-	upload.Use(rest.AuthenticationGinMiddleware(cacheStore, *authclient))
+	upload.Use(rest.AuthenticationGinMiddleware(cacheStore, *authclient, &usecases))
 	upload.Use(rest.TenantIdentifierExtractionMiddleware(infra.FHIR))
 	upload.POST("", handlers.UploadMedia)
 
 	questionnaire := v1.Group("/questionnaire")
-	questionnaire.Use(rest.AuthenticationGinMiddleware(cacheStore, *authclient))
+	questionnaire.Use(rest.AuthenticationGinMiddleware(cacheStore, *authclient, &usecases))
 	questionnaire.Use(rest.TenantIdentifierExtractionMiddleware(infra.FHIR))
 	questionnaire.POST("", handlers.LoadQuestionnaire)
 
 	questionnaireList := v1.Group("/questionnaires")
-	questionnaireList.Use(rest.AuthenticationGinMiddleware(cacheStore, *authclient))
+	questionnaireList.Use(rest.AuthenticationGinMiddleware(cacheStore, *authclient, &usecases))
 	questionnaireList.Use(rest.TenantIdentifierExtractionMiddleware(infra.FHIR))
 	questionnaireList.GET("", handlers.ListQuestionnaire)
 
 	referralReport := v1.Group("/referral-report")
-	referralReport.Use(rest.AuthenticationGinMiddleware(cacheStore, *authclient))
+	referralReport.Use(rest.AuthenticationGinMiddleware(cacheStore, *authclient, &usecases))
 	referralReport.Use(rest.TenantIdentifierExtractionMiddleware(infra.FHIR))
 	referralReport.GET("", handlers.GenerateReferralReport)
+
+	serviceAccounts := v1.Group("/service-accounts")
+	serviceAccounts.Use(rest.RequireScopeMiddleware(common.ServiceAccountAdminScope))
+	serviceAccounts.Use(rest.TenantIdentifierExtractionMiddleware(infra.FHIR))
+	serviceAccounts.POST("", handlers.CreateServiceAccount)
+	serviceAccounts.GET("", handlers.ListServiceAccounts)
+	serviceAccounts.POST("/:id/rotate", handlers.RotateServiceAccountKey)
+	serviceAccounts.POST("/:id/revoke", handlers.RevokeServiceAccount)
+
+	usecases.RegisterServiceAccountOperations(serviceAccountOperations(r)...)
+}
+
+// serviceAccountOperations are the GraphQL queries and mutations and the REST routes under /api that service accounts can be
+// granted as scopes. REST routes are named as the service account middleware names them e.g `POST:/api/v1/media`
+func serviceAccountOperations(r *gin.Engine) []string {
+	operations := []string{}
+
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+
+	for _, definition := range []*ast.Definition{schema.Query, schema.Mutation} {
+		if definition == nil {
+			continue
+		}
+
+		for _, field := range definition.Fields {
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			operations = append(operations, field.Name)
+		}
+	}
+
+	for _, route := range r.Routes() {
+		if strings.HasPrefix(route.Path, "/api/") {
+			operations = append(operations, fmt.Sprintf("%s:%s", route.Method, route.Path))
+		}
+	}
+
+	return operations
 }
 
 // GQLHandler sets up a GraphQL resolver
@@ -227,6 +319,9 @@ func GQLHandler(service clinical.UseCasesClinicalImpl) gin.HandlerFunc {
 			},
 		),
 	)
+	server.AroundRootFields(graph.PatientScopeFieldMiddleware)
+	server.AroundRootFields(resolver.ServiceAccountScopeFieldMiddleware)
+	server.SetErrorPresenter(graph.ErrorPresenter)
 
 	return func(ctx *gin.Context) {
 		server.ServeHTTP(ctx.Writer, ctx.Request)
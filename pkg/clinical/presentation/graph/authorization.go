@@ -0,0 +1,140 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/savannahghi/authutils"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/application/utils"
+	"github.com/sirupsen/logrus"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// patientScopedQueries lists the root queries that a patient-scoped token is allowed to call
+// and how the patient ID is read from each query's arguments
+var patientScopedQueries = map[string]func(args map[string]interface{}) string{
+	"patientHealthTimeline":                   patientIDFromInput,
+	"getMedicalData":                          patientIDFromArgs,
+	"getPatientTemperatureEntries":            patientIDFromArgs,
+	"getPatientBloodPressureEntries":          patientIDFromArgs,
+	"getPatientHeightEntries":                 patientIDFromArgs,
+	"getPatientRespiratoryRateEntries":        patientIDFromArgs,
+	"getPatientPulseRateEntries":              patientIDFromArgs,
+	"getPatientBMIEntries":                    patientIDFromArgs,
+	"getPatientWeightEntries":                 patientIDFromArgs,
+	"getPatientMuacEntries":                   patientIDFromArgs,
+	"getPatientOxygenSaturationEntries":       patientIDFromArgs,
+	"getPatientViralLoad":                     patientIDFromArgs,
+	"getPatientBloodSugarEntries":             patientIDFromArgs,
+	"getPatientLastMenstrualPeriodEntries":    patientIDFromArgs,
+	"getPatientDiastolicBloodPressureEntries": patientIDFromArgs,
+	"getPatientBloodPressureReadings":         patientIDFromArgs,
+	"getPatientGrowthChart":                   patientIDFromArgs,
+	"listPatientMedia":                        patientIDFromArgs,
+}
+
+func forbidden(ctx context.Context, message string) graphql.Marshaler {
+	graphql.AddError(ctx, &gqlerror.Error{
+		Message:    message,
+		Path:       graphql.GetPath(ctx),
+		Extensions: map[string]interface{}{"code": "FORBIDDEN"},
+	})
+
+	return graphql.Null
+}
+
+func isIntrospectionField(field *graphql.RootFieldContext) bool {
+	return field.Object == "Query" && (field.Field.Name == "__schema" || field.Field.Name == "__type" || field.Field.Name == "__typename")
+}
+
+func patientIDFromArgs(args map[string]interface{}) string {
+	patientID, _ := args["patientID"].(string)
+
+	return patientID
+}
+
+func patientIDFromInput(args map[string]interface{}) string {
+	input, _ := args["input"].(map[string]interface{})
+
+	return patientIDFromArgs(input)
+}
+
+// PatientScopeFieldMiddleware restricts requests made with a patient-scoped token to the queries in
+// patientScopedQueries, and only for the patient the token belongs to. Requests made with other tokens
+// are not affected
+func PatientScopeFieldMiddleware(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
+	scopedPatientID, ok := ctx.Value(utils.PatientIDContextKey).(string)
+	if !ok {
+		return next(ctx)
+	}
+
+	field := graphql.GetRootFieldContext(ctx)
+	if field == nil {
+		return next(ctx)
+	}
+
+	// introspection is allowed so that clients can discover the schema
+	if isIntrospectionField(field) {
+		return next(ctx)
+	}
+
+	patientIDFn, allowed := patientScopedQueries[field.Field.Name]
+	if field.Object != "Query" || !allowed {
+		return forbidden(ctx, fmt.Sprintf("forbidden: %s is not allowed for patient-scoped tokens", field.Field.Name))
+	}
+
+	args := field.Field.ArgumentMap(graphql.GetOperationContext(ctx).Variables)
+	if patientIDFn(args) != scopedPatientID {
+		return forbidden(ctx, "forbidden: patient-scoped tokens can only access their own patient record")
+	}
+
+	return next(ctx)
+}
+
+// ServiceAccountScopeFieldMiddleware restricts requests authenticated using a service account to the queries and
+// mutations in the service account's scopes. Every operation is recorded as an audit event
+func (r *Resolver) ServiceAccountScopeFieldMiddleware(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
+	token, ok := ctx.Value(authutils.AuthTokenContextKey).(*authutils.TokenIntrospectionResponse)
+	if !ok || token == nil || token.ClientID != common.ServiceAccountClientID {
+		return next(ctx)
+	}
+
+	field := graphql.GetRootFieldContext(ctx)
+	if field == nil || isIntrospectionField(field) {
+		return next(ctx)
+	}
+
+	operation := field.Field.Name
+
+	authorized := false
+
+	for _, scope := range strings.Fields(token.Scope) {
+		if scope == operation {
+			authorized = true
+			break
+		}
+	}
+
+	organizationID, _ := ctx.Value(utils.OrganizationIDContextKey).(string)
+	facilityID, _ := ctx.Value(utils.FacilityIDContextKey).(string)
+
+	tenant := dto.TenantIdentifiers{
+		OrganizationID: organizationID,
+		FacilityID:     facilityID,
+	}
+
+	err := r.usecases.RecordServiceAccountAudit(ctx, token.UserGUID, tenant, operation, authorized)
+	if err != nil {
+		logrus.Errorf("failed to record audit event for service account %s: %v", token.UserGUID, err)
+	}
+
+	if !authorized {
+		return forbidden(ctx, fmt.Sprintf("forbidden: the service account is not allowed to perform %s", operation))
+	}
+
+	return next(ctx)
+}
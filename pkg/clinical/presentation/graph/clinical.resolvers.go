@@ -32,9 +32,9 @@ func (r *mutationResolver) EndEpisodeOfCare(ctx context.Context, id string) (*dt
 }
 
 // StartEncounter is the resolver for the startEncounter field.
-func (r *mutationResolver) StartEncounter(ctx context.Context, episodeID string) (string, error) {
+func (r *mutationResolver) StartEncounter(ctx context.Context, episodeID string, locationID *string) (string, error) {
 	r.CheckDependencies()
-	return r.usecases.StartEncounter(ctx, episodeID)
+	return r.usecases.StartEncounter(ctx, episodeID, locationID)
 }
 
 // PatchEncounter is the resolver for the patchEncounter field.
@@ -128,6 +128,12 @@ func (r *mutationResolver) RecordDiastolicBloodPressure(ctx context.Context, inp
 	return r.usecases.RecordDiastolicBloodPressure(ctx, input)
 }
 
+// RecordBloodPressurePanel is the resolver for the recordBloodPressurePanel field.
+func (r *mutationResolver) RecordBloodPressurePanel(ctx context.Context, input dto.BloodPressurePanelInput) (*dto.BloodPressureReading, error) {
+	r.CheckDependencies()
+	return r.usecases.RecordBloodPressurePanel(ctx, input)
+}
+
 // RecordColposcopy is the resolver for the recordColposcopy field.
 func (r *mutationResolver) RecordColposcopy(ctx context.Context, input dto.ObservationInput) (*dto.Observation, error) {
 	return r.usecases.RecordColposcopy(ctx, input)
@@ -176,11 +182,47 @@ func (r *mutationResolver) CreateCondition(ctx context.Context, input dto.Condit
 	return r.usecases.CreateCondition(ctx, input)
 }
 
+// UpdateCondition is the resolver for the updateCondition field.
+func (r *mutationResolver) UpdateCondition(ctx context.Context, id string, input dto.ConditionUpdateInput) (*dto.Condition, error) {
+	r.CheckDependencies()
+	return r.usecases.UpdateCondition(ctx, id, input)
+}
+
+// ResolveCondition is the resolver for the resolveCondition field.
+func (r *mutationResolver) ResolveCondition(ctx context.Context, id string, abatementDate *scalarutils.Date, note *string) (*dto.Condition, error) {
+	r.CheckDependencies()
+	return r.usecases.ResolveCondition(ctx, id, abatementDate, note)
+}
+
+// MarkConditionEnteredInError is the resolver for the markConditionEnteredInError field.
+func (r *mutationResolver) MarkConditionEnteredInError(ctx context.Context, id string, reason *string) (*dto.Condition, error) {
+	r.CheckDependencies()
+	return r.usecases.MarkConditionEnteredInError(ctx, id, reason)
+}
+
 // CreateAllergyIntolerance is the resolver for the createAllergyIntolerance field.
 func (r *mutationResolver) CreateAllergyIntolerance(ctx context.Context, input dto.AllergyInput) (*dto.Allergy, error) {
 	return r.usecases.CreateAllergyIntolerance(ctx, input)
 }
 
+// UpdateAllergyIntolerance is the resolver for the updateAllergyIntolerance field.
+func (r *mutationResolver) UpdateAllergyIntolerance(ctx context.Context, id string, input dto.AllergyUpdateInput) (*dto.Allergy, error) {
+	r.CheckDependencies()
+	return r.usecases.UpdateAllergyIntolerance(ctx, id, input)
+}
+
+// RefuteAllergyIntolerance is the resolver for the refuteAllergyIntolerance field.
+func (r *mutationResolver) RefuteAllergyIntolerance(ctx context.Context, id string, note *string) (*dto.Allergy, error) {
+	r.CheckDependencies()
+	return r.usecases.RefuteAllergyIntolerance(ctx, id, note)
+}
+
+// MarkAllergyIntoleranceEnteredInError is the resolver for the markAllergyIntoleranceEnteredInError field.
+func (r *mutationResolver) MarkAllergyIntoleranceEnteredInError(ctx context.Context, id string, reason *string) (*dto.Allergy, error) {
+	r.CheckDependencies()
+	return r.usecases.MarkAllergyIntoleranceEnteredInError(ctx, id, reason)
+}
+
 // CreateComposition is the resolver for the createComposition field.
 func (r *mutationResolver) CreateComposition(ctx context.Context, input dto.CompositionInput) (*dto.Composition, error) {
 	r.CheckDependencies()
@@ -271,11 +313,23 @@ func (r *mutationResolver) PatchPatientBloodSugar(ctx context.Context, id string
 	return r.usecases.PatchPatientBloodSugar(ctx, id, value)
 }
 
+// MigratePatientObservationQuantities is the resolver for the migratePatientObservationQuantities field.
+func (r *mutationResolver) MigratePatientObservationQuantities(ctx context.Context, patientID string) (int, error) {
+	r.CheckDependencies()
+	return r.usecases.MigratePatientObservationQuantities(ctx, patientID)
+}
+
 // RecordConsent is the resolver for the recordConsent field.
 func (r *mutationResolver) RecordConsent(ctx context.Context, input dto.ConsentInput) (*dto.ConsentOutput, error) {
 	return r.usecases.RecordConsent(ctx, input)
 }
 
+// RevokeConsent is the resolver for the revokeConsent field.
+func (r *mutationResolver) RevokeConsent(ctx context.Context, id string, reason *string) (*dto.Consent, error) {
+	r.CheckDependencies()
+	return r.usecases.RevokeConsent(ctx, id, reason)
+}
+
 // CreateQuestionnaireResponse is the resolver for the createQuestionnaireResponse field.
 func (r *mutationResolver) CreateQuestionnaireResponse(ctx context.Context, questionnaireID string, encounterID string, input dto.QuestionnaireResponse) (string, error) {
 	return r.usecases.CreateQuestionnaireResponse(ctx, questionnaireID, encounterID, input)
@@ -312,8 +366,206 @@ func (r *mutationResolver) GetEncounterAssociatedResources(ctx context.Context,
 }
 
 // ReferPatient is the resolver for the referPatient field.
-func (r *mutationResolver) ReferPatient(ctx context.Context, input *dto.ReferralInput) (*dto.ServiceRequest, error) {
-	return r.usecases.ReferPatient(ctx, input)
+func (r *mutationResolver) ReferPatient(ctx context.Context, input dto.ReferralInput) (*dto.ServiceRequest, error) {
+	return r.usecases.ReferPatient(ctx, &input)
+}
+
+// PrescribeMedication is the resolver for the prescribeMedication field.
+func (r *mutationResolver) PrescribeMedication(ctx context.Context, input dto.PrescriptionInput) (*dto.Prescription, error) {
+	r.CheckDependencies()
+	return r.usecases.PrescribeMedication(ctx, input)
+}
+
+// DiscontinuePrescription is the resolver for the discontinuePrescription field.
+func (r *mutationResolver) DiscontinuePrescription(ctx context.Context, id string, reason string) (*dto.Prescription, error) {
+	r.CheckDependencies()
+	return r.usecases.DiscontinuePrescription(ctx, id, reason)
+}
+
+// RenewPrescription is the resolver for the renewPrescription field.
+func (r *mutationResolver) RenewPrescription(ctx context.Context, id string, encounterID string, overrideReason *string) (*dto.Prescription, error) {
+	r.CheckDependencies()
+	return r.usecases.RenewPrescription(ctx, id, encounterID, overrideReason)
+}
+
+// UpdateMedicationStatement is the resolver for the updateMedicationStatement field.
+func (r *mutationResolver) UpdateMedicationStatement(ctx context.Context, id string, input dto.MedicationStatementInput) (*dto.MedicationStatement, error) {
+	r.CheckDependencies()
+	return r.usecases.UpdateMedicationStatement(ctx, id, input)
+}
+
+// StopMedicationStatement is the resolver for the stopMedicationStatement field.
+func (r *mutationResolver) StopMedicationStatement(ctx context.Context, id string, reason string) (*dto.MedicationStatement, error) {
+	r.CheckDependencies()
+	return r.usecases.StopMedicationStatement(ctx, id, reason)
+}
+
+// RecordMedicationAdherence is the resolver for the recordMedicationAdherence field.
+func (r *mutationResolver) RecordMedicationAdherence(ctx context.Context, input dto.MedicationAdherenceInput) (*dto.MedicationAdherence, error) {
+	r.CheckDependencies()
+	return r.usecases.RecordMedicationAdherence(ctx, input)
+}
+
+// DispenseMedication is the resolver for the dispenseMedication field.
+func (r *mutationResolver) DispenseMedication(ctx context.Context, input dto.MedicationDispenseInput) (*dto.MedicationDispense, error) {
+	r.CheckDependencies()
+	return r.usecases.DispenseMedication(ctx, input)
+}
+
+// RecordImmunization is the resolver for the recordImmunization field.
+func (r *mutationResolver) RecordImmunization(ctx context.Context, input dto.ImmunizationInput) (*dto.Immunization, error) {
+	r.CheckDependencies()
+	return r.usecases.RecordImmunization(ctx, input)
+}
+
+// OrderLabTest is the resolver for the orderLabTest field.
+func (r *mutationResolver) OrderLabTest(ctx context.Context, input dto.LabOrderInput) (*dto.LabOrder, error) {
+	r.CheckDependencies()
+	return r.usecases.OrderLabTest(ctx, input)
+}
+
+// RevokeLabOrder is the resolver for the revokeLabOrder field.
+func (r *mutationResolver) RevokeLabOrder(ctx context.Context, id string, reason string) (*dto.LabOrder, error) {
+	r.CheckDependencies()
+	return r.usecases.RevokeLabOrder(ctx, id, reason)
+}
+
+// CollectSpecimen is the resolver for the collectSpecimen field.
+func (r *mutationResolver) CollectSpecimen(ctx context.Context, input dto.SpecimenInput) (*dto.Specimen, error) {
+	r.CheckDependencies()
+	return r.usecases.CollectSpecimen(ctx, input)
+}
+
+// UpdateSpecimenCustody is the resolver for the updateSpecimenCustody field.
+func (r *mutationResolver) UpdateSpecimenCustody(ctx context.Context, input dto.SpecimenCustodyInput) (*dto.Specimen, error) {
+	r.CheckDependencies()
+	return r.usecases.UpdateSpecimenCustody(ctx, input)
+}
+
+// RecordProcedure is the resolver for the recordProcedure field.
+func (r *mutationResolver) RecordProcedure(ctx context.Context, input dto.ProcedureInput) (*dto.Procedure, error) {
+	r.CheckDependencies()
+	return r.usecases.RecordProcedure(ctx, input)
+}
+
+// CreateGoal is the resolver for the createGoal field.
+func (r *mutationResolver) CreateGoal(ctx context.Context, input dto.GoalInput) (*dto.Goal, error) {
+	r.CheckDependencies()
+	return r.usecases.CreateGoal(ctx, input)
+}
+
+// UpdateGoal is the resolver for the updateGoal field.
+func (r *mutationResolver) UpdateGoal(ctx context.Context, id string, input dto.GoalUpdateInput) (*dto.Goal, error) {
+	r.CheckDependencies()
+	return r.usecases.UpdateGoal(ctx, id, input)
+}
+
+// CreateCarePlan is the resolver for the createCarePlan field.
+func (r *mutationResolver) CreateCarePlan(ctx context.Context, input dto.CarePlanInput) (*dto.CarePlan, error) {
+	r.CheckDependencies()
+	return r.usecases.CreateCarePlan(ctx, input)
+}
+
+// UpdateCarePlan is the resolver for the updateCarePlan field.
+func (r *mutationResolver) UpdateCarePlan(ctx context.Context, id string, input dto.CarePlanUpdateInput) (*dto.CarePlan, error) {
+	r.CheckDependencies()
+	return r.usecases.UpdateCarePlan(ctx, id, input)
+}
+
+// CreateSchedule is the resolver for the createSchedule field.
+func (r *mutationResolver) CreateSchedule(ctx context.Context, input dto.ScheduleInput) (*dto.Schedule, error) {
+	r.CheckDependencies()
+	return r.usecases.CreateSchedule(ctx, input)
+}
+
+// CreateSlots is the resolver for the createSlots field.
+func (r *mutationResolver) CreateSlots(ctx context.Context, input dto.SlotsInput) ([]*dto.Slot, error) {
+	r.CheckDependencies()
+	return r.usecases.CreateSlots(ctx, input)
+}
+
+// BookAppointment is the resolver for the bookAppointment field.
+func (r *mutationResolver) BookAppointment(ctx context.Context, input dto.AppointmentInput) (*dto.Appointment, error) {
+	r.CheckDependencies()
+	return r.usecases.BookAppointment(ctx, input)
+}
+
+// RescheduleAppointment is the resolver for the rescheduleAppointment field.
+func (r *mutationResolver) RescheduleAppointment(ctx context.Context, id string, slotID string) (*dto.Appointment, error) {
+	r.CheckDependencies()
+	return r.usecases.RescheduleAppointment(ctx, id, slotID)
+}
+
+// CancelAppointment is the resolver for the cancelAppointment field.
+func (r *mutationResolver) CancelAppointment(ctx context.Context, id string, reason string) (*dto.Appointment, error) {
+	r.CheckDependencies()
+	return r.usecases.CancelAppointment(ctx, id, reason)
+}
+
+// StartAppointmentEncounter is the resolver for the startAppointmentEncounter field.
+func (r *mutationResolver) StartAppointmentEncounter(ctx context.Context, appointmentID string, episodeID string) (string, error) {
+	r.CheckDependencies()
+	return r.usecases.StartAppointmentEncounter(ctx, appointmentID, episodeID)
+}
+
+// RegisterPractitioner is the resolver for the registerPractitioner field.
+func (r *mutationResolver) RegisterPractitioner(ctx context.Context, input dto.PractitionerInput) (*dto.Practitioner, error) {
+	r.CheckDependencies()
+	return r.usecases.RegisterPractitioner(ctx, input)
+}
+
+// RegisterCurrentPractitioner is the resolver for the registerCurrentPractitioner field.
+func (r *mutationResolver) RegisterCurrentPractitioner(ctx context.Context, input dto.PractitionerProfileInput) (*dto.Practitioner, error) {
+	r.CheckDependencies()
+	return r.usecases.RegisterCurrentPractitioner(ctx, input)
+}
+
+// UpdatePractitioner is the resolver for the updatePractitioner field.
+func (r *mutationResolver) UpdatePractitioner(ctx context.Context, id string, input dto.PractitionerUpdateInput) (*dto.Practitioner, error) {
+	r.CheckDependencies()
+	return r.usecases.UpdatePractitioner(ctx, id, input)
+}
+
+// AssignPractitionerRole is the resolver for the assignPractitionerRole field.
+func (r *mutationResolver) AssignPractitionerRole(ctx context.Context, input dto.PractitionerRoleInput) (*dto.PractitionerRole, error) {
+	r.CheckDependencies()
+	return r.usecases.AssignPractitionerRole(ctx, input)
+}
+
+// EndPractitionerRole is the resolver for the endPractitionerRole field.
+func (r *mutationResolver) EndPractitionerRole(ctx context.Context, id string) (*dto.PractitionerRole, error) {
+	r.CheckDependencies()
+	return r.usecases.EndPractitionerRole(ctx, id)
+}
+
+// CreateLocation is the resolver for the createLocation field.
+func (r *mutationResolver) CreateLocation(ctx context.Context, input dto.LocationInput) (*dto.Location, error) {
+	r.CheckDependencies()
+	return r.usecases.CreateLocation(ctx, input)
+}
+
+// UpdateLocation is the resolver for the updateLocation field.
+func (r *mutationResolver) UpdateLocation(ctx context.Context, id string, input dto.LocationUpdateInput) (*dto.Location, error) {
+	r.CheckDependencies()
+	return r.usecases.UpdateLocation(ctx, id, input)
+}
+
+// RecordFamilyMemberHistory is the resolver for the recordFamilyMemberHistory field.
+func (r *mutationResolver) RecordFamilyMemberHistory(ctx context.Context, input dto.FamilyMemberHistoryInput) (*dto.FamilyMemberHistory, error) {
+	r.CheckDependencies()
+	return r.usecases.RecordFamilyMemberHistory(ctx, input)
+}
+
+// UpdateFamilyMemberHistory is the resolver for the updateFamilyMemberHistory field.
+func (r *mutationResolver) UpdateFamilyMemberHistory(ctx context.Context, id string, input dto.FamilyMemberHistoryInput) (*dto.FamilyMemberHistory, error) {
+	r.CheckDependencies()
+	return r.usecases.UpdateFamilyMemberHistory(ctx, id, input)
+}
+
+// DeleteFamilyMemberHistory is the resolver for the deleteFamilyMemberHistory field.
+func (r *mutationResolver) DeleteFamilyMemberHistory(ctx context.Context, id string) (bool, error) {
+	r.CheckDependencies()
+	return r.usecases.DeleteFamilyMemberHistory(ctx, id)
 }
 
 // PatientHealthTimeline is the resolver for the patientHealthTimeline field.
@@ -342,6 +594,12 @@ func (r *queryResolver) ListPatientConditions(ctx context.Context, patientID str
 	return r.usecases.ListPatientConditions(ctx, patientID, encounterID, date, pagination)
 }
 
+// PatientProblemList is the resolver for the patientProblemList field.
+func (r *queryResolver) PatientProblemList(ctx context.Context, patientID string) (*dto.ProblemList, error) {
+	r.CheckDependencies()
+	return r.usecases.PatientProblemList(ctx, patientID)
+}
+
 // ListPatientCompositions is the resolver for the listPatientCompositions field.
 func (r *queryResolver) ListPatientCompositions(ctx context.Context, patientID string, encounterID *string, date *scalarutils.Date, pagination dto.Pagination) (*dto.CompositionConnection, error) {
 	r.CheckDependencies()
@@ -431,6 +689,18 @@ func (r *queryResolver) GetPatientDiastolicBloodPressureEntries(ctx context.Cont
 	return r.usecases.GetPatientDiastolicBloodPressureEntries(ctx, patientID, encounterID, date, &pagination)
 }
 
+// GetPatientBloodPressureReadings is the resolver for the getPatientBloodPressureReadings field.
+func (r *queryResolver) GetPatientBloodPressureReadings(ctx context.Context, patientID string, encounterID *string, date *scalarutils.Date) ([]*dto.BloodPressureReading, error) {
+	r.CheckDependencies()
+	return r.usecases.GetPatientBloodPressureReadings(ctx, patientID, encounterID, date)
+}
+
+// GetPatientGrowthChart is the resolver for the getPatientGrowthChart field.
+func (r *queryResolver) GetPatientGrowthChart(ctx context.Context, patientID string, indicator dto.GrowthIndicatorEnum) (*dto.GrowthChart, error) {
+	r.CheckDependencies()
+	return r.usecases.GetPatientGrowthChart(ctx, patientID, indicator)
+}
+
 // SearchAllergy is the resolver for the searchAllergy field.
 func (r *queryResolver) SearchAllergy(ctx context.Context, name string, pagination dto.Pagination) (*dto.TerminologyConnection, error) {
 	r.CheckDependencies()
@@ -466,6 +736,180 @@ func (r *queryResolver) GetQuestionnaireResponseRiskLevel(ctx context.Context, e
 	return r.usecases.GetQuestionnaireResponseRiskLevel(ctx, encounterID, screeningType)
 }
 
+// ListPatientConsents is the resolver for the listPatientConsents field.
+func (r *queryResolver) ListPatientConsents(ctx context.Context, patientID string, category *dto.ConsentCategoryEnum, status *dto.ConsentStatusEnum) ([]*dto.Consent, error) {
+	r.CheckDependencies()
+	return r.usecases.ListPatientConsents(ctx, patientID, category, status)
+}
+
+// ListPatientPrescriptions is the resolver for the listPatientPrescriptions field.
+func (r *queryResolver) ListPatientPrescriptions(ctx context.Context, patientID string, status *dto.MedicationRequestStatusEnum, pagination dto.Pagination) (*dto.PrescriptionConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListPatientPrescriptions(ctx, patientID, status, pagination)
+}
+
+// CheckMedicationInteractions is the resolver for the checkMedicationInteractions field.
+func (r *queryResolver) CheckMedicationInteractions(ctx context.Context, patientID string, medicationCode string, terminologySource dto.TerminologySource) ([]*dto.InteractionFinding, error) {
+	r.CheckDependencies()
+	return r.usecases.CheckMedicationInteractions(ctx, patientID, terminologySource, medicationCode)
+}
+
+// ListPatientMedicationStatements is the resolver for the listPatientMedicationStatements field.
+func (r *queryResolver) ListPatientMedicationStatements(ctx context.Context, patientID string, status *dto.MedicationStatementStatusEnum, pagination dto.Pagination) (*dto.MedicationStatementConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListPatientMedicationStatements(ctx, patientID, status, pagination)
+}
+
+// ListMedicationAdherence is the resolver for the listMedicationAdherence field.
+func (r *queryResolver) ListMedicationAdherence(ctx context.Context, medicationStatementID string) ([]*dto.MedicationAdherence, error) {
+	r.CheckDependencies()
+	return r.usecases.ListMedicationAdherence(ctx, medicationStatementID)
+}
+
+// MedicationReconciliation is the resolver for the medicationReconciliation field.
+func (r *queryResolver) MedicationReconciliation(ctx context.Context, patientID string) (*dto.MedicationReconciliation, error) {
+	r.CheckDependencies()
+	return r.usecases.MedicationReconciliation(ctx, patientID)
+}
+
+// ListPrescriptionDispenses is the resolver for the listPrescriptionDispenses field.
+func (r *queryResolver) ListPrescriptionDispenses(ctx context.Context, prescriptionID string) ([]*dto.MedicationDispense, error) {
+	r.CheckDependencies()
+	return r.usecases.ListPrescriptionDispenses(ctx, prescriptionID)
+}
+
+// PharmacyWorklist is the resolver for the pharmacyWorklist field.
+func (r *queryResolver) PharmacyWorklist(ctx context.Context, facilityID string, pagination dto.Pagination) (*dto.PharmacyWorklistConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.PharmacyWorklist(ctx, facilityID, pagination)
+}
+
+// ListPatientImmunizations is the resolver for the listPatientImmunizations field.
+func (r *queryResolver) ListPatientImmunizations(ctx context.Context, patientID string, pagination dto.Pagination) (*dto.ImmunizationConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListPatientImmunizations(ctx, patientID, pagination)
+}
+
+// PatientImmunizationRecommendations is the resolver for the patientImmunizationRecommendations field.
+func (r *queryResolver) PatientImmunizationRecommendations(ctx context.Context, patientID string) ([]*dto.ImmunizationRecommendation, error) {
+	r.CheckDependencies()
+	return r.usecases.PatientImmunizationRecommendations(ctx, patientID)
+}
+
+// ListPatientLabOrders is the resolver for the listPatientLabOrders field.
+func (r *queryResolver) ListPatientLabOrders(ctx context.Context, patientID string, status *dto.LabOrderStatusEnum, pagination dto.Pagination) (*dto.LabOrderConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListPatientLabOrders(ctx, patientID, status, pagination)
+}
+
+// ListOutstandingSpecimens is the resolver for the listOutstandingSpecimens field.
+func (r *queryResolver) ListOutstandingSpecimens(ctx context.Context, facilityID string, pagination dto.Pagination) (*dto.SpecimenConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListOutstandingSpecimens(ctx, facilityID, pagination)
+}
+
+// ListPatientProcedures is the resolver for the listPatientProcedures field.
+func (r *queryResolver) ListPatientProcedures(ctx context.Context, patientID string, pagination dto.Pagination) (*dto.ProcedureConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListPatientProcedures(ctx, patientID, pagination)
+}
+
+// GetGoal is the resolver for the getGoal field.
+func (r *queryResolver) GetGoal(ctx context.Context, id string) (*dto.Goal, error) {
+	r.CheckDependencies()
+	return r.usecases.GetGoal(ctx, id)
+}
+
+// ListPatientGoals is the resolver for the listPatientGoals field.
+func (r *queryResolver) ListPatientGoals(ctx context.Context, patientID string, pagination dto.Pagination) (*dto.GoalConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListPatientGoals(ctx, patientID, pagination)
+}
+
+// GetCarePlan is the resolver for the getCarePlan field.
+func (r *queryResolver) GetCarePlan(ctx context.Context, id string) (*dto.CarePlan, error) {
+	r.CheckDependencies()
+	return r.usecases.GetCarePlan(ctx, id)
+}
+
+// ListPatientCarePlans is the resolver for the listPatientCarePlans field.
+func (r *queryResolver) ListPatientCarePlans(ctx context.Context, patientID string, pagination dto.Pagination) (*dto.CarePlanConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListPatientCarePlans(ctx, patientID, pagination)
+}
+
+// ListFacilitySchedules is the resolver for the listFacilitySchedules field.
+func (r *queryResolver) ListFacilitySchedules(ctx context.Context, facilityID string, pagination dto.Pagination) (*dto.ScheduleConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListFacilitySchedules(ctx, facilityID, pagination)
+}
+
+// ListAvailableSlots is the resolver for the listAvailableSlots field.
+func (r *queryResolver) ListAvailableSlots(ctx context.Context, scheduleID string, pagination dto.Pagination) (*dto.SlotConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListAvailableSlots(ctx, scheduleID, pagination)
+}
+
+// GetAppointment is the resolver for the getAppointment field.
+func (r *queryResolver) GetAppointment(ctx context.Context, id string) (*dto.Appointment, error) {
+	r.CheckDependencies()
+	return r.usecases.GetAppointment(ctx, id)
+}
+
+// ListFacilityAppointments is the resolver for the listFacilityAppointments field.
+func (r *queryResolver) ListFacilityAppointments(ctx context.Context, facilityID string, filter dto.AppointmentFilterEnum, pagination dto.Pagination) (*dto.AppointmentConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListFacilityAppointments(ctx, facilityID, filter, pagination)
+}
+
+// GetPractitioner is the resolver for the getPractitioner field.
+func (r *queryResolver) GetPractitioner(ctx context.Context, id string) (*dto.Practitioner, error) {
+	r.CheckDependencies()
+	return r.usecases.GetPractitioner(ctx, id)
+}
+
+// GetCurrentPractitioner is the resolver for the getCurrentPractitioner field.
+func (r *queryResolver) GetCurrentPractitioner(ctx context.Context) (*dto.Practitioner, error) {
+	r.CheckDependencies()
+	return r.usecases.GetCurrentPractitioner(ctx)
+}
+
+// ListFacilityPractitioners is the resolver for the listFacilityPractitioners field.
+func (r *queryResolver) ListFacilityPractitioners(ctx context.Context, facilityID string, pagination dto.Pagination) (*dto.PractitionerRoleConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListFacilityPractitioners(ctx, facilityID, pagination)
+}
+
+// ListFacilities is the resolver for the listFacilities field.
+func (r *queryResolver) ListFacilities(ctx context.Context, organizationID string) ([]*dto.Organization, error) {
+	r.CheckDependencies()
+	return r.usecases.ListFacilities(ctx, organizationID)
+}
+
+// GetLocation is the resolver for the getLocation field.
+func (r *queryResolver) GetLocation(ctx context.Context, id string) (*dto.Location, error) {
+	r.CheckDependencies()
+	return r.usecases.GetLocation(ctx, id)
+}
+
+// ListFacilityLocations is the resolver for the listFacilityLocations field.
+func (r *queryResolver) ListFacilityLocations(ctx context.Context, facilityID string, pagination dto.Pagination) (*dto.LocationConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListFacilityLocations(ctx, facilityID, pagination)
+}
+
+// GetFamilyMemberHistory is the resolver for the getFamilyMemberHistory field.
+func (r *queryResolver) GetFamilyMemberHistory(ctx context.Context, id string) (*dto.FamilyMemberHistory, error) {
+	r.CheckDependencies()
+	return r.usecases.GetFamilyMemberHistory(ctx, id)
+}
+
+// ListPatientFamilyMemberHistories is the resolver for the listPatientFamilyMemberHistories field.
+func (r *queryResolver) ListPatientFamilyMemberHistories(ctx context.Context, patientID string, pagination dto.Pagination) (*dto.FamilyMemberHistoryConnection, error) {
+	r.CheckDependencies()
+	return r.usecases.ListPatientFamilyMemberHistories(ctx, patientID, pagination)
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
@@ -0,0 +1,27 @@
+package graph
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrorPresenter presents field errors with the field they were raised on so that clients can show them next to the input
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	presented := graphql.DefaultErrorPresenter(ctx, err)
+
+	var fieldErr dto.FieldError
+	if errors.As(err, &fieldErr) {
+		if presented.Extensions == nil {
+			presented.Extensions = map[string]interface{}{}
+		}
+
+		presented.Extensions["code"] = "INVALID_FIELD"
+		presented.Extensions["field"] = fieldErr.Field
+	}
+
+	return presented
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/chenyahui/gin-cache/persist"
 	"github.com/gin-gonic/gin"
 	"github.com/savannahghi/authutils"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 	"github.com/savannahghi/firebasetools"
 	"github.com/savannahghi/serverutils"
 	"github.com/sirupsen/logrus"
@@ -25,6 +27,9 @@ var (
 	introspectURL = serverutils.MustGetEnvVar("MYCAREHUB_INTROSPECT_URL")
 )
 
+// ServiceAccountKeyHeader is the header used by machine clients to pass their service account key
+const ServiceAccountKeyHeader = "X-API-Key"
+
 type IntrospectResponse struct {
 	Active bool   `json:"active"`
 	UserID string `json:"user_id"`
@@ -85,7 +90,75 @@ func HasValidMycarehubBearerToken(_ context.Context, r *http.Request) (bool, map
 		return false, serverutils.ErrorMap(err), nil
 	}
 
-	return true, nil, &authutils.TokenIntrospectionResponse{Token: token, UserGUID: introspection.UserID, IsValid: introspection.Active}
+	return true, nil, &authutils.TokenIntrospectionResponse{Token: token, ClientID: clientID, UserGUID: introspection.UserID, IsValid: introspection.Active}
+}
+
+// IsMycarehubToken checks whether the introspected token was issued to the myCareHub client.
+// Such tokens belong to patients and are restricted to the patient's own record
+func IsMycarehubToken(token *authutils.TokenIntrospectionResponse) bool {
+	return token != nil && token.ClientID != "" && token.ClientID == clientID
+}
+
+// ServiceAccountAuthenticator verifies service account keys
+type ServiceAccountAuthenticator interface {
+	AuthenticateServiceAccount(ctx context.Context, key string, tenant dto.TenantIdentifiers) (*dto.ServiceAccount, error)
+}
+
+// IsServiceAccountToken checks whether the request was authenticated using a service account key
+func IsServiceAccountToken(token *authutils.TokenIntrospectionResponse) bool {
+	return token != nil && token.ClientID == common.ServiceAccountClientID
+}
+
+// HasValidServiceAccountKey returns an authentication check function for machine clients that authenticate
+// using a service account key. Service accounts are bound to a tenant hence the tenant headers are required
+// and must match the tenant the service account was created in.
+// The scopes of the service account are passed along in the token's scope
+func HasValidServiceAccountKey(authenticator ServiceAccountAuthenticator) authCheckFn {
+	return func(ctx context.Context, r *http.Request) (bool, map[string]string, *authutils.TokenIntrospectionResponse) {
+		key := r.Header.Get(ServiceAccountKeyHeader)
+		if key == "" {
+			err := fmt.Errorf("expected `%s` header to be included in the request", ServiceAccountKeyHeader)
+			return false, serverutils.ErrorMap(err), nil
+		}
+
+		tenant := dto.TenantIdentifiers{
+			OrganizationID: r.Header.Get("Clinical-Organization-ID"),
+			FacilityID:     r.Header.Get("Clinical-Facility-ID"),
+		}
+
+		if tenant.OrganizationID == "" || tenant.FacilityID == "" {
+			err := fmt.Errorf("service accounts must include the tenant headers in the request")
+			return false, serverutils.ErrorMap(err), nil
+		}
+
+		account, err := authenticator.AuthenticateServiceAccount(ctx, key, tenant)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"organization_id": tenant.OrganizationID,
+				"facility_id":     tenant.FacilityID,
+				"method":          r.Method,
+				"path":            r.URL.Path,
+			}).Warnf("service account authentication failed: %v", err)
+
+			return false, serverutils.ErrorMap(err), nil
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"service_account_id":   account.ID,
+			"service_account_name": account.Name,
+			"organization_id":      tenant.OrganizationID,
+			"facility_id":          tenant.FacilityID,
+			"method":               r.Method,
+			"path":                 r.URL.Path,
+		}).Info("request authenticated using a service account")
+
+		return true, nil, &authutils.TokenIntrospectionResponse{
+			ClientID: common.ServiceAccountClientID,
+			UserGUID: account.ID,
+			Scope:    strings.Join(account.Scopes, " "),
+			IsValid:  true,
+		}
+	}
 }
 
 // authCheckFn is a function type for authorization and authentication checks
@@ -125,8 +198,8 @@ func HasValidCachedToken(cacheStore persist.CacheStore) authCheckFn {
 
 // AuthenticationGinMiddleware is an authentication middleware for servers using Gin. It checks the user token and ensures
 // that it is valid
-func AuthenticationGinMiddleware(cacheStore persist.CacheStore, cl authutils.Client) gin.HandlerFunc {
-	checkFuncs := []authCheckFn{HasValidCachedToken(cacheStore), cl.HasValidSlade360BearerToken, HasValidMycarehubBearerToken}
+func AuthenticationGinMiddleware(cacheStore persist.CacheStore, cl authutils.Client, serviceAccounts ServiceAccountAuthenticator) gin.HandlerFunc {
+	checkFuncs := []authCheckFn{HasValidCachedToken(cacheStore), cl.HasValidSlade360BearerToken, HasValidMycarehubBearerToken, HasValidServiceAccountKey(serviceAccounts)}
 
 	return func(c *gin.Context) {
 		var successful bool
@@ -226,6 +226,29 @@ func (p PresentationHandlersImpl) ReceivePubSubPushMessage(c *gin.Context) {
 			return
 		}
 
+	case utils.AddPubSubNamespace(common.TestOrderTopicName, common.ClinicalServiceName):
+		var data dto.PatientTestOrderPubSubMessage
+
+		err := json.Unmarshal(message.Message.Data, &data)
+		if err != nil {
+			serverutils.WriteJSONResponse(c.Writer, errorcodeutil.CustomError{
+				Err:     err,
+				Message: err.Error(),
+			}, http.StatusBadRequest)
+
+			return
+		}
+
+		err = p.usecases.CreatePubsubTestOrder(ctx, data)
+		if err != nil {
+			serverutils.WriteJSONResponse(c.Writer, errorcodeutil.CustomError{
+				Err:     err,
+				Message: err.Error(),
+			}, http.StatusBadRequest)
+
+			return
+		}
+
 	case utils.AddPubSubNamespace(common.SegmentationTopicName, common.ClinicalServiceName):
 		var data dto.SegmentationPayload
 
@@ -394,3 +417,55 @@ func (p PresentationHandlersImpl) GenerateReferralReport(c *gin.Context) {
 	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", fileName))
 	c.Data(http.StatusOK, "application/pdf", pdfBytes)
 }
+
+// CreateServiceAccount creates a service account for a machine client. The key in the response is only shown once
+func (p PresentationHandlersImpl) CreateServiceAccount(c *gin.Context) {
+	input := dto.ServiceAccountInput{}
+
+	err := c.BindJSON(&input)
+	if err != nil {
+		jsonErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	credential, err := p.usecases.CreateServiceAccount(c.Request.Context(), input)
+	if err != nil {
+		jsonErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, credential)
+}
+
+// ListServiceAccounts lists the service accounts of a tenant
+func (p PresentationHandlersImpl) ListServiceAccounts(c *gin.Context) {
+	accounts, err := p.usecases.ListServiceAccounts(c.Request.Context())
+	if err != nil {
+		jsonErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, accounts)
+}
+
+// RotateServiceAccountKey issues a new key for a service account and invalidates the previous one
+func (p PresentationHandlersImpl) RotateServiceAccountKey(c *gin.Context) {
+	credential, err := p.usecases.RotateServiceAccountKey(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		jsonErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, credential)
+}
+
+// RevokeServiceAccount revokes a service account
+func (p PresentationHandlersImpl) RevokeServiceAccount(c *gin.Context) {
+	account, err := p.usecases.RevokeServiceAccount(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		jsonErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, account)
+}
@@ -199,6 +199,26 @@ func TestPresentationHandlersImpl_ReceivePubSubPushMessage(t *testing.T) {
 			wantStatus: http.StatusBadRequest,
 			wantErr:    true,
 		},
+		{
+			name: "happy case: publish test order message",
+			args: args{
+				url:        "/pubsub",
+				httpMethod: http.MethodPost,
+				body:       nil,
+			},
+			wantStatus: http.StatusOK,
+			wantErr:    false,
+		},
+		{
+			name: "sad case: publish test order message",
+			args: args{
+				url:        "/pubsub",
+				httpMethod: http.MethodPost,
+				body:       nil,
+			},
+			wantStatus: http.StatusBadRequest,
+			wantErr:    true,
+		},
 		{
 			name: "happy case: publish patient segmentation",
 			args: args{
@@ -612,6 +632,36 @@ func TestPresentationHandlersImpl_ReceivePubSubPushMessage(t *testing.T) {
 				}
 			}
 
+			if tt.name == "happy case: publish test order message" || tt.name == "sad case: publish test order message" {
+				concept := "1019"
+				msg := dto.PatientTestOrderPubSubMessage{
+					PatientID:      gofakeit.UUID(),
+					OrganizationID: gofakeit.UUID(),
+					Name:           "Full blood count",
+					ConceptID:      &concept,
+					Date:           time.Now(),
+					Priority:       dto.LabOrderPriorityRoutine,
+				}
+				data, _ := json.Marshal(msg)
+				fakeExt.MockVerifyPubSubJWTAndDecodePayloadFn = func(w http.ResponseWriter, r *http.Request) (*pubsubtools.PubSubPayload, error) {
+					return &pubsubtools.PubSubPayload{
+						Message: pubsubtools.PubSubMessage{
+							Data: data,
+						},
+					}, nil
+				}
+
+				fakeExt.MockGetPubSubTopicFn = func(m *pubsubtools.PubSubPayload) (string, error) {
+					return utils.AddPubSubNamespace(common.TestOrderTopicName, common.ClinicalServiceName), nil
+				}
+			}
+
+			if tt.name == "sad case: publish test order message" {
+				fakeFHIR.MockCreateFHIRServiceRequestFn = func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+					return nil, fmt.Errorf("failed to create service request")
+				}
+			}
+
 			if tt.name == "sad case: verify pubsub request fails" {
 				fakeExt.MockVerifyPubSubJWTAndDecodePayloadFn = func(w http.ResponseWriter, r *http.Request) (*pubsubtools.PubSubPayload, error) {
 					return nil, fmt.Errorf("failed to verify")
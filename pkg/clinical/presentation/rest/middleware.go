@@ -4,11 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/savannahghi/authutils"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 	"github.com/savannahghi/clinical/pkg/clinical/application/utils"
 	"github.com/savannahghi/clinical/pkg/clinical/domain"
 	"github.com/savannahghi/serverutils"
+	"github.com/sirupsen/logrus"
 )
 
 // Validators defines the methods used to validate the various identifiers that the api expects
@@ -27,6 +32,11 @@ func OrganisationValidator(v Validators, identifier string) error {
 	return nil
 }
 
+// PatientFinder defines the methods used to resolve the patient that a patient-scoped token belongs to
+type PatientFinder interface {
+	SearchFHIRPatientByIdentifier(ctx context.Context, identifier string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error)
+}
+
 // TenantIdentifier is a type representing a header name and a corresponding context key
 // The header name is what will be used to extract the specified header and the context key
 // Will be the key value used when adding the header in the request context
@@ -50,6 +60,16 @@ func handleError(w http.ResponseWriter, err error) {
 	)
 }
 
+func handleForbidden(w http.ResponseWriter, err error) {
+	serverutils.WriteJSONResponse(
+		w,
+		errResponse{
+			Err: err.Error(),
+		},
+		http.StatusForbidden,
+	)
+}
+
 // TenantIdentifierExtractionMiddleware is a middleware function that extracts the `organizationID`,
 // `programID`, and `facilityID` values from the request and adds them to the request
 // context. These IDs can then be used by downstream handlers or middleware to perform
@@ -98,3 +118,153 @@ func TenantIdentifierExtractionMiddleware(validator Validators) gin.HandlerFunc
 		c.Next()
 	}
 }
+
+// PatientScopeMiddleware restricts tokens issued to the myCareHub client to the patient they belong to.
+// The introspected user ID is mapped to a patient using the myCareHub user identifier that is stored when
+// the patient is created, and the patient's ID is added to the request context.
+// It should run after the tenant identifiers have been extracted since the patient is searched within the tenant
+func PatientScopeMiddleware(finder PatientFinder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		token, ok := ctx.Value(authutils.AuthTokenContextKey).(*authutils.TokenIntrospectionResponse)
+		if !ok || !IsMycarehubToken(token) {
+			c.Next()
+
+			return
+		}
+
+		organizationID, _ := ctx.Value(utils.OrganizationIDContextKey).(string)
+		facilityID, _ := ctx.Value(utils.FacilityIDContextKey).(string)
+
+		tenant := dto.TenantIdentifiers{
+			OrganizationID: organizationID,
+			FacilityID:     facilityID,
+		}
+
+		identifier := fmt.Sprintf("%s|%s", common.MyCareHubUserIdentifierSystem, token.UserGUID)
+
+		patients, err := finder.SearchFHIRPatientByIdentifier(ctx, identifier, tenant, dto.Pagination{Skip: true})
+		if err != nil {
+			utils.ReportErrorToSentry(err)
+			handleForbidden(c.Writer, fmt.Errorf("unable to find the patient associated with the supplied token"))
+			c.Abort()
+
+			return
+		}
+
+		if patients == nil || len(patients.Edges) != 1 || patients.Edges[0].Node == nil || patients.Edges[0].Node.ID == nil {
+			handleForbidden(c.Writer, fmt.Errorf("the supplied token is not associated with exactly one patient"))
+			c.Abort()
+
+			return
+		}
+
+		patientID := *patients.Edges[0].Node.ID
+
+		c.Set(string(utils.PatientIDContextKey), patientID)
+
+		c.Request = c.Request.WithContext(context.WithValue(ctx, utils.PatientIDContextKey, patientID))
+
+		c.Next()
+	}
+}
+
+// DenyPatientScopedTokenMiddleware rejects tokens issued to the myCareHub client. It guards routes that
+// patient-scoped tokens are not allowed to access
+func DenyPatientScopedTokenMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, ok := c.Request.Context().Value(authutils.AuthTokenContextKey).(*authutils.TokenIntrospectionResponse)
+		if ok && IsMycarehubToken(token) {
+			handleForbidden(c.Writer, fmt.Errorf("the supplied token is not allowed to access this resource"))
+			c.Abort()
+
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// ServiceAccountAuditor records the operations performed using service accounts
+type ServiceAccountAuditor interface {
+	RecordServiceAccountAudit(ctx context.Context, accountID string, tenant dto.TenantIdentifiers, operation string, authorized bool) error
+}
+
+// ServiceAccountOperation returns the operation name used in service account scopes for a REST route e.g `POST:/api/v1/media`
+func ServiceAccountOperation(c *gin.Context) string {
+	return fmt.Sprintf("%s:%s", c.Request.Method, c.FullPath())
+}
+
+// HasServiceAccountScope checks whether the token's scopes include the operation
+func HasServiceAccountScope(token *authutils.TokenIntrospectionResponse, operation string) bool {
+	return HasScope(token, operation)
+}
+
+// HasScope checks whether the token was granted a scope
+func HasScope(token *authutils.TokenIntrospectionResponse, scope string) bool {
+	if token == nil {
+		return false
+	}
+
+	for _, granted := range strings.Fields(token.Scope) {
+		if granted == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RequireScopeMiddleware rejects requests whose token was not granted the scope. It guards routes that
+// only administrators are allowed to access
+func RequireScopeMiddleware(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, _ := c.Request.Context().Value(authutils.AuthTokenContextKey).(*authutils.TokenIntrospectionResponse)
+		if !HasScope(token, scope) {
+			handleForbidden(c.Writer, fmt.Errorf("the supplied token is not allowed to access this resource: %s scope required", scope))
+			c.Abort()
+
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// ServiceAccountScopeMiddleware ensures that requests authenticated using a service account only access the
+// REST operations in the service account's scopes. Every request is recorded as an audit event
+func ServiceAccountScopeMiddleware(auditor ServiceAccountAuditor) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		token, ok := ctx.Value(authutils.AuthTokenContextKey).(*authutils.TokenIntrospectionResponse)
+		if !ok || !IsServiceAccountToken(token) {
+			c.Next()
+
+			return
+		}
+
+		operation := ServiceAccountOperation(c)
+		authorized := HasServiceAccountScope(token, operation)
+
+		tenant := dto.TenantIdentifiers{
+			OrganizationID: c.GetHeader("Clinical-Organization-ID"),
+			FacilityID:     c.GetHeader("Clinical-Facility-ID"),
+		}
+
+		err := auditor.RecordServiceAccountAudit(ctx, token.UserGUID, tenant, operation, authorized)
+		if err != nil {
+			logrus.Errorf("failed to record audit event for service account %s: %v", token.UserGUID, err)
+		}
+
+		if !authorized {
+			handleForbidden(c.Writer, fmt.Errorf("the service account is not allowed to perform %s", operation))
+			c.Abort()
+
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -1,12 +1,19 @@
 package rest_test
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"testing"
 
+	"github.com/brianvoe/gofakeit"
 	"github.com/gin-gonic/gin"
+	"github.com/savannahghi/authutils"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 	"github.com/savannahghi/clinical/pkg/clinical/application/utils"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
 	"github.com/savannahghi/clinical/pkg/clinical/presentation/rest"
 	"github.com/savannahghi/clinical/pkg/clinical/usecases/clinical/mock"
 )
@@ -67,3 +74,200 @@ func TestIDExtractionMiddleware(t *testing.T) {
 		}
 	}
 }
+
+func TestPatientScopeMiddleware(t *testing.T) {
+	patientID := gofakeit.UUID()
+
+	tests := []struct {
+		name           string
+		token          *authutils.TokenIntrospectionResponse
+		wantStatusCode int
+		wantPatientID  string
+	}{
+		{
+			name: "Happy Case: myCareHub token is scoped to its patient",
+			token: &authutils.TokenIntrospectionResponse{
+				ClientID: os.Getenv("MYCAREHUB_CLIENT_ID"),
+				UserGUID: gofakeit.UUID(),
+			},
+			wantStatusCode: http.StatusOK,
+			wantPatientID:  patientID,
+		},
+		{
+			name: "Happy Case: other tokens are not scoped",
+			token: &authutils.TokenIntrospectionResponse{
+				ClientID: gofakeit.UUID(),
+				UserGUID: gofakeit.UUID(),
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "Sad Case: patient not found",
+			token: &authutils.TokenIntrospectionResponse{
+				ClientID: os.Getenv("MYCAREHUB_CLIENT_ID"),
+				UserGUID: gofakeit.UUID(),
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "Sad Case: failed to search patient",
+			token: &authutils.TokenIntrospectionResponse{
+				ClientID: os.Getenv("MYCAREHUB_CLIENT_ID"),
+				UserGUID: gofakeit.UUID(),
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeFHIR := mock.NewFHIRUsecaseMock()
+
+			if tt.name == "Happy Case: myCareHub token is scoped to its patient" {
+				fakeFHIR.MockSearchFHIRPatientByIdentifierFn = func(ctx context.Context, identifier string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error) {
+					return &domain.PatientConnection{
+						Edges: []*domain.PatientEdge{{Node: &domain.FHIRPatient{ID: &patientID}}},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad Case: patient not found" {
+				fakeFHIR.MockSearchFHIRPatientByIdentifierFn = func(ctx context.Context, identifier string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error) {
+					return &domain.PatientConnection{}, nil
+				}
+			}
+
+			if tt.name == "Sad Case: failed to search patient" {
+				fakeFHIR.MockSearchFHIRPatientByIdentifierFn = func(ctx context.Context, identifier string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			engine := gin.New()
+			engine.Use(func(c *gin.Context) {
+				ctx := context.WithValue(c.Request.Context(), authutils.AuthTokenContextKey, tt.token)
+				c.Request = c.Request.WithContext(ctx)
+				c.Next()
+			})
+			engine.Use(rest.PatientScopeMiddleware(fakeFHIR))
+
+			var gotPatientID string
+
+			engine.GET("", func(c *gin.Context) {
+				gotPatientID, _ = c.Request.Context().Value(utils.PatientIDContextKey).(string)
+				c.String(http.StatusOK, "OK")
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			res := httptest.NewRecorder()
+			engine.ServeHTTP(res, req)
+
+			if res.Code != tt.wantStatusCode {
+				t.Errorf("expected status code %v, but got %v", tt.wantStatusCode, res.Code)
+				return
+			}
+
+			if gotPatientID != tt.wantPatientID {
+				t.Errorf("expected patient ID %v, but got %v", tt.wantPatientID, gotPatientID)
+			}
+		})
+	}
+}
+
+func TestDenyPatientScopedTokenMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		token          *authutils.TokenIntrospectionResponse
+		wantStatusCode int
+	}{
+		{
+			name: "Happy Case: other tokens are allowed",
+			token: &authutils.TokenIntrospectionResponse{
+				ClientID: gofakeit.UUID(),
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "Sad Case: myCareHub token is denied",
+			token: &authutils.TokenIntrospectionResponse{
+				ClientID: os.Getenv("MYCAREHUB_CLIENT_ID"),
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := gin.New()
+			engine.Use(func(c *gin.Context) {
+				ctx := context.WithValue(c.Request.Context(), authutils.AuthTokenContextKey, tt.token)
+				c.Request = c.Request.WithContext(ctx)
+				c.Next()
+			})
+			engine.Use(rest.DenyPatientScopedTokenMiddleware())
+			engine.GET("", func(c *gin.Context) {
+				c.String(http.StatusOK, "OK")
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			res := httptest.NewRecorder()
+			engine.ServeHTTP(res, req)
+
+			if res.Code != tt.wantStatusCode {
+				t.Errorf("expected status code %v, but got %v", tt.wantStatusCode, res.Code)
+			}
+		})
+	}
+}
+
+func TestRequireScopeMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		token          *authutils.TokenIntrospectionResponse
+		wantStatusCode int
+	}{
+		{
+			name: "Happy Case: token with the scope is allowed",
+			token: &authutils.TokenIntrospectionResponse{
+				Scope: "openid clinical.service-accounts.admin",
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "Sad Case: token without the scope is denied",
+			token: &authutils.TokenIntrospectionResponse{
+				Scope: "openid",
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "Sad Case: missing token is denied",
+			wantStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := gin.New()
+			engine.Use(func(c *gin.Context) {
+				if tt.token != nil {
+					ctx := context.WithValue(c.Request.Context(), authutils.AuthTokenContextKey, tt.token)
+					c.Request = c.Request.WithContext(ctx)
+				}
+				c.Next()
+			})
+			engine.Use(rest.RequireScopeMiddleware("clinical.service-accounts.admin"))
+			engine.GET("", func(c *gin.Context) {
+				c.String(http.StatusOK, "OK")
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			res := httptest.NewRecorder()
+			engine.ServeHTTP(res, req)
+
+			if res.Code != tt.wantStatusCode {
+				t.Errorf("expected status code %v, but got %v", tt.wantStatusCode, res.Code)
+			}
+		})
+	}
+}
@@ -28,11 +28,26 @@ type FHIR interface {
 	FHIRRiskAssessment
 	FHIRDiagnosticReport
 	FHIRSubscription
+	FHIRBasic
+	FHIRAuditEvent
+	FHIRMedicationDispense
+	FHIRImmunization
+	FHIRSpecimen
+	FHIRProcedure
+	FHIRGoal
+	FHIRCarePlan
+	FHIRSchedule
+	FHIRSlot
+	FHIRAppointment
+	FHIRPractitioner
+	FHIRPractitionerRole
+	FHIRLocation
+	FHIRFamilyMemberHistory
 }
 
 type FHIROrganization interface {
 	CreateFHIROrganization(ctx context.Context, input domain.FHIROrganizationInput) (*domain.FHIROrganizationRelayPayload, error)
-	SearchFHIROrganization(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIROrganizationRelayConnection, error)
+	SearchFHIROrganization(ctx context.Context, params map[string]interface{}, pagination dto.Pagination) (*domain.FHIROrganizationRelayConnection, error)
 	GetFHIROrganization(ctx context.Context, id string) (*domain.FHIROrganizationRelayPayload, error)
 }
 
@@ -42,6 +57,7 @@ type FHIRPatient interface {
 	CreateFHIRPatient(ctx context.Context, input domain.FHIRPatientInput) (*domain.PatientPayload, error)
 	PatchFHIRPatient(ctx context.Context, id string, input domain.FHIRPatientInput) (*domain.FHIRPatient, error)
 	SearchFHIRPatient(ctx context.Context, searchParams string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error)
+	SearchFHIRPatientByIdentifier(ctx context.Context, identifier string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error)
 	GetFHIRPatientEverything(ctx context.Context, id string, params map[string]interface{}) (*domain.PagedFHIRResource, error)
 }
 type FHIREpisodeOfCare interface {
@@ -63,6 +79,7 @@ type FHIRObservation interface {
 	DeleteFHIRObservation(ctx context.Context, id string) (bool, error)
 	SearchPatientObservations(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error)
 	PatchFHIRObservation(ctx context.Context, id string, input domain.FHIRObservationInput) (*domain.FHIRObservation, error)
+	UpdateFHIRObservation(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error)
 }
 type FHIRAllergyIntolerance interface {
 	SearchFHIRAllergyIntolerance(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error)
@@ -72,21 +89,24 @@ type FHIRAllergyIntolerance interface {
 	SearchPatientAllergyIntolerance(ctx context.Context, patientReference string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error)
 }
 type FHIRServiceRequest interface {
-	SearchFHIRServiceRequest(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRServiceRequestRelayConnection, error)
+	SearchFHIRServiceRequest(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRServiceRequest, error)
 	CreateFHIRServiceRequest(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error)
+	UpdateFHIRServiceRequest(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error)
 	DeleteFHIRServiceRequest(ctx context.Context, id string) (bool, error)
 	GetFHIRServiceRequest(ctx context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error)
 }
 type FHIRMedicationRequest interface {
-	SearchFHIRMedicationRequest(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationRequestRelayConnection, error)
+	SearchFHIRMedicationRequest(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationRequest, error)
 	CreateFHIRMedicationRequest(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error)
 	UpdateFHIRMedicationRequest(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error)
 	DeleteFHIRMedicationRequest(ctx context.Context, id string) (bool, error)
+	GetFHIRMedicationRequest(ctx context.Context, id string) (*domain.FHIRMedicationRequestRelayPayload, error)
 }
 type FHIRCondition interface {
 	SearchFHIRCondition(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCondition, error)
 	CreateFHIRCondition(ctx context.Context, input domain.FHIRConditionInput) (*domain.FHIRConditionRelayPayload, error)
 	UpdateFHIRCondition(ctx context.Context, input domain.FHIRConditionInput) (*domain.FHIRConditionRelayPayload, error)
+	GetFHIRCondition(ctx context.Context, id string) (*domain.FHIRConditionRelayPayload, error)
 }
 type FHIREncounter interface {
 	CreateFHIREncounter(ctx context.Context, input domain.FHIREncounterInput) (*domain.FHIREncounterRelayPayload, error)
@@ -110,6 +130,8 @@ type FHIRComposition interface {
 type FHIRMedicationStatement interface {
 	CreateFHIRMedicationStatement(ctx context.Context, input domain.FHIRMedicationStatementInput) (*domain.FHIRMedicationStatementRelayPayload, error)
 	SearchFHIRMedicationStatement(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationStatementRelayConnection, error)
+	GetFHIRMedicationStatement(ctx context.Context, id string) (*domain.FHIRMedicationStatementRelayPayload, error)
+	UpdateFHIRMedicationStatement(ctx context.Context, input domain.FHIRMedicationStatementInput) (*domain.FHIRMedicationStatementRelayPayload, error)
 }
 
 type FHIRMedication interface {
@@ -119,6 +141,7 @@ type FHIRMedication interface {
 type FHIRMedia interface {
 	CreateFHIRMedia(ctx context.Context, input domain.FHIRMedia) (*domain.FHIRMedia, error)
 	SearchPatientMedia(ctx context.Context, patientReference string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedia, error)
+	GetFHIRMedia(ctx context.Context, id string) (*domain.FHIRMediaRelayPayload, error)
 }
 
 type FHIRQuestionnaire interface {
@@ -128,6 +151,9 @@ type FHIRQuestionnaire interface {
 }
 type FHIRConsent interface {
 	CreateFHIRConsent(ctx context.Context, input domain.FHIRConsent) (*domain.FHIRConsent, error)
+	UpdateFHIRConsent(ctx context.Context, input domain.FHIRConsent) (*domain.FHIRConsent, error)
+	SearchFHIRConsent(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRConsent, error)
+	GetFHIRConsent(ctx context.Context, id string) (*domain.FHIRConsentRelayPayload, error)
 }
 
 type FHIRQuestionnaireResponse interface {
@@ -142,9 +168,114 @@ type FHIRRiskAssessment interface {
 
 type FHIRDiagnosticReport interface {
 	CreateFHIRDiagnosticReport(_ context.Context, input *domain.FHIRDiagnosticReportInput) (*domain.FHIRDiagnosticReport, error)
+	GetFHIRDiagnosticReport(ctx context.Context, id string) (*domain.FHIRDiagnosticReportRelayPayload, error)
 }
 
 // FHIRSubscription contains method signatures of the FHIRSubscription interface
 type FHIRSubscription interface {
 	CreateFHIRSubscription(_ context.Context, input *domain.FHIRSubscriptionInput) (*domain.FHIRSubscription, error)
 }
+
+type FHIRBasic interface {
+	CreateFHIRBasic(ctx context.Context, input domain.FHIRBasic) (*domain.FHIRBasic, error)
+	UpdateFHIRBasic(ctx context.Context, input domain.FHIRBasic) (*domain.FHIRBasic, error)
+	SearchFHIRBasic(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRBasic, error)
+}
+
+type FHIRAuditEvent interface {
+	CreateFHIRAuditEvent(ctx context.Context, input domain.FHIRAuditEvent) (*domain.FHIRAuditEvent, error)
+}
+
+type FHIRMedicationDispense interface {
+	CreateFHIRMedicationDispense(ctx context.Context, input domain.FHIRMedicationDispense) (*domain.FHIRMedicationDispense, error)
+	UpdateFHIRMedicationDispense(ctx context.Context, input domain.FHIRMedicationDispense) (*domain.FHIRMedicationDispense, error)
+	SearchFHIRMedicationDispense(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationDispense, error)
+	GetFHIRMedicationDispense(ctx context.Context, id string) (*domain.FHIRMedicationDispenseRelayPayload, error)
+}
+
+type FHIRImmunization interface {
+	CreateFHIRImmunization(ctx context.Context, input domain.FHIRImmunization) (*domain.FHIRImmunization, error)
+	UpdateFHIRImmunization(ctx context.Context, input domain.FHIRImmunization) (*domain.FHIRImmunization, error)
+	SearchFHIRImmunization(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error)
+	SearchFHIROrganisationImmunization(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error)
+	GetFHIRImmunization(ctx context.Context, id string) (*domain.FHIRImmunizationRelayPayload, error)
+}
+
+type FHIRSpecimen interface {
+	CreateFHIRSpecimen(ctx context.Context, input domain.FHIRSpecimen) (*domain.FHIRSpecimen, error)
+	UpdateFHIRSpecimen(ctx context.Context, input domain.FHIRSpecimen) (*domain.FHIRSpecimen, error)
+	SearchFHIRSpecimen(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSpecimen, error)
+	GetFHIRSpecimen(ctx context.Context, id string) (*domain.FHIRSpecimenRelayPayload, error)
+}
+
+type FHIRProcedure interface {
+	CreateFHIRProcedure(ctx context.Context, input domain.FHIRProcedure) (*domain.FHIRProcedure, error)
+	UpdateFHIRProcedure(ctx context.Context, input domain.FHIRProcedure) (*domain.FHIRProcedure, error)
+	SearchFHIRProcedure(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRProcedure, error)
+	GetFHIRProcedure(ctx context.Context, id string) (*domain.FHIRProcedureRelayPayload, error)
+}
+
+type FHIRGoal interface {
+	CreateFHIRGoal(ctx context.Context, input domain.FHIRGoal) (*domain.FHIRGoal, error)
+	UpdateFHIRGoal(ctx context.Context, input domain.FHIRGoal) (*domain.FHIRGoal, error)
+	SearchFHIRGoal(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRGoal, error)
+	GetFHIRGoal(ctx context.Context, id string) (*domain.FHIRGoalRelayPayload, error)
+}
+
+type FHIRCarePlan interface {
+	CreateFHIRCarePlan(ctx context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error)
+	UpdateFHIRCarePlan(ctx context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error)
+	SearchFHIRCarePlan(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCarePlan, error)
+	GetFHIRCarePlan(ctx context.Context, id string) (*domain.FHIRCarePlanRelayPayload, error)
+}
+
+type FHIRSchedule interface {
+	CreateFHIRSchedule(ctx context.Context, input domain.FHIRSchedule) (*domain.FHIRSchedule, error)
+	UpdateFHIRSchedule(ctx context.Context, input domain.FHIRSchedule) (*domain.FHIRSchedule, error)
+	SearchFHIRSchedule(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSchedule, error)
+	GetFHIRSchedule(ctx context.Context, id string) (*domain.FHIRScheduleRelayPayload, error)
+}
+
+type FHIRSlot interface {
+	CreateFHIRSlot(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error)
+	UpdateFHIRSlot(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error)
+	SearchFHIRSlot(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSlot, error)
+	GetFHIRSlot(ctx context.Context, id string) (*domain.FHIRSlotRelayPayload, error)
+}
+
+type FHIRAppointment interface {
+	CreateFHIRAppointment(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error)
+	UpdateFHIRAppointment(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error)
+	SearchFHIRAppointment(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAppointment, error)
+	GetFHIRAppointment(ctx context.Context, id string) (*domain.FHIRAppointmentRelayPayload, error)
+}
+
+type FHIRPractitioner interface {
+	CreateFHIRPractitioner(ctx context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error)
+	UpdateFHIRPractitioner(ctx context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error)
+	SearchFHIRPractitioner(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error)
+	SearchFHIROrganisationPractitioner(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error)
+	GetFHIRPractitioner(ctx context.Context, id string) (*domain.FHIRPractitionerRelayPayload, error)
+}
+
+type FHIRPractitionerRole interface {
+	CreateFHIRPractitionerRole(ctx context.Context, input domain.FHIRPractitionerRole) (*domain.FHIRPractitionerRole, error)
+	UpdateFHIRPractitionerRole(ctx context.Context, input domain.FHIRPractitionerRole) (*domain.FHIRPractitionerRole, error)
+	SearchFHIRPractitionerRole(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRPractitionerRole, error)
+	SearchFHIROrganisationPractitionerRole(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitionerRole, error)
+	GetFHIRPractitionerRole(ctx context.Context, id string) (*domain.FHIRPractitionerRoleRelayPayload, error)
+}
+
+type FHIRLocation interface {
+	CreateFHIRLocation(ctx context.Context, input domain.FHIRLocation) (*domain.FHIRLocation, error)
+	UpdateFHIRLocation(ctx context.Context, input domain.FHIRLocation) (*domain.FHIRLocation, error)
+	SearchFHIRLocation(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRLocation, error)
+	GetFHIRLocation(ctx context.Context, id string) (*domain.FHIRLocationRelayPayload, error)
+}
+
+type FHIRFamilyMemberHistory interface {
+	CreateFHIRFamilyMemberHistory(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error)
+	UpdateFHIRFamilyMemberHistory(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error)
+	SearchFHIRFamilyMemberHistory(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRFamilyMemberHistory, error)
+	GetFHIRFamilyMemberHistory(ctx context.Context, id string) (*domain.FHIRFamilyMemberHistoryRelayPayload, error)
+}
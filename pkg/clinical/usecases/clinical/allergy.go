@@ -37,22 +37,10 @@ func (c *UseCasesClinicalImpl) CreateAllergyIntolerance(ctx context.Context, inp
 		return nil, err
 	}
 
-	clinicalStatusSystem := scalarutils.URI("http://terminology.hl7.org/CodeSystem/allergyintolerance-clinical")
-	verificationSystem := "http://terminology.hl7.org/CodeSystem/allergyintolerance-verification"
-
 	allergyIntoleranceTypeAllergy := domain.AllergyIntoleranceTypeEnumAllergy
 
-	clinicalStatusCodeActive := "active"
-	verificationDisplay := "confirmed"
-
 	allergyIntoleranceInput := domain.FHIRAllergyIntoleranceInput{
-		ClinicalStatus: domain.FHIRCodeableConceptInput{
-			Coding: []*domain.FHIRCodingInput{{
-				System:  &clinicalStatusSystem,
-				Code:    scalarutils.Code(clinicalStatusCodeActive),
-				Display: clinicalStatusCodeActive,
-			}},
-		},
+		ClinicalStatus: allergyClinicalStatusCodeableConcept(dto.AllergyClinicalStatusActive),
 		Code: domain.FHIRCodeableConceptInput{
 			Coding: []*domain.FHIRCodingInput{
 				{
@@ -78,39 +66,17 @@ func (c *UseCasesClinicalImpl) CreateAllergyIntolerance(ctx context.Context, inp
 			Month: int(time.Now().Month()),
 			Day:   time.Now().Day(),
 		},
-		Type: &allergyIntoleranceTypeAllergy,
-		VerificationStatus: domain.FHIRCodeableConceptInput{
-			Coding: []*domain.FHIRCodingInput{
-				{
-					System:  (*scalarutils.URI)(&verificationSystem),
-					Code:    scalarutils.Code(verificationDisplay),
-					Display: verificationDisplay,
-				},
-			},
-			Text: verificationDisplay,
-		},
+		Type:               &allergyIntoleranceTypeAllergy,
+		VerificationStatus: allergyVerificationStatusCodeableConcept(dto.AllergyVerificationStatusConfirmed),
 	}
 
 	if input.Reaction != nil {
-		manifestationConcept, err := c.GetConcept(ctx, dto.TerminologySourceCIEL, input.Reaction.Code)
+		reaction, err := c.allergyReaction(ctx, *input.Reaction)
 		if err != nil {
 			return nil, err
 		}
 
-		allergyIntoleranceInput.Reaction = []*domain.FHIRAllergyintoleranceReactionInput{{
-			Description: (*string)(&input.Reaction.Severity),
-			Manifestation: []*domain.FHIRCodeableConceptInput{{
-				Coding: []*domain.FHIRCodingInput{
-					{
-						System:  (*scalarutils.URI)(&manifestationConcept.URL),
-						Code:    scalarutils.Code(manifestationConcept.ID),
-						Display: manifestationConcept.DisplayName,
-					},
-				},
-				Text: manifestationConcept.DisplayName,
-			}},
-			Severity: (*domain.AllergyIntoleranceReactionSeverityEnum)(&input.Reaction.Severity),
-		}}
+		allergyIntoleranceInput.Reaction = []*domain.FHIRAllergyintoleranceReactionInput{reaction}
 	}
 
 	tags, err := c.GetTenantMetaTags(ctx)
@@ -236,3 +202,126 @@ func (c *UseCasesClinicalImpl) ListPatientAllergies(ctx context.Context, patient
 
 	return &connection, nil
 }
+
+// UpdateAllergyIntolerance corrects an allergy e.g its reactions and their severity, or records that it has resolved or last occurred
+func (c *UseCasesClinicalImpl) UpdateAllergyIntolerance(ctx context.Context, id string, input dto.AllergyUpdateInput) (*dto.Allergy, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allergy intolerance id: %s", id)
+	}
+
+	err = input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := c.infrastructure.FHIR.GetFHIRAllergyIntolerance(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationStatus := dto.AllergyVerificationStatusEnum(allergyStatusValue(resource.Resource.VerificationStatus))
+	if verificationStatus == dto.AllergyVerificationStatusEnteredInError {
+		return nil, fmt.Errorf("cannot update an allergy that was entered in error")
+	}
+
+	allergyInput, err := allergyIntoleranceInput(*resource.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ClinicalStatus != nil {
+		allergyInput.ClinicalStatus = allergyClinicalStatusCodeableConcept(*input.ClinicalStatus)
+	}
+
+	if input.VerificationStatus != nil {
+		allergyInput.VerificationStatus = allergyVerificationStatusCodeableConcept(*input.VerificationStatus)
+	}
+
+	if input.Reactions != nil {
+		allergyInput.Reaction = []*domain.FHIRAllergyintoleranceReactionInput{}
+
+		for _, reactionInput := range input.Reactions {
+			if reactionInput == nil {
+				continue
+			}
+
+			reaction, err := c.allergyReaction(ctx, *reactionInput)
+			if err != nil {
+				return nil, err
+			}
+
+			allergyInput.Reaction = append(allergyInput.Reaction, reaction)
+		}
+	}
+
+	if input.LastOccurrence != nil {
+		lastOccurrence, err := time.Parse(time.RFC3339, string(*input.LastOccurrence))
+		if err != nil {
+			return nil, fmt.Errorf("invalid last occurrence time: %w", err)
+		}
+
+		if lastOccurrence.After(time.Now()) {
+			return nil, fmt.Errorf("last occurrence cannot be in the future")
+		}
+
+		occurred := scalarutils.DateTime(lastOccurrence.Format(time.RFC3339))
+		allergyInput.LastOccurrence = &occurred
+	}
+
+	addAllergyNote(allergyInput, input.Note)
+
+	allergy, err := c.infrastructure.FHIR.UpdateFHIRAllergyIntolerance(ctx, *allergyInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRAllergyIntoleranceToAllergyIntoleranceDTO(*allergy.Resource), nil
+}
+
+// RefuteAllergyIntolerance records that a suspected allergy has been disproved e.g by a negative challenge test
+func (c *UseCasesClinicalImpl) RefuteAllergyIntolerance(ctx context.Context, id string, note *string) (*dto.Allergy, error) {
+	refuted := dto.AllergyVerificationStatusRefuted
+	input := dto.AllergyUpdateInput{
+		VerificationStatus: &refuted,
+	}
+
+	if note != nil {
+		input.Note = *note
+	}
+
+	return c.UpdateAllergyIntolerance(ctx, id, input)
+}
+
+// MarkAllergyIntoleranceEnteredInError marks an allergy that was recorded in error e.g against the wrong patient.
+// An allergy entered in error has no clinical status and is no longer considered when checking medication interactions
+func (c *UseCasesClinicalImpl) MarkAllergyIntoleranceEnteredInError(ctx context.Context, id string, reason *string) (*dto.Allergy, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allergy intolerance id: %s", id)
+	}
+
+	resource, err := c.infrastructure.FHIR.GetFHIRAllergyIntolerance(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	allergyInput, err := allergyIntoleranceInput(*resource.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	allergyInput.ClinicalStatus = nil
+	allergyInput.VerificationStatus = allergyVerificationStatusCodeableConcept(dto.AllergyVerificationStatusEnteredInError)
+
+	if reason != nil {
+		addAllergyNote(allergyInput, *reason)
+	}
+
+	allergy, err := c.infrastructure.FHIR.UpdateFHIRAllergyIntolerance(ctx, *allergyInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRAllergyIntoleranceToAllergyIntoleranceDTO(*allergy.Resource), nil
+}
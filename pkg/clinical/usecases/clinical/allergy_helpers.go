@@ -0,0 +1,129 @@
+package clinical
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// allergyStatusDisplay is the display name of an allergy status e.g `Entered in error`
+func allergyStatusDisplay(status string) string {
+	name := strings.ToLower(strings.ReplaceAll(status, "_", " "))
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func allergyClinicalStatusCodeableConcept(status dto.AllergyClinicalStatusEnum) *domain.FHIRCodeableConceptInput {
+	display := allergyStatusDisplay(status.String())
+
+	return &domain.FHIRCodeableConceptInput{
+		Coding: []*domain.FHIRCodingInput{
+			{
+				System:  (*scalarutils.URI)(&fhirAllergyIntoleranceClinicalStatusURL),
+				Code:    scalarutils.Code(status.Code()),
+				Display: display,
+			},
+		},
+		Text: display,
+	}
+}
+
+func allergyVerificationStatusCodeableConcept(status dto.AllergyVerificationStatusEnum) domain.FHIRCodeableConceptInput {
+	display := allergyStatusDisplay(status.String())
+
+	return domain.FHIRCodeableConceptInput{
+		Coding: []*domain.FHIRCodingInput{
+			{
+				System:  (*scalarutils.URI)(&fhirAllergyIntoleranceVerificationStatusURL),
+				Code:    scalarutils.Code(status.Code()),
+				Display: display,
+			},
+		},
+		Text: display,
+	}
+}
+
+// allergyStatusValue reads an allergy status as an enum value e.g `ENTERED_IN_ERROR` from its FHIR code
+func allergyStatusValue(concept domain.FHIRCodeableConcept) string {
+	for _, coding := range concept.Coding {
+		if coding != nil && coding.Code != nil {
+			return strings.ToUpper(strings.ReplaceAll(string(*coding.Code), "-", "_"))
+		}
+	}
+
+	return ""
+}
+
+// allergyReaction composes a reaction from the CIEL concept of its manifestation e.g hives, and its severity
+func (c *UseCasesClinicalImpl) allergyReaction(ctx context.Context, input dto.ReactionInput) (*domain.FHIRAllergyintoleranceReactionInput, error) {
+	manifestationConcept, err := c.GetConcept(ctx, dto.TerminologySourceCIEL, input.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	reaction := &domain.FHIRAllergyintoleranceReactionInput{
+		Manifestation: []*domain.FHIRCodeableConceptInput{{
+			Coding: []*domain.FHIRCodingInput{
+				{
+					System:  (*scalarutils.URI)(&manifestationConcept.URL),
+					Code:    scalarutils.Code(manifestationConcept.ID),
+					Display: manifestationConcept.DisplayName,
+				},
+			},
+			Text: manifestationConcept.DisplayName,
+		}},
+	}
+
+	if input.Severity != "" {
+		description := string(input.Severity)
+		severity := domain.AllergyIntoleranceReactionSeverityEnum(strings.ToLower(string(input.Severity)))
+
+		reaction.Description = &description
+		reaction.Severity = &severity
+	}
+
+	return reaction, nil
+}
+
+// addAllergyNote adds a timestamped note to an allergy, keeping its existing notes
+func addAllergyNote(input *domain.FHIRAllergyIntoleranceInput, note string) {
+	if note == "" {
+		return
+	}
+
+	text := scalarutils.Markdown(note)
+	noteTime := scalarutils.DateTime(time.Now().Format(time.RFC3339))
+
+	input.Note = append(input.Note, &domain.FHIRAnnotationInput{
+		Time: &noteTime,
+		Text: &text,
+	})
+}
+
+// allergyIntoleranceInput converts a stored allergy into the input used to update it
+func allergyIntoleranceInput(resource domain.FHIRAllergyIntolerance) (*domain.FHIRAllergyIntoleranceInput, error) {
+	bs, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal allergy intolerance: %w", err)
+	}
+
+	input := &domain.FHIRAllergyIntoleranceInput{}
+
+	err = json.Unmarshal(bs, input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal allergy intolerance input: %w", err)
+	}
+
+	// an allergy entered in error is stored without a clinical status
+	if input.ClinicalStatus != nil && len(input.ClinicalStatus.Coding) == 0 && input.ClinicalStatus.Text == "" {
+		input.ClinicalStatus = nil
+	}
+
+	return input, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/google/uuid"
@@ -664,3 +665,365 @@ func TestUseCasesClinicalImpl_GetPatientAllergies(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCasesClinicalImpl_UpdateAllergyIntolerance(t *testing.T) {
+	resolved := dto.AllergyClinicalStatusResolved
+	confirmed := dto.AllergyVerificationStatusConfirmed
+	enteredInError := dto.AllergyVerificationStatusEnteredInError
+	lastOccurrence := scalarutils.DateTime("2023-06-01T10:00:00+03:00")
+	invalidLastOccurrence := scalarutils.DateTime("last week")
+	futureLastOccurrence := scalarutils.DateTime(time.Now().AddDate(0, 1, 0).Format(time.RFC3339))
+
+	type args struct {
+		ctx   context.Context
+		id    string
+		input dto.AllergyUpdateInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update reactions and last occurrence",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.AllergyUpdateInput{
+					VerificationStatus: &confirmed,
+					Reactions: []*dto.ReactionInput{
+						{
+							Code:     "1067",
+							Severity: dto.AllergyIntoleranceReactionSeverityEnumSevere,
+						},
+					},
+					LastOccurrence: &lastOccurrence,
+					Note:           "Developed hives after a dose of amoxicillin",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: resolve allergy",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.AllergyUpdateInput{
+					ClinicalStatus: &resolved,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid allergy id",
+			args: args{
+				ctx: context.Background(),
+				id:  "invalid",
+				input: dto.AllergyUpdateInput{
+					ClinicalStatus: &resolved,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: mark as entered in error through update",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.AllergyUpdateInput{
+					VerificationStatus: &enteredInError,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: reaction without a manifestation",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.AllergyUpdateInput{
+					Reactions: []*dto.ReactionInput{
+						{
+							Severity: dto.AllergyIntoleranceReactionSeverityEnumMild,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to get allergy",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.AllergyUpdateInput{
+					ClinicalStatus: &resolved,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: update an allergy entered in error",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.AllergyUpdateInput{
+					ClinicalStatus: &resolved,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to get reaction concept",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.AllergyUpdateInput{
+					Reactions: []*dto.ReactionInput{
+						{
+							Code: "1067",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid last occurrence",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.AllergyUpdateInput{
+					LastOccurrence: &invalidLastOccurrence,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: last occurrence in the future",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.AllergyUpdateInput{
+					LastOccurrence: &futureLastOccurrence,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to update allergy",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.AllergyUpdateInput{
+					ClinicalStatus: &resolved,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: fail to get allergy" {
+				fakeFHIR.MockGetFHIRAllergyIntoleranceFn = func(ctx context.Context, id string) (*domain.FHIRAllergyIntoleranceRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get allergy")
+				}
+			}
+
+			if tt.name == "Sad case: update an allergy entered in error" {
+				getAllergy := fakeFHIR.MockGetFHIRAllergyIntoleranceFn
+				fakeFHIR.MockGetFHIRAllergyIntoleranceFn = func(ctx context.Context, id string) (*domain.FHIRAllergyIntoleranceRelayPayload, error) {
+					allergy, err := getAllergy(ctx, id)
+					code := scalarutils.Code("entered-in-error")
+					allergy.Resource.VerificationStatus = domain.FHIRCodeableConcept{
+						Coding: []*domain.FHIRCoding{{Code: &code}},
+					}
+
+					return allergy, err
+				}
+			}
+
+			if tt.name == "Sad case: fail to get reaction concept" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return nil, fmt.Errorf("failed to get concept")
+				}
+			}
+
+			if tt.name == "Sad case: fail to update allergy" {
+				fakeFHIR.MockUpdateFHIRAllergyIntoleranceFn = func(ctx context.Context, input domain.FHIRAllergyIntoleranceInput) (*domain.FHIRAllergyIntoleranceRelayPayload, error) {
+					return nil, fmt.Errorf("failed to update allergy")
+				}
+			}
+
+			got, err := c.UpdateAllergyIntolerance(tt.args.ctx, tt.args.id, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.UpdateAllergyIntolerance() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.name == "Happy case: update reactions and last occurrence" {
+				if len(got.Reactions) != 1 || got.Reactions[0].Severity != dto.AllergyIntoleranceReactionSeverityEnumSevere {
+					t.Errorf("expected a severe reaction, got %v", got.Reactions)
+				}
+
+				if got.LastOccurrence == nil || got.VerificationStatus != dto.AllergyVerificationStatusConfirmed {
+					t.Errorf("expected a confirmed allergy with its last occurrence, got %s %v", got.VerificationStatus, got.LastOccurrence)
+				}
+			}
+
+			if tt.name == "Happy case: resolve allergy" && got.ClinicalStatus != dto.AllergyClinicalStatusResolved {
+				t.Errorf("expected a resolved allergy, got %s", got.ClinicalStatus)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_RefuteAllergyIntolerance(t *testing.T) {
+	note := "Tolerated an oral penicillin challenge"
+
+	type args struct {
+		ctx  context.Context
+		id   string
+		note *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: refute allergy",
+			args: args{
+				ctx:  context.Background(),
+				id:   gofakeit.UUID(),
+				note: &note,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid allergy id",
+			args: args{
+				ctx: context.Background(),
+				id:  "invalid",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			got, err := c.RefuteAllergyIntolerance(tt.args.ctx, tt.args.id, tt.args.note)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RefuteAllergyIntolerance() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got.VerificationStatus != dto.AllergyVerificationStatusRefuted {
+				t.Errorf("expected a refuted allergy, got %s", got.VerificationStatus)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_MarkAllergyIntoleranceEnteredInError(t *testing.T) {
+	reason := "Recorded against the wrong patient"
+
+	type args struct {
+		ctx    context.Context
+		id     string
+		reason *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: mark allergy as entered in error",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				reason: &reason,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid allergy id",
+			args: args{
+				ctx: context.Background(),
+				id:  "invalid",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to get allergy",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to update allergy",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: fail to get allergy" {
+				fakeFHIR.MockGetFHIRAllergyIntoleranceFn = func(ctx context.Context, id string) (*domain.FHIRAllergyIntoleranceRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get allergy")
+				}
+			}
+
+			if tt.name == "Sad case: fail to update allergy" {
+				fakeFHIR.MockUpdateFHIRAllergyIntoleranceFn = func(ctx context.Context, input domain.FHIRAllergyIntoleranceInput) (*domain.FHIRAllergyIntoleranceRelayPayload, error) {
+					return nil, fmt.Errorf("failed to update allergy")
+				}
+			}
+
+			got, err := c.MarkAllergyIntoleranceEnteredInError(tt.args.ctx, tt.args.id, tt.args.reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.MarkAllergyIntoleranceEnteredInError() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (got.VerificationStatus != dto.AllergyVerificationStatusEnteredInError || got.ClinicalStatus != "") {
+				t.Errorf("expected an allergy entered in error without a clinical status, got %s %s", got.VerificationStatus, got.ClinicalStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,393 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/application/utils"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// BookAppointment books a patient into a free slot of a facility's or a practitioner's schedule.
+// An appointment booked for a service request e.g a referral or a follow-up after a high-risk screening result references it
+func (c *UseCasesClinicalImpl) BookAppointment(ctx context.Context, input dto.AppointmentInput) (*dto.Appointment, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	slot, schedule, err := c.bookableSlot(ctx, input.SlotID)
+	if err != nil {
+		return nil, err
+	}
+
+	patient, err := c.infrastructure.FHIR.GetFHIRPatient(ctx, input.PatientID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := scalarutils.Code(dto.AppointmentStatusBooked.Code())
+	accepted := scalarutils.Code(appointmentParticipantAccepted)
+	patientReference := fmt.Sprintf("Patient/%s", input.PatientID)
+	slotReference := fmt.Sprintf("Slot/%s", input.SlotID)
+	created := time.Now().Format(time.RFC3339)
+
+	appointment := domain.FHIRAppointment{
+		Status:      &status,
+		ServiceType: slot.ServiceType,
+		Start:       slot.Start,
+		End:         slot.End,
+		Slot: []*domain.FHIRReference{
+			{
+				ID:        &input.SlotID,
+				Reference: &slotReference,
+			},
+		},
+		Created: &created,
+		Participant: append([]*domain.FHIRAppointmentParticipant{
+			{
+				Actor: &domain.FHIRReference{
+					ID:        &input.PatientID,
+					Reference: &patientReference,
+					Display:   patient.Resource.Names(),
+				},
+				Status: &accepted,
+			},
+		}, scheduleParticipants(*schedule)...),
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+	}
+
+	if input.ServiceRequestID != "" {
+		serviceRequest, err := c.infrastructure.FHIR.GetFHIRServiceRequest(ctx, input.ServiceRequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		subject := serviceRequest.Resource.Subject
+		if subject == nil || subject.ID == nil || *subject.ID != input.PatientID {
+			return nil, fmt.Errorf("service request %s is not a service request of patient %s", input.ServiceRequestID, input.PatientID)
+		}
+
+		serviceRequestReference := fmt.Sprintf("ServiceRequest/%s", input.ServiceRequestID)
+		appointment.BasedOn = []*domain.FHIRReference{
+			{
+				ID:        &input.ServiceRequestID,
+				Reference: &serviceRequestReference,
+			},
+		}
+	}
+
+	if input.Description != "" {
+		appointment.Description = &input.Description
+	}
+
+	if input.Comment != "" {
+		appointment.Comment = &input.Comment
+	}
+
+	// The slot is claimed before the appointment is recorded so that a slot booked concurrently is not booked twice
+	err = c.updateSlotStatus(ctx, *slot, dto.SlotStatusBusy)
+	if err != nil {
+		return nil, fmt.Errorf("slot %s is not available: %w", input.SlotID, err)
+	}
+
+	resource, err := c.infrastructure.FHIR.CreateFHIRAppointment(ctx, appointment)
+	if err != nil {
+		c.releaseClaimedSlots(ctx, appointment.Slot)
+
+		return nil, err
+	}
+
+	return mapFHIRAppointmentToDTO(*resource), nil
+}
+
+// RescheduleAppointment moves a booked appointment to another free slot, freeing the slot it was booked in
+func (c *UseCasesClinicalImpl) RescheduleAppointment(ctx context.Context, id string, slotID string) (*dto.Appointment, error) {
+	_, err := uuid.Parse(slotID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid slot id: %s", slotID)
+	}
+
+	appointment, err := c.bookedAppointment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, schedule, err := c.bookableSlot(ctx, slotID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousSlots := appointment.Slot
+	slotReference := fmt.Sprintf("Slot/%s", slotID)
+
+	participants := []*domain.FHIRAppointmentParticipant{}
+
+	for _, participant := range appointment.Participant {
+		if participant != nil && isPatientReference(participant.Actor) {
+			participants = append(participants, participant)
+		}
+	}
+
+	appointment.Participant = append(participants, scheduleParticipants(*schedule)...)
+	appointment.Start = slot.Start
+	appointment.End = slot.End
+	appointment.Slot = []*domain.FHIRReference{
+		{
+			ID:        &slotID,
+			Reference: &slotReference,
+		},
+	}
+
+	err = c.updateSlotStatus(ctx, *slot, dto.SlotStatusBusy)
+	if err != nil {
+		return nil, fmt.Errorf("slot %s is not available: %w", slotID, err)
+	}
+
+	resource, err := c.infrastructure.FHIR.UpdateFHIRAppointment(ctx, *appointment)
+	if err != nil {
+		c.releaseClaimedSlots(ctx, appointment.Slot)
+
+		return nil, err
+	}
+
+	err = c.releaseSlots(ctx, previousSlots)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRAppointmentToDTO(*resource), nil
+}
+
+// CancelAppointment cancels a booked appointment, freeing the slot it was booked in
+func (c *UseCasesClinicalImpl) CancelAppointment(ctx context.Context, id string, reason string) (*dto.Appointment, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("a cancellation reason is required")
+	}
+
+	appointment, err := c.bookedAppointment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	status := scalarutils.Code(dto.AppointmentStatusCancelled.Code())
+	appointment.Status = &status
+	appointment.CancelationReason = &domain.FHIRCodeableConcept{
+		Text: reason,
+	}
+
+	resource, err := c.infrastructure.FHIR.UpdateFHIRAppointment(ctx, *appointment)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.releaseSlots(ctx, appointment.Slot)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRAppointmentToDTO(*resource), nil
+}
+
+// StartAppointmentEncounter starts an encounter within an episode of care for a patient who has arrived for a booked appointment.
+// The encounter references the appointment, and the appointment is marked as arrived
+func (c *UseCasesClinicalImpl) StartAppointmentEncounter(ctx context.Context, appointmentID string, episodeID string) (string, error) {
+	if episodeID == "" {
+		return "", fmt.Errorf("an episode of care ID is required")
+	}
+
+	appointment, err := c.bookedAppointment(ctx, appointmentID)
+	if err != nil {
+		return "", err
+	}
+
+	episodeOfCare, err := c.infrastructure.FHIR.GetFHIREpisodeOfCare(ctx, episodeID)
+	if err != nil {
+		return "", err
+	}
+
+	if episodeOfCare.Resource.Status != nil && *episodeOfCare.Resource.Status == domain.EpisodeOfCareStatusEnumFinished {
+		return "", fmt.Errorf("cannot start an encounter in a finished episode of care")
+	}
+
+	patientID := appointmentPatientID(*appointment)
+	if episodeOfCare.Resource.Patient == nil || episodeOfCare.Resource.Patient.ID == nil || *episodeOfCare.Resource.Patient.ID != patientID {
+		return "", fmt.Errorf("episode of care %s is not an episode of care of patient %s", episodeID, patientID)
+	}
+
+	encounterID, err := c.startEncounter(ctx, episodeOfCare, appointment, nil)
+	if err != nil {
+		return "", err
+	}
+
+	status := scalarutils.Code(dto.AppointmentStatusArrived.Code())
+	appointment.Status = &status
+
+	_, err = c.infrastructure.FHIR.UpdateFHIRAppointment(ctx, *appointment)
+	if err != nil {
+		return "", err
+	}
+
+	return encounterID, nil
+}
+
+// GetAppointment retrieves an appointment
+func (c *UseCasesClinicalImpl) GetAppointment(ctx context.Context, id string) (*dto.Appointment, error) {
+	payload, err := c.infrastructure.FHIR.GetFHIRAppointment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRAppointmentToDTO(*payload.Resource), nil
+}
+
+// ListFacilityAppointments lists a facility's upcoming appointments, earliest first, or its missed appointments, most recent first.
+// Missed appointments are booked appointments whose time has passed without the patient arriving
+func (c *UseCasesClinicalImpl) ListFacilityAppointments(ctx context.Context, facilityID string, filter dto.AppointmentFilterEnum, pagination dto.Pagination) (*dto.AppointmentConnection, error) {
+	_, err := uuid.Parse(facilityID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid facility id: %s", facilityID)
+	}
+
+	if !filter.IsValid() {
+		return nil, fmt.Errorf("invalid appointment filter: %s", filter)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	now := time.Now().Format(time.RFC3339)
+
+	params := map[string]interface{}{
+		"actor": fmt.Sprintf("Organization/%s", facilityID),
+	}
+
+	switch filter {
+	case dto.AppointmentFilterUpcoming:
+		params["status"] = dto.AppointmentStatusBooked.Code()
+		params["date"] = fmt.Sprintf("ge%s", now)
+		params["_sort"] = "date"
+	case dto.AppointmentFilterMissed:
+		params["status"] = fmt.Sprintf("%s,%s", dto.AppointmentStatusBooked.Code(), dto.AppointmentStatusNoshow.Code())
+		params["date"] = fmt.Sprintf("lt%s", now)
+		params["_sort"] = "-date"
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRAppointment(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	appointments := []*dto.Appointment{}
+
+	for _, resource := range resources.Appointments {
+		appointments = append(appointments, mapFHIRAppointmentToDTO(resource))
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreateAppointmentConnection(appointments, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
+
+// bookableSlot fetches a free slot that has not started, together with the schedule it belongs to
+func (c *UseCasesClinicalImpl) bookableSlot(ctx context.Context, slotID string) (*domain.FHIRSlot, *domain.FHIRSchedule, error) {
+	slot, err := c.infrastructure.FHIR.GetFHIRSlot(ctx, slotID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !slotIsFree(*slot.Resource, time.Now()) {
+		return nil, nil, fmt.Errorf("slot %s is not available", slotID)
+	}
+
+	if slot.Resource.Schedule == nil || slot.Resource.Schedule.ID == nil {
+		return nil, nil, fmt.Errorf("slot %s has no schedule", slotID)
+	}
+
+	schedule, err := c.infrastructure.FHIR.GetFHIRSchedule(ctx, *slot.Resource.Schedule.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return slot.Resource, schedule.Resource, nil
+}
+
+// bookedAppointment fetches an appointment that can still be rescheduled, cancelled or attended
+func (c *UseCasesClinicalImpl) bookedAppointment(ctx context.Context, id string) (*domain.FHIRAppointment, error) {
+	payload, err := c.infrastructure.FHIR.GetFHIRAppointment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.Resource.Status == nil || string(*payload.Resource.Status) != dto.AppointmentStatusBooked.Code() {
+		return nil, fmt.Errorf("appointment %s is not booked", id)
+	}
+
+	return payload.Resource, nil
+}
+
+// updateSlotStatus marks a slot as busy once an appointment is booked in it, or as free once the appointment is moved or cancelled.
+// The update fails if the slot has changed since it was read
+func (c *UseCasesClinicalImpl) updateSlotStatus(ctx context.Context, slot domain.FHIRSlot, status dto.SlotStatusEnum) error {
+	code := scalarutils.Code(status.Code())
+	slot.Status = &code
+
+	_, err := c.infrastructure.FHIR.UpdateFHIRSlot(ctx, slot)
+
+	return err
+}
+
+// releaseSlots frees the slots an appointment was booked in
+func (c *UseCasesClinicalImpl) releaseSlots(ctx context.Context, slots []*domain.FHIRReference) error {
+	for _, reference := range slots {
+		if reference == nil || reference.ID == nil {
+			continue
+		}
+
+		slot, err := c.infrastructure.FHIR.GetFHIRSlot(ctx, *reference.ID)
+		if err != nil {
+			return err
+		}
+
+		err = c.updateSlotStatus(ctx, *slot.Resource, dto.SlotStatusFree)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// releaseClaimedSlots frees the slots claimed for an appointment that could not then be recorded.
+// Failing to free them is only logged so that the error that prevented the booking is the one returned
+func (c *UseCasesClinicalImpl) releaseClaimedSlots(ctx context.Context, slots []*domain.FHIRReference) {
+	err := c.releaseSlots(ctx, slots)
+	if err != nil {
+		utils.ReportErrorToSentry(fmt.Errorf("unable to release slots claimed for an appointment that was not recorded: %w", err))
+	}
+}
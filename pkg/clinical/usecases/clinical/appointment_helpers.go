@@ -0,0 +1,235 @@
+package clinical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+const (
+	// appointmentParticipantAccepted is the participation status of the patient, facility and practitioner of a booked appointment
+	appointmentParticipantAccepted = "accepted"
+)
+
+// scheduleSlotPeriod is the period of a single slot of a schedule
+type scheduleSlotPeriod struct {
+	start time.Time
+	end   time.Time
+}
+
+// scheduleSlotPeriods divides a period into consecutive slots of the given duration.
+// A remainder shorter than the duration is not made into a slot
+func scheduleSlotPeriods(start, end time.Time, duration time.Duration) []scheduleSlotPeriod {
+	periods := []scheduleSlotPeriod{}
+
+	for slotStart := start; !slotStart.Add(duration).After(end); slotStart = slotStart.Add(duration) {
+		periods = append(periods, scheduleSlotPeriod{
+			start: slotStart,
+			end:   slotStart.Add(duration),
+		})
+	}
+
+	return periods
+}
+
+// organizationReference references the facility of a schedule or an appointment
+func organizationReference(organizationID string) *domain.FHIRReference {
+	reference := fmt.Sprintf("Organization/%s", organizationID)
+
+	return &domain.FHIRReference{
+		ID:        &organizationID,
+		Reference: &reference,
+	}
+}
+
+// scheduleParticipants are the facility and the practitioner a patient is booked with in a slot of the schedule
+func scheduleParticipants(schedule domain.FHIRSchedule) []*domain.FHIRAppointmentParticipant {
+	participants := []*domain.FHIRAppointmentParticipant{}
+
+	for _, actor := range schedule.Actor {
+		if actor == nil {
+			continue
+		}
+
+		status := scalarutils.Code(appointmentParticipantAccepted)
+
+		participants = append(participants, &domain.FHIRAppointmentParticipant{
+			Actor:  actor,
+			Status: &status,
+		})
+	}
+
+	return participants
+}
+
+// isPatientReference checks whether a reference is to a patient, as opposed to the facility or the practitioner
+func isPatientReference(reference *domain.FHIRReference) bool {
+	return reference != nil && reference.Reference != nil && strings.HasPrefix(*reference.Reference, "Patient/")
+}
+
+// isOrganizationReference checks whether a reference is to a facility
+func isOrganizationReference(reference *domain.FHIRReference) bool {
+	return reference != nil && reference.Reference != nil && strings.HasPrefix(*reference.Reference, "Organization/")
+}
+
+// appointmentPatientID reads the ID of the patient booked in an appointment
+func appointmentPatientID(appointment domain.FHIRAppointment) string {
+	for _, participant := range appointment.Participant {
+		if participant != nil && isPatientReference(participant.Actor) && participant.Actor.ID != nil {
+			return *participant.Actor.ID
+		}
+	}
+
+	return ""
+}
+
+// slotIsFree checks whether a slot can be booked. Slots that have started can not be booked
+func slotIsFree(slot domain.FHIRSlot, now time.Time) bool {
+	if slot.Status == nil || string(*slot.Status) != dto.SlotStatusFree.Code() {
+		return false
+	}
+
+	if slot.Start == nil {
+		return false
+	}
+
+	start, err := time.Parse(time.RFC3339, *slot.Start)
+	if err != nil {
+		return false
+	}
+
+	return start.After(now)
+}
+
+func mapFHIRScheduleToDTO(resource domain.FHIRSchedule) *dto.Schedule {
+	output := &dto.Schedule{}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.Active != nil {
+		output.Active = *resource.Active
+	}
+
+	for _, actor := range resource.Actor {
+		switch {
+		case actor == nil:
+			continue
+		case isOrganizationReference(actor) && actor.ID != nil:
+			output.FacilityID = *actor.ID
+		default:
+			output.PractitionerName = actor.Display
+		}
+	}
+
+	if len(resource.ServiceType) > 0 && resource.ServiceType[0] != nil {
+		output.ServiceType = resource.ServiceType[0].Text
+	}
+
+	if resource.Comment != nil {
+		output.Comment = *resource.Comment
+	}
+
+	if resource.PlanningHorizon != nil {
+		if resource.PlanningHorizon.Start != "" {
+			output.StartDate = parseFHIRDate(string(resource.PlanningHorizon.Start))
+		}
+
+		if resource.PlanningHorizon.End != "" {
+			output.EndDate = parseFHIRDate(string(resource.PlanningHorizon.End))
+		}
+	}
+
+	return output
+}
+
+func mapFHIRSlotToDTO(resource domain.FHIRSlot) *dto.Slot {
+	output := &dto.Slot{}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.Schedule != nil && resource.Schedule.ID != nil {
+		output.ScheduleID = *resource.Schedule.ID
+	}
+
+	if resource.Status != nil {
+		output.Status = dto.SlotStatusEnum(strings.ToUpper(string(*resource.Status)))
+	}
+
+	if resource.Start != nil {
+		output.Start = scalarutils.DateTime(*resource.Start)
+	}
+
+	if resource.End != nil {
+		output.End = scalarutils.DateTime(*resource.End)
+	}
+
+	return output
+}
+
+func mapFHIRAppointmentToDTO(resource domain.FHIRAppointment) *dto.Appointment {
+	output := &dto.Appointment{
+		PatientID: appointmentPatientID(resource),
+	}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.Status != nil {
+		output.Status = dto.AppointmentStatusEnum(strings.ToUpper(string(*resource.Status)))
+	}
+
+	for _, participant := range resource.Participant {
+		if participant == nil || participant.Actor == nil || isPatientReference(participant.Actor) {
+			continue
+		}
+
+		if isOrganizationReference(participant.Actor) {
+			if participant.Actor.ID != nil {
+				output.FacilityID = *participant.Actor.ID
+			}
+
+			continue
+		}
+
+		output.PractitionerName = participant.Actor.Display
+	}
+
+	if len(resource.Slot) > 0 && resource.Slot[0] != nil && resource.Slot[0].ID != nil {
+		output.SlotID = *resource.Slot[0].ID
+	}
+
+	if len(resource.BasedOn) > 0 && resource.BasedOn[0] != nil && resource.BasedOn[0].ID != nil {
+		output.ServiceRequestID = *resource.BasedOn[0].ID
+	}
+
+	if resource.Description != nil {
+		output.Description = *resource.Description
+	}
+
+	if resource.Comment != nil {
+		output.Comment = *resource.Comment
+	}
+
+	if resource.Start != nil {
+		output.Start = scalarutils.DateTime(*resource.Start)
+	}
+
+	if resource.End != nil {
+		output.End = scalarutils.DateTime(*resource.End)
+	}
+
+	if resource.CancelationReason != nil {
+		output.CancellationReason = resource.CancelationReason.Text
+	}
+
+	return output
+}
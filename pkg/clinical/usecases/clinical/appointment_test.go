@@ -0,0 +1,958 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+// appointmentPatientID is the patient booked in the mock appointments
+const appointmentPatientID = "12345678905432345"
+
+// fakeSlotWithStatus returns a slot of the given status starting at the given time
+func fakeSlotWithStatus(id string, status string, start time.Time) *domain.FHIRSlotRelayPayload {
+	scheduleID := gofakeit.UUID()
+	scheduleReference := "Schedule/" + scheduleID
+	slotStatus := scalarutils.Code(status)
+	startTime := start.Format(time.RFC3339)
+	endTime := start.Add(30 * time.Minute).Format(time.RFC3339)
+
+	return &domain.FHIRSlotRelayPayload{
+		Resource: &domain.FHIRSlot{
+			ID: &id,
+			Schedule: &domain.FHIRReference{
+				ID:        &scheduleID,
+				Reference: &scheduleReference,
+			},
+			Status: &slotStatus,
+			Start:  &startTime,
+			End:    &endTime,
+		},
+	}
+}
+
+// fakeAppointmentWithStatus returns a mock appointment with the given status
+func fakeAppointmentWithStatus(id string, status string) *domain.FHIRAppointmentRelayPayload {
+	payload, _ := fakeFHIRMock.NewFHIRMock().GetFHIRAppointment(context.Background(), id)
+	appointmentStatus := scalarutils.Code(status)
+	payload.Resource.Status = &appointmentStatus
+
+	return payload
+}
+
+func TestUseCasesClinicalImpl_BookAppointment(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.AppointmentInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: book a referral follow-up",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentInput{
+					PatientID:        gofakeit.UUID(),
+					SlotID:           gofakeit.UUID(),
+					ServiceRequestID: gofakeit.UUID(),
+					Description:      "Colposcopy after a positive VIA screening",
+					Comment:          "Patient prefers morning visits",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: book appointment",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentInput{
+					PatientID: gofakeit.UUID(),
+					SlotID:    gofakeit.UUID(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid input",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentInput{
+					PatientID: gofakeit.UUID(),
+					SlotID:    "slot",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get slot",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentInput{
+					PatientID: gofakeit.UUID(),
+					SlotID:    gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: slot is busy",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentInput{
+					PatientID: gofakeit.UUID(),
+					SlotID:    gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: slot has started",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentInput{
+					PatientID: gofakeit.UUID(),
+					SlotID:    gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get schedule",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentInput{
+					PatientID: gofakeit.UUID(),
+					SlotID:    gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get patient",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentInput{
+					PatientID: gofakeit.UUID(),
+					SlotID:    gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get service request",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentInput{
+					PatientID:        gofakeit.UUID(),
+					SlotID:           gofakeit.UUID(),
+					ServiceRequestID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: service request is not the patient's",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentInput{
+					PatientID:        gofakeit.UUID(),
+					SlotID:           gofakeit.UUID(),
+					ServiceRequestID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create appointment",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentInput{
+					PatientID: gofakeit.UUID(),
+					SlotID:    gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: slot claimed by another booking",
+			args: args{
+				ctx: context.Background(),
+				input: dto.AppointmentInput{
+					PatientID: gofakeit.UUID(),
+					SlotID:    gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			var updatedSlot domain.FHIRSlot
+
+			created := false
+
+			createAppointment := fakeFHIR.MockCreateFHIRAppointmentFn
+			fakeFHIR.MockCreateFHIRAppointmentFn = func(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error) {
+				created = true
+
+				return createAppointment(ctx, input)
+			}
+
+			fakeFHIR.MockUpdateFHIRSlotFn = func(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error) {
+				updatedSlot = input
+
+				return &input, nil
+			}
+
+			fakeFHIR.MockGetFHIRServiceRequestFn = func(ctx context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error) {
+				return &domain.FHIRServiceRequestRelayPayload{
+					Resource: &domain.FHIRServiceRequest{
+						ID: &id,
+						Subject: &domain.FHIRReference{
+							ID: &tt.args.input.PatientID,
+						},
+					},
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to get slot" {
+				fakeFHIR.MockGetFHIRSlotFn = func(ctx context.Context, id string) (*domain.FHIRSlotRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: slot is busy" {
+				fakeFHIR.MockGetFHIRSlotFn = func(ctx context.Context, id string) (*domain.FHIRSlotRelayPayload, error) {
+					return fakeSlotWithStatus(id, "busy", time.Now().Add(time.Hour)), nil
+				}
+			}
+
+			if tt.name == "Sad case: slot has started" {
+				fakeFHIR.MockGetFHIRSlotFn = func(ctx context.Context, id string) (*domain.FHIRSlotRelayPayload, error) {
+					return fakeSlotWithStatus(id, "free", time.Now().Add(-time.Minute)), nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get schedule" {
+				fakeFHIR.MockGetFHIRScheduleFn = func(ctx context.Context, id string) (*domain.FHIRScheduleRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get patient" {
+				fakeFHIR.MockGetFHIRPatientFn = func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get service request" {
+				fakeFHIR.MockGetFHIRServiceRequestFn = func(ctx context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: service request is not the patient's" {
+				fakeFHIR.MockGetFHIRServiceRequestFn = func(ctx context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error) {
+					patientID := gofakeit.UUID()
+
+					return &domain.FHIRServiceRequestRelayPayload{
+						Resource: &domain.FHIRServiceRequest{
+							ID: &id,
+							Subject: &domain.FHIRReference{
+								ID: &patientID,
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to create appointment" {
+				fakeFHIR.MockCreateFHIRAppointmentFn = func(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: slot claimed by another booking" {
+				fakeFHIR.MockUpdateFHIRSlotFn = func(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error) {
+					return nil, fmt.Errorf("precondition failed")
+				}
+			}
+
+			got, err := c.BookAppointment(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.BookAppointment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.name == "Sad case: slot claimed by another booking" && created {
+				t.Errorf("expected no appointment to be recorded in a slot claimed by another booking")
+			}
+
+			if tt.name == "Sad case: failed to create appointment" && (updatedSlot.Status == nil || *updatedSlot.Status != "free") {
+				t.Errorf("expected the claimed slot to be freed, got %v", updatedSlot.Status)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Status != dto.AppointmentStatusBooked || got.PatientID != tt.args.input.PatientID || got.SlotID != tt.args.input.SlotID {
+				t.Errorf("expected the patient to be booked in slot %s, got %v", tt.args.input.SlotID, got)
+			}
+
+			if got.ServiceRequestID != tt.args.input.ServiceRequestID {
+				t.Errorf("expected the appointment to be based on %q, got %q", tt.args.input.ServiceRequestID, got.ServiceRequestID)
+			}
+
+			if got.FacilityID == "" || got.PractitionerName == "" || got.Start == "" {
+				t.Errorf("expected the appointment to be with the schedule's facility and practitioner, got %v", got)
+			}
+
+			if updatedSlot.Status == nil || *updatedSlot.Status != "busy" {
+				t.Errorf("expected the slot to be marked as busy, got %v", updatedSlot.Status)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_RescheduleAppointment(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		id     string
+		slotID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: reschedule appointment",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				slotID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid slot id",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				slotID: "slot",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: appointment is cancelled",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				slotID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: slot is busy",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				slotID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update appointment",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				slotID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to release previous slot",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				slotID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			updatedSlots := map[string]string{}
+
+			fakeFHIR.MockUpdateFHIRSlotFn = func(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error) {
+				updatedSlots[*input.ID] = string(*input.Status)
+
+				return &input, nil
+			}
+
+			if tt.name == "Sad case: appointment is cancelled" {
+				fakeFHIR.MockGetFHIRAppointmentFn = func(ctx context.Context, id string) (*domain.FHIRAppointmentRelayPayload, error) {
+					return fakeAppointmentWithStatus(id, "cancelled"), nil
+				}
+			}
+
+			if tt.name == "Sad case: slot is busy" {
+				fakeFHIR.MockGetFHIRSlotFn = func(ctx context.Context, id string) (*domain.FHIRSlotRelayPayload, error) {
+					return fakeSlotWithStatus(id, "busy", time.Now().Add(time.Hour)), nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to update appointment" {
+				fakeFHIR.MockUpdateFHIRAppointmentFn = func(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to release previous slot" {
+				fakeFHIR.MockGetFHIRSlotFn = func(ctx context.Context, id string) (*domain.FHIRSlotRelayPayload, error) {
+					if id != tt.args.slotID {
+						return nil, fmt.Errorf("an error occurred")
+					}
+
+					return fakeSlotWithStatus(id, "free", time.Now().Add(time.Hour)), nil
+				}
+			}
+
+			got, err := c.RescheduleAppointment(tt.args.ctx, tt.args.id, tt.args.slotID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RescheduleAppointment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.name == "Sad case: failed to update appointment" && updatedSlots[tt.args.slotID] != "free" {
+				t.Errorf("expected the claimed slot to be freed, got %v", updatedSlots)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.SlotID != tt.args.slotID || got.PatientID != appointmentPatientID || got.PractitionerName == "" {
+				t.Errorf("expected the patient to be moved to slot %s, got %v", tt.args.slotID, got)
+			}
+
+			if len(updatedSlots) != 2 || updatedSlots[tt.args.slotID] != "busy" {
+				t.Errorf("expected the new slot to be busy and the previous slot to be freed, got %v", updatedSlots)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_CancelAppointment(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		id     string
+		reason string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: cancel appointment",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				reason: "Patient travelled",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: no reason",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get appointment",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				reason: "Patient travelled",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: appointment is fulfilled",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				reason: "Patient travelled",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update appointment",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				reason: "Patient travelled",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to free slot",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				reason: "Patient travelled",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			var freed bool
+
+			fakeFHIR.MockUpdateFHIRSlotFn = func(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error) {
+				freed = *input.Status == "free"
+
+				return &input, nil
+			}
+
+			if tt.name == "Sad case: failed to get appointment" {
+				fakeFHIR.MockGetFHIRAppointmentFn = func(ctx context.Context, id string) (*domain.FHIRAppointmentRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: appointment is fulfilled" {
+				fakeFHIR.MockGetFHIRAppointmentFn = func(ctx context.Context, id string) (*domain.FHIRAppointmentRelayPayload, error) {
+					return fakeAppointmentWithStatus(id, "fulfilled"), nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to update appointment" {
+				fakeFHIR.MockUpdateFHIRAppointmentFn = func(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to free slot" {
+				fakeFHIR.MockUpdateFHIRSlotFn = func(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.CancelAppointment(tt.args.ctx, tt.args.id, tt.args.reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.CancelAppointment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Status != dto.AppointmentStatusCancelled || got.CancellationReason != tt.args.reason || !freed {
+				t.Errorf("expected a cancelled appointment with its slot freed, got %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_StartAppointmentEncounter(t *testing.T) {
+	type args struct {
+		ctx           context.Context
+		appointmentID string
+		episodeID     string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: start encounter from appointment",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: gofakeit.UUID(),
+				episodeID:     gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: no episode of care",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: appointment is cancelled",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: gofakeit.UUID(),
+				episodeID:     gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get episode of care",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: gofakeit.UUID(),
+				episodeID:     gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: episode of care is another patient's",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: gofakeit.UUID(),
+				episodeID:     gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create encounter",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: gofakeit.UUID(),
+				episodeID:     gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update appointment",
+			args: args{
+				ctx:           context.Background(),
+				appointmentID: gofakeit.UUID(),
+				episodeID:     gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			getEpisodeOfCare := fakeFHIR.MockGetFHIREpisodeOfCareFn
+			fakeFHIR.MockGetFHIREpisodeOfCareFn = func(ctx context.Context, id string) (*domain.FHIREpisodeOfCareRelayPayload, error) {
+				episode, err := getEpisodeOfCare(ctx, id)
+				if err != nil {
+					return nil, err
+				}
+
+				patientID := appointmentPatientID
+				episode.Resource.Patient.ID = &patientID
+
+				return episode, nil
+			}
+
+			var encounter domain.FHIREncounterInput
+
+			createEncounter := fakeFHIR.MockCreateFHIREncounterFn
+			fakeFHIR.MockCreateFHIREncounterFn = func(ctx context.Context, input domain.FHIREncounterInput) (*domain.FHIREncounterRelayPayload, error) {
+				encounter = input
+
+				return createEncounter(ctx, input)
+			}
+
+			var arrived bool
+
+			fakeFHIR.MockUpdateFHIRAppointmentFn = func(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error) {
+				arrived = *input.Status == "arrived"
+
+				return &input, nil
+			}
+
+			if tt.name == "Sad case: appointment is cancelled" {
+				fakeFHIR.MockGetFHIRAppointmentFn = func(ctx context.Context, id string) (*domain.FHIRAppointmentRelayPayload, error) {
+					return fakeAppointmentWithStatus(id, "cancelled"), nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get episode of care" {
+				fakeFHIR.MockGetFHIREpisodeOfCareFn = func(ctx context.Context, id string) (*domain.FHIREpisodeOfCareRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: episode of care is another patient's" {
+				fakeFHIR.MockGetFHIREpisodeOfCareFn = getEpisodeOfCare
+			}
+
+			if tt.name == "Sad case: failed to create encounter" {
+				fakeFHIR.MockCreateFHIREncounterFn = func(ctx context.Context, input domain.FHIREncounterInput) (*domain.FHIREncounterRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to update appointment" {
+				fakeFHIR.MockUpdateFHIRAppointmentFn = func(ctx context.Context, input domain.FHIRAppointment) (*domain.FHIRAppointment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.StartAppointmentEncounter(tt.args.ctx, tt.args.appointmentID, tt.args.episodeID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.StartAppointmentEncounter() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got == "" || !arrived {
+				t.Errorf("expected an encounter to be started and the patient marked as arrived, got %q", got)
+			}
+
+			if len(encounter.Appointment) != 1 || *encounter.Appointment[0].ID != tt.args.appointmentID {
+				t.Errorf("expected the encounter to reference appointment %s, got %v", tt.args.appointmentID, encounter.Appointment)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_GetAppointment(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get appointment",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to get appointment",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to get appointment" {
+				fakeFHIR.MockGetFHIRAppointmentFn = func(ctx context.Context, id string) (*domain.FHIRAppointmentRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.GetAppointment(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.GetAppointment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (got.PatientID != appointmentPatientID || got.FacilityID == "" || got.SlotID == "") {
+				t.Errorf("expected the appointment's patient, facility and slot, got %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListFacilityAppointments(t *testing.T) {
+	first := 10
+	invalidFirst := -1
+
+	type args struct {
+		ctx        context.Context
+		facilityID string
+		filter     dto.AppointmentFilterEnum
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantStatus string
+		wantErr    bool
+	}{
+		{
+			name: "Happy case: list upcoming appointments",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				filter:     dto.AppointmentFilterUpcoming,
+				pagination: dto.Pagination{First: &first},
+			},
+			wantStatus: "booked",
+			wantErr:    false,
+		},
+		{
+			name: "Happy case: list missed appointments",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				filter:     dto.AppointmentFilterMissed,
+				pagination: dto.Pagination{First: &first},
+			},
+			wantStatus: "booked,noshow",
+			wantErr:    false,
+		},
+		{
+			name: "Sad case: invalid facility id",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: "facility",
+				filter:     dto.AppointmentFilterUpcoming,
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid filter",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				filter:     "TODAY",
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid pagination",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				filter:     dto.AppointmentFilterUpcoming,
+				pagination: dto.Pagination{First: &invalidFirst},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search appointments",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				filter:     dto.AppointmentFilterUpcoming,
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			var searched map[string]interface{}
+
+			searchAppointments := fakeFHIR.MockSearchFHIRAppointmentFn
+			fakeFHIR.MockSearchFHIRAppointmentFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAppointment, error) {
+				searched = params
+
+				return searchAppointments(ctx, params, tenant, pagination)
+			}
+
+			if tt.name == "Sad case: failed to search appointments" {
+				fakeFHIR.MockSearchFHIRAppointmentFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAppointment, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.ListFacilityAppointments(tt.args.ctx, tt.args.facilityID, tt.args.filter, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListFacilityAppointments() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if searched["status"] != tt.wantStatus || searched["actor"] != "Organization/"+tt.args.facilityID {
+				t.Errorf("expected the facility's %s appointments to be searched, got %v", tt.wantStatus, searched)
+			}
+
+			if len(got.Edges) != 1 {
+				t.Errorf("expected one appointment, got %v", got.Edges)
+			}
+		})
+	}
+}
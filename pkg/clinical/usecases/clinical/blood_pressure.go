@@ -0,0 +1,263 @@
+package clinical
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common/helpers"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/application/utils"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/referenceranges"
+	"github.com/savannahghi/scalarutils"
+)
+
+// RecordBloodPressurePanel records a systolic and a diastolic pressure read together as the components of one observation.
+// The pressures are converted to `mm[Hg]` and interpreted against the reference ranges of the patient,
+// and the early warning scores of the encounter are recomputed
+func (c *UseCasesClinicalImpl) RecordBloodPressurePanel(ctx context.Context, input dto.BloodPressurePanelInput) (*dto.BloodPressureReading, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	encounter, err := c.infrastructure.FHIR.GetFHIREncounter(ctx, input.EncounterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if encounter.Resource.Status == domain.EncounterStatusEnumFinished {
+		return nil, fmt.Errorf("cannot record an observation in a finished encounter")
+	}
+
+	patientID := encounter.Resource.Subject.ID
+	patientReference := fmt.Sprintf("Patient/%s", *patientID)
+
+	encounterReference := fmt.Sprintf("Encounter/%s", *encounter.Resource.ID)
+
+	panelConcept, err := c.GetConcept(ctx, dto.TerminologySourceLOINC, common.LOINCBloodPressurePanel)
+	if err != nil {
+		return nil, err
+	}
+
+	patient, err := c.referenceRangesPatient(ctx, *patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	components := []*domain.FHIRObservationComponentInput{}
+
+	for _, pressure := range []struct {
+		field     string
+		value     float64
+		conceptID string
+		loincCode string
+	}{
+		{"systolic", input.Systolic, common.BloodPressureCIELTerminologyCode, common.LOINCSystolicBloodPressure},
+		{"diastolic", input.Diastolic, common.DiastolicBloodPressureCIELTerminologyCode, common.LOINCDiastolicBloodPressure},
+	} {
+		quantity, err := observationQuantity(pressure.conceptID, strconv.FormatFloat(pressure.value, 'f', -1, 64), input.Unit)
+		if err != nil {
+			return nil, err
+		}
+
+		component, err := c.bloodPressureComponent(ctx, pressure.conceptID, pressure.loincCode, *quantity, patient)
+		if err != nil {
+			var fieldErr dto.FieldError
+			if errors.As(err, &fieldErr) {
+				fieldErr.Field = pressure.field
+
+				return nil, fieldErr
+			}
+
+			return nil, err
+		}
+
+		components = append(components, component)
+	}
+
+	instant := scalarutils.Instant(time.Now().Format(time.RFC3339))
+
+	observation := domain.FHIRObservationInput{
+		Status:           (*domain.ObservationStatusEnum)(&input.Status),
+		Category:         []*domain.FHIRCodeableConceptInput{},
+		EffectiveInstant: &instant,
+		Code: &domain.FHIRCodeableConceptInput{
+			Coding: []*domain.FHIRCodingInput{
+				{
+					System:  (*scalarutils.URI)(&panelConcept.URL),
+					Code:    scalarutils.Code(common.LOINCBloodPressurePanel),
+					Display: panelConcept.DisplayName,
+				},
+			},
+			Text: panelConcept.DisplayName,
+		},
+		Subject: &domain.FHIRReferenceInput{
+			ID:        patientID,
+			Reference: &patientReference,
+			Display:   encounter.Resource.Subject.Display,
+		},
+		Encounter: &domain.FHIRReferenceInput{
+			ID:        encounter.Resource.ID,
+			Reference: &encounterReference,
+		},
+		Component: components,
+	}
+
+	err = addObservationCategory("vital-signs")(ctx, &observation)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Note != "" {
+		observation.Note = append(observation.Note, &domain.FHIRAnnotationInput{
+			Text: (*scalarutils.Markdown)(&input.Note),
+		})
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	observation.Meta = &domain.FHIRMetaInput{
+		Tag: tags,
+	}
+
+	fhirObservation, err := c.infrastructure.FHIR.CreateFHIRObservation(ctx, observation)
+	if err != nil {
+		return nil, err
+	}
+
+	reading := mapFHIRObservationToBloodPressureReading(*fhirObservation)
+
+	if c.contributesToEarlyWarningScores(common.BloodPressureCIELTerminologyCode) {
+		err = c.deriveEarlyWarningScores(ctx, reading.EncounterID, reading.PatientID)
+		if err != nil {
+			utils.ReportErrorToSentry(fmt.Errorf("unable to compute the early warning scores of encounter %s: %w", reading.EncounterID, err))
+		}
+	}
+
+	return reading, nil
+}
+
+// bloodPressureComponent is a pressure in a blood pressure panel coded with its CIEL and LOINC concepts
+func (c *UseCasesClinicalImpl) bloodPressureComponent(ctx context.Context, conceptID string, loincCode string, quantity domain.FHIRQuantityInput, patient referenceranges.Patient) (*domain.FHIRObservationComponentInput, error) {
+	cielConcept, err := c.GetConcept(ctx, dto.TerminologySourceCIEL, conceptID)
+	if err != nil {
+		return nil, err
+	}
+
+	loincConcept, err := c.GetConcept(ctx, dto.TerminologySourceLOINC, loincCode)
+	if err != nil {
+		return nil, err
+	}
+
+	interpretation, referenceRange, err := c.interpretQuantity(conceptID, quantity, patient)
+	if err != nil {
+		return nil, err
+	}
+
+	component := &domain.FHIRObservationComponentInput{
+		Code: domain.FHIRCodeableConceptInput{
+			Coding: []*domain.FHIRCodingInput{
+				{
+					System:  (*scalarutils.URI)(&cielConcept.URL),
+					Code:    scalarutils.Code(conceptID),
+					Display: cielConcept.DisplayName,
+				},
+				{
+					System:  (*scalarutils.URI)(&loincConcept.URL),
+					Code:    scalarutils.Code(loincCode),
+					Display: loincConcept.DisplayName,
+				},
+			},
+			Text: cielConcept.DisplayName,
+		},
+		ValueQuantity: &quantity,
+	}
+
+	if interpretation != nil {
+		component.Interpretation = append(component.Interpretation, interpretation)
+	}
+
+	if referenceRange != nil {
+		component.ReferenceRange = append(component.ReferenceRange, referenceRange)
+	}
+
+	return component, nil
+}
+
+// GetPatientBloodPressureReadings lists the blood pressure readings of a patient, latest first. Readings recorded before the
+// blood pressure panel as separate systolic and diastolic observations are paired by the encounter and the time they were taken in
+func (c *UseCasesClinicalImpl) GetPatientBloodPressureReadings(ctx context.Context, patientID string, encounterID *string, date *scalarutils.Date) ([]*dto.BloodPressureReading, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	_, err = c.infrastructure.FHIR.GetFHIRPatient(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	searchParams := map[string]interface{}{
+		"patient": fmt.Sprintf("Patient/%s", patientID),
+		"code": fmt.Sprintf(
+			"%s,%s,%s",
+			common.LOINCBloodPressurePanel, common.BloodPressureCIELTerminologyCode, common.DiastolicBloodPressureCIELTerminologyCode,
+		),
+	}
+
+	if encounterID != nil {
+		searchParams["encounter"] = fmt.Sprintf("Encounter/%s", *encounterID)
+	}
+
+	if date != nil {
+		searchParams["date"] = date.AsTime().Format(dateFormatStr)
+	}
+
+	observations, err := c.infrastructure.FHIR.SearchPatientObservations(ctx, searchParams, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	readings := []*dto.BloodPressureReading{}
+	systolic, diastolic := []*dto.Observation{}, []*dto.Observation{}
+
+	for _, observation := range observations.Observations {
+		if observation.ID == nil || observation.Subject == nil || observation.Subject.ID == nil || observation.Code == nil {
+			continue
+		}
+
+		switch {
+		case hasCode(*observation.Code, common.LOINCBloodPressurePanel):
+			readings = append(readings, mapFHIRObservationToBloodPressureReading(observation))
+
+		case hasCode(*observation.Code, common.BloodPressureCIELTerminologyCode):
+			systolic = append(systolic, mapFHIRObservationToObservationDTO(observation))
+
+		case hasCode(*observation.Code, common.DiastolicBloodPressureCIELTerminologyCode):
+			diastolic = append(diastolic, mapFHIRObservationToObservationDTO(observation))
+		}
+	}
+
+	readings = append(readings, pairBloodPressureObservations(systolic, diastolic)...)
+
+	sort.SliceStable(readings, func(i, j int) bool {
+		return helpers.ParseDate(readings[i].TimeRecorded).After(helpers.ParseDate(readings[j].TimeRecorded))
+	})
+
+	return readings, nil
+}
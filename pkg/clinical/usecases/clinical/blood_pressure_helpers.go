@@ -0,0 +1,222 @@
+package clinical
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common/helpers"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+)
+
+// bloodPressurePairingWindow is how far apart a systolic and a diastolic pressure recorded separately in an encounter
+// can be taken and still be paired as one reading
+const bloodPressurePairingWindow = 15 * time.Minute
+
+func mapFHIRObservationToBloodPressureReading(observation domain.FHIRObservation) *dto.BloodPressureReading {
+	reading := &dto.BloodPressureReading{}
+
+	if observation.ID != nil {
+		reading.ID = *observation.ID
+		reading.ObservationIDs = []string{*observation.ID}
+	}
+
+	if observation.Subject != nil && observation.Subject.ID != nil {
+		reading.PatientID = *observation.Subject.ID
+	}
+
+	if observation.Encounter != nil && observation.Encounter.ID != nil {
+		reading.EncounterID = *observation.Encounter.ID
+	}
+
+	if observation.EffectiveInstant != nil {
+		reading.TimeRecorded = string(*observation.EffectiveInstant)
+	}
+
+	if len(observation.Note) > 0 && observation.Note[0].Text != nil {
+		reading.Note = string(*observation.Note[0].Text)
+	}
+
+	for _, component := range observation.Component {
+		if component == nil || component.ValueQuantity == nil {
+			continue
+		}
+
+		value := component.ValueQuantity.Value
+		flag := observationInterpretationFlag(component.Interpretation)
+
+		switch {
+		case hasCode(component.Code, common.BloodPressureCIELTerminologyCode, common.LOINCSystolicBloodPressure):
+			reading.Systolic = &value
+			reading.SystolicFlag = flag
+
+		case hasCode(component.Code, common.DiastolicBloodPressureCIELTerminologyCode, common.LOINCDiastolicBloodPressure):
+			reading.Diastolic = &value
+			reading.DiastolicFlag = flag
+
+		default:
+			continue
+		}
+
+		reading.Unit = string(component.ValueQuantity.Code)
+	}
+
+	reading.Abnormal = isAbnormalBloodPressure(reading)
+
+	return reading
+}
+
+// pairBloodPressureObservations pairs systolic and diastolic pressures that were recorded as separate observations.
+// Each systolic pressure is paired with the closest diastolic pressure taken in the same encounter within the pairing window.
+// Pressures that can not be paired are half readings
+func pairBloodPressureObservations(systolic []*dto.Observation, diastolic []*dto.Observation) []*dto.BloodPressureReading {
+	readings := []*dto.BloodPressureReading{}
+	paired := map[int]bool{}
+
+	for _, systolicObservation := range systolic {
+		systolicTime := helpers.ParseDate(systolicObservation.TimeRecorded)
+		match := -1
+
+		var closest time.Duration
+
+		for i, diastolicObservation := range diastolic {
+			if paired[i] || diastolicObservation.EncounterID != systolicObservation.EncounterID {
+				continue
+			}
+
+			apart := helpers.ParseDate(diastolicObservation.TimeRecorded).Sub(systolicTime).Abs()
+			if apart > bloodPressurePairingWindow || (match >= 0 && apart >= closest) {
+				continue
+			}
+
+			match, closest = i, apart
+		}
+
+		reading := pairedBloodPressureReading(systolicObservation)
+		reading.Systolic = systolicObservation.Quantity
+		reading.SystolicFlag = systolicObservation.Flag
+
+		if match >= 0 {
+			paired[match] = true
+
+			reading.ObservationIDs = append(reading.ObservationIDs, diastolic[match].ID)
+			reading.Diastolic = diastolic[match].Quantity
+			reading.DiastolicFlag = diastolic[match].Flag
+		}
+
+		reading.Abnormal = isAbnormalBloodPressure(reading)
+		readings = append(readings, reading)
+	}
+
+	for i, diastolicObservation := range diastolic {
+		if paired[i] {
+			continue
+		}
+
+		reading := pairedBloodPressureReading(diastolicObservation)
+		reading.Diastolic = diastolicObservation.Quantity
+		reading.DiastolicFlag = diastolicObservation.Flag
+		reading.Abnormal = isAbnormalBloodPressure(reading)
+
+		readings = append(readings, reading)
+	}
+
+	return readings
+}
+
+// pairedBloodPressureReading is a reading taken from the details of the first pressure in a pair
+func pairedBloodPressureReading(observation *dto.Observation) *dto.BloodPressureReading {
+	return &dto.BloodPressureReading{
+		ID:             observation.ID,
+		ObservationIDs: []string{observation.ID},
+		PatientID:      observation.PatientID,
+		EncounterID:    observation.EncounterID,
+		TimeRecorded:   observation.TimeRecorded,
+		Note:           observation.Note,
+		Unit:           observation.Unit,
+		Paired:         true,
+	}
+}
+
+func isAbnormalBloodPressure(reading *dto.BloodPressureReading) bool {
+	return (reading.SystolicFlag != nil && reading.SystolicFlag.IsAbnormal()) ||
+		(reading.DiastolicFlag != nil && reading.DiastolicFlag.IsAbnormal())
+}
+
+// bloodPressureFlag is the most severe flag of the pressures in a reading
+func bloodPressureFlag(reading *dto.BloodPressureReading) *dto.ObservationInterpretationEnum {
+	var flag *dto.ObservationInterpretationEnum
+
+	for _, pressureFlag := range []*dto.ObservationInterpretationEnum{reading.SystolicFlag, reading.DiastolicFlag} {
+		if pressureFlag != nil && (flag == nil || observationFlagSeverity(*pressureFlag) > observationFlagSeverity(*flag)) {
+			flag = pressureFlag
+		}
+	}
+
+	return flag
+}
+
+func observationFlagSeverity(flag dto.ObservationInterpretationEnum) int {
+	switch flag {
+	case dto.ObservationInterpretationCriticalHigh, dto.ObservationInterpretationCriticalLow:
+		return 2
+	case dto.ObservationInterpretationHigh, dto.ObservationInterpretationLow:
+		return 1
+	}
+
+	return 0
+}
+
+// bloodPressureText is a blood pressure reading as it is displayed e.g `120/80`, in the unit of the reading
+func bloodPressureText(reading *dto.BloodPressureReading) string {
+	if reading.Systolic == nil || reading.Diastolic == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%v/%v", *reading.Systolic, *reading.Diastolic)
+}
+
+// bloodPressureComponentCodes are the codes a pressure is coded with in a blood pressure panel, by the CIEL concept
+// the pressure is recorded with on its own
+var bloodPressureComponentCodes = map[string][]string{
+	common.BloodPressureCIELTerminologyCode:          {common.BloodPressureCIELTerminologyCode, common.LOINCSystolicBloodPressure},
+	common.DiastolicBloodPressureCIELTerminologyCode: {common.DiastolicBloodPressureCIELTerminologyCode, common.LOINCDiastolicBloodPressure},
+}
+
+// observationCodeSearchParam is the search parameter that finds the observations of a CIEL concept.
+// Pressures are also found in the components of blood pressure panels
+func observationCodeSearchParam(conceptID string) string {
+	if _, ok := bloodPressureComponentCodes[conceptID]; ok {
+		return "combo-code"
+	}
+
+	return "code"
+}
+
+// bloodPressureComponentObservation reads the pressure of a CIEL concept from a blood pressure panel as an observation of its own,
+// so that it is read like the pressures that were recorded separately before the panel. Other observations are returned as they are
+func bloodPressureComponentObservation(observation domain.FHIRObservation, conceptID string) domain.FHIRObservation {
+	codes, ok := bloodPressureComponentCodes[conceptID]
+	if !ok || observation.Code == nil || !hasCode(*observation.Code, common.LOINCBloodPressurePanel) {
+		return observation
+	}
+
+	for _, component := range observation.Component {
+		if component == nil || !hasCode(component.Code, codes...) {
+			continue
+		}
+
+		code := component.Code
+
+		observation.Code = &code
+		observation.ValueQuantity = component.ValueQuantity
+		observation.Interpretation = component.Interpretation
+		observation.ReferenceRange = component.ReferenceRange
+		observation.Component = nil
+
+		break
+	}
+
+	return observation
+}
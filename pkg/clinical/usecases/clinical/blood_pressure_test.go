@@ -0,0 +1,540 @@
+package clinical_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+func TestUseCasesClinicalImpl_RecordBloodPressurePanel(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.BloodPressurePanelInput
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantSystolic  float64
+		wantDiastolic float64
+		wantAbnormal  bool
+		wantField     string
+		wantErr       bool
+	}{
+		{
+			name: "Happy case: record a blood pressure reading",
+			args: args{
+				ctx: context.Background(),
+				input: dto.BloodPressurePanelInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Systolic:    120,
+					Diastolic:   80,
+					Note:        "Seated",
+				},
+			},
+			wantSystolic:  120,
+			wantDiastolic: 80,
+			wantAbnormal:  false,
+			wantErr:       false,
+		},
+		{
+			name: "Happy case: flag a high blood pressure reading",
+			args: args{
+				ctx: context.Background(),
+				input: dto.BloodPressurePanelInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Systolic:    150,
+					Diastolic:   95,
+				},
+			},
+			wantSystolic:  150,
+			wantDiastolic: 95,
+			wantAbnormal:  true,
+			wantErr:       false,
+		},
+		{
+			name: "Happy case: record a reading in kilopascals as millimetres of mercury",
+			args: args{
+				ctx: context.Background(),
+				input: dto.BloodPressurePanelInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Systolic:    16,
+					Diastolic:   10.7,
+					Unit:        "kPa",
+				},
+			},
+			wantSystolic:  120.01,
+			wantDiastolic: 80.26,
+			wantAbnormal:  false,
+			wantErr:       false,
+		},
+		{
+			name: "Sad case: diastolic pressure above the systolic pressure",
+			args: args{
+				ctx: context.Background(),
+				input: dto.BloodPressurePanelInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Systolic:    80,
+					Diastolic:   120,
+				},
+			},
+			wantField: "diastolic",
+			wantErr:   true,
+		},
+		{
+			name: "Sad case: systolic pressure that is not possible",
+			args: args{
+				ctx: context.Background(),
+				input: dto.BloodPressurePanelInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Systolic:    400,
+					Diastolic:   80,
+				},
+			},
+			wantField: "systolic",
+			wantErr:   true,
+		},
+		{
+			name: "Sad case: missing encounter",
+			args: args{
+				ctx: context.Background(),
+				input: dto.BloodPressurePanelInput{
+					Status:    dto.ObservationStatusFinal,
+					Systolic:  120,
+					Diastolic: 80,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unit that can not be converted",
+			args: args{
+				ctx: context.Background(),
+				input: dto.BloodPressurePanelInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Systolic:    120,
+					Diastolic:   80,
+					Unit:        "kg",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to get encounter",
+			args: args{
+				ctx: context.Background(),
+				input: dto.BloodPressurePanelInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Systolic:    120,
+					Diastolic:   80,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: finished encounter",
+			args: args{
+				ctx: context.Background(),
+				input: dto.BloodPressurePanelInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Systolic:    120,
+					Diastolic:   80,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to get concept",
+			args: args{
+				ctx: context.Background(),
+				input: dto.BloodPressurePanelInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Systolic:    120,
+					Diastolic:   80,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to get patient",
+			args: args{
+				ctx: context.Background(),
+				input: dto.BloodPressurePanelInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Systolic:    120,
+					Diastolic:   80,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to get tenant meta tags",
+			args: args{
+				ctx: context.Background(),
+				input: dto.BloodPressurePanelInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Systolic:    120,
+					Diastolic:   80,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to create observation",
+			args: args{
+				ctx: context.Background(),
+				input: dto.BloodPressurePanelInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Systolic:    120,
+					Diastolic:   80,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeFHIR.MockCreateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				if input.Code == nil || len(input.Code.Coding) != 1 || input.Code.Coding[0].Code != common.LOINCBloodPressurePanel {
+					t.Errorf("expected the reading to be coded as a blood pressure panel")
+				}
+
+				if input.ValueQuantity != nil || input.ValueString != nil || len(input.Component) != 2 {
+					t.Errorf("expected the pressures to be recorded as the components of the reading")
+				}
+
+				bs, err := json.Marshal(input)
+				if err != nil {
+					return nil, err
+				}
+
+				observation := &domain.FHIRObservation{}
+
+				err = json.Unmarshal(bs, observation)
+				if err != nil {
+					return nil, err
+				}
+
+				id := uuid.New().String()
+				observation.ID = &id
+
+				return observation, nil
+			}
+
+			if tt.name == "Sad case: fail to get encounter" {
+				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: finished encounter" {
+				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+					encounterID := uuid.New().String()
+					patientID := uuid.New().String()
+
+					return &domain.FHIREncounterRelayPayload{
+						Resource: &domain.FHIREncounter{
+							ID:     &encounterID,
+							Status: domain.EncounterStatusEnumFinished,
+							Subject: &domain.FHIRReference{
+								ID: &patientID,
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: fail to get concept" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: fail to get patient" {
+				fakeFHIR.MockGetFHIRPatientFn = func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: fail to get tenant meta tags" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: fail to create observation" {
+				fakeFHIR.MockCreateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.RecordBloodPressurePanel(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RecordBloodPressurePanel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantField != "" {
+				var fieldErr dto.FieldError
+				if !errors.As(err, &fieldErr) || fieldErr.Field != tt.wantField {
+					t.Errorf("expected a field error on %s but got %v", tt.wantField, err)
+				}
+
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Systolic == nil || *got.Systolic != tt.wantSystolic || got.Diastolic == nil || *got.Diastolic != tt.wantDiastolic {
+				t.Errorf("expected %v/%v but got %v/%v", tt.wantSystolic, tt.wantDiastolic, got.Systolic, got.Diastolic)
+				return
+			}
+
+			if got.Unit != "mm[Hg]" {
+				t.Errorf("expected the reading to be in mm[Hg] but got %s", got.Unit)
+			}
+
+			if got.SystolicFlag == nil || got.DiastolicFlag == nil || got.Abnormal != tt.wantAbnormal {
+				t.Errorf("expected the pressures to be interpreted with abnormal %v, got %v", tt.wantAbnormal, got.Abnormal)
+			}
+
+			if got.Paired {
+				t.Errorf("expected a reading recorded as a panel not to be paired")
+			}
+		})
+	}
+}
+
+// fakeBloodPressureObservation is a systolic or diastolic pressure recorded as a separate observation
+func fakeBloodPressureObservation(conceptID string, encounterID string, value string, recorded time.Time) domain.FHIRObservation {
+	id := uuid.New().String()
+	patientID := uuid.New().String()
+	status := domain.ObservationStatusEnumFinal
+	code := scalarutils.Code(conceptID)
+	instant := scalarutils.Instant(recorded.Format(time.RFC3339))
+
+	return domain.FHIRObservation{
+		ID:     &id,
+		Status: &status,
+		Code: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					Code:    &code,
+					Display: "Blood pressure",
+				},
+			},
+		},
+		Subject: &domain.FHIRReference{
+			ID: &patientID,
+		},
+		Encounter: &domain.FHIRReference{
+			ID: &encounterID,
+		},
+		EffectiveInstant: &instant,
+		ValueString:      &value,
+	}
+}
+
+// fakeBloodPressurePanel is a blood pressure reading recorded as a panel
+func fakeBloodPressurePanel(encounterID string, systolic float64, diastolic float64, recorded time.Time) domain.FHIRObservation {
+	observation := fakeBloodPressureObservation(common.LOINCBloodPressurePanel, encounterID, "", recorded)
+	observation.ValueString = nil
+
+	systolicCode := scalarutils.Code(common.LOINCSystolicBloodPressure)
+	diastolicCode := scalarutils.Code(common.LOINCDiastolicBloodPressure)
+	high := scalarutils.Code(dto.ObservationInterpretationHigh.Code())
+	system := scalarutils.URI("http://terminology.hl7.org/CodeSystem/v3-ObservationInterpretation")
+
+	observation.Component = []*domain.FHIRObservationComponent{
+		{
+			Code: domain.FHIRCodeableConcept{
+				Coding: []*domain.FHIRCoding{{Code: &systolicCode}},
+			},
+			ValueQuantity: &domain.FHIRQuantity{Value: systolic, Code: "mm[Hg]"},
+			Interpretation: []*domain.FHIRCodeableConcept{
+				{Coding: []*domain.FHIRCoding{{System: &system, Code: &high}}},
+			},
+		},
+		{
+			Code: domain.FHIRCodeableConcept{
+				Coding: []*domain.FHIRCoding{{Code: &diastolicCode}},
+			},
+			ValueQuantity: &domain.FHIRQuantity{Value: diastolic, Code: "mm[Hg]"},
+		},
+	}
+
+	return observation
+}
+
+func TestUseCasesClinicalImpl_GetPatientBloodPressureReadings(t *testing.T) {
+	recorded := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	firstEncounter := uuid.New().String()
+	secondEncounter := uuid.New().String()
+
+	type args struct {
+		ctx       context.Context
+		patientID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list panels and paired readings",
+			args: args{
+				ctx:       context.Background(),
+				patientID: uuid.New().String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid patient id",
+			args: args{
+				ctx:       context.Background(),
+				patientID: "invalid",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to get patient",
+			args: args{
+				ctx:       context.Background(),
+				patientID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to get tenant identifiers",
+			args: args{
+				ctx:       context.Background(),
+				patientID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to search observations",
+			args: args{
+				ctx:       context.Background(),
+				patientID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				return &domain.PagedFHIRObservations{
+					Observations: []domain.FHIRObservation{
+						fakeBloodPressureObservation(common.BloodPressureCIELTerminologyCode, firstEncounter, "118", recorded),
+						fakeBloodPressureObservation(common.DiastolicBloodPressureCIELTerminologyCode, firstEncounter, "76", recorded.Add(2*time.Minute)),
+						fakeBloodPressureObservation(common.DiastolicBloodPressureCIELTerminologyCode, firstEncounter, "79", recorded.Add(time.Hour)),
+						fakeBloodPressureObservation(common.DiastolicBloodPressureCIELTerminologyCode, secondEncounter, "82", recorded.Add(time.Minute)),
+						fakeBloodPressurePanel(secondEncounter, 145, 85, recorded.AddDate(0, 1, 0)),
+					},
+				}, nil
+			}
+
+			if tt.name == "Sad case: fail to get patient" {
+				fakeFHIR.MockGetFHIRPatientFn = func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: fail to get tenant identifiers" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: fail to search observations" {
+				fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := u.GetPatientBloodPressureReadings(tt.args.ctx, tt.args.patientID, nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.GetPatientBloodPressureReadings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if len(got) != 4 {
+				t.Errorf("expected a panel, a paired reading and two half readings but got %d readings", len(got))
+				return
+			}
+
+			panel := got[0]
+			if panel.Paired || *panel.Systolic != 145 || *panel.Diastolic != 85 || !panel.Abnormal {
+				t.Errorf("expected the latest reading to be the abnormal panel, got %+v", panel)
+			}
+
+			paired := got[3]
+			if !paired.Paired || len(paired.ObservationIDs) != 2 || *paired.Systolic != 118 || *paired.Diastolic != 76 {
+				t.Errorf("expected the systolic pressure to be paired with the closest diastolic pressure in its encounter, got %+v", paired)
+			}
+
+			for _, reading := range got[1:3] {
+				if !reading.Paired || reading.Systolic != nil || reading.Diastolic == nil {
+					t.Errorf("expected a diastolic pressure without a systolic pressure, got %+v", reading)
+				}
+			}
+		})
+	}
+}
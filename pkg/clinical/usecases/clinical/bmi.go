@@ -0,0 +1,257 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common/helpers"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/application/utils"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+const (
+	// BMIDerivationWindowEnvVarName is the environment variable holding how recent a height and a weight must be to derive BMI from e.g `90d`
+	BMIDerivationWindowEnvVarName = "BMI_DERIVATION_WINDOW"
+
+	defaultBMIDerivationWindow = "90d"
+
+	// bmiTolerance is how far a BMI supplied by a client can be from the BMI derived from the patient's height and weight
+	bmiTolerance = 0.5
+)
+
+// derivedBMI is a BMI worked out from a height and a weight of a patient
+type derivedBMI struct {
+	value  float64
+	height domain.FHIRObservation
+	weight domain.FHIRObservation
+}
+
+// deriveBMI records the BMI derived from the most recent height and weight of a patient in an encounter. A BMI that was derived
+// in the encounter before is updated. No BMI is derived when either the height or the weight was not taken within the derivation window
+func (c *UseCasesClinicalImpl) deriveBMI(ctx context.Context, encounterID string, patientID string) error {
+	derived, err := c.latestDerivableBMI(ctx, patientID)
+	if err != nil || derived == nil {
+		return err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	searchParams := map[string]interface{}{
+		"patient":   fmt.Sprintf("Patient/%s", patientID),
+		"encounter": fmt.Sprintf("Encounter/%s", encounterID),
+		"code":      common.BMICIELTerminologyCode,
+	}
+
+	observations, err := c.infrastructure.FHIR.SearchPatientObservations(ctx, searchParams, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return err
+	}
+
+	for _, observation := range observations.Observations {
+		if len(observation.DerivedFrom) > 0 {
+			return c.updateDerivedBMI(ctx, observation, *derived)
+		}
+	}
+
+	_, err = c.RecordObservation(
+		ctx,
+		dto.ObservationInput{
+			Status:      dto.ObservationStatusFinal,
+			EncounterID: encounterID,
+			Value:       strconv.FormatFloat(derived.value, 'f', -1, 64),
+		},
+		common.BMICIELTerminologyCode,
+		[]ObservationInputMutatorFunc{addObservationCategory("vital-signs"), addObservationDerivedFrom(derived.height, derived.weight)},
+	)
+
+	return err
+}
+
+// rederiveBMI recomputes the BMIs that were derived from a height or a weight after it is corrected
+func (c *UseCasesClinicalImpl) rederiveBMI(ctx context.Context, observationID string) error {
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	searchParams := map[string]interface{}{
+		"derived-from": fmt.Sprintf("Observation/%s", observationID),
+		"code":         common.BMICIELTerminologyCode,
+	}
+
+	observations, err := c.infrastructure.FHIR.SearchPatientObservations(ctx, searchParams, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return err
+	}
+
+	for _, bmi := range observations.Observations {
+		var height, weight domain.FHIRObservation
+
+		for _, reference := range bmi.DerivedFrom {
+			if reference == nil || reference.ID == nil {
+				continue
+			}
+
+			source, err := c.infrastructure.FHIR.GetFHIRObservation(ctx, *reference.ID)
+			if err != nil {
+				return err
+			}
+
+			switch observationConceptCode(source.Resource.Code) {
+			case common.HeightCIELTerminologyCode:
+				height = *source.Resource
+			case common.WeightCIELTerminologyCode:
+				weight = *source.Resource
+			}
+		}
+
+		derived, ok := bmiFromMeasurements(height, weight)
+		if !ok {
+			continue
+		}
+
+		err = c.updateDerivedBMI(ctx, bmi, *derived)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *UseCasesClinicalImpl) updateDerivedBMI(ctx context.Context, bmi domain.FHIRObservation, derived derivedBMI) error {
+	input, err := observationInput(bmi)
+	if err != nil {
+		return err
+	}
+
+	quantity, err := observationQuantity(common.BMICIELTerminologyCode, strconv.FormatFloat(derived.value, 'f', -1, 64), "")
+	if err != nil {
+		return err
+	}
+
+	instant := scalarutils.Instant(time.Now().Format(time.RFC3339))
+
+	input.EffectiveInstant = &instant
+	input.ValueString = nil
+	input.ValueQuantity = quantity
+
+	err = addObservationDerivedFrom(derived.height, derived.weight)(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	if bmi.Subject != nil && bmi.Subject.ID != nil {
+		err = c.interpretMeasurement(ctx, input, common.BMICIELTerminologyCode, *bmi.Subject.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = c.infrastructure.FHIR.UpdateFHIRObservation(ctx, *input)
+
+	return err
+}
+
+// latestDerivableBMI derives BMI from the most recent height and weight of a patient, if both were taken within the derivation window
+func (c *UseCasesClinicalImpl) latestDerivableBMI(ctx context.Context, patientID string) (*derivedBMI, error) {
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	now := time.Now()
+	since := now.Add(-c.bmiDerivationWindow().AddTo(now).Sub(now))
+
+	searchParams := map[string]interface{}{
+		"patient": fmt.Sprintf("Patient/%s", patientID),
+		"code":    strings.Join([]string{common.HeightCIELTerminologyCode, common.WeightCIELTerminologyCode}, ","),
+		"date":    fmt.Sprintf("ge%s", since.Format(dateFormatStr)),
+		"_sort":   "-date",
+	}
+
+	observations, err := c.infrastructure.FHIR.SearchPatientObservations(ctx, searchParams, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	var height, weight *domain.FHIRObservation
+
+	for i, observation := range observations.Observations {
+		if observation.Status != nil && *observation.Status == domain.ObservationStatusEnumEnteredInError {
+			continue
+		}
+
+		if observation.EffectiveInstant == nil || helpers.ParseDate(string(*observation.EffectiveInstant)).Before(since) {
+			continue
+		}
+
+		switch observationConceptCode(observation.Code) {
+		case common.HeightCIELTerminologyCode:
+			if height == nil || isMoreRecent(observation, *height) {
+				height = &observations.Observations[i]
+			}
+		case common.WeightCIELTerminologyCode:
+			if weight == nil || isMoreRecent(observation, *weight) {
+				weight = &observations.Observations[i]
+			}
+		}
+	}
+
+	if height == nil || weight == nil {
+		return nil, nil
+	}
+
+	derived, ok := bmiFromMeasurements(*height, *weight)
+	if !ok {
+		return nil, nil
+	}
+
+	return derived, nil
+}
+
+// validateBMI checks that a BMI supplied by a client agrees with the BMI derived from the patient's most recent height and weight
+func (c *UseCasesClinicalImpl) validateBMI(ctx context.Context, patientID string, value float64) error {
+	derived, err := c.latestDerivableBMI(ctx, patientID)
+	if err != nil || derived == nil {
+		return err
+	}
+
+	if math.Abs(value-derived.value) > bmiTolerance {
+		return dto.FieldError{
+			Field:   "value",
+			Message: fmt.Sprintf("a BMI of %v does not agree with the BMI of %v derived from the patient's height and weight", value, derived.value),
+		}
+	}
+
+	return nil
+}
+
+// bmiDerivationWindow is how recent a height and a weight must be to derive BMI from
+func (c *UseCasesClinicalImpl) bmiDerivationWindow() helpers.Period {
+	defaultWindow, _ := helpers.ParsePeriod(defaultBMIDerivationWindow)
+
+	value, err := c.infrastructure.BaseExtension.GetEnvVar(BMIDerivationWindowEnvVarName)
+	if err != nil || value == "" {
+		return defaultWindow
+	}
+
+	window, err := helpers.ParsePeriod(value)
+	if err != nil || window.IsZero() {
+		utils.ReportErrorToSentry(fmt.Errorf("invalid BMI derivation window %q, using %s", value, defaultBMIDerivationWindow))
+
+		return defaultWindow
+	}
+
+	return window
+}
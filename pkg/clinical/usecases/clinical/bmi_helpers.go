@@ -0,0 +1,30 @@
+package clinical
+
+import (
+	"math"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+)
+
+// bmiFromMeasurements works out BMI from a height in cm and a weight in kg, rounded to one decimal place.
+// Measurements recorded before they were stored as quantities are read in the unit their concept is recorded in
+func bmiFromMeasurements(height domain.FHIRObservation, weight domain.FHIRObservation) (*derivedBMI, bool) {
+	heightCM, ok := measurementValue(height, common.HeightCIELTerminologyCode)
+	if !ok || heightCM <= 0 {
+		return nil, false
+	}
+
+	weightKG, ok := measurementValue(weight, common.WeightCIELTerminologyCode)
+	if !ok || weightKG <= 0 {
+		return nil, false
+	}
+
+	heightM := heightCM / 100
+
+	return &derivedBMI{
+		value:  math.Round(weightKG/(heightM*heightM)*10) / 10,
+		height: height,
+		weight: weight,
+	}, true
+}
@@ -0,0 +1,581 @@
+package clinical_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+// fakeMeasurement is a height, weight or BMI of a patient recorded as a quantity
+func fakeMeasurement(conceptID string, value float64, recorded time.Time) domain.FHIRObservation {
+	id := uuid.New().String()
+	patientID := uuid.New().String()
+	encounterID := uuid.New().String()
+	status := domain.ObservationStatusEnumFinal
+	code := scalarutils.Code(conceptID)
+	instant := scalarutils.Instant(recorded.Format(time.RFC3339))
+
+	return domain.FHIRObservation{
+		ID:     &id,
+		Status: &status,
+		Code: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					Code: &code,
+				},
+			},
+		},
+		Subject: &domain.FHIRReference{
+			ID: &patientID,
+		},
+		Encounter: &domain.FHIRReference{
+			ID: &encounterID,
+		},
+		EffectiveInstant: &instant,
+		ValueQuantity:    &domain.FHIRQuantity{Value: value},
+	}
+}
+
+// fakeDerivedBMI is a BMI derived from a height and a weight
+func fakeDerivedBMI(value float64, height domain.FHIRObservation, weight domain.FHIRObservation) domain.FHIRObservation {
+	bmi := fakeMeasurement(common.BMICIELTerminologyCode, value, time.Now())
+	bmi.DerivedFrom = []*domain.FHIRReference{
+		{ID: height.ID},
+		{ID: weight.ID},
+	}
+
+	return bmi
+}
+
+func TestUseCasesClinicalImpl_RecordWeight_DeriveBMI(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.ObservationInput
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantBMI     float64
+		wantCreated bool
+		wantUpdated bool
+		wantErr     bool
+	}{
+		{
+			name: "Happy case: derive BMI from the latest height and weight",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "70",
+				},
+			},
+			wantBMI:     22.9,
+			wantCreated: true,
+			wantErr:     false,
+		},
+		{
+			name: "Happy case: update the BMI derived in the encounter",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "70",
+				},
+			},
+			wantBMI:     22.9,
+			wantUpdated: true,
+			wantErr:     false,
+		},
+		{
+			name: "Happy case: no BMI from a height outside the derivation window",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "70",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: no BMI from an entered in error height",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "70",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: record the weight when BMI can not be derived",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "70",
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			height := fakeMeasurement(common.HeightCIELTerminologyCode, 175, time.Now().AddDate(0, 0, -30))
+			olderHeight := fakeMeasurement(common.HeightCIELTerminologyCode, 160, time.Now().AddDate(0, 0, -60))
+			weight := fakeMeasurement(common.WeightCIELTerminologyCode, 70, time.Now())
+
+			var bmis []domain.FHIRObservation
+			if tt.name == "Happy case: update the BMI derived in the encounter" {
+				bmis = append(bmis, fakeDerivedBMI(26.2, height, weight))
+			}
+
+			if tt.name == "Happy case: no BMI from a height outside the derivation window" {
+				fakeExt.GetEnvVarFn = func(envName string) (string, error) {
+					return "7d", nil
+				}
+			}
+
+			if tt.name == "Happy case: no BMI from an entered in error height" {
+				enteredInError := domain.ObservationStatusEnumEnteredInError
+				height.Status = &enteredInError
+				olderHeight.Status = &enteredInError
+			}
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				if tt.name == "Happy case: record the weight when BMI can not be derived" {
+					return nil, fmt.Errorf("an error occurred")
+				}
+
+				if searchParameters["code"] == common.BMICIELTerminologyCode {
+					return &domain.PagedFHIRObservations{Observations: bmis}, nil
+				}
+
+				observations := []domain.FHIRObservation{olderHeight, weight}
+				if tt.name != "Happy case: no BMI from a height outside the derivation window" {
+					observations = append(observations, height)
+				}
+
+				return &domain.PagedFHIRObservations{Observations: observations}, nil
+			}
+
+			created, updated := false, false
+
+			createObservation := fakeFHIR.MockCreateFHIRObservationFn
+			fakeFHIR.MockCreateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				if len(input.DerivedFrom) > 0 {
+					created = true
+
+					if input.ValueQuantity == nil || input.ValueQuantity.Value != tt.wantBMI {
+						t.Errorf("expected a BMI of %v but got %v", tt.wantBMI, input.ValueQuantity)
+					}
+
+					if len(input.DerivedFrom) != 2 || *input.DerivedFrom[0].ID != *height.ID || *input.DerivedFrom[1].ID != *weight.ID {
+						t.Errorf("expected the BMI to be derived from the latest height and weight")
+					}
+				}
+
+				return createObservation(ctx, input)
+			}
+
+			updateObservation := fakeFHIR.MockUpdateFHIRObservationFn
+			fakeFHIR.MockUpdateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				updated = true
+
+				if input.ValueQuantity == nil || input.ValueQuantity.Value != tt.wantBMI {
+					t.Errorf("expected a BMI of %v but got %v", tt.wantBMI, input.ValueQuantity)
+				}
+
+				return updateObservation(ctx, input)
+			}
+
+			got, err := u.RecordWeight(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RecordWeight() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a response but got %v", got)
+				return
+			}
+
+			if created != tt.wantCreated {
+				t.Errorf("expected a BMI to be created %v but got %v", tt.wantCreated, created)
+			}
+
+			if updated != tt.wantUpdated {
+				t.Errorf("expected a BMI to be updated %v but got %v", tt.wantUpdated, updated)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_RecordBMI_Validate(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.ObservationInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: BMI that agrees with the height and weight",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "23.2",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: BMI that agrees with a height and weight recorded before quantities",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "22.8",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: BMI without a recent height and weight",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "30",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: BMI that disagrees with the height and weight",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "30",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to search height and weight",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "22.9",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				if tt.name == "Sad case: fail to search height and weight" {
+					return nil, fmt.Errorf("an error occurred")
+				}
+
+				if tt.name == "Happy case: BMI without a recent height and weight" {
+					return &domain.PagedFHIRObservations{}, nil
+				}
+
+				if tt.name == "Happy case: BMI that agrees with a height and weight recorded before quantities" {
+					heightValue, weightValue := "175", "154 lb"
+
+					height := fakeMeasurement(common.HeightCIELTerminologyCode, 0, time.Now())
+					height.ValueQuantity = nil
+					height.ValueInteger = &heightValue
+
+					weight := fakeMeasurement(common.WeightCIELTerminologyCode, 0, time.Now())
+					weight.ValueQuantity = nil
+					weight.ValueString = &weightValue
+
+					return &domain.PagedFHIRObservations{
+						Observations: []domain.FHIRObservation{height, weight},
+					}, nil
+				}
+
+				return &domain.PagedFHIRObservations{
+					Observations: []domain.FHIRObservation{
+						fakeMeasurement(common.HeightCIELTerminologyCode, 175, time.Now()),
+						fakeMeasurement(common.WeightCIELTerminologyCode, 70, time.Now()),
+					},
+				}, nil
+			}
+
+			got, err := u.RecordBMI(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RecordBMI() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.name == "Sad case: BMI that disagrees with the height and weight" {
+				var fieldErr dto.FieldError
+				if !errors.As(err, &fieldErr) || fieldErr.Field != "value" {
+					t.Errorf("expected a field error on value but got %v", err)
+				}
+			}
+
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a response but got %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_PatchPatientWeight_RederiveBMI(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		value string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantBMI     float64
+		wantUpdated bool
+		wantErr     bool
+	}{
+		{
+			name: "Happy case: recompute the BMI derived from a corrected weight",
+			args: args{
+				ctx:   context.Background(),
+				value: "80",
+			},
+			wantBMI:     26.1,
+			wantUpdated: true,
+			wantErr:     false,
+		},
+		{
+			name: "Happy case: correct a weight no BMI was derived from",
+			args: args{
+				ctx:   context.Background(),
+				value: "80",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: correct the weight when the BMI can not be recomputed",
+			args: args{
+				ctx:   context.Background(),
+				value: "80",
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			height := fakeMeasurement(common.HeightCIELTerminologyCode, 175, time.Now())
+			weight := fakeMeasurement(common.WeightCIELTerminologyCode, 70, time.Now())
+			bmi := fakeDerivedBMI(22.9, height, weight)
+
+			fakeFHIR.MockGetFHIRObservationFn = func(ctx context.Context, id string) (*domain.FHIRObservationRelayPayload, error) {
+				if id == *height.ID {
+					return &domain.FHIRObservationRelayPayload{Resource: &height}, nil
+				}
+
+				corrected := weight
+				corrected.ValueQuantity = &domain.FHIRQuantity{Value: 80}
+
+				return &domain.FHIRObservationRelayPayload{Resource: &corrected}, nil
+			}
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				if tt.name == "Happy case: correct the weight when the BMI can not be recomputed" {
+					return nil, fmt.Errorf("an error occurred")
+				}
+
+				if tt.name == "Happy case: correct a weight no BMI was derived from" {
+					return &domain.PagedFHIRObservations{}, nil
+				}
+
+				return &domain.PagedFHIRObservations{Observations: []domain.FHIRObservation{bmi}}, nil
+			}
+
+			updated := false
+
+			updateObservation := fakeFHIR.MockUpdateFHIRObservationFn
+			fakeFHIR.MockUpdateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				if input.ID != nil && *input.ID == *bmi.ID {
+					updated = true
+
+					if input.ValueQuantity == nil || input.ValueQuantity.Value != tt.wantBMI {
+						t.Errorf("expected a BMI of %v but got %v", tt.wantBMI, input.ValueQuantity)
+					}
+				}
+
+				return updateObservation(ctx, input)
+			}
+
+			got, err := u.PatchPatientWeight(tt.args.ctx, *weight.ID, tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.PatchPatientWeight() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a response but got %v", got)
+				return
+			}
+
+			if updated != tt.wantUpdated {
+				t.Errorf("expected the BMI to be recomputed %v but got %v", tt.wantUpdated, updated)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_PatchPatientBMI_Derived(t *testing.T) {
+	height := fakeMeasurement(common.HeightCIELTerminologyCode, 175, time.Now())
+	weight := fakeMeasurement(common.WeightCIELTerminologyCode, 70, time.Now())
+
+	type args struct {
+		ctx   context.Context
+		value string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		bmi     domain.FHIRObservation
+		wantErr bool
+	}{
+		{
+			name: "Happy case: patch a BMI that agrees with the height and weight",
+			args: args{
+				ctx:   context.Background(),
+				value: "23",
+			},
+			bmi:     fakeMeasurement(common.BMICIELTerminologyCode, 22, time.Now()),
+			wantErr: false,
+		},
+		{
+			name: "Sad case: patch a derived BMI",
+			args: args{
+				ctx:   context.Background(),
+				value: "23",
+			},
+			bmi:     fakeDerivedBMI(22.9, height, weight),
+			wantErr: true,
+		},
+		{
+			name: "Sad case: patch a BMI that disagrees with the height and weight",
+			args: args{
+				ctx:   context.Background(),
+				value: "30",
+			},
+			bmi:     fakeMeasurement(common.BMICIELTerminologyCode, 22, time.Now()),
+			wantErr: true,
+		},
+		{
+			name: "Sad case: patch a BMI that is not a number",
+			args: args{
+				ctx:   context.Background(),
+				value: "heavy",
+			},
+			bmi:     fakeMeasurement(common.BMICIELTerminologyCode, 22, time.Now()),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeFHIR.MockGetFHIRObservationFn = func(ctx context.Context, id string) (*domain.FHIRObservationRelayPayload, error) {
+				bmi := tt.bmi
+
+				return &domain.FHIRObservationRelayPayload{Resource: &bmi}, nil
+			}
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				return &domain.PagedFHIRObservations{Observations: []domain.FHIRObservation{height, weight}}, nil
+			}
+
+			got, err := u.PatchPatientBMI(tt.args.ctx, *tt.bmi.ID, tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.PatchPatientBMI() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected a response but got %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// CreateCarePlan creates a care plan for the patient of an episode of care.
+// The plan addresses the patient's conditions through the patient's goals and planned activities
+func (c *UseCasesClinicalImpl) CreateCarePlan(ctx context.Context, input dto.CarePlanInput) (*dto.CarePlan, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	episode, err := c.infrastructure.FHIR.GetFHIREpisodeOfCare(ctx, input.EpisodeOfCareID)
+	if err != nil {
+		return nil, err
+	}
+
+	if episode.Resource.Status != nil && *episode.Resource.Status == domain.EpisodeOfCareStatusEnumFinished {
+		return nil, fmt.Errorf("cannot create a care plan in a finished episode of care")
+	}
+
+	if episode.Resource.Patient == nil || episode.Resource.Patient.ID == nil {
+		return nil, fmt.Errorf("episode of care %s has no patient", input.EpisodeOfCareID)
+	}
+
+	patientID := *episode.Resource.Patient.ID
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	conditions, err := c.patientConditionReferences(ctx, patientID, input.ConditionIDs, *identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	goals, err := c.patientGoalReferences(ctx, patientID, input.GoalIDs, *identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	activities, err := c.carePlanActivities(ctx, input.Activities)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now().Format(time.DateOnly)
+	if input.StartDate != nil {
+		start = input.StartDate.AsTime().Format(time.DateOnly)
+	}
+
+	status := scalarutils.Code(dto.CarePlanStatusActive.Code())
+	intent := scalarutils.Code(carePlanIntent)
+	patientReference := fmt.Sprintf("Patient/%s", patientID)
+
+	carePlan := domain.FHIRCarePlan{
+		Status: &status,
+		Intent: &intent,
+		Title:  &input.Title,
+		Subject: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+			Display:   episode.Resource.Patient.Display,
+		},
+		Period: &domain.FHIRPeriod{
+			Start: scalarutils.DateTime(start),
+		},
+		Addresses: conditions,
+		SupportingInfo: []*domain.FHIRReference{
+			episodeOfCareReference(input.EpisodeOfCareID),
+		},
+		Goal:     goals,
+		Activity: activities,
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+	}
+
+	if input.Description != "" {
+		carePlan.Description = &input.Description
+	}
+
+	if input.EndDate != nil {
+		carePlan.Period.End = scalarutils.DateTime(input.EndDate.AsTime().Format(time.DateOnly))
+	}
+
+	resource, err := c.infrastructure.FHIR.CreateFHIRCarePlan(ctx, carePlan)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRCarePlanToDTO(*resource), nil
+}
+
+// UpdateCarePlan updates a care plan e.g to revise its activities or to mark it as completed
+func (c *UseCasesClinicalImpl) UpdateCarePlan(ctx context.Context, id string, input dto.CarePlanUpdateInput) (*dto.CarePlan, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := c.infrastructure.FHIR.GetFHIRCarePlan(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	carePlan := *payload.Resource
+
+	if carePlan.Subject == nil || carePlan.Subject.ID == nil {
+		return nil, fmt.Errorf("care plan %s has no patient", id)
+	}
+
+	patientID := *carePlan.Subject.ID
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	if input.Status != nil {
+		status := scalarutils.Code(input.Status.Code())
+		carePlan.Status = &status
+	}
+
+	if input.Title != nil {
+		carePlan.Title = input.Title
+	}
+
+	if input.Description != nil {
+		carePlan.Description = input.Description
+	}
+
+	if input.ConditionIDs != nil {
+		carePlan.Addresses, err = c.patientConditionReferences(ctx, patientID, input.ConditionIDs, *identifiers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if input.GoalIDs != nil {
+		carePlan.Goal, err = c.patientGoalReferences(ctx, patientID, input.GoalIDs, *identifiers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Activities != nil {
+		carePlan.Activity, err = c.carePlanActivities(ctx, input.Activities)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if input.EndDate != nil {
+		if carePlan.Period == nil {
+			carePlan.Period = &domain.FHIRPeriod{}
+		}
+
+		carePlan.Period.End = scalarutils.DateTime(input.EndDate.AsTime().Format(time.DateOnly))
+	}
+
+	resource, err := c.infrastructure.FHIR.UpdateFHIRCarePlan(ctx, carePlan)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRCarePlanToDTO(*resource), nil
+}
+
+// GetCarePlan retrieves a care plan
+func (c *UseCasesClinicalImpl) GetCarePlan(ctx context.Context, id string) (*dto.CarePlan, error) {
+	payload, err := c.infrastructure.FHIR.GetFHIRCarePlan(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRCarePlanToDTO(*payload.Resource), nil
+}
+
+// ListPatientCarePlans lists a patient's care plans, most recent first
+func (c *UseCasesClinicalImpl) ListPatientCarePlans(ctx context.Context, patientID string, pagination dto.Pagination) (*dto.CarePlanConnection, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"patient": fmt.Sprintf("Patient/%s", patientID),
+		"_sort":   "-date",
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRCarePlan(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	carePlans := []*dto.CarePlan{}
+
+	for _, resource := range resources.CarePlans {
+		carePlans = append(carePlans, mapFHIRCarePlanToDTO(resource))
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreateCarePlanConnection(carePlans, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
+
+// carePlanActivities composes the activities planned in a care plan, identifying coded activities by their CIEL concepts
+func (c *UseCasesClinicalImpl) carePlanActivities(ctx context.Context, inputs []*dto.CarePlanActivityInput) ([]*domain.FHIRCarePlanActivity, error) {
+	activities := []*domain.FHIRCarePlanActivity{}
+
+	for _, input := range inputs {
+		var concept *domain.Concept
+
+		if input.Code != "" {
+			var err error
+
+			concept, err = c.GetConcept(ctx, dto.TerminologySourceCIEL, input.Code)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		activities = append(activities, carePlanActivity(concept, *input))
+	}
+
+	return activities, nil
+}
+
+// patientGoalReferences fetches the goals a care plan works towards, ensuring they are the patient's goals
+func (c *UseCasesClinicalImpl) patientGoalReferences(ctx context.Context, patientID string, goalIDs []string, identifiers dto.TenantIdentifiers) ([]*domain.FHIRReference, error) {
+	references := []*domain.FHIRReference{}
+
+	if len(goalIDs) == 0 {
+		return references, nil
+	}
+
+	params := map[string]interface{}{
+		"_id":     strings.Join(goalIDs, ","),
+		"patient": fmt.Sprintf("Patient/%s", patientID),
+	}
+
+	goals, err := c.infrastructure.FHIR.SearchFHIRGoal(ctx, params, identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[string]bool{}
+
+	for _, goal := range goals.Goals {
+		if goal.ID != nil {
+			found[*goal.ID] = true
+		}
+	}
+
+	for _, goalID := range goalIDs {
+		if !found[goalID] {
+			return nil, fmt.Errorf("goal %s is not a goal of patient %s", goalID, patientID)
+		}
+
+		id := goalID
+		reference := fmt.Sprintf("Goal/%s", id)
+		references = append(references, &domain.FHIRReference{
+			ID:        &id,
+			Reference: &reference,
+		})
+	}
+
+	return references, nil
+}
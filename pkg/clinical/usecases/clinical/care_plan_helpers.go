@@ -0,0 +1,139 @@
+package clinical
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+const (
+	// carePlanIntent is the intent of the care plans clinicians set for their patients
+	carePlanIntent = "plan"
+)
+
+// carePlanActivity composes an activity planned for a patient. Activities are not started unless their status is given
+func carePlanActivity(concept *domain.Concept, input dto.CarePlanActivityInput) *domain.FHIRCarePlanActivity {
+	status := dto.CarePlanActivityStatusNotStarted
+	if input.Status != nil {
+		status = *input.Status
+	}
+
+	statusCode := scalarutils.Code(status.Code())
+	description := input.Description
+
+	detail := &domain.FHIRCarePlanActivityDetail{
+		Status:      &statusCode,
+		Description: &description,
+	}
+
+	if concept != nil {
+		detail.Code = conceptCodeableConcept(concept)
+	}
+
+	if input.Schedule != "" {
+		schedule := input.Schedule
+		detail.ScheduledString = &schedule
+	}
+
+	return &domain.FHIRCarePlanActivity{
+		Detail: detail,
+	}
+}
+
+// episodeOfCareReference references the episode of care a care plan is delivered in
+func episodeOfCareReference(episodeOfCareID string) *domain.FHIRReference {
+	reference := fmt.Sprintf("EpisodeOfCare/%s", episodeOfCareID)
+
+	return &domain.FHIRReference{
+		ID:        &episodeOfCareID,
+		Reference: &reference,
+	}
+}
+
+func mapFHIRCarePlanToDTO(resource domain.FHIRCarePlan) *dto.CarePlan {
+	output := &dto.CarePlan{
+		ConditionIDs: []string{},
+		GoalIDs:      []string{},
+		Activities:   []*dto.CarePlanActivity{},
+	}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.Subject != nil && resource.Subject.ID != nil {
+		output.PatientID = *resource.Subject.ID
+	}
+
+	if resource.Status != nil {
+		output.Status = dto.CarePlanStatusEnum(strings.ToUpper(strings.ReplaceAll(string(*resource.Status), "-", "_")))
+	}
+
+	if resource.Title != nil {
+		output.Title = *resource.Title
+	}
+
+	if resource.Description != nil {
+		output.Description = *resource.Description
+	}
+
+	if resource.Period != nil {
+		if resource.Period.Start != "" {
+			output.StartDate = parseFHIRDate(string(resource.Period.Start))
+		}
+
+		if resource.Period.End != "" {
+			output.EndDate = parseFHIRDate(string(resource.Period.End))
+		}
+	}
+
+	for _, info := range resource.SupportingInfo {
+		if info != nil && info.ID != nil && info.Reference != nil && strings.HasPrefix(*info.Reference, "EpisodeOfCare/") {
+			output.EpisodeOfCareID = *info.ID
+		}
+	}
+
+	for _, condition := range resource.Addresses {
+		if condition != nil && condition.ID != nil {
+			output.ConditionIDs = append(output.ConditionIDs, *condition.ID)
+		}
+	}
+
+	for _, goal := range resource.Goal {
+		if goal != nil && goal.ID != nil {
+			output.GoalIDs = append(output.GoalIDs, *goal.ID)
+		}
+	}
+
+	for _, activity := range resource.Activity {
+		if activity == nil || activity.Detail == nil {
+			continue
+		}
+
+		detail := activity.Detail
+		planned := &dto.CarePlanActivity{}
+
+		if detail.Code != nil && len(detail.Code.Coding) > 0 && detail.Code.Coding[0].Code != nil {
+			planned.Code = string(*detail.Code.Coding[0].Code)
+		}
+
+		if detail.Description != nil {
+			planned.Description = *detail.Description
+		}
+
+		if detail.ScheduledString != nil {
+			planned.Schedule = *detail.ScheduledString
+		}
+
+		if detail.Status != nil {
+			planned.Status = dto.CarePlanActivityStatusEnum(strings.ToUpper(strings.ReplaceAll(string(*detail.Status), "-", "_")))
+		}
+
+		output.Activities = append(output.Activities, planned)
+	}
+
+	return output
+}
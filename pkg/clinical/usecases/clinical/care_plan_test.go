@@ -0,0 +1,552 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+func TestUseCasesClinicalImpl_CreateCarePlan(t *testing.T) {
+	scheduled := dto.CarePlanActivityStatusScheduled
+	startDate := scalarutils.Date{Year: 2026, Month: 1, Day: 1}
+	endDate := scalarutils.Date{Year: 2025, Month: 1, Day: 1}
+
+	type args struct {
+		ctx   context.Context
+		input dto.CarePlanInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create care plan",
+			args: args{
+				ctx: context.Background(),
+				input: dto.CarePlanInput{
+					EpisodeOfCareID: gofakeit.UUID(),
+					Title:           "HIV care plan",
+					Description:     "Care plan for a newly diagnosed patient",
+					ConditionIDs:    []string{gofakeit.UUID()},
+					GoalIDs:         []string{gofakeit.UUID()},
+					Activities: []*dto.CarePlanActivityInput{
+						{
+							Code:        "856",
+							Description: "Viral load test",
+							Schedule:    "Every 6 months",
+							Status:      &scheduled,
+						},
+						{
+							Description: "Adherence counselling",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid input",
+			args: args{
+				ctx: context.Background(),
+				input: dto.CarePlanInput{
+					EpisodeOfCareID: "episode",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: care plan ends before it starts",
+			args: args{
+				ctx: context.Background(),
+				input: dto.CarePlanInput{
+					EpisodeOfCareID: gofakeit.UUID(),
+					Title:           "HIV care plan",
+					StartDate:       &startDate,
+					EndDate:         &endDate,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get episode of care",
+			args: args{
+				ctx: context.Background(),
+				input: dto.CarePlanInput{
+					EpisodeOfCareID: gofakeit.UUID(),
+					Title:           "HIV care plan",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: episode of care is finished",
+			args: args{
+				ctx: context.Background(),
+				input: dto.CarePlanInput{
+					EpisodeOfCareID: gofakeit.UUID(),
+					Title:           "HIV care plan",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: goal is not the patient's",
+			args: args{
+				ctx: context.Background(),
+				input: dto.CarePlanInput{
+					EpisodeOfCareID: gofakeit.UUID(),
+					Title:           "HIV care plan",
+					GoalIDs:         []string{gofakeit.UUID()},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get activity concept",
+			args: args{
+				ctx: context.Background(),
+				input: dto.CarePlanInput{
+					EpisodeOfCareID: gofakeit.UUID(),
+					Title:           "HIV care plan",
+					Activities: []*dto.CarePlanActivityInput{
+						{
+							Code:        "856",
+							Description: "Viral load test",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create care plan",
+			args: args{
+				ctx: context.Background(),
+				input: dto.CarePlanInput{
+					EpisodeOfCareID: gofakeit.UUID(),
+					Title:           "HIV care plan",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+				return &domain.Concept{
+					ID:          concept,
+					DisplayName: gofakeit.Word(),
+				}, nil
+			}
+
+			fakeFHIR.MockSearchFHIRConditionFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCondition, error) {
+				conditions := &domain.PagedFHIRCondition{}
+
+				for _, id := range tt.args.input.ConditionIDs {
+					conditionID := id
+					conditions.Conditions = append(conditions.Conditions, domain.FHIRCondition{ID: &conditionID})
+				}
+
+				return conditions, nil
+			}
+
+			fakeFHIR.MockSearchFHIRGoalFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRGoal, error) {
+				goals := &domain.PagedFHIRGoal{}
+
+				for _, id := range tt.args.input.GoalIDs {
+					goalID := id
+					goals.Goals = append(goals.Goals, domain.FHIRGoal{ID: &goalID})
+				}
+
+				return goals, nil
+			}
+
+			fakeFHIR.MockCreateFHIRCarePlanFn = func(ctx context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error) {
+				id := gofakeit.UUID()
+				input.ID = &id
+
+				return &input, nil
+			}
+
+			if tt.name == "Sad case: failed to get episode of care" {
+				fakeFHIR.MockGetFHIREpisodeOfCareFn = func(ctx context.Context, id string) (*domain.FHIREpisodeOfCareRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: episode of care is finished" {
+				fakeFHIR.MockGetFHIREpisodeOfCareFn = func(ctx context.Context, id string) (*domain.FHIREpisodeOfCareRelayPayload, error) {
+					patientID := gofakeit.UUID()
+					status := domain.EpisodeOfCareStatusEnumFinished
+
+					return &domain.FHIREpisodeOfCareRelayPayload{
+						Resource: &domain.FHIREpisodeOfCare{
+							ID:     &id,
+							Status: &status,
+							Patient: &domain.FHIRReference{
+								ID: &patientID,
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: goal is not the patient's" {
+				fakeFHIR.MockSearchFHIRGoalFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRGoal, error) {
+					return &domain.PagedFHIRGoal{}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get activity concept" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create care plan" {
+				fakeFHIR.MockCreateFHIRCarePlanFn = func(ctx context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.CreateCarePlan(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.CreateCarePlan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Status != dto.CarePlanStatusActive || got.EpisodeOfCareID != tt.args.input.EpisodeOfCareID || got.StartDate == nil {
+				t.Errorf("expected an active care plan in episode %s, got %v", tt.args.input.EpisodeOfCareID, got)
+			}
+
+			if len(got.ConditionIDs) != 1 || len(got.GoalIDs) != 1 || len(got.Activities) != 2 {
+				t.Errorf("expected the care plan to address a condition through a goal and two activities, got %v", got)
+			}
+
+			if got.Activities[0].Code != "856" || got.Activities[0].Status != dto.CarePlanActivityStatusScheduled {
+				t.Errorf("expected a scheduled viral load test, got %v", got.Activities[0])
+			}
+
+			if got.Activities[1].Code != "" || got.Activities[1].Status != dto.CarePlanActivityStatusNotStarted {
+				t.Errorf("expected adherence counselling to not be started, got %v", got.Activities[1])
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_UpdateCarePlan(t *testing.T) {
+	completed := dto.CarePlanStatusCompleted
+	title := "HIV care plan"
+	emptyTitle := ""
+	endDate := scalarutils.Date{Year: 2026, Month: 6, Day: 30}
+
+	type args struct {
+		ctx   context.Context
+		id    string
+		input dto.CarePlanUpdateInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update care plan",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.CarePlanUpdateInput{
+					Status:       &completed,
+					Title:        &title,
+					ConditionIDs: []string{},
+					GoalIDs:      []string{},
+					Activities: []*dto.CarePlanActivityInput{
+						{
+							Description: "Transition to community follow up",
+						},
+					},
+					EndDate: &endDate,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: empty title",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.CarePlanUpdateInput{
+					Title: &emptyTitle,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get care plan",
+			args: args{
+				ctx:   context.Background(),
+				id:    gofakeit.UUID(),
+				input: dto.CarePlanUpdateInput{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: condition is not the patient's",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.CarePlanUpdateInput{
+					ConditionIDs: []string{gofakeit.UUID()},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: goal is not the patient's",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.CarePlanUpdateInput{
+					GoalIDs: []string{gofakeit.UUID()},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update care plan",
+			args: args{
+				ctx:   context.Background(),
+				id:    gofakeit.UUID(),
+				input: dto.CarePlanUpdateInput{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeFHIR.MockUpdateFHIRCarePlanFn = func(ctx context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error) {
+				return &input, nil
+			}
+
+			if tt.name == "Sad case: failed to get care plan" {
+				fakeFHIR.MockGetFHIRCarePlanFn = func(ctx context.Context, id string) (*domain.FHIRCarePlanRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: condition is not the patient's" {
+				fakeFHIR.MockSearchFHIRConditionFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCondition, error) {
+					return &domain.PagedFHIRCondition{}, nil
+				}
+			}
+
+			if tt.name == "Sad case: goal is not the patient's" {
+				fakeFHIR.MockSearchFHIRGoalFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRGoal, error) {
+					return &domain.PagedFHIRGoal{}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to update care plan" {
+				fakeFHIR.MockUpdateFHIRCarePlanFn = func(ctx context.Context, input domain.FHIRCarePlan) (*domain.FHIRCarePlan, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.UpdateCarePlan(tt.args.ctx, tt.args.id, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.UpdateCarePlan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Status != completed || got.EndDate == nil || *got.EndDate != endDate {
+				t.Errorf("expected the care plan to be completed on %v, got %v", endDate, got)
+			}
+
+			if len(got.GoalIDs) != 0 || len(got.Activities) != 1 || got.Activities[0].Status != dto.CarePlanActivityStatusNotStarted {
+				t.Errorf("expected the care plan's goals and activities to be revised, got %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_GetCarePlan(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get care plan",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to get care plan",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to get care plan" {
+				fakeFHIR.MockGetFHIRCarePlanFn = func(ctx context.Context, id string) (*domain.FHIRCarePlanRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.GetCarePlan(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.GetCarePlan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.EpisodeOfCareID == "" || got.StartDate == nil || len(got.GoalIDs) != 1 {
+				t.Errorf("expected the care plan's episode, start date and goal, got %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListPatientCarePlans(t *testing.T) {
+	first := 10
+	invalidFirst := -1
+
+	type args struct {
+		ctx        context.Context
+		patientID  string
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list patient care plans",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid patient id",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  "patient",
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid pagination",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &invalidFirst},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search care plans",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to search care plans" {
+				fakeFHIR.MockSearchFHIRCarePlanFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCarePlan, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			_, err := c.ListPatientCarePlans(tt.args.ctx, tt.args.patientID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListPatientCarePlans() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
@@ -46,44 +46,11 @@ func (c *UseCasesClinicalImpl) CreateCondition(ctx context.Context, input dto.Co
 		return nil, err
 	}
 
-	statusSystem := scalarutils.URI("http://terminology.hl7.org/CodeSystem/condition-clinical")
-	categorySystem := scalarutils.URI("http://terminology.hl7.org/CodeSystem/condition-category")
-	verificationSystem := scalarutils.URI("http://terminology.hl7.org/CodeSystem/condition-ver-status")
-	userSelectedFalse := false
 	conditionInput := domain.FHIRConditionInput{
-		ClinicalStatus: &domain.FHIRCodeableConceptInput{
-			Coding: []*domain.FHIRCodingInput{
-				{
-					System:  &statusSystem,
-					Code:    scalarutils.Code(string(input.Status)),
-					Display: string(input.Status),
-				},
-			},
-			Text: string(input.Status),
-		},
-		VerificationStatus: &domain.FHIRCodeableConceptInput{
-			Coding: []*domain.FHIRCodingInput{
-				{
-					System:       &verificationSystem,
-					Code:         scalarutils.Code("confirmed"),
-					Display:      "confirmed",
-					UserSelected: &userSelectedFalse,
-				},
-			},
-			Text: "confirmed",
-		},
+		ClinicalStatus:     conditionStatusCodeableConcept(input.Status),
+		VerificationStatus: conditionVerificationStatusCodeableConcept(dto.ConditionVerificationStatusConfirmed),
 		Category: []*domain.FHIRCodeableConceptInput{
-			{
-				Coding: []*domain.FHIRCodingInput{
-					{
-						System:       &categorySystem,
-						Code:         scalarutils.Code(input.Category),
-						Display:      string(input.Category),
-						UserSelected: &userSelectedFalse,
-					},
-				},
-				Text: string(input.Category),
-			},
+			conditionCategoryCodeableConcept(input.Category),
 		},
 		Code: &domain.FHIRCodeableConceptInput{
 			Coding: []*domain.FHIRCodingInput{
@@ -114,17 +81,7 @@ func (c *UseCasesClinicalImpl) CreateCondition(ctx context.Context, input dto.Co
 		conditionInput.OnsetDateTime = input.OnsetDate
 	}
 
-	if input.Note != "" {
-		note := scalarutils.Markdown(input.Note)
-		noteTime := scalarutils.DateTime(time.Now().Format(scalarutils.DateTimeFormatLayout))
-
-		conditionInput.Note = []*domain.FHIRAnnotationInput{
-			{
-				Time: &noteTime,
-				Text: &note,
-			},
-		}
-	}
+	addConditionNote(&conditionInput, input.Note)
 
 	tags, err := c.GetTenantMetaTags(ctx)
 	if err != nil {
@@ -144,33 +101,49 @@ func (c *UseCasesClinicalImpl) CreateCondition(ctx context.Context, input dto.Co
 }
 
 func mapFHIRConditionToConditionDTO(condition domain.FHIRCondition) *dto.Condition {
-	var category scalarutils.Code
+	output := dto.Condition{
+		Status:             dto.ConditionStatus(conditionConceptValue(condition.ClinicalStatus)),
+		VerificationStatus: conditionVerificationStatus(condition),
+		OnsetDate:          condition.OnsetDateTime,
+		AbatementDate:      condition.AbatementDateTime,
+		RecordedDate:       condition.RecordedDate,
+	}
 
-	switch condition.Category[0].Text {
-	case string(dto.ConditionCategoryProblemList):
-		category = "problem-list-item"
-	case string(dto.ConditionCategoryDiagnosis):
-		category = "encounter-diagnosis"
+	if condition.ID != nil {
+		output.ID = *condition.ID
 	}
 
-	output := dto.Condition{
-		ID:           *condition.ID,
-		Status:       dto.ConditionStatus(condition.ClinicalStatus.Text),
-		Name:         condition.Code.Text,
-		Code:         string(*condition.Code.Coding[0].Code),
-		System:       string(*condition.Code.Coding[0].System),
-		Category:     dto.ConditionCategory(category),
-		RecordedDate: condition.RecordedDate,
-		PatientID:    *condition.Subject.ID,
-		EncounterID:  *condition.Encounter.ID,
-	}
-
-	if condition.Note != nil || len(condition.Note) > 0 {
-		output.Note = string(*condition.Note[0].Text)
+	if len(condition.Category) > 0 {
+		category := dto.ConditionCategory(conditionConceptValue(condition.Category[0]))
+		if category.IsValid() {
+			output.Category = category
+		}
+	}
+
+	if condition.Code != nil {
+		output.Name = condition.Code.Text
+
+		if len(condition.Code.Coding) > 0 && condition.Code.Coding[0] != nil {
+			if condition.Code.Coding[0].Code != nil {
+				output.Code = string(*condition.Code.Coding[0].Code)
+			}
+
+			if condition.Code.Coding[0].System != nil {
+				output.System = string(*condition.Code.Coding[0].System)
+			}
+		}
+	}
+
+	if condition.Subject != nil && condition.Subject.ID != nil {
+		output.PatientID = *condition.Subject.ID
 	}
 
-	if condition.OnsetDateTime != nil {
-		output.OnsetDate = condition.OnsetDateTime
+	if condition.Encounter != nil && condition.Encounter.ID != nil {
+		output.EncounterID = *condition.Encounter.ID
+	}
+
+	if len(condition.Note) > 0 && condition.Note[0].Text != nil {
+		output.Note = string(*condition.Note[0].Text)
 	}
 
 	return &output
@@ -201,8 +174,9 @@ func (c UseCasesClinicalImpl) ListPatientConditions(ctx context.Context, patient
 
 	patientRef := fmt.Sprintf("Patient/%s", *patient.Resource.ID)
 	params := map[string]interface{}{
-		"subject": patientRef,
-		"_sort":   "date",
+		"subject":                 patientRef,
+		"verification-status:not": dto.ConditionVerificationStatusEnteredInError.Code(),
+		"_sort":                   "date",
 	}
 
 	if encounterID != nil {
@@ -237,3 +211,216 @@ func (c UseCasesClinicalImpl) ListPatientConditions(ctx context.Context, patient
 
 	return &connection, nil
 }
+
+// UpdateCondition updates the status, category, onset and abatement of a condition e.g when a provisional diagnosis is confirmed
+func (c *UseCasesClinicalImpl) UpdateCondition(ctx context.Context, id string, input dto.ConditionUpdateInput) (*dto.Condition, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition id: %s", id)
+	}
+
+	err = input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := c.infrastructure.FHIR.GetFHIRCondition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if conditionVerificationStatus(*resource.Resource) == dto.ConditionVerificationStatusEnteredInError {
+		return nil, fmt.Errorf("cannot update a condition that was entered in error")
+	}
+
+	status := dto.ConditionStatus(conditionConceptValue(resource.Resource.ClinicalStatus))
+
+	conditionInput, err := conditionInput(*resource.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Status != nil {
+		status = *input.Status
+		conditionInput.ClinicalStatus = conditionStatusCodeableConcept(status)
+
+		// a recurrence or relapse of a condition has not abated
+		if status.IsActive() {
+			conditionInput.AbatementDateTime = nil
+		}
+	}
+
+	if input.VerificationStatus != nil {
+		conditionInput.VerificationStatus = conditionVerificationStatusCodeableConcept(*input.VerificationStatus)
+	}
+
+	if input.Category != nil {
+		conditionInput.Category = []*domain.FHIRCodeableConceptInput{
+			conditionCategoryCodeableConcept(*input.Category),
+		}
+	}
+
+	if input.OnsetDate != nil {
+		conditionInput.OnsetDateTime = input.OnsetDate
+	}
+
+	if input.AbatementDate != nil {
+		if status.IsActive() {
+			return nil, fmt.Errorf("an active condition cannot have an abatement date")
+		}
+
+		conditionInput.AbatementDateTime = input.AbatementDate
+	}
+
+	if conditionInput.OnsetDateTime != nil && conditionInput.AbatementDateTime != nil &&
+		conditionInput.AbatementDateTime.AsTime().Before(conditionInput.OnsetDateTime.AsTime()) {
+		return nil, fmt.Errorf("abatement date cannot be before the onset date")
+	}
+
+	addConditionNote(conditionInput, input.Note)
+
+	condition, err := c.infrastructure.FHIR.UpdateFHIRCondition(ctx, *conditionInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRConditionToConditionDTO(*condition.Resource), nil
+}
+
+// ResolveCondition records that a condition has resolved. The abatement date defaults to today
+func (c *UseCasesClinicalImpl) ResolveCondition(ctx context.Context, id string, abatementDate *scalarutils.Date, note *string) (*dto.Condition, error) {
+	if abatementDate == nil {
+		today := time.Now()
+
+		date, err := scalarutils.NewDate(today.Day(), int(today.Month()), today.Year())
+		if err != nil {
+			return nil, err
+		}
+
+		abatementDate = date
+	}
+
+	status := dto.ConditionStatusResolved
+	input := dto.ConditionUpdateInput{
+		Status:        &status,
+		AbatementDate: abatementDate,
+	}
+
+	if note != nil {
+		input.Note = *note
+	}
+
+	return c.UpdateCondition(ctx, id, input)
+}
+
+// MarkConditionEnteredInError marks a condition that was recorded in error so that it no longer appears in the patient's records.
+// A condition entered in error has no clinical status
+func (c *UseCasesClinicalImpl) MarkConditionEnteredInError(ctx context.Context, id string, reason *string) (*dto.Condition, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition id: %s", id)
+	}
+
+	resource, err := c.infrastructure.FHIR.GetFHIRCondition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	conditionInput, err := conditionInput(*resource.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	conditionInput.ClinicalStatus = nil
+	conditionInput.VerificationStatus = conditionVerificationStatusCodeableConcept(dto.ConditionVerificationStatusEnteredInError)
+
+	if reason != nil {
+		addConditionNote(conditionInput, *reason)
+	}
+
+	condition, err := c.infrastructure.FHIR.UpdateFHIRCondition(ctx, *conditionInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRConditionToConditionDTO(*condition.Resource), nil
+}
+
+// PatientProblemList summarises the conditions of a patient across all their encounters. A condition recorded in several
+// encounters appears once, as it was most recently recorded. The active problem list items are separated from the encounter
+// diagnoses and a diagnosis that is on the problem list is not repeated in the encounter diagnoses
+func (c *UseCasesClinicalImpl) PatientProblemList(ctx context.Context, patientID string) (*dto.ProblemList, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"subject":                 fmt.Sprintf("Patient/%s", patientID),
+		"verification-status:not": dto.ConditionVerificationStatusEnteredInError.Code(),
+		"_sort":                   "-recorded-date",
+	}
+
+	conditions, err := c.infrastructure.FHIR.SearchFHIRCondition(ctx, params, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	problemList := &dto.ProblemList{
+		ProblemListItems:   []*dto.Condition{},
+		EncounterDiagnoses: []*dto.Condition{},
+	}
+
+	problemKeys := map[string]bool{}
+	activeProblemKeys := map[string]bool{}
+	diagnosisKeys := []string{}
+	diagnoses := map[string]*dto.Condition{}
+
+	// the conditions are sorted by the most recently recorded so the first condition with a code is the latest
+	for _, resource := range conditions.Conditions {
+		switch conditionVerificationStatus(resource) {
+		case dto.ConditionVerificationStatusEnteredInError, dto.ConditionVerificationStatusRefuted:
+			continue
+		}
+
+		key := conditionKey(resource)
+		condition := mapFHIRConditionToConditionDTO(resource)
+
+		switch condition.Category {
+		case dto.ConditionCategoryProblemList:
+			if problemKeys[key] {
+				continue
+			}
+
+			problemKeys[key] = true
+
+			if condition.Status.IsActive() {
+				activeProblemKeys[key] = true
+				problemList.ProblemListItems = append(problemList.ProblemListItems, condition)
+			}
+
+		case dto.ConditionCategoryDiagnosis:
+			if _, ok := diagnoses[key]; ok {
+				continue
+			}
+
+			diagnoses[key] = condition
+			diagnosisKeys = append(diagnosisKeys, key)
+		}
+	}
+
+	for _, key := range diagnosisKeys {
+		if activeProblemKeys[key] {
+			continue
+		}
+
+		problemList.EncounterDiagnoses = append(problemList.EncounterDiagnoses, diagnoses[key])
+	}
+
+	return problemList, nil
+}
@@ -0,0 +1,123 @@
+package clinical
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// The HL7 code systems that the clinical status, verification status and category of a condition are coded in
+const (
+	conditionClinicalStatusSystem     = "http://terminology.hl7.org/CodeSystem/condition-clinical"
+	conditionVerificationStatusSystem = "http://terminology.hl7.org/CodeSystem/condition-ver-status"
+	conditionCategorySystem           = "http://terminology.hl7.org/CodeSystem/condition-category"
+)
+
+// conditionCodeableConcept codes a condition status or category in its HL7 code system
+func conditionCodeableConcept(system string, code string, display string) *domain.FHIRCodeableConceptInput {
+	codeSystem := scalarutils.URI(system)
+	userSelected := false
+
+	return &domain.FHIRCodeableConceptInput{
+		Coding: []*domain.FHIRCodingInput{
+			{
+				System:       &codeSystem,
+				Code:         scalarutils.Code(code),
+				Display:      display,
+				UserSelected: &userSelected,
+			},
+		},
+		Text: display,
+	}
+}
+
+func conditionStatusCodeableConcept(status dto.ConditionStatus) *domain.FHIRCodeableConceptInput {
+	return conditionCodeableConcept(conditionClinicalStatusSystem, status.Code(), string(status))
+}
+
+func conditionVerificationStatusCodeableConcept(status dto.ConditionVerificationStatus) *domain.FHIRCodeableConceptInput {
+	return conditionCodeableConcept(conditionVerificationStatusSystem, status.Code(), status.Code())
+}
+
+func conditionCategoryCodeableConcept(category dto.ConditionCategory) *domain.FHIRCodeableConceptInput {
+	return conditionCodeableConcept(conditionCategorySystem, category.Code(), string(category))
+}
+
+// conditionConceptValue reads a condition status or category as an enum value e.g `ENCOUNTER_DIAGNOSIS`.
+// Conditions have been recorded with both the FHIR codes e.g `encounter-diagnosis` and the enum values
+func conditionConceptValue(concept *domain.FHIRCodeableConcept) string {
+	if concept == nil {
+		return ""
+	}
+
+	value := concept.Text
+
+	if len(concept.Coding) > 0 && concept.Coding[0] != nil && concept.Coding[0].Code != nil {
+		value = string(*concept.Coding[0].Code)
+	}
+
+	return strings.ToUpper(strings.ReplaceAll(value, "-", "_"))
+}
+
+func conditionVerificationStatus(condition domain.FHIRCondition) dto.ConditionVerificationStatus {
+	return dto.ConditionVerificationStatus(conditionConceptValue(condition.VerificationStatus))
+}
+
+// conditionKey identifies what a patient has regardless of the encounter it was recorded in e.g the CIEL concept for malaria
+func conditionKey(condition domain.FHIRCondition) string {
+	if condition.Code == nil {
+		return ""
+	}
+
+	for _, coding := range condition.Code.Coding {
+		if coding == nil || coding.Code == nil {
+			continue
+		}
+
+		system := ""
+		if coding.System != nil {
+			system = string(*coding.System)
+		}
+
+		return fmt.Sprintf("%s|%s", system, *coding.Code)
+	}
+
+	return strings.ToLower(condition.Code.Text)
+}
+
+// addConditionNote adds a timestamped note to a condition, keeping its existing notes
+func addConditionNote(input *domain.FHIRConditionInput, note string) {
+	if note == "" {
+		return
+	}
+
+	text := scalarutils.Markdown(note)
+	noteTime := scalarutils.DateTime(time.Now().Format(scalarutils.DateTimeFormatLayout))
+
+	input.Note = append(input.Note, &domain.FHIRAnnotationInput{
+		Time: &noteTime,
+		Text: &text,
+	})
+}
+
+// conditionInput converts a stored condition into the input used to update it
+func conditionInput(resource domain.FHIRCondition) (*domain.FHIRConditionInput, error) {
+	bs, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal condition: %w", err)
+	}
+
+	input := &domain.FHIRConditionInput{}
+
+	err = json.Unmarshal(bs, input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal condition input: %w", err)
+	}
+
+	return input, nil
+}
@@ -601,3 +601,492 @@ func TestUseCasesClinicalImpl_ListPatientConditions(t *testing.T) {
 		})
 	}
 }
+
+// problemListCondition is a condition of a patient recorded with its code, category, clinical and verification status
+func problemListCondition(code string, category string, status string, verificationStatus string) domain.FHIRCondition {
+	id := gofakeit.UUID()
+	patientID := gofakeit.UUID()
+	system := scalarutils.URI("/orgs/CIEL/sources/CIEL/concepts/" + code + "/")
+	conditionCode := scalarutils.Code(code)
+	categoryCode := scalarutils.Code(category)
+	statusCode := scalarutils.Code(status)
+	verificationCode := scalarutils.Code(verificationStatus)
+
+	return domain.FHIRCondition{
+		ID: &id,
+		ClinicalStatus: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{{Code: &statusCode}},
+			Text:   status,
+		},
+		VerificationStatus: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{{Code: &verificationCode}},
+			Text:   verificationStatus,
+		},
+		Category: []*domain.FHIRCodeableConcept{
+			{
+				Coding: []*domain.FHIRCoding{{Code: &categoryCode}},
+				Text:   category,
+			},
+		},
+		Code: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{{System: &system, Code: &conditionCode}},
+			Text:   code,
+		},
+		Subject: &domain.FHIRReference{
+			ID: &patientID,
+		},
+	}
+}
+
+func TestUseCasesClinicalImpl_UpdateCondition(t *testing.T) {
+	confirmed := dto.ConditionVerificationStatusConfirmed
+	enteredInError := dto.ConditionVerificationStatusEnteredInError
+	problemList := dto.ConditionCategoryProblemList
+	resolved := dto.ConditionStatusResolved
+	relapse := dto.ConditionStatusRelapse
+
+	type args struct {
+		ctx   context.Context
+		id    string
+		input dto.ConditionUpdateInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: confirm a diagnosis and add it to the problem list",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.ConditionUpdateInput{
+					VerificationStatus: &confirmed,
+					Category:           &problemList,
+					Note:               "Confirmed by a positive malaria RDT",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: record a relapse of a resolved condition",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.ConditionUpdateInput{
+					Status: &relapse,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid condition id",
+			args: args{
+				ctx: context.Background(),
+				id:  "invalid",
+				input: dto.ConditionUpdateInput{
+					VerificationStatus: &confirmed,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: mark as entered in error through update",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.ConditionUpdateInput{
+					VerificationStatus: &enteredInError,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to get condition",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.ConditionUpdateInput{
+					VerificationStatus: &confirmed,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: update a condition entered in error",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.ConditionUpdateInput{
+					VerificationStatus: &confirmed,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: abatement date of an active condition",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.ConditionUpdateInput{
+					AbatementDate: &scalarutils.Date{Year: 2023, Month: 7, Day: 1},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: abatement date before the onset date",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.ConditionUpdateInput{
+					Status:        &resolved,
+					AbatementDate: &scalarutils.Date{Year: 2023, Month: 5, Day: 1},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to update condition",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.ConditionUpdateInput{
+					VerificationStatus: &confirmed,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			getCondition := fakeFHIR.MockGetFHIRConditionFn
+
+			if tt.name == "Happy case: record a relapse of a resolved condition" {
+				fakeFHIR.MockGetFHIRConditionFn = func(ctx context.Context, id string) (*domain.FHIRConditionRelayPayload, error) {
+					condition, err := getCondition(ctx, id)
+					resolvedCode := scalarutils.Code("resolved")
+					condition.Resource.ClinicalStatus.Coding[0].Code = &resolvedCode
+					condition.Resource.AbatementDateTime = &scalarutils.Date{Year: 2023, Month: 7, Day: 1}
+
+					return condition, err
+				}
+			}
+
+			if tt.name == "Sad case: fail to get condition" {
+				fakeFHIR.MockGetFHIRConditionFn = func(ctx context.Context, id string) (*domain.FHIRConditionRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get condition")
+				}
+			}
+
+			if tt.name == "Sad case: update a condition entered in error" {
+				fakeFHIR.MockGetFHIRConditionFn = func(ctx context.Context, id string) (*domain.FHIRConditionRelayPayload, error) {
+					condition, err := getCondition(ctx, id)
+					enteredInErrorCode := scalarutils.Code("entered-in-error")
+					condition.Resource.VerificationStatus.Coding[0].Code = &enteredInErrorCode
+
+					return condition, err
+				}
+			}
+
+			if tt.name == "Sad case: fail to update condition" {
+				fakeFHIR.MockUpdateFHIRConditionFn = func(ctx context.Context, input domain.FHIRConditionInput) (*domain.FHIRConditionRelayPayload, error) {
+					return nil, fmt.Errorf("failed to update condition")
+				}
+			}
+
+			got, err := c.UpdateCondition(tt.args.ctx, tt.args.id, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.UpdateCondition() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.name == "Happy case: confirm a diagnosis and add it to the problem list" {
+				if got.VerificationStatus != dto.ConditionVerificationStatusConfirmed || got.Category != dto.ConditionCategoryProblemList {
+					t.Errorf("expected a confirmed problem list item, got %s %s", got.VerificationStatus, got.Category)
+				}
+			}
+
+			if tt.name == "Happy case: record a relapse of a resolved condition" {
+				if got.Status != dto.ConditionStatusRelapse || got.AbatementDate != nil {
+					t.Errorf("expected a relapse without an abatement date, got %s %v", got.Status, got.AbatementDate)
+				}
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ResolveCondition(t *testing.T) {
+	note := "Completed a course of artemether/lumefantrine"
+
+	type args struct {
+		ctx           context.Context
+		id            string
+		abatementDate *scalarutils.Date
+		note          *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: resolve condition today",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: resolve condition on a date",
+			args: args{
+				ctx:           context.Background(),
+				id:            gofakeit.UUID(),
+				abatementDate: &scalarutils.Date{Year: 2023, Month: 6, Day: 10},
+				note:          &note,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: abatement date in the future",
+			args: args{
+				ctx:           context.Background(),
+				id:            gofakeit.UUID(),
+				abatementDate: &scalarutils.Date{Year: time.Now().Year() + 1, Month: 1, Day: 1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid condition id",
+			args: args{
+				ctx: context.Background(),
+				id:  "invalid",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			got, err := c.ResolveCondition(tt.args.ctx, tt.args.id, tt.args.abatementDate, tt.args.note)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ResolveCondition() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (got.Status != dto.ConditionStatusResolved || got.AbatementDate == nil) {
+				t.Errorf("expected a resolved condition with an abatement date, got %s %v", got.Status, got.AbatementDate)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_MarkConditionEnteredInError(t *testing.T) {
+	reason := "Recorded against the wrong patient"
+
+	type args struct {
+		ctx    context.Context
+		id     string
+		reason *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: mark condition as entered in error",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				reason: &reason,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid condition id",
+			args: args{
+				ctx: context.Background(),
+				id:  "invalid",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to get condition",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to update condition",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: fail to get condition" {
+				fakeFHIR.MockGetFHIRConditionFn = func(ctx context.Context, id string) (*domain.FHIRConditionRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get condition")
+				}
+			}
+
+			if tt.name == "Sad case: fail to update condition" {
+				fakeFHIR.MockUpdateFHIRConditionFn = func(ctx context.Context, input domain.FHIRConditionInput) (*domain.FHIRConditionRelayPayload, error) {
+					return nil, fmt.Errorf("failed to update condition")
+				}
+			}
+
+			got, err := c.MarkConditionEnteredInError(tt.args.ctx, tt.args.id, tt.args.reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.MarkConditionEnteredInError() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (got.VerificationStatus != dto.ConditionVerificationStatusEnteredInError || got.Status != "") {
+				t.Errorf("expected a condition entered in error without a clinical status, got %s %s", got.VerificationStatus, got.Status)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_PatientProblemList(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		patientID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: problem list across encounters",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid patient id",
+			args: args{
+				ctx:       context.Background(),
+				patientID: "invalid",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to get identifiers",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to search conditions",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Happy case: problem list across encounters" {
+				fakeFHIR.MockSearchFHIRConditionFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCondition, error) {
+					return &domain.PagedFHIRCondition{
+						Conditions: []domain.FHIRCondition{
+							// hypertension is an active problem that was also diagnosed in an encounter
+							problemListCondition("117399", "problem-list-item", "active", "confirmed"),
+							problemListCondition("117399", "encounter-diagnosis", "active", "confirmed"),
+							// asthma was a problem that has since resolved
+							problemListCondition("121375", "PROBLEM_LIST_ITEM", "RESOLVED", "confirmed"),
+							problemListCondition("121375", "PROBLEM_LIST_ITEM", "ACTIVE", "confirmed"),
+							// malaria was diagnosed in two encounters
+							problemListCondition("116128", "encounter-diagnosis", "resolved", "confirmed"),
+							problemListCondition("116128", "encounter-diagnosis", "active", "confirmed"),
+							// tuberculosis was ruled out
+							problemListCondition("112141", "encounter-diagnosis", "active", "refuted"),
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: fail to get identifiers" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("failed to get identifiers")
+				}
+			}
+
+			if tt.name == "Sad case: fail to search conditions" {
+				fakeFHIR.MockSearchFHIRConditionFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCondition, error) {
+					return nil, fmt.Errorf("failed to search conditions")
+				}
+			}
+
+			got, err := c.PatientProblemList(tt.args.ctx, tt.args.patientID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.PatientProblemList() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.name == "Happy case: problem list across encounters" {
+				if len(got.ProblemListItems) != 1 || got.ProblemListItems[0].Code != "117399" {
+					t.Errorf("expected hypertension to be the only active problem, got %v", got.ProblemListItems)
+				}
+
+				if len(got.EncounterDiagnoses) != 1 || got.EncounterDiagnoses[0].Code != "116128" || got.EncounterDiagnoses[0].Status != dto.ConditionStatusResolved {
+					t.Errorf("expected the most recent malaria diagnosis, got %v", got.EncounterDiagnoses)
+				}
+			}
+		})
+	}
+}
@@ -2,7 +2,12 @@ package clinical
 
 import (
 	"context"
+	"crypto/sha1" //nolint:gosec
+	"encoding/base64"
 	"fmt"
+	"io"
+	"strings"
+	"time"
 
 	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 	"github.com/savannahghi/clinical/pkg/clinical/domain"
@@ -37,24 +42,32 @@ func (u *UseCasesClinicalImpl) RecordConsent(ctx context.Context, input dto.Cons
 		Text: "patient-privacy",
 	}
 
-	var system scalarutils.URI = "http://terminology.hl7.org/CodeSystem/consentcategorycodes"
-
-	code := scalarutils.Code("acd")
-
-	coding := &domain.FHIRCoding{
-		System:  &system,
-		Code:    &code,
-		Display: "Advance Directive",
-	}
-	category := &domain.FHIRCodeableConcept{
-		Text:   "Advance Directive",
-		Coding: []*domain.FHIRCoding{coding},
+	if !input.Category.IsValid() {
+		return nil, fmt.Errorf("a consent must have a valid category, got %q", input.Category)
 	}
+
+	category := composeConsentCategory(input.Category)
 	policyRule := &domain.FHIRCodeableConcept{
 		Text: "cric",
 	}
+
+	now := time.Now()
+	period := &domain.FHIRPeriod{
+		Start: scalarutils.DateTime(now.Format(time.RFC3339)),
+	}
+
+	if input.ExpiresAt != nil {
+		period.End = scalarutils.DateTime(input.ExpiresAt.AsTime().Format(time.DateOnly))
+
+		lapses, _ := consentPeriodLapses(period.End)
+		if !now.Before(lapses) {
+			return nil, fmt.Errorf("a consent's expiry date must not be in the past")
+		}
+	}
+
 	consentProvision := &domain.FHIRConsentProvision{
-		Type: &input.Provision,
+		Type:   &input.Provision,
+		Period: period,
 		Data: []domain.FHIRConsentProvisionData{
 			{
 				Meaning:   dto.ConsentDataMeaningRelated,
@@ -73,7 +86,9 @@ func (u *UseCasesClinicalImpl) RecordConsent(ctx context.Context, input dto.Cons
 	}
 
 	status := dto.ConditionStatusActive
+	dateTime := now.Format(time.RFC3339)
 	consent := domain.FHIRConsent{
+		DateTime:   &dateTime,
 		Provision:  consentProvision,
 		Status:     (*dto.ConsentStatusEnum)(&status),
 		Patient:    subjectReference,
@@ -91,14 +106,240 @@ func (u *UseCasesClinicalImpl) RecordConsent(ctx context.Context, input dto.Cons
 		consent.Extension = []domain.Extension{*extension}
 	}
 
+	if input.ConsentFormMediaID != nil {
+		attachment, err := u.consentFormAttachment(ctx, *input.ConsentFormMediaID, *patientID)
+		if err != nil {
+			return nil, err
+		}
+
+		consent.SourceAttachment = attachment
+	}
+
 	resp, err := u.infrastructure.FHIR.CreateFHIRConsent(ctx, consent)
 	if err != nil {
 		return nil, err
 	}
 
+	result := mapFHIRConsentToConsentDTO(*resp)
+
 	output := &dto.ConsentOutput{
-		Status: resp.Status,
+		Status:    result.Status,
+		Category:  result.Category,
+		Provision: result.Provision,
+	}
+
+	if resp.ID != nil {
+		output.ID = *resp.ID
+	}
+
+	output.ConsentFormURL, err = u.consentFormDownloadURL(ctx, *resp)
+	if err != nil {
+		return nil, err
 	}
 
 	return output, nil
 }
+
+// ListPatientConsents lists a patient's consents. The consents can be filtered by category and status.
+// Consents whose provision period has lapsed are reported as inactive
+func (u *UseCasesClinicalImpl) ListPatientConsents(ctx context.Context, patientID string, category *dto.ConsentCategoryEnum, status *dto.ConsentStatusEnum) ([]*dto.Consent, error) {
+	if patientID == "" {
+		return nil, fmt.Errorf("a patient ID is required")
+	}
+
+	identifiers, err := u.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"patient": fmt.Sprintf("Patient/%s", patientID),
+		"_sort":   "-_lastUpdated",
+	}
+
+	if category != nil {
+		if !category.IsValid() {
+			return nil, fmt.Errorf("invalid consent category: %s", *category)
+		}
+
+		params["category"] = fmt.Sprintf("%s|%s", consentCategoryCodeSystem, category.Code())
+	}
+
+	resources, err := u.infrastructure.FHIR.SearchFHIRConsent(ctx, params, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	consents := []*dto.Consent{}
+
+	for _, resource := range resources.Consents {
+		consent := mapFHIRConsentToConsentDTO(resource)
+
+		if status != nil && (consent.Status == nil || *consent.Status != *status) {
+			continue
+		}
+
+		consent.ConsentFormURL, err = u.consentFormDownloadURL(ctx, resource)
+		if err != nil {
+			return nil, err
+		}
+
+		consents = append(consents, consent)
+	}
+
+	return consents, nil
+}
+
+// RevokeConsent inactivates a consent so that it no longer applies to the patient.
+// Only consents that belong to the caller's organisation can be revoked
+func (u *UseCasesClinicalImpl) RevokeConsent(ctx context.Context, consentID string, reason *string) (*dto.Consent, error) {
+	if consentID == "" {
+		return nil, fmt.Errorf("a consent ID is required")
+	}
+
+	identifiers, err := u.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	consent, err := u.infrastructure.FHIR.GetFHIRConsent(ctx, consentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resourceInputOrganizationID(consent.Resource.Meta) != identifiers.OrganizationID {
+		return nil, fmt.Errorf("consent %s does not belong to organisation %s", consentID, identifiers.OrganizationID)
+	}
+
+	if consent.Resource.Status != nil && *consent.Resource.Status == dto.ConsentStatusInactive {
+		return nil, fmt.Errorf("consent %s has already been revoked", consentID)
+	}
+
+	status := dto.ConsentStatusEnum(dto.ConsentStatusInactive)
+	consent.Resource.Status = &status
+
+	if reason != nil && *reason != "" {
+		consent.Resource.Extension = append(consent.Resource.Extension, domain.Extension{
+			URL:         consentRevocationReasonExtensionURL,
+			ValueString: *reason,
+		})
+	}
+
+	updated, err := u.infrastructure.FHIR.UpdateFHIRConsent(ctx, *consent.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRConsentToConsentDTO(*updated), nil
+}
+
+// consentFormAttachment builds the source attachment of a consent from the media created when the signed consent form was uploaded.
+// The media must belong to the patient giving the consent
+func (u *UseCasesClinicalImpl) consentFormAttachment(ctx context.Context, mediaID, patientID string) (*domain.FHIRAttachment, error) {
+	media, err := u.infrastructure.FHIR.GetFHIRMedia(ctx, mediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	if media.Resource.Subject == nil || media.Resource.Subject.ID == nil || *media.Resource.Subject.ID != patientID {
+		return nil, fmt.Errorf("the consent form does not belong to the patient")
+	}
+
+	content := media.Resource.Content
+	if content == nil || content.URL == nil || content.ContentType == nil || content.Title == nil {
+		return nil, fmt.Errorf("the consent form media %s has no uploaded content", mediaID)
+	}
+
+	attachment := &domain.FHIRAttachment{
+		ContentType: content.ContentType,
+		URL:         content.URL,
+		Size:        content.Size,
+		Hash:        content.Hash,
+		Title:       content.Title,
+		Creation:    content.Creation,
+	}
+
+	// consent forms uploaded before hashes were recorded are hashed from the stored content
+	if attachment.Hash == nil {
+		attachment.Hash, attachment.Size, err = u.uploadedMediaHash(ctx, *content.Title)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash the consent form media %s: %w", mediaID, err)
+		}
+	}
+
+	return attachment, nil
+}
+
+// uploadedMediaHash computes the attachment hash and size of an uploaded object
+func (u *UseCasesClinicalImpl) uploadedMediaHash(ctx context.Context, name string) (*scalarutils.Base64Binary, *int, error) {
+	reader, err := u.infrastructure.Upload.ReadMedia(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer reader.Close()
+
+	hasher := sha1.New() //nolint:gosec
+	counter := &byteCounter{}
+
+	_, err = io.Copy(io.MultiWriter(hasher, counter), reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hash := scalarutils.Base64Binary(base64.StdEncoding.EncodeToString(hasher.Sum(nil)))
+	size := counter.count
+
+	return &hash, &size, nil
+}
+
+// consentFormDownloadURL generates a time limited download link for a consent's signed form, if it has one
+func (u *UseCasesClinicalImpl) consentFormDownloadURL(ctx context.Context, consent domain.FHIRConsent) (*string, error) {
+	if consent.SourceAttachment == nil || consent.SourceAttachment.Title == nil {
+		return nil, nil
+	}
+
+	url, err := u.infrastructure.Upload.GetSignedURL(ctx, *consent.SourceAttachment.Title, consentFormURLExpiry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate consent form download link: %w", err)
+	}
+
+	return &url, nil
+}
+
+// ensureConsentPermits checks that the patient has not denied consent for activities in the given category.
+// An active, unexpired consent with a deny provision blocks the activity. Consents recorded before categories
+// were introduced have no category and apply to activities in every category.
+//
+// Referrals are checked against data sharing consents and screening questionnaire responses against screening consents.
+// Research consents are recorded but not checked since no operation of this service shares data for research.
+// Lab orders, prescriptions and the other orders placed during an encounter are part of the patient's care and
+// are deliberately not checked
+func (u *UseCasesClinicalImpl) ensureConsentPermits(ctx context.Context, patientID string, category dto.ConsentCategoryEnum) error {
+	identifiers, err := u.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"patient": fmt.Sprintf("Patient/%s", patientID),
+		"status":  dto.ConsentStatusActive,
+	}
+
+	resources, err := u.infrastructure.FHIR.SearchFHIRConsent(ctx, params, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Consents {
+		resourceCategory := consentCategory(resource)
+		if resourceCategory != nil && *resourceCategory != category {
+			continue
+		}
+
+		if consentDenies(resource, time.Now()) {
+			return fmt.Errorf("the patient has denied consent for %s", strings.ToLower(category.Display()))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package clinical
+
+import (
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// consentFormURLExpiry is how long a consent form download link remains valid
+const consentFormURLExpiry = 15 * time.Minute
+
+const (
+	consentCategoryCodeSystem           = "http://savannahghi.org/fhir/CodeSystem/consent-category"
+	consentRevocationReasonExtensionURL = "http://savannahghi.org/fhir/StructureDefinition/consent-revocation-reason"
+)
+
+// composeConsentCategory builds the FHIR category of a consent
+func composeConsentCategory(category dto.ConsentCategoryEnum) *domain.FHIRCodeableConcept {
+	system := scalarutils.URI(consentCategoryCodeSystem)
+	code := scalarutils.Code(category.Code())
+
+	return &domain.FHIRCodeableConcept{
+		Coding: []*domain.FHIRCoding{
+			{
+				System:  &system,
+				Code:    &code,
+				Display: category.Display(),
+			},
+		},
+		Text: category.Display(),
+	}
+}
+
+// consentCategory reads the category of a consent. Consents recorded before categories were introduced have none
+func consentCategory(consent domain.FHIRConsent) *dto.ConsentCategoryEnum {
+	categories := []dto.ConsentCategoryEnum{dto.ConsentCategoryDataSharing, dto.ConsentCategoryScreening, dto.ConsentCategoryResearch}
+
+	for _, concept := range consent.Category {
+		if concept == nil {
+			continue
+		}
+
+		for _, coding := range concept.Coding {
+			if coding == nil || coding.System == nil || coding.Code == nil || string(*coding.System) != consentCategoryCodeSystem {
+				continue
+			}
+
+			for _, category := range categories {
+				if category.Code() == string(*coding.Code) {
+					return &category
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// consentPeriodLapses is the instant a consent whose provision period ends at `end` stops applying.
+// A date only end is inclusive of the whole day hence the consent lapses at the start of the next day
+func consentPeriodLapses(end scalarutils.DateTime) (time.Time, bool) {
+	endTime, err := time.Parse(time.RFC3339, string(end))
+	if err == nil {
+		return endTime, true
+	}
+
+	endDate, err := time.Parse(time.DateOnly, string(end))
+	if err == nil {
+		return endDate.AddDate(0, 0, 1), true
+	}
+
+	return time.Time{}, false
+}
+
+// consentExpired checks whether the provision period of a consent has lapsed at the given time
+func consentExpired(consent domain.FHIRConsent, now time.Time) bool {
+	if consent.Provision == nil || consent.Provision.Period == nil || consent.Provision.Period.End == "" {
+		return false
+	}
+
+	lapses, ok := consentPeriodLapses(consent.Provision.Period.End)
+	if !ok {
+		return false
+	}
+
+	return !now.Before(lapses)
+}
+
+// consentDenies checks whether a consent is an active deny that has not expired
+func consentDenies(consent domain.FHIRConsent, now time.Time) bool {
+	if consent.Status == nil || *consent.Status != dto.ConsentStatusActive {
+		return false
+	}
+
+	if consent.Provision == nil || consent.Provision.Type == nil || *consent.Provision.Type != dto.ConsentProvisionTypeDeny {
+		return false
+	}
+
+	return !consentExpired(consent, now)
+}
+
+// mapFHIRConsentToConsentDTO maps a FHIR consent to its output representation
+func mapFHIRConsentToConsentDTO(consent domain.FHIRConsent) *dto.Consent {
+	output := &dto.Consent{
+		ID:       consent.ID,
+		Status:   consent.Status,
+		Category: consentCategory(consent),
+	}
+
+	if consent.Status != nil && *consent.Status == dto.ConsentStatusActive && consentExpired(consent, time.Now()) {
+		status := dto.ConsentStatusEnum(dto.ConsentStatusInactive)
+		output.Status = &status
+	}
+
+	if consent.Provision != nil {
+		output.Provision = &dto.ConsentProvision{
+			ID:   consent.Provision.ID,
+			Type: consent.Provision.Type,
+		}
+
+		if consent.Provision.Period != nil {
+			output.Provision.Period = &dto.Period{
+				ID: consent.Provision.Period.ID,
+			}
+
+			if consent.Provision.Period.Start != "" {
+				output.Provision.Period.Start = &consent.Provision.Period.Start
+			}
+
+			if consent.Provision.Period.End != "" {
+				output.Provision.Period.End = &consent.Provision.Period.End
+			}
+		}
+	}
+
+	if consent.Patient != nil {
+		output.Patient = &dto.Reference{
+			Display: consent.Patient.Display,
+		}
+
+		if consent.Patient.ID != nil {
+			output.Patient.ID = *consent.Patient.ID
+		}
+
+		if consent.Patient.Reference != nil {
+			output.Patient.Reference = *consent.Patient.Reference
+		}
+	}
+
+	return output
+}
@@ -3,9 +3,12 @@ package clinical_test
 import (
 	"context"
 	"fmt"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
 	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
 	"github.com/savannahghi/clinical/pkg/clinical/domain"
@@ -16,12 +19,35 @@ import (
 	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
 	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
 	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
 )
 
+// fakeDenyConsents returns an active consent that denies the action
+func fakeDenyConsents() *domain.PagedFHIRConsent {
+	id := gofakeit.UUID()
+	status := dto.ConsentStatusEnum(dto.ConsentStatusActive)
+	provision := dto.ConsentProvisionTypeEnum(dto.ConsentProvisionTypeDeny)
+
+	return &domain.PagedFHIRConsent{
+		Consents: []domain.FHIRConsent{
+			{
+				ID:     &id,
+				Status: &status,
+				Provision: &domain.FHIRConsentProvision{
+					Type: &provision,
+				},
+			},
+		},
+	}
+}
+
 func TestUseCasesClinicalImpl_RecordConsent(t *testing.T) {
 	ID := gofakeit.UUID()
 	status := dto.ConsentStatusActive
 	provisionType := dto.ConsentProvisionTypePermit
+	research := dto.ConsentCategoryResearch
+	mediaID := gofakeit.UUID()
+	invalidCategory := dto.ConsentCategoryEnum("invalid")
 
 	type args struct {
 		ctx   context.Context
@@ -38,6 +64,7 @@ func TestUseCasesClinicalImpl_RecordConsent(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				input: dto.ConsentInput{
+					Category:    dto.ConsentCategoryScreening,
 					EncounterID: ID,
 					Provision:   dto.ConsentProvisionTypeEnum(provisionType),
 					Status:      dto.ConsentStatusEnum(status),
@@ -46,11 +73,163 @@ func TestUseCasesClinicalImpl_RecordConsent(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Happy case: create a research consent with an expiry date",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ConsentInput{
+					EncounterID: ID,
+					Provision:   dto.ConsentProvisionTypeEnum(provisionType),
+					Status:      dto.ConsentStatusEnum(status),
+					Category:    research,
+					ExpiresAt:   &scalarutils.Date{Year: time.Now().Year() + 1, Month: 1, Day: 1},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: create a consent with a signed consent form",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ConsentInput{
+					Category:           dto.ConsentCategoryScreening,
+					EncounterID:        ID,
+					Provision:          dto.ConsentProvisionTypeEnum(provisionType),
+					Status:             dto.ConsentStatusEnum(status),
+					ConsentFormMediaID: &mediaID,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case: consent form belongs to another patient",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ConsentInput{
+					Category:           dto.ConsentCategoryScreening,
+					EncounterID:        ID,
+					Provision:          dto.ConsentProvisionTypeEnum(provisionType),
+					Status:             dto.ConsentStatusEnum(status),
+					ConsentFormMediaID: &mediaID,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case: failed to get consent form media",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ConsentInput{
+					Category:           dto.ConsentCategoryScreening,
+					EncounterID:        ID,
+					Provision:          dto.ConsentProvisionTypeEnum(provisionType),
+					Status:             dto.ConsentStatusEnum(status),
+					ConsentFormMediaID: &mediaID,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Happy case: hash a consent form uploaded without a hash",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ConsentInput{
+					Category:           dto.ConsentCategoryScreening,
+					EncounterID:        ID,
+					Provision:          dto.ConsentProvisionTypeEnum(provisionType),
+					Status:             dto.ConsentStatusEnum(status),
+					ConsentFormMediaID: &mediaID,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case: failed to read a consent form uploaded without a hash",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ConsentInput{
+					Category:           dto.ConsentCategoryScreening,
+					EncounterID:        ID,
+					Provision:          dto.ConsentProvisionTypeEnum(provisionType),
+					Status:             dto.ConsentStatusEnum(status),
+					ConsentFormMediaID: &mediaID,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case: failed to generate consent form download link",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ConsentInput{
+					Category:           dto.ConsentCategoryScreening,
+					EncounterID:        ID,
+					Provision:          dto.ConsentProvisionTypeEnum(provisionType),
+					Status:             dto.ConsentStatusEnum(status),
+					ConsentFormMediaID: &mediaID,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Happy case: create a consent that expires at the end of today",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ConsentInput{
+					Category:    dto.ConsentCategoryScreening,
+					EncounterID: ID,
+					Provision:   dto.ConsentProvisionTypeEnum(provisionType),
+					Status:      dto.ConsentStatusEnum(status),
+					ExpiresAt:   &scalarutils.Date{Year: time.Now().UTC().Year(), Month: int(time.Now().UTC().Month()), Day: time.Now().UTC().Day()},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case: expiry date in the past",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ConsentInput{
+					Category:    dto.ConsentCategoryScreening,
+					EncounterID: ID,
+					Provision:   dto.ConsentProvisionTypeEnum(provisionType),
+					Status:      dto.ConsentStatusEnum(status),
+					ExpiresAt:   &scalarutils.Date{Year: 2020, Month: 1, Day: 1},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case: missing category",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ConsentInput{
+					EncounterID: ID,
+					Provision:   dto.ConsentProvisionTypeEnum(provisionType),
+					Status:      dto.ConsentStatusEnum(status),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case: invalid category",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ConsentInput{
+					EncounterID: ID,
+					Provision:   dto.ConsentProvisionTypeEnum(provisionType),
+					Status:      dto.ConsentStatusEnum(status),
+					Category:    invalidCategory,
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Sad Case: failed to create consent",
 			args: args{
 				ctx: context.Background(),
 				input: dto.ConsentInput{
+					Category:    dto.ConsentCategoryScreening,
 					EncounterID: ID,
 					Status:      dto.ConsentStatusEnum(status),
 					DenyReason:  "",
@@ -63,6 +242,7 @@ func TestUseCasesClinicalImpl_RecordConsent(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				input: dto.ConsentInput{
+					Category:    dto.ConsentCategoryScreening,
 					EncounterID: "",
 					Status:      dto.ConsentStatusEnum(status),
 					DenyReason:  "",
@@ -75,6 +255,7 @@ func TestUseCasesClinicalImpl_RecordConsent(t *testing.T) {
 			args: args{
 				ctx: nil,
 				input: dto.ConsentInput{
+					Category:    dto.ConsentCategoryScreening,
 					EncounterID: "",
 					Status:      dto.ConsentStatusEnum(status),
 					DenyReason:  "",
@@ -102,6 +283,54 @@ func TestUseCasesClinicalImpl_RecordConsent(t *testing.T) {
 				}
 
 			}
+			if tt.name == "Sad Case: consent form belongs to another patient" {
+				fakeFHIR.MockGetFHIRMediaFn = func(ctx context.Context, id string) (*domain.FHIRMediaRelayPayload, error) {
+					patientID := gofakeit.UUID()
+
+					return &domain.FHIRMediaRelayPayload{
+						Resource: &domain.FHIRMedia{
+							ID: &id,
+							Subject: &domain.FHIRReferenceInput{
+								ID: &patientID,
+							},
+						},
+					}, nil
+				}
+			}
+			if tt.name == "Sad Case: failed to get consent form media" {
+				fakeFHIR.MockGetFHIRMediaFn = func(ctx context.Context, id string) (*domain.FHIRMediaRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Happy case: hash a consent form uploaded without a hash" || tt.name == "Sad Case: failed to read a consent form uploaded without a hash" {
+				media, _ := fakeFHIR.MockGetFHIRMediaFn(context.Background(), mediaID)
+				media.Resource.Content.Hash = nil
+
+				fakeFHIR.MockGetFHIRMediaFn = func(ctx context.Context, id string) (*domain.FHIRMediaRelayPayload, error) {
+					return media, nil
+				}
+			}
+			if tt.name == "Happy case: hash a consent form uploaded without a hash" {
+				createConsent := fakeFHIR.MockCreateFHIRConsentFn
+				fakeFHIR.MockCreateFHIRConsentFn = func(ctx context.Context, input domain.FHIRConsent) (*domain.FHIRConsent, error) {
+					// the base64 encoded SHA-1 of the mocked consent form content
+					if input.SourceAttachment == nil || input.SourceAttachment.Hash == nil || *input.SourceAttachment.Hash != "q2mPkUJnBasIbOYvaUyyO/wgAbc=" {
+						return nil, fmt.Errorf("expected the consent form to be hashed")
+					}
+
+					return createConsent(ctx, input)
+				}
+			}
+			if tt.name == "Sad Case: failed to read a consent form uploaded without a hash" {
+				fakeUpload.MockReadMediaFn = func(ctx context.Context, name string) (io.ReadCloser, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+			if tt.name == "Sad Case: failed to generate consent form download link" {
+				fakeUpload.MockGetSignedURLFn = func(ctx context.Context, name string, expiry time.Duration) (string, error) {
+					return "", fmt.Errorf("an error occurred")
+				}
+			}
 			if tt.name == "Sad Case: invalid encounter id" {
 				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
 					return nil, fmt.Errorf("an error occurred")
@@ -115,12 +344,311 @@ func TestUseCasesClinicalImpl_RecordConsent(t *testing.T) {
 
 			}
 
-			_, err := c.RecordConsent(tt.args.ctx, tt.args.input)
+			got, err := c.RecordConsent(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesClinicalImpl.RecordConsent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				if got.ID == "" || got.Provision == nil {
+					t.Errorf("expected the consent ID and provision, got %v", got)
+					return
+				}
+
+				if (tt.args.input.ConsentFormMediaID != nil) != (got.ConsentFormURL != nil) {
+					t.Errorf("expected a consent form download link only when a consent form is attached")
+				}
+			}
+
+		})
+	}
+
+}
+
+func TestUseCasesClinicalImpl_ListPatientConsents(t *testing.T) {
+	screening := dto.ConsentCategoryScreening
+	invalidCategory := dto.ConsentCategoryEnum("invalid")
+	active := dto.ConsentStatusEnum(dto.ConsentStatusActive)
+
+	type args struct {
+		ctx       context.Context
+		patientID string
+		category  *dto.ConsentCategoryEnum
+		status    *dto.ConsentStatusEnum
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: list patient consents",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantCount: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: list active screening consents",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+				category:  &screening,
+				status:    &active,
+			},
+			wantCount: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: expired consents are inactive",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+				status:    &active,
+			},
+			wantCount: 0,
+			wantErr:   false,
+		},
+		{
+			name: "Sad case: missing patient ID",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid category",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+				category:  &invalidCategory,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get tenant identifiers",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search consents",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Happy case: expired consents are inactive" {
+				fakeFHIR.MockSearchFHIRConsentFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRConsent, error) {
+					consents := fakeDenyConsents()
+					consents.Consents[0].Provision.Period = &domain.FHIRPeriod{
+						Start: "2020-01-01T00:00:00Z",
+						End:   "2021-01-01",
+					}
+
+					return consents, nil
+				}
 			}
 
+			if tt.name == "Sad case: failed to get tenant identifiers" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to search consents" {
+				fakeFHIR.MockSearchFHIRConsentFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRConsent, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.ListPatientConsents(tt.args.ctx, tt.args.patientID, tt.args.category, tt.args.status)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListPatientConsents() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && len(got) != tt.wantCount {
+				t.Errorf("expected %d consents, got %d", tt.wantCount, len(got))
+			}
 		})
 	}
+}
+
+func TestUseCasesClinicalImpl_RevokeConsent(t *testing.T) {
+	reason := "Patient withdrew from the programme"
+	organisationID := gofakeit.UUID()
+	organisationTagSystem := scalarutils.URI(common.OrganizationTagSystem)
 
+	type args struct {
+		ctx       context.Context
+		consentID string
+		reason    *string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: revoke consent",
+			args: args{
+				ctx:       context.Background(),
+				consentID: gofakeit.UUID(),
+				reason:    &reason,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing consent ID",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: consent already revoked",
+			args: args{
+				ctx:       context.Background(),
+				consentID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get consent",
+			args: args{
+				ctx:       context.Background(),
+				consentID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: consent of another organisation",
+			args: args{
+				ctx:       context.Background(),
+				consentID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get tenant identifiers",
+			args: args{
+				ctx:       context.Background(),
+				consentID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update consent",
+			args: args{
+				ctx:       context.Background(),
+				consentID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+				return &dto.TenantIdentifiers{OrganizationID: organisationID, FacilityID: gofakeit.UUID()}, nil
+			}
+
+			fakeFHIR.MockGetFHIRConsentFn = func(ctx context.Context, id string) (*domain.FHIRConsentRelayPayload, error) {
+				return &domain.FHIRConsentRelayPayload{
+					Resource: &domain.FHIRConsent{
+						ID: &id,
+						Meta: &domain.FHIRMetaInput{
+							Tag: []domain.FHIRCodingInput{
+								{System: &organisationTagSystem, Code: scalarutils.Code(organisationID)},
+							},
+						},
+					},
+				}, nil
+			}
+
+			if tt.name == "Sad case: consent already revoked" {
+				fakeFHIR.MockGetFHIRConsentFn = func(ctx context.Context, id string) (*domain.FHIRConsentRelayPayload, error) {
+					status := dto.ConsentStatusEnum(dto.ConsentStatusInactive)
+
+					return &domain.FHIRConsentRelayPayload{
+						Resource: &domain.FHIRConsent{
+							ID:     &id,
+							Status: &status,
+							Meta: &domain.FHIRMetaInput{
+								Tag: []domain.FHIRCodingInput{
+									{System: &organisationTagSystem, Code: scalarutils.Code(organisationID)},
+								},
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: consent of another organisation" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return &dto.TenantIdentifiers{OrganizationID: gofakeit.UUID(), FacilityID: gofakeit.UUID()}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get tenant identifiers" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get consent" {
+				fakeFHIR.MockGetFHIRConsentFn = func(ctx context.Context, id string) (*domain.FHIRConsentRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to update consent" {
+				fakeFHIR.MockUpdateFHIRConsentFn = func(ctx context.Context, input domain.FHIRConsent) (*domain.FHIRConsent, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.RevokeConsent(tt.args.ctx, tt.args.consentID, tt.args.reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RevokeConsent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (got.Status == nil || *got.Status != dto.ConsentStatusInactive) {
+				t.Errorf("expected the consent to be inactive, got %v", got.Status)
+			}
+		})
+	}
 }
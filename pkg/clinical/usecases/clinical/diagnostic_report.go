@@ -226,6 +226,29 @@ func (c *UseCasesClinicalImpl) RecordDiagnosticReport(ctx context.Context, conce
 		}
 	}
 
+	if input.SpecimenID != "" {
+		specimen, err := c.infrastructure.FHIR.GetFHIRSpecimen(ctx, input.SpecimenID)
+		if err != nil {
+			return nil, err
+		}
+
+		subject := specimen.Resource.Subject
+		if subject == nil || subject.ID == nil || *subject.ID != observation.PatientID {
+			return nil, fmt.Errorf("specimen %s is not from patient %s", input.SpecimenID, observation.PatientID)
+		}
+
+		specimenReference := fmt.Sprintf("Specimen/%s", input.SpecimenID)
+		specimenType := scalarutils.URI("Specimen")
+
+		diagnosticReport.Specimen = []*domain.FHIRReferenceInput{
+			{
+				ID:        &input.SpecimenID,
+				Reference: &specimenReference,
+				Type:      &specimenType,
+			},
+		}
+	}
+
 	if len(mutators) > 0 {
 		for _, mutator := range mutators {
 			err = mutator(ctx, diagnosticReport)
@@ -240,12 +263,18 @@ func (c *UseCasesClinicalImpl) RecordDiagnosticReport(ctx context.Context, conce
 		return nil, err
 	}
 
-	return &dto.DiagnosticReport{
+	report := &dto.DiagnosticReport{
 		ID:          *result.ID,
 		Status:      dto.ObservationStatus(result.Status),
 		PatientID:   *result.Subject.ID,
 		EncounterID: *result.Encounter.ID,
 		Issued:      *result.Issued,
 		Conclusion:  *result.Conclusion,
-	}, nil
+	}
+
+	if len(result.Specimen) > 0 && result.Specimen[0].ID != nil {
+		report.SpecimenID = *result.Specimen[0].ID
+	}
+
+	return report, nil
 }
@@ -288,6 +288,45 @@ func TestUseCasesClinicalImpl_RecordBiopsy(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Happy case: record biopsy of a specimen",
+			args: args{
+				ctx: addTenantIdentifierContext(context.Background()),
+				input: dto.DiagnosticReportInput{
+					EncounterID: "12345678905432345",
+					Note:        "Go for biopsy test",
+					Findings:    gofakeit.HipsterSentence(20),
+					SpecimenID:  gofakeit.UUID(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: specimen is from another patient",
+			args: args{
+				ctx: addTenantIdentifierContext(context.Background()),
+				input: dto.DiagnosticReportInput{
+					EncounterID: "12345678905432345",
+					Note:        "Go for biopsy test",
+					Findings:    gofakeit.HipsterSentence(20),
+					SpecimenID:  gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unable to get specimen",
+			args: args{
+				ctx: addTenantIdentifierContext(context.Background()),
+				input: dto.DiagnosticReportInput{
+					EncounterID: "12345678905432345",
+					Note:        "Go for biopsy test",
+					Findings:    gofakeit.HipsterSentence(20),
+					SpecimenID:  gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Sad case: unable to successfully record biopsy test",
 			args: args{
@@ -379,6 +418,35 @@ func TestUseCasesClinicalImpl_RecordBiopsy(t *testing.T) {
 			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
 			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
 
+			var created *domain.FHIRDiagnosticReportInput
+
+			if tt.name == "Happy case: record biopsy of a specimen" {
+				patientID := "12345678905432345"
+
+				createObservation := fakeFHIR.MockCreateFHIRObservationFn
+				fakeFHIR.MockCreateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+					observation, err := createObservation(ctx, input)
+					if err != nil {
+						return nil, err
+					}
+
+					observation.Subject.ID = &patientID
+
+					return observation, nil
+				}
+
+				createReport := fakeFHIR.MockCreateFHIRDiagnosticReportFn
+				fakeFHIR.MockCreateFHIRDiagnosticReportFn = func(ctx context.Context, input *domain.FHIRDiagnosticReportInput) (*domain.FHIRDiagnosticReport, error) {
+					created = input
+
+					return createReport(ctx, input)
+				}
+			}
+			if tt.name == "Sad case: unable to get specimen" {
+				fakeFHIR.MockGetFHIRSpecimenFn = func(ctx context.Context, id string) (*domain.FHIRSpecimenRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
 			if tt.name == "Sad case: unable to successfully record biopsy test" {
 				fakeFHIR.MockCreateFHIRDiagnosticReportFn = func(_ context.Context, input *domain.FHIRDiagnosticReportInput) (*domain.FHIRDiagnosticReport, error) {
 					return nil, fmt.Errorf("an error occurred")
@@ -405,6 +473,12 @@ func TestUseCasesClinicalImpl_RecordBiopsy(t *testing.T) {
 				t.Errorf("UseCasesClinicalImpl.RecordBiopsy() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+
+			if tt.name == "Happy case: record biopsy of a specimen" {
+				if created == nil || len(created.Specimen) != 1 || *created.Specimen[0].ID != tt.args.input.SpecimenID {
+					t.Errorf("expected the biopsy report to reference specimen %s", tt.args.input.SpecimenID)
+				}
+			}
 		})
 	}
 }
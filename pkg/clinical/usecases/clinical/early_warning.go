@@ -0,0 +1,159 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/application/utils"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/earlywarning"
+)
+
+const (
+	// EarlyWarningAlertRiskEnvVarName is the environment variable holding the early warning risk e.g `MEDIUM` that alerts are published from
+	EarlyWarningAlertRiskEnvVarName = "EARLY_WARNING_ALERT_RISK"
+
+	defaultEarlyWarningAlertRisk = dto.EarlyWarningRiskMedium
+)
+
+// contributesToEarlyWarningScores checks whether an early warning score is computed from the vital signs of a concept
+func (c *UseCasesClinicalImpl) contributesToEarlyWarningScores(conceptID string) bool {
+	return slices.Contains(c.infrastructure.EarlyWarningScores.Concepts(), conceptID)
+}
+
+// deriveEarlyWarningScores records the early warning scores computed from the latest vital signs of a patient in an in-progress encounter.
+// The scores derived in the encounter before are updated. An alert is published when a score rises to the alert risk or above
+func (c *UseCasesClinicalImpl) deriveEarlyWarningScores(ctx context.Context, encounterID string, patientID string) error {
+	encounter, err := c.infrastructure.FHIR.GetFHIREncounter(ctx, encounterID)
+	if err != nil {
+		return err
+	}
+
+	if encounter.Resource.Status != domain.EncounterStatusEnumInProgress {
+		return nil
+	}
+
+	patient, err := c.referenceRangesPatient(ctx, patientID)
+	if err != nil {
+		return err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	searchParams := map[string]interface{}{
+		"patient":   fmt.Sprintf("Patient/%s", patientID),
+		"encounter": fmt.Sprintf("Encounter/%s", encounterID),
+		"code":      strings.Join(append(c.infrastructure.EarlyWarningScores.Concepts(), common.LOINCBloodPressurePanel), ","),
+	}
+
+	observations, err := c.infrastructure.FHIR.SearchPatientObservations(ctx, searchParams, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return err
+	}
+
+	vitals, sources := encounterVitals(observations.Observations)
+
+	results := c.infrastructure.EarlyWarningScores.Score(earlywarning.Patient{Sex: patient.Sex, BirthDate: patient.BirthDate}, vitals, time.Now())
+	if len(results) == 0 {
+		return nil
+	}
+
+	searchParams["code"] = fmt.Sprintf("%s|", common.EarlyWarningScoreSystem)
+
+	derived, err := c.infrastructure.FHIR.SearchPatientObservations(ctx, searchParams, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]domain.FHIRObservation{}
+
+	for _, observation := range derived.Observations {
+		existing[observationConceptCode(observation.Code)] = observation
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return err
+	}
+
+	alertRisk := c.earlyWarningAlertRisk()
+
+	for _, result := range results {
+		input, err := earlyWarningObservationInput(ctx, result, sources, patientID, encounterID)
+		if err != nil {
+			return err
+		}
+
+		input.Meta = &domain.FHIRMetaInput{
+			Tag: tags,
+		}
+
+		var (
+			observation  *domain.FHIRObservation
+			previousRisk dto.EarlyWarningRiskEnum
+		)
+
+		previous, ok := existing[result.Code]
+		if ok {
+			input.ID = previous.ID
+			previousRisk = earlyWarningObservationRisk(previous)
+
+			observation, err = c.infrastructure.FHIR.UpdateFHIRObservation(ctx, *input)
+		} else {
+			observation, err = c.infrastructure.FHIR.CreateFHIRObservation(ctx, *input)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if result.Risk.Level() < alertRisk.Level() || result.Risk.Level() <= previousRisk.Level() {
+			continue
+		}
+
+		// The score has been recorded so failing to publish the alert should not fail recording the vital sign
+		err = c.infrastructure.Pubsub.NotifyEarlyWarningAlert(ctx, dto.EarlyWarningAlertPubSubMessage{
+			ID:             *observation.ID,
+			Code:           result.Code,
+			Name:           result.Display,
+			Score:          result.Score,
+			Risk:           result.Risk,
+			PreviousRisk:   previousRisk,
+			Date:           time.Now(),
+			PatientID:      patientID,
+			EncounterID:    encounterID,
+			OrganizationID: identifiers.OrganizationID,
+			FacilityID:     identifiers.FacilityID,
+		})
+		if err != nil {
+			utils.ReportErrorToSentry(fmt.Errorf("unable to publish the early warning alert of %s: %w", *observation.ID, err))
+		}
+	}
+
+	return nil
+}
+
+// earlyWarningAlertRisk is the early warning risk that alerts are published from
+func (c *UseCasesClinicalImpl) earlyWarningAlertRisk() dto.EarlyWarningRiskEnum {
+	value, err := c.infrastructure.BaseExtension.GetEnvVar(EarlyWarningAlertRiskEnvVarName)
+	if err != nil || value == "" {
+		return defaultEarlyWarningAlertRisk
+	}
+
+	risk := dto.EarlyWarningRiskEnum(strings.ToUpper(value))
+	if !risk.IsValid() {
+		utils.ReportErrorToSentry(fmt.Errorf("invalid early warning alert risk %q, using %s", value, defaultEarlyWarningAlertRisk))
+
+		return defaultEarlyWarningAlertRisk
+	}
+
+	return risk
+}
@@ -0,0 +1,154 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/earlywarning"
+	"github.com/savannahghi/scalarutils"
+)
+
+// earlyWarningRiskCode is the code of the component an early warning score records its risk in
+const earlyWarningRiskCode = "risk"
+
+var earlyWarningInterpretations = map[dto.EarlyWarningRiskEnum]dto.ObservationInterpretationEnum{
+	dto.EarlyWarningRiskLow:       dto.ObservationInterpretationNormal,
+	dto.EarlyWarningRiskLowMedium: dto.ObservationInterpretationHigh,
+	dto.EarlyWarningRiskMedium:    dto.ObservationInterpretationHigh,
+	dto.EarlyWarningRiskHigh:      dto.ObservationInterpretationCriticalHigh,
+}
+
+// encounterVitals are the latest vital signs of an encounter by their CIEL concept, and the observations they were read from.
+// The systolic pressure of a blood pressure panel is used when it was read after a systolic pressure recorded on its own
+func encounterVitals(observations []domain.FHIRObservation) (earlywarning.Vitals, map[string]domain.FHIRObservation) {
+	vitals := earlywarning.Vitals{}
+	sources := map[string]domain.FHIRObservation{}
+
+	latest := latestMeasurements(observations)
+
+	for conceptID, observation := range latest {
+		value, ok := measurementValue(*observation, conceptID)
+		if !ok {
+			continue
+		}
+
+		vitals[conceptID] = value
+		sources[conceptID] = *observation
+	}
+
+	panel, ok := latest[common.LOINCBloodPressurePanel]
+	if !ok {
+		return vitals, sources
+	}
+
+	reading := mapFHIRObservationToBloodPressureReading(*panel)
+
+	systolic, recorded := sources[common.BloodPressureCIELTerminologyCode]
+	if reading.Systolic != nil && (!recorded || isMoreRecent(*panel, systolic)) {
+		vitals[common.BloodPressureCIELTerminologyCode] = *reading.Systolic
+		sources[common.BloodPressureCIELTerminologyCode] = *panel
+	}
+
+	return vitals, sources
+}
+
+// earlyWarningObservationInput records an early warning score as a survey observation of the patient in the encounter.
+// The points of each parameter and the risk the score indicates are recorded as components
+func earlyWarningObservationInput(ctx context.Context, result earlywarning.Result, sources map[string]domain.FHIRObservation, patientID string, encounterID string) (*domain.FHIRObservationInput, error) {
+	status := domain.ObservationStatusEnumFinal
+	instant := scalarutils.Instant(time.Now().Format(time.RFC3339))
+	patientReference := fmt.Sprintf("Patient/%s", patientID)
+	encounterReference := fmt.Sprintf("Encounter/%s", encounterID)
+	risk := result.Risk.String()
+
+	observation := &domain.FHIRObservationInput{
+		Status:           &status,
+		Category:         []*domain.FHIRCodeableConceptInput{},
+		EffectiveInstant: &instant,
+		Code:             earlyWarningConcept(result.Code, result.Display),
+		ValueQuantity:    earlyWarningPoints(result.Score),
+		Subject: &domain.FHIRReferenceInput{
+			ID:        &patientID,
+			Reference: &patientReference,
+		},
+		Encounter: &domain.FHIRReferenceInput{
+			ID:        &encounterID,
+			Reference: &encounterReference,
+		},
+		Interpretation: []*domain.FHIRCodeableConceptInput{observationInterpretationConcept(earlyWarningInterpretations[result.Risk])},
+		Component:      []*domain.FHIRObservationComponentInput{},
+	}
+
+	derivedFrom := []domain.FHIRObservation{}
+	derived := map[string]bool{}
+
+	for _, parameter := range result.Parameters {
+		observation.Component = append(observation.Component, &domain.FHIRObservationComponentInput{
+			Code:          *earlyWarningConcept(parameter.Name, parameter.Display),
+			ValueQuantity: earlyWarningPoints(parameter.Points),
+		})
+
+		source, ok := sources[parameter.ConceptID]
+		if !ok || derived[*source.ID] {
+			continue
+		}
+
+		derived[*source.ID] = true
+		derivedFrom = append(derivedFrom, source)
+	}
+
+	observation.Component = append(observation.Component, &domain.FHIRObservationComponentInput{
+		Code:        *earlyWarningConcept(earlyWarningRiskCode, "Clinical risk"),
+		ValueString: &risk,
+	})
+
+	for _, mutator := range []ObservationInputMutatorFunc{addObservationCategory("survey"), addObservationDerivedFrom(derivedFrom...)} {
+		err := mutator(ctx, observation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return observation, nil
+}
+
+// earlyWarningObservationRisk reads the risk an early warning score was recorded with
+func earlyWarningObservationRisk(observation domain.FHIRObservation) dto.EarlyWarningRiskEnum {
+	for _, component := range observation.Component {
+		if component == nil || component.ValueString == nil || !hasCode(component.Code, earlyWarningRiskCode) {
+			continue
+		}
+
+		return dto.EarlyWarningRiskEnum(*component.ValueString)
+	}
+
+	return ""
+}
+
+func earlyWarningConcept(code string, display string) *domain.FHIRCodeableConceptInput {
+	system := scalarutils.URI(common.EarlyWarningScoreSystem)
+
+	return &domain.FHIRCodeableConceptInput{
+		Coding: []*domain.FHIRCodingInput{
+			{
+				System:  &system,
+				Code:    scalarutils.Code(code),
+				Display: display,
+			},
+		},
+		Text: display,
+	}
+}
+
+func earlyWarningPoints(points int) *domain.FHIRQuantityInput {
+	return &domain.FHIRQuantityInput{
+		Value:  float64(points),
+		Unit:   "score",
+		System: scalarutils.URI(ucumSystem),
+		Code:   scalarutils.Code("{score}"),
+	}
+}
@@ -0,0 +1,383 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+// fakeEarlyWarningScore is a NEWS2 recorded in an encounter with a risk
+func fakeEarlyWarningScore(risk dto.EarlyWarningRiskEnum) domain.FHIRObservation {
+	score := fakeMeasurement("news2", 5, time.Now())
+	system := scalarutils.URI(common.EarlyWarningScoreSystem)
+	score.Code.Coding[0].System = &system
+
+	riskCode := scalarutils.Code("risk")
+	value := risk.String()
+
+	score.Component = []*domain.FHIRObservationComponent{
+		{
+			Code: domain.FHIRCodeableConcept{
+				Coding: []*domain.FHIRCoding{{System: &system, Code: &riskCode}},
+			},
+			ValueString: &value,
+		},
+	}
+
+	return score
+}
+
+func isEarlyWarningScore(input domain.FHIRObservationInput) bool {
+	return input.Code != nil && len(input.Code.Coding) > 0 && input.Code.Coding[0].System != nil &&
+		string(*input.Code.Coding[0].System) == common.EarlyWarningScoreSystem
+}
+
+func TestUseCasesClinicalImpl_RecordPulseRate_DeriveEarlyWarningScores(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.ObservationInput
+	}
+	tests := []struct {
+		name            string
+		args            args
+		wantScore       *float64
+		wantUpdated     bool
+		wantDerivedFrom int
+		wantAlert       dto.EarlyWarningRiskEnum
+		wantErr         bool
+	}{
+		{
+			name: "Happy case: score NEWS2 from the vital signs of the encounter",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "72",
+				},
+			},
+			wantScore:       func(v float64) *float64 { return &v }(0),
+			wantDerivedFrom: 5,
+			wantErr:         false,
+		},
+		{
+			name: "Happy case: alert when the score rises to medium risk",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "95",
+				},
+			},
+			wantScore:       func(v float64) *float64 { return &v }(5),
+			wantDerivedFrom: 5,
+			wantAlert:       dto.EarlyWarningRiskMedium,
+			wantErr:         false,
+		},
+		{
+			name: "Happy case: update the score derived in the encounter without alerting again",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "95",
+				},
+			},
+			wantScore:       func(v float64) *float64 { return &v }(5),
+			wantUpdated:     true,
+			wantDerivedFrom: 5,
+			wantErr:         false,
+		},
+		{
+			name: "Happy case: alert when the risk escalates",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "135",
+				},
+			},
+			wantScore:       func(v float64) *float64 { return &v }(7),
+			wantUpdated:     true,
+			wantDerivedFrom: 5,
+			wantAlert:       dto.EarlyWarningRiskHigh,
+			wantErr:         false,
+		},
+		{
+			name: "Happy case: no alert below the configured alert risk",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "95",
+				},
+			},
+			wantScore:       func(v float64) *float64 { return &v }(5),
+			wantDerivedFrom: 5,
+			wantErr:         false,
+		},
+		{
+			name: "Happy case: systolic pressure from a blood pressure panel",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "72",
+				},
+			},
+			wantScore:       func(v float64) *float64 { return &v }(3),
+			wantDerivedFrom: 5,
+			wantErr:         false,
+		},
+		{
+			name: "Happy case: no score without all the vital signs",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "72",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: no score in an encounter that is not in progress",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "72",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: record the pulse when the alert can not be published",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "95",
+				},
+			},
+			wantScore:       func(v float64) *float64 { return &v }(5),
+			wantDerivedFrom: 5,
+			wantAlert:       dto.EarlyWarningRiskMedium,
+			wantErr:         false,
+		},
+		{
+			name: "Happy case: record the pulse when the scores can not be computed",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "72",
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			encounterStatus := domain.EncounterStatusEnumInProgress
+			if tt.name == "Happy case: no score in an encounter that is not in progress" {
+				encounterStatus = domain.EncounterStatusEnumArrived
+			}
+
+			fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+				patientID := uuid.New().String()
+
+				return &domain.FHIREncounterRelayPayload{
+					Resource: &domain.FHIREncounter{
+						ID:     &id,
+						Status: encounterStatus,
+						Subject: &domain.FHIRReference{
+							ID: &patientID,
+						},
+					},
+				}, nil
+			}
+
+			if tt.name == "Happy case: no alert below the configured alert risk" {
+				fakeExt.GetEnvVarFn = func(envName string) (string, error) {
+					if envName == clinicalUsecase.EarlyWarningAlertRiskEnvVarName {
+						return "high", nil
+					}
+
+					return "", nil
+				}
+			}
+
+			var pulse float64
+			fmt.Sscanf(tt.args.input.Value, "%g", &pulse)
+
+			recorded := time.Now().Add(-time.Minute)
+
+			vitals := []domain.FHIRObservation{
+				fakeMeasurement(common.RespiratoryRateCIELTerminologyCode, 16, recorded),
+				fakeMeasurement(common.OxygenSaturationCIELTerminologyCode, 98, recorded),
+				fakeMeasurement(common.BloodPressureCIELTerminologyCode, 120, recorded),
+				fakeMeasurement(common.PulseCIELTerminologyCode, pulse, time.Now()),
+				fakeMeasurement(common.TemperatureCIELTerminologyCode, 37, recorded),
+			}
+
+			switch tt.name {
+			case "Happy case: alert when the score rises to medium risk",
+				"Happy case: update the score derived in the encounter without alerting again",
+				"Happy case: no alert below the configured alert risk",
+				"Happy case: record the pulse when the alert can not be published":
+				// a respiration rate of 22, SpO2 of 94, systolic pressure of 105 and pulse of 95 score 5
+				vitals[0] = fakeMeasurement(common.RespiratoryRateCIELTerminologyCode, 22, recorded)
+				vitals[1] = fakeMeasurement(common.OxygenSaturationCIELTerminologyCode, 94, recorded)
+				vitals[2] = fakeMeasurement(common.BloodPressureCIELTerminologyCode, 105, recorded)
+			case "Happy case: alert when the risk escalates":
+				// with a pulse of 135 the score is 7
+				vitals[0] = fakeMeasurement(common.RespiratoryRateCIELTerminologyCode, 22, recorded)
+				vitals[1] = fakeMeasurement(common.OxygenSaturationCIELTerminologyCode, 94, recorded)
+				vitals[2] = fakeMeasurement(common.BloodPressureCIELTerminologyCode, 105, recorded)
+			case "Happy case: systolic pressure from a blood pressure panel":
+				// the panel read after the systolic pressure scores 3 for a pressure of 90
+				vitals = append(vitals, fakeBloodPressurePanel(tt.args.input.EncounterID, 90, 60, time.Now()))
+			case "Happy case: no score without all the vital signs":
+				vitals = vitals[:4]
+			}
+
+			scores := []domain.FHIRObservation{}
+			switch tt.name {
+			case "Happy case: update the score derived in the encounter without alerting again":
+				scores = append(scores, fakeEarlyWarningScore(dto.EarlyWarningRiskMedium))
+			case "Happy case: alert when the risk escalates":
+				scores = append(scores, fakeEarlyWarningScore(dto.EarlyWarningRiskMedium))
+			}
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				if tt.name == "Happy case: record the pulse when the scores can not be computed" {
+					return nil, fmt.Errorf("an error occurred")
+				}
+
+				code, _ := searchParameters["code"].(string)
+				if strings.HasPrefix(code, common.EarlyWarningScoreSystem) {
+					return &domain.PagedFHIRObservations{Observations: scores}, nil
+				}
+
+				return &domain.PagedFHIRObservations{Observations: vitals}, nil
+			}
+
+			var score *domain.FHIRObservationInput
+			updated := false
+
+			createObservation := fakeFHIR.MockCreateFHIRObservationFn
+			fakeFHIR.MockCreateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				if isEarlyWarningScore(input) {
+					score = &input
+				}
+
+				return createObservation(ctx, input)
+			}
+
+			updateObservation := fakeFHIR.MockUpdateFHIRObservationFn
+			fakeFHIR.MockUpdateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				if isEarlyWarningScore(input) {
+					score = &input
+					updated = true
+				}
+
+				return updateObservation(ctx, input)
+			}
+
+			var alert *dto.EarlyWarningAlertPubSubMessage
+
+			fakePubSub.MockNotifyEarlyWarningAlertFn = func(ctx context.Context, data dto.EarlyWarningAlertPubSubMessage) error {
+				alert = &data
+
+				if tt.name == "Happy case: record the pulse when the alert can not be published" {
+					return fmt.Errorf("an error occurred")
+				}
+
+				return nil
+			}
+
+			got, err := u.RecordPulseRate(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RecordPulseRate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected the pulse to be recorded")
+			}
+
+			if (score != nil) != (tt.wantScore != nil) {
+				t.Errorf("expected a score %v but got %v", tt.wantScore, score)
+				return
+			}
+
+			if score != nil {
+				if score.ValueQuantity.Value != *tt.wantScore {
+					t.Errorf("expected a score of %v but got %v", *tt.wantScore, score.ValueQuantity.Value)
+				}
+
+				if len(score.DerivedFrom) != tt.wantDerivedFrom {
+					t.Errorf("expected the score to be derived from %d vital signs but got %d", tt.wantDerivedFrom, len(score.DerivedFrom))
+				}
+
+				if updated != tt.wantUpdated {
+					t.Errorf("expected the score to be updated %v but got %v", tt.wantUpdated, updated)
+				}
+			}
+
+			if tt.name == "Happy case: systolic pressure from a blood pressure panel" && *score.DerivedFrom[2].ID != *vitals[5].ID {
+				t.Errorf("expected the systolic pressure to be read from the panel")
+			}
+
+			if (alert != nil) != (tt.wantAlert != "") {
+				t.Errorf("expected an alert %v but got %v", tt.wantAlert, alert)
+				return
+			}
+
+			if alert != nil && alert.Risk != tt.wantAlert {
+				t.Errorf("expected a %s alert but got %s", tt.wantAlert, alert.Risk)
+			}
+
+			if tt.name == "Happy case: alert when the risk escalates" && alert.PreviousRisk != dto.EarlyWarningRiskMedium {
+				t.Errorf("expected the alert to escalate from %s but got %s", dto.EarlyWarningRiskMedium, alert.PreviousRisk)
+			}
+		})
+	}
+}
@@ -14,8 +14,8 @@ import (
 	"github.com/savannahghi/scalarutils"
 )
 
-// StartEncounter starts an encounter within an episode of care
-func (c *UseCasesClinicalImpl) StartEncounter(ctx context.Context, episodeID string) (string, error) {
+// StartEncounter starts an encounter within an episode of care. The encounter may take place in a location of the facility e.g a ward
+func (c *UseCasesClinicalImpl) StartEncounter(ctx context.Context, episodeID string, locationID *string) (string, error) {
 	if episodeID == "" {
 		return "", fmt.Errorf("an episode of care ID is required")
 	}
@@ -25,6 +25,21 @@ func (c *UseCasesClinicalImpl) StartEncounter(ctx context.Context, episodeID str
 		return "", err
 	}
 
+	var location *domain.FHIREncounterLocationInput
+
+	if locationID != nil {
+		location, err = c.encounterLocation(ctx, *locationID, episodeOfCare.Resource.ManagingOrganization)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return c.startEncounter(ctx, episodeOfCare, nil, location)
+}
+
+// startEncounter starts an encounter within an episode of care. An encounter started from an appointment
+// references the appointment and the service request the appointment was booked for
+func (c *UseCasesClinicalImpl) startEncounter(ctx context.Context, episodeOfCare *domain.FHIREpisodeOfCareRelayPayload, appointment *domain.FHIRAppointment, location *domain.FHIREncounterLocationInput) (string, error) {
 	encounterClassCode := scalarutils.Code("AMB")
 	encounterClassSystem := scalarutils.URI("http://terminology.hl7.org/CodeSystem/v3-ActCode")
 	encounterClassVersion := "2018-08-12"
@@ -65,6 +80,50 @@ func (c *UseCasesClinicalImpl) StartEncounter(ctx context.Context, episodeID str
 		},
 	}
 
+	if appointment != nil && appointment.ID != nil {
+		appointmentReference := fmt.Sprintf("Appointment/%s", *appointment.ID)
+		encounterPayload.Appointment = []*domain.FHIRReferenceInput{
+			{
+				ID:        appointment.ID,
+				Reference: &appointmentReference,
+			},
+		}
+
+		for _, basedOn := range appointment.BasedOn {
+			if basedOn == nil {
+				continue
+			}
+
+			encounterPayload.BasedOn = append(encounterPayload.BasedOn, &domain.FHIRReferenceInput{
+				ID:        basedOn.ID,
+				Reference: basedOn.Reference,
+			})
+		}
+	}
+
+	if location != nil {
+		location.Period = &domain.FHIRPeriodInput{
+			Start: startTime,
+		}
+		encounterPayload.Location = []*domain.FHIREncounterLocationInput{location}
+	}
+
+	participant, err := c.currentPractitionerReference(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if participant != nil {
+		encounterPayload.Participant = []*domain.FHIREncounterParticipantInput{
+			{
+				Period: &domain.FHIRPeriodInput{
+					Start: startTime,
+				},
+				Individual: participant,
+			},
+		}
+	}
+
 	tags, err := c.GetTenantMetaTags(ctx)
 	if err != nil {
 		return "", err
@@ -177,24 +236,47 @@ func (c *UseCasesClinicalImpl) PatchEncounter(ctx context.Context, encounterID s
 		Status: status,
 	}
 
-	if status.IsFinal() {
+	if status.IsFinal() || input.LocationID != nil {
 		encounter, err := c.infrastructure.FHIR.GetFHIREncounter(ctx, encounterID)
 		if err != nil {
 			return nil, fmt.Errorf("unable to get encounter with ID %s: %w", encounterID, err)
 		}
 
-		var startTime scalarutils.DateTime
-		if encounter.Resource.Period == nil {
-			startTime = scalarutils.DateTime(time.Now().Format(timeFormatStr))
-		} else {
-			startTime = encounter.Resource.Period.Start
+		// moving the patient to a location e.g admitting them to a ward records when they arrived there
+		// and when they left the location they were moved from
+		if input.LocationID != nil {
+			location, err := c.encounterLocation(ctx, *input.LocationID, encounter.Resource.ServiceProvider)
+			if err != nil {
+				return nil, err
+			}
+
+			movedAt := scalarutils.DateTime(time.Now().Format(timeFormatStr))
+
+			locations, err := encounterLocationHistory(encounter.Resource.Location, movedAt)
+			if err != nil {
+				return nil, err
+			}
+
+			location.Period = &domain.FHIRPeriodInput{
+				Start: movedAt,
+			}
+			encounterInput.Location = append(locations, location)
 		}
 
-		// workaround for odd date comparison behavior on the Google Cloud Healthcare API
-		end := startTime.Time().Add(time.Hour * 24)
-		endTime := scalarutils.DateTime(end.Format(timeFormatStr))
+		if status.IsFinal() {
+			var startTime scalarutils.DateTime
+			if encounter.Resource.Period == nil {
+				startTime = scalarutils.DateTime(time.Now().Format(timeFormatStr))
+			} else {
+				startTime = encounter.Resource.Period.Start
+			}
 
-		encounterInput.Period = &domain.FHIRPeriodInput{Start: startTime, End: endTime}
+			// workaround for odd date comparison behavior on the Google Cloud Healthcare API
+			end := startTime.Time().Add(time.Hour * 24)
+			endTime := scalarutils.DateTime(end.Format(timeFormatStr))
+
+			encounterInput.Period = &domain.FHIRPeriodInput{Start: startTime, End: endTime}
+		}
 	}
 
 	fhirEncounter, err := c.infrastructure.FHIR.PatchFHIREncounter(ctx, encounterID, encounterInput)
@@ -214,6 +296,46 @@ func (c *UseCasesClinicalImpl) PatchEncounter(ctx context.Context, encounterID s
 	return encounters[0], nil
 }
 
+// encounterLocationHistory carries over the locations an encounter has taken place in when the patient is moved.
+// The location the patient is moved from is completed at the time they are moved
+func encounterLocationHistory(locations []*domain.FHIREncounterLocation, movedAt scalarutils.DateTime) ([]*domain.FHIREncounterLocationInput, error) {
+	history := []*domain.FHIREncounterLocationInput{}
+
+	if len(locations) == 0 {
+		return history, nil
+	}
+
+	bs, err := json.Marshal(locations)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal encounter locations: %w", err)
+	}
+
+	err = json.Unmarshal(bs, &history)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal encounter location inputs: %w", err)
+	}
+
+	completed := domain.EncounterLocationStatusEnumCompleted
+
+	for _, location := range history {
+		if location == nil || (location.Status != nil && *location.Status != domain.EncounterLocationStatusEnumActive) {
+			continue
+		}
+
+		if location.Period == nil {
+			location.Period = &domain.FHIRPeriodInput{}
+		}
+
+		if location.Period.End == "" {
+			location.Period.End = movedAt
+		}
+
+		location.Status = &completed
+	}
+
+	return history, nil
+}
+
 // EndEncounter marks an encounter as finished and updates the endtime field
 func (c *UseCasesClinicalImpl) EndEncounter(ctx context.Context, encounterID string) (bool, error) {
 	if encounterID == "" {
@@ -322,7 +444,7 @@ func (c *UseCasesClinicalImpl) GetEncounterAssociatedResources(ctx context.Conte
 
 			result.RiskAssessment = append(result.RiskAssessment, &riskAssessment)
 		case "Consent":
-			var consent dto.Consent
+			var consent domain.FHIRConsent
 
 			consentBytes, err := json.Marshal(encounterData)
 
@@ -334,12 +456,12 @@ func (c *UseCasesClinicalImpl) GetEncounterAssociatedResources(ctx context.Conte
 				return nil, err
 			}
 
-			result.Consent = append(result.Consent, &consent)
+			result.Consent = append(result.Consent, mapFHIRConsentToConsentDTO(consent))
 
 		case "Observation":
 			var observation domain.FHIRObservation
 
-			var observationValue, observationNote string
+			var observationNote string
 
 			observationBytes, err := json.Marshal(encounterData)
 			if err != nil {
@@ -350,10 +472,6 @@ func (c *UseCasesClinicalImpl) GetEncounterAssociatedResources(ctx context.Conte
 				return nil, err
 			}
 
-			if observation.ValueString != nil {
-				observationValue = *observation.ValueString
-			}
-
 			if observation.Note != nil {
 				observationNote = string(*observation.Note[0].Text)
 			}
@@ -361,7 +479,7 @@ func (c *UseCasesClinicalImpl) GetEncounterAssociatedResources(ctx context.Conte
 			result.Observation = append(result.Observation, &dto.Observation{
 				ID:           *observation.ID,
 				Name:         observation.Code.Text,
-				Value:        observationValue,
+				Value:        observationValueText(observation),
 				Status:       dto.ObservationStatus(*observation.Status),
 				TimeRecorded: string(*observation.EffectiveInstant),
 				Note:         observationNote,
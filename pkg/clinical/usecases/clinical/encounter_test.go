@@ -17,13 +17,17 @@ import (
 	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
 	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
 	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
 )
 
 func TestUseCasesClinicalImpl_StartEncounter(t *testing.T) {
 	ctx := context.Background()
+	locationID := gofakeit.UUID()
+
 	type args struct {
-		ctx       context.Context
-		episodeID string
+		ctx        context.Context
+		episodeID  string
+		locationID *string
 	}
 	tests := []struct {
 		name    string
@@ -38,6 +42,50 @@ func TestUseCasesClinicalImpl_StartEncounter(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Happy Case - Start an encounter by a registered practitioner",
+			args: args{
+				ctx:       ctx,
+				episodeID: uuid.New().String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - Start an encounter in a location",
+			args: args{
+				ctx:        ctx,
+				episodeID:  uuid.New().String(),
+				locationID: &locationID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case - Fail to get location",
+			args: args{
+				ctx:        ctx,
+				episodeID:  uuid.New().String(),
+				locationID: &locationID,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - Location is in another facility",
+			args: args{
+				ctx:        ctx,
+				episodeID:  uuid.New().String(),
+				locationID: &locationID,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - Location is suspended",
+			args: args{
+				ctx:        ctx,
+				episodeID:  uuid.New().String(),
+				locationID: &locationID,
+			},
+			wantErr: true,
+		},
 		{
 			name: "Sad Case - Empty episode of care ID",
 			args: args{
@@ -85,6 +133,14 @@ func TestUseCasesClinicalImpl_StartEncounter(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Sad Case - failed to find the practitioner starting the encounter",
+			args: args{
+				ctx:       ctx,
+				episodeID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -127,7 +183,80 @@ func TestUseCasesClinicalImpl_StartEncounter(t *testing.T) {
 				}
 			}
 
-			got, err := u.StartEncounter(tt.args.ctx, tt.args.episodeID)
+			if tt.name == "Happy Case - Start an encounter by a registered practitioner" {
+				userID := gofakeit.UUID()
+
+				fakeExt.GetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+					return userID, nil
+				}
+
+				fakeFHIR.MockSearchFHIROrganisationPractitionerFn = func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+					return &domain.PagedFHIRPractitioner{
+						Practitioners: []domain.FHIRPractitioner{registeredPractitioner(userID)},
+						TotalCount:    1,
+					}, nil
+				}
+
+				createEncounter := fakeFHIR.MockCreateFHIREncounterFn
+				fakeFHIR.MockCreateFHIREncounterFn = func(ctx context.Context, input domain.FHIREncounterInput) (*domain.FHIREncounterRelayPayload, error) {
+					if len(input.Participant) != 1 || input.Participant[0].Individual == nil || input.Participant[0].Individual.ID == nil {
+						return nil, fmt.Errorf("expected the practitioner to participate in the encounter")
+					}
+
+					return createEncounter(ctx, input)
+				}
+			}
+			if tt.name == "Sad Case - failed to find the practitioner starting the encounter" {
+				fakeFHIR.MockSearchFHIROrganisationPractitionerFn = func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+					return nil, fmt.Errorf("failed to search practitioners")
+				}
+			}
+
+			facilityReference := "Organization/1"
+
+			if tt.name == "Happy Case - Start an encounter in a location" {
+				fakeFHIR.MockGetFHIRLocationFn = func(ctx context.Context, id string) (*domain.FHIRLocationRelayPayload, error) {
+					return &domain.FHIRLocationRelayPayload{
+						Resource: &domain.FHIRLocation{
+							ID: &id,
+							ManagingOrganization: &domain.FHIRReference{
+								Reference: &facilityReference,
+							},
+						},
+					}, nil
+				}
+
+				createEncounter := fakeFHIR.MockCreateFHIREncounterFn
+				fakeFHIR.MockCreateFHIREncounterFn = func(ctx context.Context, input domain.FHIREncounterInput) (*domain.FHIREncounterRelayPayload, error) {
+					if len(input.Location) != 1 || input.Location[0].Location == nil || *input.Location[0].Location.ID != locationID {
+						return nil, fmt.Errorf("expected the encounter to take place in location %s", locationID)
+					}
+
+					return createEncounter(ctx, input)
+				}
+			}
+			if tt.name == "Sad Case - Fail to get location" {
+				fakeFHIR.MockGetFHIRLocationFn = func(ctx context.Context, id string) (*domain.FHIRLocationRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get location")
+				}
+			}
+			if tt.name == "Sad Case - Location is suspended" {
+				fakeFHIR.MockGetFHIRLocationFn = func(ctx context.Context, id string) (*domain.FHIRLocationRelayPayload, error) {
+					status := scalarutils.Code(dto.LocationStatusSuspended.Code())
+
+					return &domain.FHIRLocationRelayPayload{
+						Resource: &domain.FHIRLocation{
+							ID:     &id,
+							Status: &status,
+							ManagingOrganization: &domain.FHIRReference{
+								Reference: &facilityReference,
+							},
+						},
+					}, nil
+				}
+			}
+
+			got, err := u.StartEncounter(tt.args.ctx, tt.args.episodeID, tt.args.locationID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesClinicalImpl.StartEncounter() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -144,6 +273,9 @@ func TestUseCasesClinicalImpl_StartEncounter(t *testing.T) {
 
 func TestUseCasesClinicalImpl_PatchEncounter(t *testing.T) {
 	ctx := context.Background()
+	locationID := gofakeit.UUID()
+	invalidLocationID := "ward"
+
 	type args struct {
 		ctx         context.Context
 		encounterID string
@@ -165,6 +297,42 @@ func TestUseCasesClinicalImpl_PatchEncounter(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Happy Case - Move the patient to a location",
+			args: args{
+				ctx:         ctx,
+				encounterID: gofakeit.UUID(),
+				input: dto.EncounterInput{
+					Status:     dto.EncounterStatusEnumInProgress,
+					LocationID: &locationID,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - Move the patient from a previous location",
+			args: args{
+				ctx:         ctx,
+				encounterID: gofakeit.UUID(),
+				input: dto.EncounterInput{
+					Status:     dto.EncounterStatusEnumInProgress,
+					LocationID: &locationID,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case - Invalid location id",
+			args: args{
+				ctx:         ctx,
+				encounterID: gofakeit.UUID(),
+				input: dto.EncounterInput{
+					Status:     dto.EncounterStatusEnumInProgress,
+					LocationID: &invalidLocationID,
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Sad Case - Invalid encounterID",
 			args: args{
@@ -222,6 +390,60 @@ func TestUseCasesClinicalImpl_PatchEncounter(t *testing.T) {
 				}
 			}
 
+			if tt.name == "Happy Case - Move the patient to a location" {
+				patchEncounter := fakeFHIR.MockPatchFHIREncounterFn
+				fakeFHIR.MockPatchFHIREncounterFn = func(ctx context.Context, encounterID string, input domain.FHIREncounterInput) (*domain.FHIREncounter, error) {
+					if len(input.Location) != 1 || input.Location[0].Location == nil || *input.Location[0].Location.ID != locationID {
+						return nil, fmt.Errorf("expected the encounter to move to location %s", locationID)
+					}
+
+					return patchEncounter(ctx, encounterID, input)
+				}
+			}
+
+			if tt.name == "Happy Case - Move the patient from a previous location" {
+				previousLocationID := uuid.New().String()
+				active := domain.EncounterLocationStatusEnumActive
+
+				getEncounter := fakeFHIR.MockGetFHIREncounterFn
+				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+					encounter, err := getEncounter(ctx, id)
+					if err != nil {
+						return nil, err
+					}
+
+					encounter.Resource.Location = []*domain.FHIREncounterLocation{
+						{
+							Location: &domain.FHIRReference{ID: &previousLocationID},
+							Status:   &active,
+							Period:   &domain.FHIRPeriod{Start: "2026-10-18T08:00:00+03:00"},
+						},
+					}
+
+					return encounter, nil
+				}
+
+				patchEncounter := fakeFHIR.MockPatchFHIREncounterFn
+				fakeFHIR.MockPatchFHIREncounterFn = func(ctx context.Context, encounterID string, input domain.FHIREncounterInput) (*domain.FHIREncounter, error) {
+					if len(input.Location) != 2 {
+						return nil, fmt.Errorf("expected the previous and new locations, got %d", len(input.Location))
+					}
+
+					previous, current := input.Location[0], input.Location[1]
+
+					if *previous.Location.ID != previousLocationID || previous.Status == nil || *previous.Status != domain.EncounterLocationStatusEnumCompleted ||
+						previous.Period == nil || previous.Period.End == "" {
+						return nil, fmt.Errorf("expected the previous location to be completed, got %+v", previous)
+					}
+
+					if *current.Location.ID != locationID || current.Period == nil || current.Period.Start != previous.Period.End {
+						return nil, fmt.Errorf("expected the patient to arrive at location %s when they left the previous location", locationID)
+					}
+
+					return patchEncounter(ctx, encounterID, input)
+				}
+			}
+
 			got, err := c.PatchEncounter(ctx, tt.args.encounterID, tt.args.input)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("PatchEncounter() error = %v, wantErr %v", err, tt.wantErr)
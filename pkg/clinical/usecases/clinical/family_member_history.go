@@ -0,0 +1,342 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// RecordFamilyMemberHistory records the conditions a relative of the patient had e.g a mother who had breast cancer at 45
+func (c *UseCasesClinicalImpl) RecordFamilyMemberHistory(ctx context.Context, input dto.FamilyMemberHistoryInput) (*dto.FamilyMemberHistory, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	patient, err := c.infrastructure.FHIR.GetFHIRPatient(ctx, input.PatientID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	patientReference := fmt.Sprintf("Patient/%s", input.PatientID)
+	recordedAt := time.Now().Format(time.RFC3339)
+
+	history := domain.FHIRFamilyMemberHistory{
+		Patient: &domain.FHIRReference{
+			ID:        &input.PatientID,
+			Reference: &patientReference,
+			Display:   patient.Resource.Names(),
+		},
+		Date: &recordedAt,
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+	}
+
+	err = c.setFamilyMemberHistory(ctx, &history, input)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := c.infrastructure.FHIR.CreateFHIRFamilyMemberHistory(ctx, history)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRFamilyMemberHistoryToDTO(*resource), nil
+}
+
+// GetFamilyMemberHistory retrieves the history of a relative of a patient
+func (c *UseCasesClinicalImpl) GetFamilyMemberHistory(ctx context.Context, id string) (*dto.FamilyMemberHistory, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid family member history id: %s", id)
+	}
+
+	resource, err := c.infrastructure.FHIR.GetFHIRFamilyMemberHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRFamilyMemberHistoryToDTO(*resource.Resource), nil
+}
+
+// UpdateFamilyMemberHistory replaces the relationship, conditions and note of the history of a relative e.g when the patient
+// corrects the age their mother was diagnosed at. The history remains linked to the questionnaire response it was extracted from
+func (c *UseCasesClinicalImpl) UpdateFamilyMemberHistory(ctx context.Context, id string, input dto.FamilyMemberHistoryInput) (*dto.FamilyMemberHistory, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid family member history id: %s", id)
+	}
+
+	err = input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := c.infrastructure.FHIR.GetFHIRFamilyMemberHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	history := *resource.Resource
+
+	if history.Patient == nil || history.Patient.ID == nil || *history.Patient.ID != input.PatientID {
+		return nil, fmt.Errorf("family member history %s is not for patient %s", id, input.PatientID)
+	}
+
+	if history.Status != nil && string(*history.Status) == dto.FamilyMemberHistoryStatusEnteredInError.Code() {
+		return nil, fmt.Errorf("cannot update a family member history that was entered in error")
+	}
+
+	history.Name = nil
+	history.Condition = nil
+	history.Note = nil
+
+	err = c.setFamilyMemberHistory(ctx, &history, input)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := c.infrastructure.FHIR.UpdateFHIRFamilyMemberHistory(ctx, history)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRFamilyMemberHistoryToDTO(*updated), nil
+}
+
+// DeleteFamilyMemberHistory removes the history of a relative that was recorded in error.
+// The history is kept with the status `entered-in-error` so that it no longer appears in the patient's records
+func (c *UseCasesClinicalImpl) DeleteFamilyMemberHistory(ctx context.Context, id string) (bool, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("invalid family member history id: %s", id)
+	}
+
+	resource, err := c.infrastructure.FHIR.GetFHIRFamilyMemberHistory(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	history := *resource.Resource
+
+	status := scalarutils.Code(dto.FamilyMemberHistoryStatusEnteredInError.Code())
+	history.Status = &status
+
+	_, err = c.infrastructure.FHIR.UpdateFHIRFamilyMemberHistory(ctx, history)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ListPatientFamilyMemberHistories lists the histories of the relatives of a patient, most recent first
+func (c *UseCasesClinicalImpl) ListPatientFamilyMemberHistories(ctx context.Context, patientID string, pagination dto.Pagination) (*dto.FamilyMemberHistoryConnection, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"patient":    fmt.Sprintf("Patient/%s", patientID),
+		"status:not": dto.FamilyMemberHistoryStatusEnteredInError.Code(),
+		"_sort":      "-date",
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRFamilyMemberHistory(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	histories := []*dto.FamilyMemberHistory{}
+
+	for _, resource := range resources.FamilyMemberHistories {
+		histories = append(histories, mapFHIRFamilyMemberHistoryToDTO(resource))
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreateFamilyMemberHistoryConnection(histories, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
+
+// setFamilyMemberHistory sets the relationship, status, conditions and note of the history of a relative from its input
+func (c *UseCasesClinicalImpl) setFamilyMemberHistory(ctx context.Context, history *domain.FHIRFamilyMemberHistory, input dto.FamilyMemberHistoryInput) error {
+	recordedStatus := dto.FamilyMemberHistoryStatusCompleted
+	if input.Status != nil {
+		recordedStatus = *input.Status
+	}
+
+	status := scalarutils.Code(recordedStatus.Code())
+	history.Status = &status
+	history.Relationship = familyRelationshipCodeableConcept(input.Relationship)
+
+	if input.Name != "" {
+		history.Name = &input.Name
+	}
+
+	for _, conditionInput := range input.Conditions {
+		if conditionInput == nil {
+			continue
+		}
+
+		concept, err := c.GetConcept(ctx, dto.TerminologySourceCIEL, conditionInput.ConditionCode)
+		if err != nil {
+			return err
+		}
+
+		condition := &domain.FHIRFamilyMemberHistoryCondition{
+			Code:               conceptCodeableConcept(concept),
+			ContributedToDeath: conditionInput.ContributedToDeath,
+		}
+
+		if conditionInput.OnsetAge != nil {
+			condition.OnsetAge = familyMemberOnsetAge(*conditionInput.OnsetAge)
+		}
+
+		if conditionInput.Note != "" {
+			condition.Note = []*domain.FHIRAnnotation{
+				{
+					Text: (*scalarutils.Markdown)(&conditionInput.Note),
+				},
+			}
+		}
+
+		history.Condition = append(history.Condition, condition)
+	}
+
+	if input.Note != "" {
+		history.Note = []*domain.FHIRAnnotation{
+			{
+				Text: (*scalarutils.Markdown)(&input.Note),
+			},
+		}
+	}
+
+	return nil
+}
+
+// extractFamilyMemberHistories records the relatives and conditions answered in a questionnaire response as family member histories of the patient.
+// The questionnaire items declare the family member history element they capture in their definition. Histories are recorded as partial since
+// a screening questionnaire only asks about the conditions it screens for, and are updated rather than duplicated if the response is extracted again
+func (c *UseCasesClinicalImpl) extractFamilyMemberHistories(
+	ctx context.Context,
+	questionnaire *domain.FHIRQuestionnaire,
+	encounter *domain.FHIREncounterRelayPayload,
+	questionnaireResponseID string,
+	questionnaireResponse *dto.QuestionnaireResponse,
+) error {
+	elements := questionnaireFamilyHistoryElements(questionnaire.Item)
+	if len(elements) == 0 {
+		return nil
+	}
+
+	members := questionnaireFamilyMembers(questionnaireResponse.Item, elements)
+	if len(members) == 0 {
+		return nil
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return err
+	}
+
+	patientReference := fmt.Sprintf("Patient/%s", *encounter.Resource.Subject.ID)
+	questionnaireResponseReference := fmt.Sprintf("QuestionnaireResponse/%s", questionnaireResponseID)
+	status := scalarutils.Code(dto.FamilyMemberHistoryStatusPartial.Code())
+	recordedAt := time.Now().Format(time.RFC3339)
+	occurrences := map[string]int{}
+
+	for _, member := range members {
+		occurrences[member.LinkID]++
+
+		relationship, conditions := questionnaireFamilyMember(member, elements)
+		if relationship == nil || len(conditions) == 0 {
+			continue
+		}
+
+		identifier := questionnaireResponseItemIdentifier(questionnaireResponseID, member.LinkID, occurrences[member.LinkID])
+
+		history := domain.FHIRFamilyMemberHistory{
+			Identifier: []*domain.FHIRIdentifier{identifier},
+			Status:     &status,
+			Patient: &domain.FHIRReference{
+				ID:        encounter.Resource.Subject.ID,
+				Reference: &patientReference,
+				Display:   encounter.Resource.Subject.Display,
+			},
+			Date:         &recordedAt,
+			Relationship: relationship,
+			ReasonReference: []*domain.FHIRReference{
+				{
+					ID:        &questionnaireResponseID,
+					Reference: &questionnaireResponseReference,
+				},
+			},
+			Condition: conditions,
+			Meta: &domain.FHIRMetaInput{
+				Tag: tags,
+			},
+		}
+
+		params := map[string]interface{}{
+			"identifier": fmt.Sprintf("%s|%s", *identifier.System, identifier.Value),
+		}
+
+		existing, err := c.infrastructure.FHIR.SearchFHIRFamilyMemberHistory(ctx, params, *identifiers, dto.Pagination{Skip: true})
+		if err != nil {
+			return err
+		}
+
+		if len(existing.FamilyMemberHistories) > 0 {
+			history.ID = existing.FamilyMemberHistories[0].ID
+
+			_, err = c.infrastructure.FHIR.UpdateFHIRFamilyMemberHistory(ctx, history)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		_, err = c.infrastructure.FHIR.CreateFHIRFamilyMemberHistory(ctx, history)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,332 @@
+package clinical
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// familyRelationshipSystem is the HL7 v3 RoleCode system that family relationships are coded in
+const familyRelationshipSystem = "http://terminology.hl7.org/CodeSystem/v3-RoleCode"
+
+// familyMemberHistoryDefinition is the base of the element definitions that questionnaire items use to declare which family member history
+// element they capture, as in definition based extraction of structured data capture e.g
+// `http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory#FamilyMemberHistory.relationship`
+const familyMemberHistoryDefinition = "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory#"
+
+// The family member history elements that are extracted from questionnaire responses. Each relative is a group with a relationship item,
+// the conditions they had and optionally the age each condition started at
+const (
+	familyMemberRelationshipElement = "FamilyMemberHistory.relationship"
+	familyMemberConditionElement    = "FamilyMemberHistory.condition.code"
+	familyMemberOnsetAgeElement     = "FamilyMemberHistory.condition.onsetAge"
+	familyMemberOnsetElement        = "FamilyMemberHistory.condition.onset[x]"
+)
+
+// familyRelationshipDisplay is the display name of a relationship e.g `Mother`
+func familyRelationshipDisplay(relationship dto.FamilyRelationshipEnum) string {
+	name := strings.ToLower(relationship.String())
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func familyRelationshipCodeableConcept(relationship dto.FamilyRelationshipEnum) *domain.FHIRCodeableConcept {
+	system := scalarutils.URI(familyRelationshipSystem)
+	code := scalarutils.Code(relationship.RoleCode())
+	display := familyRelationshipDisplay(relationship)
+
+	return &domain.FHIRCodeableConcept{
+		Coding: []*domain.FHIRCoding{
+			{
+				System:  &system,
+				Code:    &code,
+				Display: display,
+			},
+		},
+		Text: display,
+	}
+}
+
+// familyRelationship reads the relationship of a relative from its HL7 v3 RoleCode
+func familyRelationship(concept *domain.FHIRCodeableConcept) dto.FamilyRelationshipEnum {
+	if concept == nil {
+		return ""
+	}
+
+	for _, coding := range concept.Coding {
+		if coding == nil || coding.Code == nil || coding.System == nil || *coding.System != familyRelationshipSystem {
+			continue
+		}
+
+		if relationship, ok := dto.FamilyRelationshipFromRoleCode(string(*coding.Code)); ok {
+			return relationship
+		}
+	}
+
+	return ""
+}
+
+// familyMemberOnsetAge records the age in years a relative was when a condition started
+func familyMemberOnsetAge(age int) *domain.FHIRQuantity {
+	return &domain.FHIRQuantity{
+		Value:  float64(age),
+		Unit:   "years",
+		System: ucumSystem,
+		Code:   "a",
+	}
+}
+
+// questionnaireFamilyHistoryElements maps the linkIds of the questionnaire items that capture a family member history element to the element
+func questionnaireFamilyHistoryElements(items []*domain.FHIRQuestionnaireItem) map[string]string {
+	elements := map[string]string{}
+
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+
+		if item.LinkID != nil && item.Definition != nil && strings.HasPrefix(string(*item.Definition), familyMemberHistoryDefinition) {
+			elements[*item.LinkID] = strings.TrimPrefix(string(*item.Definition), familyMemberHistoryDefinition)
+		}
+
+		for linkID, element := range questionnaireFamilyHistoryElements(item.Item) {
+			elements[linkID] = element
+		}
+	}
+
+	return elements
+}
+
+// questionnaireFamilyMembers finds the groups anywhere in a questionnaire response, including the items nested in answers, that describe a relative.
+// A relative's group is one with an item that captures the relationship
+func questionnaireFamilyMembers(items []dto.QuestionnaireResponseItem, elements map[string]string) []dto.QuestionnaireResponseItem {
+	found := []dto.QuestionnaireResponseItem{}
+
+	for _, item := range items {
+		if isQuestionnaireFamilyMember(item, elements) {
+			found = append(found, item)
+
+			continue
+		}
+
+		found = append(found, questionnaireFamilyMembers(item.Item, elements)...)
+
+		for _, answer := range item.Answer {
+			found = append(found, questionnaireFamilyMembers(answer.Item, elements)...)
+		}
+	}
+
+	return found
+}
+
+func isQuestionnaireFamilyMember(item dto.QuestionnaireResponseItem, elements map[string]string) bool {
+	for _, child := range item.Item {
+		if elements[child.LinkID] == familyMemberRelationshipElement {
+			return true
+		}
+	}
+
+	return false
+}
+
+// questionnaireAnswerAge reads an age in years from the first answer of an item that captures the age a condition started at
+func questionnaireAnswerAge(items []dto.QuestionnaireResponseItem, elements map[string]string) *int {
+	for _, item := range items {
+		element := elements[item.LinkID]
+		if element != familyMemberOnsetAgeElement && element != familyMemberOnsetElement {
+			continue
+		}
+
+		for _, answer := range item.Answer {
+			switch {
+			case answer.ValueInteger != nil:
+				return answer.ValueInteger
+			case answer.ValueDecimal != nil:
+				age := int(*answer.ValueDecimal)
+
+				return &age
+			}
+		}
+	}
+
+	return nil
+}
+
+// questionnaireAnswerCodeableConcept converts a coded or free text answer to a codeable concept
+func questionnaireAnswerCodeableConcept(answer dto.QuestionnaireResponseItemAnswer) *domain.FHIRCodeableConcept {
+	switch {
+	case answer.ValueCoding != nil && answer.ValueCoding.Code != nil:
+		system := answer.ValueCoding.System
+
+		return &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &system,
+					Code:    answer.ValueCoding.Code,
+					Display: answer.ValueCoding.Display,
+				},
+			},
+			Text: answer.ValueCoding.Display,
+		}
+
+	case answer.ValueString != nil && *answer.ValueString != "":
+		return &domain.FHIRCodeableConcept{
+			Text: *answer.ValueString,
+		}
+	}
+
+	return nil
+}
+
+// questionnaireFamilyRelationship reads the relationship of a relative from a questionnaire answer.
+// Answers are coded as HL7 v3 RoleCodes e.g `MTH` or written out e.g `mother`. Relationships that can not be coded are kept as text
+func questionnaireFamilyRelationship(answer dto.QuestionnaireResponseItemAnswer) *domain.FHIRCodeableConcept {
+	if answer.ValueCoding != nil && answer.ValueCoding.Code != nil {
+		if relationship, ok := dto.FamilyRelationshipFromRoleCode(string(*answer.ValueCoding.Code)); ok {
+			return familyRelationshipCodeableConcept(relationship)
+		}
+	}
+
+	if answer.ValueString != nil {
+		relationship := dto.FamilyRelationshipEnum(strings.ToUpper(strings.TrimSpace(*answer.ValueString)))
+		if relationship.IsValid() {
+			return familyRelationshipCodeableConcept(relationship)
+		}
+	}
+
+	return questionnaireAnswerCodeableConcept(answer)
+}
+
+// questionnaireFamilyMember reads the relationship and conditions of a relative from their group.
+// An onset age nested in a condition answer applies to that condition, otherwise the relative's onset age applies
+func questionnaireFamilyMember(member dto.QuestionnaireResponseItem, elements map[string]string) (*domain.FHIRCodeableConcept, []*domain.FHIRFamilyMemberHistoryCondition) {
+	var relationship *domain.FHIRCodeableConcept
+
+	conditions := []*domain.FHIRFamilyMemberHistoryCondition{}
+	memberOnsetAge := questionnaireAnswerAge(member.Item, elements)
+
+	for _, item := range member.Item {
+		switch elements[item.LinkID] {
+		case familyMemberRelationshipElement:
+			if len(item.Answer) > 0 {
+				relationship = questionnaireFamilyRelationship(item.Answer[0])
+			}
+
+		case familyMemberConditionElement:
+			for _, answer := range item.Answer {
+				code := questionnaireAnswerCodeableConcept(answer)
+				if code == nil {
+					continue
+				}
+
+				condition := &domain.FHIRFamilyMemberHistoryCondition{
+					Code: code,
+				}
+
+				onsetAge := questionnaireAnswerAge(answer.Item, elements)
+				if onsetAge == nil {
+					onsetAge = memberOnsetAge
+				}
+
+				if onsetAge != nil {
+					condition.OnsetAge = familyMemberOnsetAge(*onsetAge)
+				}
+
+				conditions = append(conditions, condition)
+			}
+		}
+	}
+
+	return relationship, conditions
+}
+
+// questionnaireResponseItemIdentifier identifies the resource extracted from an item of a questionnaire response so that it is updated
+// rather than duplicated when the response is extracted again. Repeated items are told apart by their position e.g `<responseID>/<linkId>/2`
+func questionnaireResponseItemIdentifier(questionnaireResponseID, linkID string, occurrence int) *domain.FHIRIdentifier {
+	system := scalarutils.URI(common.QuestionnaireResponseItemIdentifierSystem)
+	value := fmt.Sprintf("%s/%s", questionnaireResponseID, linkID)
+
+	if occurrence > 1 {
+		value = fmt.Sprintf("%s/%d", value, occurrence)
+	}
+
+	return &domain.FHIRIdentifier{
+		Use:    domain.IdentifierUseEnumSecondary,
+		System: &system,
+		Value:  value,
+	}
+}
+
+func mapFHIRFamilyMemberHistoryToDTO(resource domain.FHIRFamilyMemberHistory) *dto.FamilyMemberHistory {
+	output := &dto.FamilyMemberHistory{
+		RecordedAt: (*scalarutils.DateTime)(resource.Date),
+		Conditions: []*dto.FamilyMemberCondition{},
+	}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.Status != nil {
+		output.Status = dto.FamilyMemberHistoryStatusEnum(strings.ToUpper(strings.ReplaceAll(string(*resource.Status), "-", "_")))
+	}
+
+	if resource.Patient != nil && resource.Patient.ID != nil {
+		output.PatientID = *resource.Patient.ID
+	}
+
+	if resource.Relationship != nil {
+		output.Relationship = familyRelationship(resource.Relationship)
+		output.RelationshipName = resource.Relationship.Text
+	}
+
+	if resource.Name != nil {
+		output.Name = *resource.Name
+	}
+
+	for _, reason := range resource.ReasonReference {
+		if reason != nil && reason.ID != nil && reason.Reference != nil && *reason.Reference == fmt.Sprintf("QuestionnaireResponse/%s", *reason.ID) {
+			output.QuestionnaireResponseID = *reason.ID
+		}
+	}
+
+	for _, condition := range resource.Condition {
+		if condition == nil || condition.Code == nil {
+			continue
+		}
+
+		output.Conditions = append(output.Conditions, mapFHIRFamilyMemberConditionToDTO(*condition))
+	}
+
+	if len(resource.Note) > 0 && resource.Note[0].Text != nil {
+		output.Note = string(*resource.Note[0].Text)
+	}
+
+	return output
+}
+
+func mapFHIRFamilyMemberConditionToDTO(resource domain.FHIRFamilyMemberHistoryCondition) *dto.FamilyMemberCondition {
+	output := &dto.FamilyMemberCondition{
+		Name:               resource.Code.Text,
+		ContributedToDeath: resource.ContributedToDeath,
+	}
+
+	if len(resource.Code.Coding) > 0 && resource.Code.Coding[0] != nil && resource.Code.Coding[0].Code != nil {
+		output.Code = string(*resource.Code.Coding[0].Code)
+	}
+
+	if resource.OnsetAge != nil {
+		age := int(resource.OnsetAge.Value)
+		output.OnsetAge = &age
+	}
+
+	if len(resource.Note) > 0 && resource.Note[0].Text != nil {
+		output.Note = string(*resource.Note[0].Text)
+	}
+
+	return output
+}
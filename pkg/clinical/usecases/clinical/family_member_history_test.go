@@ -0,0 +1,630 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+func TestUseCasesClinicalImpl_RecordFamilyMemberHistory(t *testing.T) {
+	ctx := context.Background()
+	onsetAge := 45
+	invalidOnsetAge := -1
+	partial := dto.FamilyMemberHistoryStatusPartial
+	enteredInError := dto.FamilyMemberHistoryStatusEnteredInError
+
+	type args struct {
+		ctx   context.Context
+		input dto.FamilyMemberHistoryInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: record the history of a mother",
+			args: args{
+				ctx: ctx,
+				input: dto.FamilyMemberHistoryInput{
+					PatientID:    gofakeit.UUID(),
+					Relationship: dto.FamilyRelationshipMother,
+					Status:       &partial,
+					Conditions: []*dto.FamilyMemberConditionInput{
+						{
+							ConditionCode: "116026",
+							OnsetAge:      &onsetAge,
+							Note:          "Treated at Kenyatta National Hospital",
+						},
+					},
+					Note: "Reported by the patient",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid relationship",
+			args: args{
+				ctx: ctx,
+				input: dto.FamilyMemberHistoryInput{
+					PatientID:    gofakeit.UUID(),
+					Relationship: "NEIGHBOUR",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid onset age",
+			args: args{
+				ctx: ctx,
+				input: dto.FamilyMemberHistoryInput{
+					PatientID:    gofakeit.UUID(),
+					Relationship: dto.FamilyRelationshipMother,
+					Conditions: []*dto.FamilyMemberConditionInput{
+						{
+							ConditionCode: "116026",
+							OnsetAge:      &invalidOnsetAge,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: recorded as entered in error",
+			args: args{
+				ctx: ctx,
+				input: dto.FamilyMemberHistoryInput{
+					PatientID:    gofakeit.UUID(),
+					Relationship: dto.FamilyRelationshipMother,
+					Status:       &enteredInError,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get patient",
+			args: args{
+				ctx: ctx,
+				input: dto.FamilyMemberHistoryInput{
+					PatientID:    gofakeit.UUID(),
+					Relationship: dto.FamilyRelationshipMother,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get condition concept",
+			args: args{
+				ctx: ctx,
+				input: dto.FamilyMemberHistoryInput{
+					PatientID:    gofakeit.UUID(),
+					Relationship: dto.FamilyRelationshipMother,
+					Conditions: []*dto.FamilyMemberConditionInput{
+						{
+							ConditionCode: "116026",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create family member history",
+			args: args{
+				ctx: ctx,
+				input: dto.FamilyMemberHistoryInput{
+					PatientID:    gofakeit.UUID(),
+					Relationship: dto.FamilyRelationshipMother,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+				return &domain.Concept{
+					ID:          concept,
+					DisplayName: "Breast cancer",
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to get patient" {
+				fakeFHIR.MockGetFHIRPatientFn = func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get patient")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get condition concept" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return nil, fmt.Errorf("failed to get concept")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create family member history" {
+				fakeFHIR.MockCreateFHIRFamilyMemberHistoryFn = func(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error) {
+					return nil, fmt.Errorf("failed to create family member history")
+				}
+			}
+
+			got, err := u.RecordFamilyMemberHistory(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RecordFamilyMemberHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.name == "Happy case: record the history of a mother" {
+				if got.Relationship != dto.FamilyRelationshipMother || got.Status != dto.FamilyMemberHistoryStatusPartial {
+					t.Errorf("expected a partial history of the patient's mother, got %v", got)
+				}
+
+				if len(got.Conditions) != 1 || got.Conditions[0].Name != "Breast cancer" || *got.Conditions[0].OnsetAge != onsetAge {
+					t.Errorf("expected the mother to have had breast cancer at %d, got %v", onsetAge, got.Conditions)
+				}
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_GetFamilyMemberHistory(t *testing.T) {
+	ctx := context.Background()
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get family member history",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid family member history id",
+			args: args{
+				ctx: ctx,
+				id:  "mother",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get family member history",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to get family member history" {
+				fakeFHIR.MockGetFHIRFamilyMemberHistoryFn = func(ctx context.Context, id string) (*domain.FHIRFamilyMemberHistoryRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get family member history")
+				}
+			}
+
+			got, err := u.GetFamilyMemberHistory(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.GetFamilyMemberHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (got.Relationship != dto.FamilyRelationshipMother || got.RelationshipName != "Mother") {
+				t.Errorf("expected the history of the patient's mother, got %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_UpdateFamilyMemberHistory(t *testing.T) {
+	ctx := context.Background()
+	patientID := gofakeit.UUID()
+	onsetAge := 42
+
+	input := dto.FamilyMemberHistoryInput{
+		PatientID:    patientID,
+		Relationship: dto.FamilyRelationshipMother,
+		Conditions: []*dto.FamilyMemberConditionInput{
+			{
+				ConditionCode: "116026",
+				OnsetAge:      &onsetAge,
+			},
+		},
+	}
+
+	type args struct {
+		ctx   context.Context
+		id    string
+		input dto.FamilyMemberHistoryInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: correct the onset age",
+			args: args{
+				ctx:   ctx,
+				id:    gofakeit.UUID(),
+				input: input,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid family member history id",
+			args: args{
+				ctx:   ctx,
+				id:    "mother",
+				input: input,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid relationship",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+				input: dto.FamilyMemberHistoryInput{
+					PatientID:    patientID,
+					Relationship: "NEIGHBOUR",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: history of another patient",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+				input: dto.FamilyMemberHistoryInput{
+					PatientID:    gofakeit.UUID(),
+					Relationship: dto.FamilyRelationshipMother,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: history entered in error",
+			args: args{
+				ctx:   ctx,
+				id:    gofakeit.UUID(),
+				input: input,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get family member history",
+			args: args{
+				ctx:   ctx,
+				id:    gofakeit.UUID(),
+				input: input,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update family member history",
+			args: args{
+				ctx:   ctx,
+				id:    gofakeit.UUID(),
+				input: input,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+				return &domain.Concept{
+					ID:          concept,
+					DisplayName: "Breast cancer",
+				}, nil
+			}
+
+			getHistory := fakeFHIR.MockGetFHIRFamilyMemberHistoryFn
+			fakeFHIR.MockGetFHIRFamilyMemberHistoryFn = func(ctx context.Context, id string) (*domain.FHIRFamilyMemberHistoryRelayPayload, error) {
+				history, err := getHistory(ctx, id)
+				if err != nil {
+					return nil, err
+				}
+
+				history.Resource.Patient.ID = &patientID
+
+				if tt.name == "Sad case: history entered in error" {
+					status := scalarutils.Code("entered-in-error")
+					history.Resource.Status = &status
+				}
+
+				return history, nil
+			}
+
+			if tt.name == "Sad case: failed to get family member history" {
+				fakeFHIR.MockGetFHIRFamilyMemberHistoryFn = func(ctx context.Context, id string) (*domain.FHIRFamilyMemberHistoryRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get family member history")
+				}
+			}
+
+			if tt.name == "Sad case: failed to update family member history" {
+				fakeFHIR.MockUpdateFHIRFamilyMemberHistoryFn = func(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error) {
+					return nil, fmt.Errorf("failed to update family member history")
+				}
+			}
+
+			got, err := u.UpdateFamilyMemberHistory(tt.args.ctx, tt.args.id, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.UpdateFamilyMemberHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (len(got.Conditions) != 1 || *got.Conditions[0].OnsetAge != onsetAge) {
+				t.Errorf("expected the onset age to be corrected to %d, got %v", onsetAge, got.Conditions)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_DeleteFamilyMemberHistory(t *testing.T) {
+	ctx := context.Background()
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: delete family member history",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid family member history id",
+			args: args{
+				ctx: ctx,
+				id:  "mother",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get family member history",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update family member history",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Happy case: delete family member history" {
+				fakeFHIR.MockUpdateFHIRFamilyMemberHistoryFn = func(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error) {
+					if input.Status == nil || *input.Status != "entered-in-error" {
+						return nil, fmt.Errorf("expected the family member history to be marked as entered in error")
+					}
+
+					return &input, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get family member history" {
+				fakeFHIR.MockGetFHIRFamilyMemberHistoryFn = func(ctx context.Context, id string) (*domain.FHIRFamilyMemberHistoryRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get family member history")
+				}
+			}
+
+			if tt.name == "Sad case: failed to update family member history" {
+				fakeFHIR.MockUpdateFHIRFamilyMemberHistoryFn = func(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error) {
+					return nil, fmt.Errorf("failed to update family member history")
+				}
+			}
+
+			got, err := u.DeleteFamilyMemberHistory(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.DeleteFamilyMemberHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if got == tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.DeleteFamilyMemberHistory() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListPatientFamilyMemberHistories(t *testing.T) {
+	ctx := context.Background()
+	first := 10
+	invalidFirst := -1
+
+	type args struct {
+		ctx        context.Context
+		patientID  string
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list the family history of a patient",
+			args: args{
+				ctx:       ctx,
+				patientID: gofakeit.UUID(),
+				pagination: dto.Pagination{
+					First: &first,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid patient id",
+			args: args{
+				ctx:       ctx,
+				patientID: "patient",
+				pagination: dto.Pagination{
+					First: &first,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid pagination",
+			args: args{
+				ctx:       ctx,
+				patientID: gofakeit.UUID(),
+				pagination: dto.Pagination{
+					First: &invalidFirst,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get tenant identifiers",
+			args: args{
+				ctx:       ctx,
+				patientID: gofakeit.UUID(),
+				pagination: dto.Pagination{
+					First: &first,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search family member histories",
+			args: args{
+				ctx:       ctx,
+				patientID: gofakeit.UUID(),
+				pagination: dto.Pagination{
+					First: &first,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Happy case: list the family history of a patient" {
+				searchHistories := fakeFHIR.MockSearchFHIRFamilyMemberHistoryFn
+				fakeFHIR.MockSearchFHIRFamilyMemberHistoryFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRFamilyMemberHistory, error) {
+					if params["status:not"] != "entered-in-error" {
+						return nil, fmt.Errorf("expected histories entered in error to be excluded")
+					}
+
+					return searchHistories(ctx, params, tenant, pagination)
+				}
+			}
+
+			if tt.name == "Sad case: failed to get tenant identifiers" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("failed to get tenant identifiers")
+				}
+			}
+
+			if tt.name == "Sad case: failed to search family member histories" {
+				fakeFHIR.MockSearchFHIRFamilyMemberHistoryFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRFamilyMemberHistory, error) {
+					return nil, fmt.Errorf("failed to search family member histories")
+				}
+			}
+
+			got, err := u.ListPatientFamilyMemberHistories(tt.args.ctx, tt.args.patientID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListPatientFamilyMemberHistories() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got.TotalCount != 1 {
+				t.Errorf("expected one family member history, got %v", got.TotalCount)
+			}
+		})
+	}
+}
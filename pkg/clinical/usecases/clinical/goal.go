@@ -0,0 +1,342 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// CreateGoal sets a goal for a patient e.g a viral load below 1000 copies/ml or a blood pressure below 140/90.
+// The goal's achievement is computed from the patient's latest observations of each target's measure
+func (c *UseCasesClinicalImpl) CreateGoal(ctx context.Context, input dto.GoalInput) (*dto.Goal, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	patient, err := c.infrastructure.FHIR.GetFHIRPatient(ctx, input.PatientID)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	conditions, err := c.patientConditionReferences(ctx, input.PatientID, input.ConditionIDs, *identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	targets, err := c.goalTargets(ctx, input.Targets)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate := time.Now().Format(time.DateOnly)
+	if input.StartDate != nil {
+		startDate = input.StartDate.AsTime().Format(time.DateOnly)
+	}
+
+	status := scalarutils.Code(dto.GoalLifecycleStatusActive.Code())
+	patientReference := fmt.Sprintf("Patient/%s", input.PatientID)
+
+	goal := domain.FHIRGoal{
+		LifecycleStatus: &status,
+		Description: &domain.FHIRCodeableConcept{
+			Text: input.Description,
+		},
+		Subject: &domain.FHIRReference{
+			ID:        &input.PatientID,
+			Reference: &patientReference,
+			Display:   patient.Resource.Names(),
+		},
+		StartDate: &startDate,
+		Target:    targets,
+		Addresses: conditions,
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+	}
+
+	if input.Note != "" {
+		goal.Note = []*domain.FHIRAnnotation{
+			{
+				Text: (*scalarutils.Markdown)(&input.Note),
+			},
+		}
+	}
+
+	evaluated, err := c.evaluateGoal(ctx, goal, *identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	goal.AchievementStatus = goalAchievementCodeableConcept(evaluated.AchievementStatus)
+
+	resource, err := c.infrastructure.FHIR.CreateFHIRGoal(ctx, goal)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapFHIRGoalToDTO(*resource)
+	output.Targets = evaluated.Targets
+
+	return output, nil
+}
+
+// UpdateGoal updates a goal e.g to put it on hold or revise its targets. The goal's achievement is recomputed
+func (c *UseCasesClinicalImpl) UpdateGoal(ctx context.Context, id string, input dto.GoalUpdateInput) (*dto.Goal, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := c.infrastructure.FHIR.GetFHIRGoal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	goal := *payload.Resource
+
+	if goal.Subject == nil || goal.Subject.ID == nil {
+		return nil, fmt.Errorf("goal %s has no patient", id)
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	if input.LifecycleStatus != nil {
+		status := scalarutils.Code(input.LifecycleStatus.Code())
+		statusDate := time.Now().Format(time.DateOnly)
+
+		goal.LifecycleStatus = &status
+		goal.StatusDate = &statusDate
+	}
+
+	if input.Description != nil {
+		goal.Description = &domain.FHIRCodeableConcept{
+			Text: *input.Description,
+		}
+	}
+
+	if input.ConditionIDs != nil {
+		goal.Addresses, err = c.patientConditionReferences(ctx, *goal.Subject.ID, input.ConditionIDs, *identifiers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Targets != nil {
+		goal.Target, err = c.goalTargets(ctx, input.Targets)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Note != nil {
+		goal.Note = []*domain.FHIRAnnotation{
+			{
+				Text: (*scalarutils.Markdown)(input.Note),
+			},
+		}
+	}
+
+	evaluated, err := c.evaluateGoal(ctx, goal, *identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	goal.AchievementStatus = goalAchievementCodeableConcept(evaluated.AchievementStatus)
+
+	resource, err := c.infrastructure.FHIR.UpdateFHIRGoal(ctx, goal)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapFHIRGoalToDTO(*resource)
+	output.Targets = evaluated.Targets
+
+	return output, nil
+}
+
+// GetGoal retrieves a goal with its achievement computed from the patient's latest observations
+func (c *UseCasesClinicalImpl) GetGoal(ctx context.Context, id string) (*dto.Goal, error) {
+	payload, err := c.infrastructure.FHIR.GetFHIRGoal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	return c.evaluateGoal(ctx, *payload.Resource, *identifiers)
+}
+
+// ListPatientGoals lists a patient's goals with their achievement computed from the patient's latest observations
+func (c *UseCasesClinicalImpl) ListPatientGoals(ctx context.Context, patientID string, pagination dto.Pagination) (*dto.GoalConnection, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"patient": fmt.Sprintf("Patient/%s", patientID),
+		"_sort":   "-start-date",
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRGoal(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	goals := []*dto.Goal{}
+
+	for _, resource := range resources.Goals {
+		goal, err := c.evaluateGoal(ctx, resource, *identifiers)
+		if err != nil {
+			return nil, err
+		}
+
+		goals = append(goals, goal)
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreateGoalConnection(goals, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
+
+// goalTargets composes the targets of a goal, each measured by the observations of its CIEL concept
+func (c *UseCasesClinicalImpl) goalTargets(ctx context.Context, inputs []*dto.GoalTargetInput) ([]*domain.FHIRGoalTarget, error) {
+	targets := []*domain.FHIRGoalTarget{}
+
+	for _, input := range inputs {
+		measure, err := c.GetConcept(ctx, dto.TerminologySourceCIEL, input.MeasureCode)
+		if err != nil {
+			return nil, err
+		}
+
+		targets = append(targets, goalTarget(measure, *input))
+	}
+
+	return targets, nil
+}
+
+// evaluateGoal compares each of a goal's targets with the latest numeric observation of its measure,
+// and computes the goal's achievement from the comparison
+func (c *UseCasesClinicalImpl) evaluateGoal(ctx context.Context, resource domain.FHIRGoal, identifiers dto.TenantIdentifiers) (*dto.Goal, error) {
+	goal := mapFHIRGoalToDTO(resource)
+
+	first := goalObservationsCount
+
+	for _, target := range goal.Targets {
+		params := map[string]interface{}{
+			"patient": fmt.Sprintf("Patient/%s", goal.PatientID),
+			"_sort":   "-date",
+		}
+		params[observationCodeSearchParam(target.MeasureCode)] = target.MeasureCode
+
+		observations, err := c.infrastructure.FHIR.SearchPatientObservations(ctx, params, identifiers, dto.Pagination{First: &first})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, observation := range observations.Observations {
+			if observation.Status != nil && strings.EqualFold(string(*observation.Status), string(domain.ObservationStatusEnumCancelled)) {
+				continue
+			}
+
+			observation = bloodPressureComponentObservation(observation, target.MeasureCode)
+
+			value, ok := observationNumericValue(observation)
+			if !ok {
+				continue
+			}
+
+			latestValue := mapFHIRObservationToObservationDTO(observation).Value
+			target.LatestValue = &latestValue
+			target.Met = target.Comparator.Compare(value, target.Value)
+
+			break
+		}
+	}
+
+	goal.AchievementStatus = goalAchievement(goal.Targets)
+
+	return goal, nil
+}
+
+// patientConditionReferences fetches the conditions a goal or care plan addresses, ensuring they are the patient's conditions
+func (c *UseCasesClinicalImpl) patientConditionReferences(ctx context.Context, patientID string, conditionIDs []string, identifiers dto.TenantIdentifiers) ([]*domain.FHIRReference, error) {
+	references := []*domain.FHIRReference{}
+
+	if len(conditionIDs) == 0 {
+		return references, nil
+	}
+
+	params := map[string]interface{}{
+		"_id":     strings.Join(conditionIDs, ","),
+		"patient": fmt.Sprintf("Patient/%s", patientID),
+	}
+
+	conditions, err := c.infrastructure.FHIR.SearchFHIRCondition(ctx, params, identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[string]bool{}
+
+	for _, condition := range conditions.Conditions {
+		if condition.ID != nil {
+			found[*condition.ID] = true
+		}
+	}
+
+	for _, conditionID := range conditionIDs {
+		if !found[conditionID] {
+			return nil, fmt.Errorf("condition %s is not a condition of patient %s", conditionID, patientID)
+		}
+
+		id := conditionID
+		reference := fmt.Sprintf("Condition/%s", id)
+		references = append(references, &domain.FHIRReference{
+			ID:        &id,
+			Reference: &reference,
+		})
+	}
+
+	return references, nil
+}
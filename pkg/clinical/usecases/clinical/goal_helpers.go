@@ -0,0 +1,219 @@
+package clinical
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+const (
+	goalAchievementSystem = "http://terminology.hl7.org/CodeSystem/goal-achievement"
+
+	// goalObservationsCount is the number of a measure's latest observations looked through for a value to compare against a goal target
+	goalObservationsCount = 10
+)
+
+var goalAchievementDisplays = map[dto.GoalAchievementStatusEnum]string{
+	dto.GoalAchievementStatusInProgress:  "In Progress",
+	dto.GoalAchievementStatusAchieved:    "Achieved",
+	dto.GoalAchievementStatusNotAchieved: "Not Achieved",
+}
+
+func goalAchievementCodeableConcept(status dto.GoalAchievementStatusEnum) *domain.FHIRCodeableConcept {
+	system := scalarutils.URI(goalAchievementSystem)
+	code := scalarutils.Code(status.Code())
+
+	return &domain.FHIRCodeableConcept{
+		Coding: []*domain.FHIRCoding{
+			{
+				System:  &system,
+				Code:    &code,
+				Display: goalAchievementDisplays[status],
+			},
+		},
+		Text: goalAchievementDisplays[status],
+	}
+}
+
+// goalTarget composes the target of a goal measured by the observations of the given concept
+func goalTarget(measure *domain.Concept, input dto.GoalTargetInput) *domain.FHIRGoalTarget {
+	comparator := domain.QuantityComparatorEnum(input.Comparator.Code())
+
+	target := &domain.FHIRGoalTarget{
+		Measure: conceptCodeableConcept(measure),
+		DetailQuantity: &domain.FHIRQuantity{
+			Value:      input.Value,
+			Comparator: &comparator,
+			Unit:       input.Unit,
+		},
+	}
+
+	if input.DueDate != nil {
+		dueDate := input.DueDate.AsTime().Format(time.DateOnly)
+		target.DueDate = &dueDate
+	}
+
+	return target
+}
+
+// goalAchievement computes the achievement status of a goal from its evaluated targets.
+// A goal is not achieved when any observed target is not met, and is in progress until all its targets have been observed
+func goalAchievement(targets []*dto.GoalTarget) dto.GoalAchievementStatusEnum {
+	if len(targets) == 0 {
+		return dto.GoalAchievementStatusInProgress
+	}
+
+	achieved := true
+
+	for _, target := range targets {
+		if target.LatestValue == nil {
+			achieved = false
+
+			continue
+		}
+
+		if !target.Met {
+			return dto.GoalAchievementStatusNotAchieved
+		}
+	}
+
+	if !achieved {
+		return dto.GoalAchievementStatusInProgress
+	}
+
+	return dto.GoalAchievementStatusAchieved
+}
+
+// observationNumericValue reads the numeric value of an observation e.g `850` from a viral load recorded as `850 copies/ml`.
+// Measurements recorded before they were stored as quantities are read in the unit their concept is recorded in
+func observationNumericValue(observation domain.FHIRObservation) (float64, bool) {
+	if observation.ValueQuantity != nil {
+		return observation.ValueQuantity.Value, true
+	}
+
+	var value string
+
+	switch {
+	case observation.ValueString != nil:
+		value = *observation.ValueString
+	case observation.ValueInteger != nil:
+		value = *observation.ValueInteger
+	}
+
+	conceptID := observationConceptCode(observation.Code)
+	if _, measured := observationUnits[conceptID]; measured {
+		quantity, err := observationQuantity(conceptID, value, "")
+		if err != nil || quantity == nil {
+			return 0, false
+		}
+
+		return quantity.Value, true
+	}
+
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return 0, false
+	}
+
+	number, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return number, true
+}
+
+// parseFHIRDate reads a FHIR date, which may also be recorded as a date time
+func parseFHIRDate(value string) *scalarutils.Date {
+	date, err := time.Parse(time.DateOnly, value)
+	if err == nil {
+		return timeToDate(date)
+	}
+
+	date, err = time.Parse(time.RFC3339, value)
+	if err == nil {
+		return timeToDate(date)
+	}
+
+	return nil
+}
+
+func mapFHIRGoalToDTO(resource domain.FHIRGoal) *dto.Goal {
+	output := &dto.Goal{
+		AchievementStatus: dto.GoalAchievementStatusInProgress,
+		ConditionIDs:      []string{},
+		Targets:           []*dto.GoalTarget{},
+	}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.Subject != nil && resource.Subject.ID != nil {
+		output.PatientID = *resource.Subject.ID
+	}
+
+	if resource.Description != nil {
+		output.Description = resource.Description.Text
+	}
+
+	if resource.LifecycleStatus != nil {
+		output.LifecycleStatus = dto.GoalLifecycleStatusEnum(strings.ToUpper(strings.ReplaceAll(string(*resource.LifecycleStatus), "-", "_")))
+	}
+
+	if resource.AchievementStatus != nil && len(resource.AchievementStatus.Coding) > 0 && resource.AchievementStatus.Coding[0].Code != nil {
+		status := dto.GoalAchievementStatusEnum(strings.ToUpper(strings.ReplaceAll(string(*resource.AchievementStatus.Coding[0].Code), "-", "_")))
+		if status.IsValid() {
+			output.AchievementStatus = status
+		}
+	}
+
+	if resource.StartDate != nil {
+		output.StartDate = parseFHIRDate(*resource.StartDate)
+	}
+
+	for _, condition := range resource.Addresses {
+		if condition != nil && condition.ID != nil {
+			output.ConditionIDs = append(output.ConditionIDs, *condition.ID)
+		}
+	}
+
+	for _, target := range resource.Target {
+		if target == nil || target.Measure == nil {
+			continue
+		}
+
+		goalTarget := &dto.GoalTarget{
+			MeasureName: target.Measure.Text,
+		}
+
+		if len(target.Measure.Coding) > 0 && target.Measure.Coding[0].Code != nil {
+			goalTarget.MeasureCode = string(*target.Measure.Coding[0].Code)
+		}
+
+		if target.DetailQuantity != nil {
+			goalTarget.Value = target.DetailQuantity.Value
+			goalTarget.Unit = target.DetailQuantity.Unit
+
+			if target.DetailQuantity.Comparator != nil {
+				goalTarget.Comparator = dto.GoalTargetComparatorFromCode(string(*target.DetailQuantity.Comparator))
+			}
+		}
+
+		if target.DueDate != nil {
+			goalTarget.DueDate = parseFHIRDate(*target.DueDate)
+		}
+
+		output.Targets = append(output.Targets, goalTarget)
+	}
+
+	if len(resource.Note) > 0 && resource.Note[0].Text != nil {
+		output.Note = string(*resource.Note[0].Text)
+	}
+
+	return output
+}
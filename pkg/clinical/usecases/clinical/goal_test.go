@@ -0,0 +1,725 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+// fakeObservations returns a search of observations with the given values, the latest first
+func fakeObservations(values ...string) *domain.PagedFHIRObservations {
+	observations := &domain.PagedFHIRObservations{}
+
+	for _, value := range values {
+		id := gofakeit.UUID()
+		patientID := gofakeit.UUID()
+		observationValue := value
+		status := domain.ObservationStatusEnumFinal
+		instant := time.Now().Format(time.RFC3339)
+
+		observations.Observations = append(observations.Observations, domain.FHIRObservation{
+			ID:     &id,
+			Status: &status,
+			Code: &domain.FHIRCodeableConcept{
+				Coding: []*domain.FHIRCoding{
+					{
+						Display: gofakeit.Word(),
+					},
+				},
+			},
+			Subject: &domain.FHIRReference{
+				ID: &patientID,
+			},
+			EffectiveInstant: (*scalarutils.Instant)(&instant),
+			ValueString:      &observationValue,
+		})
+	}
+
+	observations.TotalCount = len(values)
+
+	return observations
+}
+
+// bloodPressureTargets are the targets of a blood pressure below 140/90 goal
+func bloodPressureTargets() []*dto.GoalTargetInput {
+	return []*dto.GoalTargetInput{
+		{
+			MeasureCode: "5085",
+			Comparator:  dto.GoalTargetComparatorLessThan,
+			Value:       140,
+			Unit:        "mmHg",
+		},
+		{
+			MeasureCode: "5086",
+			Comparator:  dto.GoalTargetComparatorLessThan,
+			Value:       90,
+			Unit:        "mmHg",
+		},
+	}
+}
+
+func TestUseCasesClinicalImpl_CreateGoal(t *testing.T) {
+	dueDate := scalarutils.Date{Year: 2030, Month: 1, Day: 1}
+
+	type args struct {
+		ctx   context.Context
+		input dto.GoalInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    dto.GoalAchievementStatusEnum
+		wantErr bool
+	}{
+		{
+			name: "Happy case: blood pressure goal is achieved",
+			args: args{
+				ctx: context.Background(),
+				input: dto.GoalInput{
+					PatientID:    gofakeit.UUID(),
+					Description:  "Blood pressure below 140/90",
+					ConditionIDs: []string{gofakeit.UUID()},
+					Targets:      bloodPressureTargets(),
+					Note:         "Review at every visit",
+				},
+			},
+			want:    dto.GoalAchievementStatusAchieved,
+			wantErr: false,
+		},
+		{
+			name: "Happy case: blood pressure goal is not achieved",
+			args: args{
+				ctx: context.Background(),
+				input: dto.GoalInput{
+					PatientID:   gofakeit.UUID(),
+					Description: "Blood pressure below 140/90",
+					Targets:     bloodPressureTargets(),
+				},
+			},
+			want:    dto.GoalAchievementStatusNotAchieved,
+			wantErr: false,
+		},
+		{
+			name: "Happy case: blood pressure goal is read from a blood pressure panel",
+			args: args{
+				ctx: context.Background(),
+				input: dto.GoalInput{
+					PatientID:   gofakeit.UUID(),
+					Description: "Blood pressure below 140/90",
+					Targets:     bloodPressureTargets(),
+				},
+			},
+			want:    dto.GoalAchievementStatusNotAchieved,
+			wantErr: false,
+		},
+		{
+			name: "Happy case: viral load goal without observations is in progress",
+			args: args{
+				ctx: context.Background(),
+				input: dto.GoalInput{
+					PatientID:   gofakeit.UUID(),
+					Description: "Viral load suppression",
+					Targets: []*dto.GoalTargetInput{
+						{
+							MeasureCode: "856",
+							Comparator:  dto.GoalTargetComparatorLessThan,
+							Value:       1000,
+							Unit:        "copies/ml",
+							DueDate:     &dueDate,
+						},
+					},
+				},
+			},
+			want:    dto.GoalAchievementStatusInProgress,
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid input",
+			args: args{
+				ctx: context.Background(),
+				input: dto.GoalInput{
+					PatientID: "patient",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid target comparator",
+			args: args{
+				ctx: context.Background(),
+				input: dto.GoalInput{
+					PatientID:   gofakeit.UUID(),
+					Description: "Viral load suppression",
+					Targets: []*dto.GoalTargetInput{
+						{
+							MeasureCode: "856",
+							Comparator:  "BELOW",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get patient",
+			args: args{
+				ctx: context.Background(),
+				input: dto.GoalInput{
+					PatientID:   gofakeit.UUID(),
+					Description: "Viral load suppression",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: condition is not the patient's",
+			args: args{
+				ctx: context.Background(),
+				input: dto.GoalInput{
+					PatientID:    gofakeit.UUID(),
+					Description:  "Viral load suppression",
+					ConditionIDs: []string{gofakeit.UUID()},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get target measure",
+			args: args{
+				ctx: context.Background(),
+				input: dto.GoalInput{
+					PatientID:   gofakeit.UUID(),
+					Description: "Blood pressure below 140/90",
+					Targets:     bloodPressureTargets(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search observations",
+			args: args{
+				ctx: context.Background(),
+				input: dto.GoalInput{
+					PatientID:   gofakeit.UUID(),
+					Description: "Blood pressure below 140/90",
+					Targets:     bloodPressureTargets(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create goal",
+			args: args{
+				ctx: context.Background(),
+				input: dto.GoalInput{
+					PatientID:   gofakeit.UUID(),
+					Description: "Viral load suppression",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			var created domain.FHIRGoal
+
+			fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+				return &domain.Concept{
+					ID:          concept,
+					DisplayName: gofakeit.Word(),
+				}, nil
+			}
+
+			fakeFHIR.MockSearchFHIRConditionFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCondition, error) {
+				conditions := &domain.PagedFHIRCondition{}
+
+				for _, id := range tt.args.input.ConditionIDs {
+					conditionID := id
+					conditions.Conditions = append(conditions.Conditions, domain.FHIRCondition{ID: &conditionID})
+				}
+
+				return conditions, nil
+			}
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				switch {
+				case tt.want == dto.GoalAchievementStatusAchieved && searchParameters["combo-code"] == "5085":
+					return fakeObservations("128", "150"), nil
+				case tt.want == dto.GoalAchievementStatusAchieved && searchParameters["combo-code"] == "5086":
+					return fakeObservations("82"), nil
+				case tt.want == dto.GoalAchievementStatusNotAchieved && searchParameters["combo-code"] == "5085":
+					return fakeObservations("152"), nil
+				case tt.want == dto.GoalAchievementStatusNotAchieved && searchParameters["combo-code"] == "5086":
+					return fakeObservations("not recorded", "84"), nil
+				}
+
+				return fakeObservations(), nil
+			}
+
+			fakeFHIR.MockCreateFHIRGoalFn = func(ctx context.Context, input domain.FHIRGoal) (*domain.FHIRGoal, error) {
+				id := gofakeit.UUID()
+				input.ID = &id
+				created = input
+
+				return &input, nil
+			}
+
+			if tt.name == "Happy case: blood pressure goal is read from a blood pressure panel" {
+				fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+					if searchParameters["code"] != nil {
+						return nil, fmt.Errorf("expected the pressures to be searched in the components of panels too")
+					}
+
+					return &domain.PagedFHIRObservations{
+						Observations: []domain.FHIRObservation{fakeBloodPressurePanel(gofakeit.UUID(), 150, 85, time.Now())},
+						TotalCount:   1,
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get patient" {
+				fakeFHIR.MockGetFHIRPatientFn = func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: condition is not the patient's" {
+				fakeFHIR.MockSearchFHIRConditionFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCondition, error) {
+					return &domain.PagedFHIRCondition{}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get target measure" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to search observations" {
+				fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create goal" {
+				fakeFHIR.MockCreateFHIRGoalFn = func(ctx context.Context, input domain.FHIRGoal) (*domain.FHIRGoal, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.CreateGoal(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.CreateGoal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.AchievementStatus != tt.want {
+				t.Errorf("expected the goal to be %s, got %s", tt.want, got.AchievementStatus)
+			}
+
+			if created.AchievementStatus == nil || created.AchievementStatus.Text == "" {
+				t.Errorf("expected the computed achievement status to be recorded on the goal")
+			}
+
+			if got.LifecycleStatus != dto.GoalLifecycleStatusActive || got.PatientID != tt.args.input.PatientID {
+				t.Errorf("expected an active goal for patient %s, got %s for %s", tt.args.input.PatientID, got.LifecycleStatus, got.PatientID)
+			}
+
+			if len(got.Targets) != len(tt.args.input.Targets) || len(got.ConditionIDs) != len(tt.args.input.ConditionIDs) {
+				t.Errorf("expected %d targets and %d conditions, got %v and %v", len(tt.args.input.Targets), len(tt.args.input.ConditionIDs), got.Targets, got.ConditionIDs)
+			}
+
+			if tt.name == "Happy case: blood pressure goal is read from a blood pressure panel" {
+				if got.Targets[0].Met || *got.Targets[0].LatestValue != "150" || !got.Targets[1].Met || *got.Targets[1].LatestValue != "85" {
+					t.Errorf("expected only the systolic target to be missed, got %v and %v", got.Targets[0], got.Targets[1])
+				}
+			}
+
+			if tt.name == "Happy case: blood pressure goal is not achieved" {
+				if got.Targets[0].Met || *got.Targets[0].LatestValue != "152" || !got.Targets[1].Met || *got.Targets[1].LatestValue != "84" {
+					t.Errorf("expected only the systolic target to be missed, got %v and %v", got.Targets[0], got.Targets[1])
+				}
+			}
+
+			if tt.want == dto.GoalAchievementStatusInProgress && (got.Targets[0].LatestValue != nil || got.Targets[0].DueDate == nil) {
+				t.Errorf("expected an unobserved target due on %v, got %v", dueDate, got.Targets[0])
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_UpdateGoal(t *testing.T) {
+	onHold := dto.GoalLifecycleStatusOnHold
+	description := "Viral load below 200 copies/ml"
+	note := "Enhanced adherence counselling started"
+	empty := ""
+
+	type args struct {
+		ctx   context.Context
+		id    string
+		input dto.GoalUpdateInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update goal",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.GoalUpdateInput{
+					LifecycleStatus: &onHold,
+					Description:     &description,
+					ConditionIDs:    []string{},
+					Targets: []*dto.GoalTargetInput{
+						{
+							MeasureCode: "856",
+							Comparator:  dto.GoalTargetComparatorLessThan,
+							Value:       200,
+							Unit:        "copies/ml",
+						},
+					},
+					Note: &note,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: empty description",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.GoalUpdateInput{
+					Description: &empty,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get goal",
+			args: args{
+				ctx:   context.Background(),
+				id:    gofakeit.UUID(),
+				input: dto.GoalUpdateInput{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: condition is not the patient's",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.GoalUpdateInput{
+					ConditionIDs: []string{gofakeit.UUID()},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get target measure",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.GoalUpdateInput{
+					Targets: bloodPressureTargets(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update goal",
+			args: args{
+				ctx:   context.Background(),
+				id:    gofakeit.UUID(),
+				input: dto.GoalUpdateInput{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				return fakeObservations("450 copies/ml"), nil
+			}
+
+			if tt.name == "Sad case: failed to get goal" {
+				fakeFHIR.MockGetFHIRGoalFn = func(ctx context.Context, id string) (*domain.FHIRGoalRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: condition is not the patient's" {
+				fakeFHIR.MockSearchFHIRConditionFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCondition, error) {
+					return &domain.PagedFHIRCondition{}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get target measure" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to update goal" {
+				fakeFHIR.MockUpdateFHIRGoalFn = func(ctx context.Context, input domain.FHIRGoal) (*domain.FHIRGoal, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.UpdateGoal(tt.args.ctx, tt.args.id, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.UpdateGoal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.LifecycleStatus != onHold || got.Description != description || got.Note != note {
+				t.Errorf("expected the goal to be updated, got %v", got)
+			}
+
+			if len(got.Targets) != 1 || got.Targets[0].Value != 200 || got.Targets[0].Met {
+				t.Errorf("expected a viral load of 450 copies/ml to miss the revised target, got %v", got.Targets)
+			}
+
+			if got.AchievementStatus != dto.GoalAchievementStatusNotAchieved {
+				t.Errorf("expected the revised goal to not be achieved, got %s", got.AchievementStatus)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_GetGoal(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get goal",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: failed to get goal",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get tenant identifiers",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				return fakeObservations("LDL", "850"), nil
+			}
+
+			if tt.name == "Sad case: failed to get goal" {
+				fakeFHIR.MockGetFHIRGoalFn = func(ctx context.Context, id string) (*domain.FHIRGoalRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get tenant identifiers" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.GetGoal(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.GetGoal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.AchievementStatus != dto.GoalAchievementStatusAchieved || *got.Targets[0].LatestValue != "850" {
+				t.Errorf("expected the latest numeric viral load of 850 to achieve the goal, got %s with %v", got.AchievementStatus, got.Targets[0].LatestValue)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListPatientGoals(t *testing.T) {
+	first := 10
+	invalidFirst := -1
+
+	type args struct {
+		ctx        context.Context
+		patientID  string
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list patient goals",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid patient id",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  "patient",
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid pagination",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &invalidFirst},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search goals",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search observations",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			var searched map[string]interface{}
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				searched = searchParameters
+
+				return fakeObservations("12000"), nil
+			}
+
+			if tt.name == "Sad case: failed to search goals" {
+				fakeFHIR.MockSearchFHIRGoalFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRGoal, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to search observations" {
+				fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.ListPatientGoals(tt.args.ctx, tt.args.patientID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListPatientGoals() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if searched["code"] != "856" {
+				t.Errorf("expected the viral load observations to be searched, got %v", searched)
+			}
+
+			if len(got.Edges) != 1 || got.Edges[0].Node.AchievementStatus != dto.GoalAchievementStatusNotAchieved {
+				t.Errorf("expected one unsuppressed viral load goal, got %v", got.Edges)
+			}
+		})
+	}
+}
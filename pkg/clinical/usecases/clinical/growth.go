@@ -0,0 +1,294 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common/helpers"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/growthstandards"
+)
+
+// growthMeasurementCodes are the CIEL concepts of the measurements a child's growth is monitored with
+var growthMeasurementCodes = []string{common.WeightCIELTerminologyCode, common.HeightCIELTerminologyCode, common.MuacCIELTerminologyCode}
+
+// deriveGrowth records the z-scores of the most recent weight and height of a child in an encounter against the growth standards,
+// and the acute malnutrition classified from their most recent MUAC. The results derived in the encounter before are updated.
+// Nothing is derived for patients without a birth date or who are too old to be monitored against the standards
+func (c *UseCasesClinicalImpl) deriveGrowth(ctx context.Context, encounterID string, patientID string) error {
+	child, err := c.growthChild(ctx, patientID)
+	if err != nil || child == nil {
+		return err
+	}
+
+	if !c.infrastructure.GrowthStandards.Covers(*child, time.Now()) {
+		return nil
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	searchParams := map[string]interface{}{
+		"patient":   fmt.Sprintf("Patient/%s", patientID),
+		"encounter": fmt.Sprintf("Encounter/%s", encounterID),
+		"code":      strings.Join(growthMeasurementCodes, ","),
+	}
+
+	observations, err := c.infrastructure.FHIR.SearchPatientObservations(ctx, searchParams, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return err
+	}
+
+	results, err := c.growthResults(*child, latestMeasurements(observations.Observations))
+	if err != nil || len(results) == 0 {
+		return err
+	}
+
+	searchParams["code"] = fmt.Sprintf("%s|", common.GrowthIndicatorSystem)
+
+	derived, err := c.infrastructure.FHIR.SearchPatientObservations(ctx, searchParams, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]domain.FHIRObservation{}
+
+	for _, observation := range derived.Observations {
+		existing[observationConceptCode(observation.Code)] = observation
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		input, err := growthObservationInput(ctx, result, patientID, encounterID)
+		if err != nil {
+			return err
+		}
+
+		input.Meta = &domain.FHIRMetaInput{
+			Tag: tags,
+		}
+
+		previous, ok := existing[result.code]
+		if !ok {
+			_, err = c.infrastructure.FHIR.CreateFHIRObservation(ctx, *input)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		input.ID = previous.ID
+
+		_, err = c.infrastructure.FHIR.UpdateFHIRObservation(ctx, *input)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// growthResults scores the measurements of a child for each growth indicator and classifies their MUAC.
+// The measurements are keyed by their CIEL concept
+func (c *UseCasesClinicalImpl) growthResults(child growthstandards.Child, measurements map[string]*domain.FHIRObservation) ([]growthResult, error) {
+	results := []growthResult{}
+
+	weight, height := measurements[common.WeightCIELTerminologyCode], measurements[common.HeightCIELTerminologyCode]
+
+	for _, indicator := range []dto.GrowthIndicatorEnum{dto.GrowthIndicatorWeightForAge, dto.GrowthIndicatorHeightForAge, dto.GrowthIndicatorWeightForHeight} {
+		score, sources, err := c.scoreGrowth(indicator, child, weight, height)
+		if err != nil {
+			return nil, err
+		}
+
+		if score == nil {
+			continue
+		}
+
+		results = append(results, growthResult{
+			code:        indicator.Code(),
+			display:     growthIndicatorDisplays[indicator],
+			score:       score,
+			flag:        growthInterpretation(score.ZScore),
+			derivedFrom: sources,
+		})
+	}
+
+	muac, ok := measurements[common.MuacCIELTerminologyCode]
+	if !ok {
+		return results, nil
+	}
+
+	circumference, ok := measurementValue(*muac, common.MuacCIELTerminologyCode)
+	if !ok {
+		return results, nil
+	}
+
+	unit := observationUnits[common.MuacCIELTerminologyCode].code
+
+	classification := c.infrastructure.GrowthStandards.ClassifyMUAC(circumference, unit, child, observationTime(*muac))
+	if classification != nil {
+		results = append(results, growthResult{
+			code:           common.MUACClassificationCode,
+			display:        "MUAC nutritional status",
+			classification: classification,
+			flag:           muacInterpretations[*classification],
+			derivedFrom:    []domain.FHIRObservation{*muac},
+		})
+	}
+
+	return results, nil
+}
+
+// scoreGrowth scores a child's weight and height for a growth indicator, and returns the measurements the score was derived from.
+// The age of the child is taken on the date of the latest of the measurements
+func (c *UseCasesClinicalImpl) scoreGrowth(indicator dto.GrowthIndicatorEnum, child growthstandards.Child, weight *domain.FHIRObservation, height *domain.FHIRObservation) (*growthstandards.Score, []domain.FHIRObservation, error) {
+	measurements := growthstandards.Measurements{}
+	sources := []domain.FHIRObservation{}
+
+	if indicator != dto.GrowthIndicatorHeightForAge && weight != nil {
+		value, ok := measurementValue(*weight, common.WeightCIELTerminologyCode)
+		if ok {
+			measurements.Weight = &value
+			sources = append(sources, *weight)
+		}
+	}
+
+	if indicator != dto.GrowthIndicatorWeightForAge && height != nil {
+		value, ok := measurementValue(*height, common.HeightCIELTerminologyCode)
+		if ok {
+			measurements.Height = &value
+			sources = append(sources, *height)
+		}
+	}
+
+	if len(sources) == 0 {
+		return nil, nil, nil
+	}
+
+	on := observationTime(sources[0])
+
+	for _, source := range sources[1:] {
+		if recorded := observationTime(source); recorded.After(on) {
+			on = recorded
+		}
+	}
+
+	score, err := c.infrastructure.GrowthStandards.Score(indicator, child, measurements, on)
+	if err != nil || score == nil {
+		return nil, nil, err
+	}
+
+	return score, sources, nil
+}
+
+// growthChild is the sex and birth date of a patient. No child is returned for a patient without a birth date
+func (c *UseCasesClinicalImpl) growthChild(ctx context.Context, patientID string) (*growthstandards.Child, error) {
+	patient, err := c.referenceRangesPatient(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if patient.BirthDate == nil {
+		return nil, nil
+	}
+
+	return &growthstandards.Child{
+		Sex:       patient.Sex,
+		BirthDate: *patient.BirthDate,
+	}, nil
+}
+
+// GetPatientGrowthChart plots the weight and height of a child against the z-score lines of the growth standards of an indicator.
+// The latest weight and height of each encounter are scored, oldest first
+func (c *UseCasesClinicalImpl) GetPatientGrowthChart(ctx context.Context, patientID string, indicator dto.GrowthIndicatorEnum) (*dto.GrowthChart, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	if !indicator.IsValid() {
+		return nil, fmt.Errorf("%s is not a valid growth indicator", indicator)
+	}
+
+	child, err := c.growthChild(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if child == nil {
+		return nil, fmt.Errorf("the birth date of the patient is required to plot their growth")
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	searchParams := map[string]interface{}{
+		"patient": fmt.Sprintf("Patient/%s", patientID),
+		"code":    strings.Join([]string{common.WeightCIELTerminologyCode, common.HeightCIELTerminologyCode}, ","),
+	}
+
+	observations, err := c.infrastructure.FHIR.SearchPatientObservations(ctx, searchParams, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	chart := &dto.GrowthChart{
+		PatientID:    patientID,
+		Indicator:    indicator,
+		Sex:          child.Sex,
+		Curves:       []*dto.GrowthCurve{},
+		Measurements: []*dto.GrowthMeasurement{},
+	}
+
+	for _, curve := range c.infrastructure.GrowthStandards.Curves(indicator, child.Sex) {
+		chart.Curves = append(chart.Curves, mapGrowthCurveToGrowthCurveDTO(curve))
+	}
+
+	encounters := map[string][]domain.FHIRObservation{}
+
+	for _, observation := range observations.Observations {
+		encounterID := ""
+		if observation.Encounter != nil && observation.Encounter.ID != nil {
+			encounterID = *observation.Encounter.ID
+		}
+
+		encounters[encounterID] = append(encounters[encounterID], observation)
+	}
+
+	for encounterID, encounterObservations := range encounters {
+		measurements := latestMeasurements(encounterObservations)
+
+		score, sources, err := c.scoreGrowth(indicator, *child, measurements[common.WeightCIELTerminologyCode], measurements[common.HeightCIELTerminologyCode])
+		if err != nil {
+			return nil, err
+		}
+
+		if score == nil {
+			continue
+		}
+
+		chart.Measurements = append(chart.Measurements, growthMeasurement(encounterID, indicator, *score, sources))
+	}
+
+	sort.SliceStable(chart.Measurements, func(i, j int) bool {
+		return helpers.ParseDate(chart.Measurements[i].TimeRecorded).Before(helpers.ParseDate(chart.Measurements[j].TimeRecorded))
+	})
+
+	return chart, nil
+}
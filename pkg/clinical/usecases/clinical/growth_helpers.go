@@ -0,0 +1,218 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common/helpers"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/growthstandards"
+	"github.com/savannahghi/scalarutils"
+)
+
+// growthResult is a z-score or a MUAC classification derived from the measurements of a child
+type growthResult struct {
+	code           string
+	display        string
+	score          *growthstandards.Score
+	classification *dto.MUACClassificationEnum
+	flag           dto.ObservationInterpretationEnum
+	derivedFrom    []domain.FHIRObservation
+}
+
+var growthIndicatorDisplays = map[dto.GrowthIndicatorEnum]string{
+	dto.GrowthIndicatorWeightForAge:    "Weight-for-age z-score",
+	dto.GrowthIndicatorHeightForAge:    "Length/height-for-age z-score",
+	dto.GrowthIndicatorWeightForHeight: "Weight-for-length/height z-score",
+}
+
+var muacInterpretations = map[dto.MUACClassificationEnum]dto.ObservationInterpretationEnum{
+	dto.MUACClassificationSAM:    dto.ObservationInterpretationCriticalLow,
+	dto.MUACClassificationMAM:    dto.ObservationInterpretationLow,
+	dto.MUACClassificationNormal: dto.ObservationInterpretationNormal,
+}
+
+// growthInterpretation flags z-scores beyond 2 as low or high, and beyond 3 as critically low or high
+func growthInterpretation(zScore float64) dto.ObservationInterpretationEnum {
+	switch {
+	case zScore < -3:
+		return dto.ObservationInterpretationCriticalLow
+	case zScore < -2:
+		return dto.ObservationInterpretationLow
+	case zScore > 3:
+		return dto.ObservationInterpretationCriticalHigh
+	case zScore > 2:
+		return dto.ObservationInterpretationHigh
+	}
+
+	return dto.ObservationInterpretationNormal
+}
+
+// latestMeasurements are the most recent observations of each concept, keyed by the concept. Observations entered in error are left out
+func latestMeasurements(observations []domain.FHIRObservation) map[string]*domain.FHIRObservation {
+	latest := map[string]*domain.FHIRObservation{}
+
+	for i, observation := range observations {
+		if observation.Status != nil && *observation.Status == domain.ObservationStatusEnumEnteredInError {
+			continue
+		}
+
+		code := observationConceptCode(observation.Code)
+
+		if previous, ok := latest[code]; !ok || isMoreRecent(observation, *previous) {
+			latest[code] = &observations[i]
+		}
+	}
+
+	return latest
+}
+
+// observationTime is when an observation was taken. Observations without an effective instant are taken to be current
+func observationTime(observation domain.FHIRObservation) time.Time {
+	if observation.EffectiveInstant == nil {
+		return time.Now()
+	}
+
+	return helpers.ParseDate(string(*observation.EffectiveInstant))
+}
+
+// growthObservationInput records a growth result as an exam observation of the patient in the encounter.
+// Z-scores are recorded as quantities with the percentile as a component, and MUAC classifications as text
+func growthObservationInput(ctx context.Context, result growthResult, patientID string, encounterID string) (*domain.FHIRObservationInput, error) {
+	system := scalarutils.URI(common.GrowthIndicatorSystem)
+	status := domain.ObservationStatusEnumFinal
+	instant := scalarutils.Instant(time.Now().Format(time.RFC3339))
+	patientReference := fmt.Sprintf("Patient/%s", patientID)
+	encounterReference := fmt.Sprintf("Encounter/%s", encounterID)
+
+	observation := &domain.FHIRObservationInput{
+		Status:           &status,
+		Category:         []*domain.FHIRCodeableConceptInput{},
+		EffectiveInstant: &instant,
+		Code: &domain.FHIRCodeableConceptInput{
+			Coding: []*domain.FHIRCodingInput{
+				{
+					System:  &system,
+					Code:    scalarutils.Code(result.code),
+					Display: result.display,
+				},
+			},
+			Text: result.display,
+		},
+		Subject: &domain.FHIRReferenceInput{
+			ID:        &patientID,
+			Reference: &patientReference,
+		},
+		Encounter: &domain.FHIRReferenceInput{
+			ID:        &encounterID,
+			Reference: &encounterReference,
+		},
+		Interpretation: []*domain.FHIRCodeableConceptInput{observationInterpretationConcept(result.flag)},
+	}
+
+	switch {
+	case result.score != nil:
+		observation.ValueQuantity = &domain.FHIRQuantityInput{
+			Value:  result.score.ZScore,
+			Unit:   "z-score",
+			System: scalarutils.URI(ucumSystem),
+			Code:   scalarutils.Code("{Z-score}"),
+		}
+
+		observation.Component = []*domain.FHIRObservationComponentInput{
+			{
+				Code: domain.FHIRCodeableConceptInput{
+					Coding: []*domain.FHIRCodingInput{
+						{
+							System:  &system,
+							Code:    scalarutils.Code("percentile"),
+							Display: "Percentile",
+						},
+					},
+					Text: "Percentile",
+				},
+				ValueQuantity: &domain.FHIRQuantityInput{
+					Value:  result.score.Percentile,
+					Unit:   "%",
+					System: scalarutils.URI(ucumSystem),
+					Code:   scalarutils.Code("%"),
+				},
+			},
+		}
+	case result.classification != nil:
+		classification := result.classification.String()
+		observation.ValueString = &classification
+	}
+
+	for _, mutator := range []ObservationInputMutatorFunc{addObservationCategory("exam"), addObservationDerivedFrom(result.derivedFrom...)} {
+		err := mutator(ctx, observation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return observation, nil
+}
+
+func mapGrowthCurveToGrowthCurveDTO(curve growthstandards.Curve) *dto.GrowthCurve {
+	output := &dto.GrowthCurve{
+		Measure: curve.Measure,
+		Points:  []*dto.GrowthCurvePoint{},
+	}
+
+	for _, point := range curve.Points {
+		output.Points = append(output.Points, &dto.GrowthCurvePoint{
+			X:      point.X,
+			SD3Neg: point.SD3Neg,
+			SD2Neg: point.SD2Neg,
+			SD1Neg: point.SD1Neg,
+			Median: point.Median,
+			SD1:    point.SD1,
+			SD2:    point.SD2,
+			SD3:    point.SD3,
+		})
+	}
+
+	return output
+}
+
+// growthMeasurement plots a score on a growth chart. The value is the weight for weight-for-age and weight-for-height,
+// and the length or height for height-for-age
+func growthMeasurement(encounterID string, indicator dto.GrowthIndicatorEnum, score growthstandards.Score, sources []domain.FHIRObservation) *dto.GrowthMeasurement {
+	flag := growthInterpretation(score.ZScore)
+
+	measurement := &dto.GrowthMeasurement{
+		ObservationIDs: []string{},
+		EncounterID:    encounterID,
+		Measure:        score.Measure,
+		X:              score.X,
+		ZScore:         score.ZScore,
+		Percentile:     score.Percentile,
+		Flag:           &flag,
+	}
+
+	valueConcept := common.WeightCIELTerminologyCode
+	if indicator == dto.GrowthIndicatorHeightForAge {
+		valueConcept = common.HeightCIELTerminologyCode
+	}
+
+	var recorded time.Time
+
+	for _, source := range sources {
+		measurement.ObservationIDs = append(measurement.ObservationIDs, *source.ID)
+
+		if value, ok := measurementValue(source, valueConcept); ok {
+			measurement.Value = value
+		}
+
+		if taken := observationTime(source); taken.After(recorded) {
+			recorded = taken
+			measurement.TimeRecorded = taken.Format(time.RFC3339)
+		}
+	}
+
+	return measurement
+}
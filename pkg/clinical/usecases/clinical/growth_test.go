@@ -0,0 +1,526 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+// fakeChild is a boy born on a date
+func fakeChild(birthDate time.Time) func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+	return func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+		gender := domain.PatientGenderEnumMale
+
+		return &domain.FHIRPatientRelayPayload{
+			Resource: &domain.FHIRPatient{
+				ID:     &id,
+				Gender: &gender,
+				BirthDate: &scalarutils.Date{
+					Year:  birthDate.Year(),
+					Month: int(birthDate.Month()),
+					Day:   birthDate.Day(),
+				},
+			},
+		}, nil
+	}
+}
+
+// fakeGrowthResult is a z-score or MUAC classification derived in an encounter
+func fakeGrowthResult(code string) domain.FHIRObservation {
+	result := fakeMeasurement(code, 0, time.Now())
+	system := scalarutils.URI(common.GrowthIndicatorSystem)
+	result.Code.Coding[0].System = &system
+
+	return result
+}
+
+func growthResultCode(input domain.FHIRObservationInput) string {
+	if input.Code == nil || len(input.Code.Coding) == 0 || input.Code.Coding[0].System == nil {
+		return ""
+	}
+
+	if string(*input.Code.Coding[0].System) != common.GrowthIndicatorSystem {
+		return ""
+	}
+
+	return string(input.Code.Coding[0].Code)
+}
+
+func TestUseCasesClinicalImpl_RecordWeight_DeriveGrowth(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.ObservationInput
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantCreated []string
+		wantUpdated []string
+		wantFlag    string
+		wantErr     bool
+	}{
+		{
+			name: "Happy case: score the growth of a child",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "9.6",
+				},
+			},
+			wantCreated: []string{"weight-for-age", "height-for-age", "weight-for-height"},
+			wantFlag:    "Normal",
+			wantErr:     false,
+		},
+		{
+			name: "Happy case: update the growth scores derived in the encounter",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "9.6",
+				},
+			},
+			wantCreated: []string{"height-for-age", "weight-for-height"},
+			wantUpdated: []string{"weight-for-age"},
+			wantFlag:    "Normal",
+			wantErr:     false,
+		},
+		{
+			name: "Happy case: flag a severely underweight child",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "6",
+				},
+			},
+			wantCreated: []string{"weight-for-age", "height-for-age", "weight-for-height"},
+			wantFlag:    "Critical low",
+			wantErr:     false,
+		},
+		{
+			name: "Happy case: no growth scores for an adult",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "70",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: record the weight when growth can not be scored",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "9.6",
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name != "Happy case: no growth scores for an adult" {
+				fakeFHIR.MockGetFHIRPatientFn = fakeChild(time.Now().AddDate(-1, 0, 0))
+			}
+
+			weightValue := 9.6
+			if tt.name == "Happy case: flag a severely underweight child" {
+				weightValue = 6
+			}
+
+			height := fakeMeasurement(common.HeightCIELTerminologyCode, 75.7, time.Now().Add(-time.Hour))
+			weight := fakeMeasurement(common.WeightCIELTerminologyCode, weightValue, time.Now())
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				code, _ := searchParameters["code"].(string)
+
+				switch {
+				case strings.HasPrefix(code, common.GrowthIndicatorSystem):
+					if tt.name == "Happy case: record the weight when growth can not be scored" {
+						return nil, fmt.Errorf("an error occurred")
+					}
+
+					if tt.name == "Happy case: update the growth scores derived in the encounter" {
+						return &domain.PagedFHIRObservations{Observations: []domain.FHIRObservation{fakeGrowthResult("weight-for-age")}}, nil
+					}
+
+					return &domain.PagedFHIRObservations{}, nil
+				case code == common.BMICIELTerminologyCode:
+					return &domain.PagedFHIRObservations{}, nil
+				}
+
+				return &domain.PagedFHIRObservations{Observations: []domain.FHIRObservation{height, weight}}, nil
+			}
+
+			created, updated := []string{}, []string{}
+			flag := ""
+
+			createObservation := fakeFHIR.MockCreateFHIRObservationFn
+			fakeFHIR.MockCreateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				if code := growthResultCode(input); code != "" {
+					created = append(created, code)
+
+					if code == "weight-for-age" {
+						flag = input.Interpretation[0].Text
+
+						if len(input.DerivedFrom) != 1 || *input.DerivedFrom[0].ID != *weight.ID {
+							t.Errorf("expected weight-for-age to be derived from the weight")
+						}
+					}
+				}
+
+				return createObservation(ctx, input)
+			}
+
+			updateObservation := fakeFHIR.MockUpdateFHIRObservationFn
+			fakeFHIR.MockUpdateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				if code := growthResultCode(input); code != "" {
+					updated = append(updated, code)
+
+					if code == "weight-for-age" {
+						flag = input.Interpretation[0].Text
+					}
+				}
+
+				return updateObservation(ctx, input)
+			}
+
+			got, err := u.RecordWeight(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RecordWeight() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got == nil {
+				t.Errorf("expected an observation")
+			}
+
+			if fmt.Sprint(created) != fmt.Sprint(tt.wantCreated) {
+				t.Errorf("expected growth results %v to be created but got %v", tt.wantCreated, created)
+			}
+
+			if fmt.Sprint(updated) != fmt.Sprint(tt.wantUpdated) {
+				t.Errorf("expected growth results %v to be updated but got %v", tt.wantUpdated, updated)
+			}
+
+			if flag != tt.wantFlag {
+				t.Errorf("expected weight-for-age to be flagged %q but got %q", tt.wantFlag, flag)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_RecordMuac_ClassifyMalnutrition(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.ObservationInput
+	}
+	tests := []struct {
+		name               string
+		args               args
+		wantClassification string
+		wantFlag           string
+		wantErr            bool
+	}{
+		{
+			name: "Happy case: classify severe acute malnutrition",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "11",
+				},
+			},
+			wantClassification: "SAM",
+			wantFlag:           "Critical low",
+			wantErr:            false,
+		},
+		{
+			name: "Happy case: classify moderate acute malnutrition",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "12",
+				},
+			},
+			wantClassification: "MAM",
+			wantFlag:           "Low",
+			wantErr:            false,
+		},
+		{
+			name: "Happy case: no classification for an adult",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "24",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: fail to record MUAC",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "11",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name != "Happy case: no classification for an adult" {
+				fakeFHIR.MockGetFHIRPatientFn = fakeChild(time.Now().AddDate(-2, 0, 0))
+			}
+
+			value, _ := strconv.ParseFloat(tt.args.input.Value, 64)
+
+			muac := fakeMeasurement(common.MuacCIELTerminologyCode, value, time.Now())
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				code, _ := searchParameters["code"].(string)
+				if strings.HasPrefix(code, common.GrowthIndicatorSystem) {
+					return &domain.PagedFHIRObservations{}, nil
+				}
+
+				return &domain.PagedFHIRObservations{Observations: []domain.FHIRObservation{muac}}, nil
+			}
+
+			classification, flag := "", ""
+
+			createObservation := fakeFHIR.MockCreateFHIRObservationFn
+			fakeFHIR.MockCreateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				if tt.name == "Sad case: fail to record MUAC" {
+					return nil, fmt.Errorf("an error occurred")
+				}
+
+				if growthResultCode(input) == common.MUACClassificationCode {
+					classification = *input.ValueString
+					flag = input.Interpretation[0].Text
+				}
+
+				return createObservation(ctx, input)
+			}
+
+			_, err := u.RecordMuac(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RecordMuac() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if classification != tt.wantClassification {
+				t.Errorf("expected MUAC to be classified %q but got %q", tt.wantClassification, classification)
+			}
+
+			if flag != tt.wantFlag {
+				t.Errorf("expected the classification to be flagged %q but got %q", tt.wantFlag, flag)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_GetPatientGrowthChart(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		patientID string
+		indicator dto.GrowthIndicatorEnum
+	}
+	tests := []struct {
+		name             string
+		args             args
+		wantMeasurements int
+		wantErr          bool
+	}{
+		{
+			name: "Happy case: plot weight-for-age",
+			args: args{
+				ctx:       context.Background(),
+				patientID: uuid.New().String(),
+				indicator: dto.GrowthIndicatorWeightForAge,
+			},
+			wantMeasurements: 2,
+			wantErr:          false,
+		},
+		{
+			name: "Happy case: plot weight-for-height from the encounters with both measurements",
+			args: args{
+				ctx:       context.Background(),
+				patientID: uuid.New().String(),
+				indicator: dto.GrowthIndicatorWeightForHeight,
+			},
+			wantMeasurements: 1,
+			wantErr:          false,
+		},
+		{
+			name: "Sad case: invalid patient id",
+			args: args{
+				ctx:       context.Background(),
+				patientID: "invalid",
+				indicator: dto.GrowthIndicatorWeightForAge,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid indicator",
+			args: args{
+				ctx:       context.Background(),
+				patientID: uuid.New().String(),
+				indicator: dto.GrowthIndicatorEnum("INVALID"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: patient without a birth date",
+			args: args{
+				ctx:       context.Background(),
+				patientID: uuid.New().String(),
+				indicator: dto.GrowthIndicatorWeightForAge,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to get patient",
+			args: args{
+				ctx:       context.Background(),
+				patientID: uuid.New().String(),
+				indicator: dto.GrowthIndicatorWeightForAge,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: fail to search observations",
+			args: args{
+				ctx:       context.Background(),
+				patientID: uuid.New().String(),
+				indicator: dto.GrowthIndicatorWeightForAge,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeFHIR.MockGetFHIRPatientFn = fakeChild(time.Now().AddDate(-1, 0, 0))
+
+			// the child was weighed at six months, and weighed and measured at a year
+			earlier := fakeMeasurement(common.WeightCIELTerminologyCode, 7.9, time.Now().AddDate(0, -6, 0))
+			weight := fakeMeasurement(common.WeightCIELTerminologyCode, 9.6, time.Now())
+			height := fakeMeasurement(common.HeightCIELTerminologyCode, 75.7, time.Now())
+			height.Encounter = weight.Encounter
+
+			if tt.name == "Sad case: patient without a birth date" {
+				fakeFHIR.MockGetFHIRPatientFn = func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+					return &domain.FHIRPatientRelayPayload{Resource: &domain.FHIRPatient{ID: &id}}, nil
+				}
+			}
+
+			if tt.name == "Sad case: fail to get patient" {
+				fakeFHIR.MockGetFHIRPatientFn = func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				if tt.name == "Sad case: fail to search observations" {
+					return nil, fmt.Errorf("an error occurred")
+				}
+
+				return &domain.PagedFHIRObservations{Observations: []domain.FHIRObservation{weight, height, earlier}}, nil
+			}
+
+			got, err := u.GetPatientGrowthChart(tt.args.ctx, tt.args.patientID, tt.args.indicator)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.GetPatientGrowthChart() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if len(got.Curves) == 0 {
+				t.Errorf("expected the z-score lines of the standards")
+			}
+
+			if len(got.Measurements) != tt.wantMeasurements {
+				t.Errorf("expected %d measurements but got %d", tt.wantMeasurements, len(got.Measurements))
+				return
+			}
+
+			if tt.name == "Happy case: plot weight-for-age" && got.Measurements[0].Value != 7.9 {
+				t.Errorf("expected the oldest measurement first but got %v", got.Measurements[0].Value)
+			}
+
+			if tt.name == "Happy case: plot weight-for-height from the encounters with both measurements" && len(got.Measurements[0].ObservationIDs) != 2 {
+				t.Errorf("expected weight-for-height to be plotted from the weight and height, got %v", got.Measurements[0].ObservationIDs)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/immunizationschedule"
+	"github.com/savannahghi/scalarutils"
+)
+
+// RecordImmunization records a vaccine given to the patient of an encounter.
+// The vaccine is coded through OCL so that it can be matched against the immunization schedule
+func (c *UseCasesClinicalImpl) RecordImmunization(ctx context.Context, input dto.ImmunizationInput) (*dto.Immunization, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	encounter, err := c.infrastructure.FHIR.GetFHIREncounter(ctx, input.EncounterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if encounter.Resource.Status == domain.EncounterStatusEnumFinished {
+		return nil, fmt.Errorf("cannot record an immunization in a finished encounter")
+	}
+
+	vaccineConcept, err := c.GetConceptWithMappings(ctx, input.TerminologySource, input.VaccineCode)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	patientID := *encounter.Resource.Subject.ID
+	patientReference := fmt.Sprintf("Patient/%s", patientID)
+	encounterReference := fmt.Sprintf("Encounter/%s", *encounter.Resource.ID)
+	status := scalarutils.Code(dto.ImmunizationStatusCompleted.Code())
+	recorded := time.Now().Format(time.RFC3339)
+	primarySource := !input.Reported
+
+	occurrence := recorded
+	if input.OccurrenceDate != nil {
+		occurrence = input.OccurrenceDate.AsTime().Format(time.DateOnly)
+	}
+
+	immunization := domain.FHIRImmunization{
+		Status: &status,
+		VaccineCode: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  (*scalarutils.URI)(&vaccineConcept.URL),
+					Code:    (*scalarutils.Code)(&vaccineConcept.ID),
+					Display: vaccineConcept.DisplayName,
+				},
+			},
+			Text: vaccineConcept.DisplayName,
+		},
+		Patient: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+			Display:   encounter.Resource.Subject.Display,
+		},
+		Encounter: &domain.FHIRReference{
+			ID:        encounter.Resource.ID,
+			Reference: &encounterReference,
+		},
+		OccurrenceDateTime: &occurrence,
+		Recorded:           &recorded,
+		PrimarySource:      &primarySource,
+		Site:               immunizationSiteCodeableConcept(input.Site),
+		Route:              immunizationRouteCodeableConcept(input.Route),
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+	}
+
+	if input.LotNumber != "" {
+		immunization.LotNumber = &input.LotNumber
+	}
+
+	if input.DoseNumber != nil {
+		immunization.ProtocolApplied = []*domain.FHIRImmunizationProtocolApplied{
+			{
+				Series:                &vaccineConcept.DisplayName,
+				DoseNumberPositiveInt: input.DoseNumber,
+			},
+		}
+	}
+
+	// a reported vaccine was given elsewhere so the facility recording it is not its performer
+	if identifiers.FacilityID != "" && !input.Reported {
+		facilityReference := fmt.Sprintf("Organization/%s", identifiers.FacilityID)
+		immunization.Performer = []*domain.FHIRImmunizationPerformer{
+			{
+				Actor: &domain.FHIRReference{
+					ID:        &identifiers.FacilityID,
+					Reference: &facilityReference,
+				},
+			},
+		}
+	}
+
+	if input.Note != "" {
+		immunization.Note = []*domain.FHIRAnnotation{
+			{
+				Text: (*scalarutils.Markdown)(&input.Note),
+			},
+		}
+	}
+
+	resource, err := c.infrastructure.FHIR.CreateFHIRImmunization(ctx, immunization)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRImmunizationToDTO(*resource), nil
+}
+
+// ListPatientImmunizations lists the vaccines given to a patient, most recent first
+func (c *UseCasesClinicalImpl) ListPatientImmunizations(ctx context.Context, patientID string, pagination dto.Pagination) (*dto.ImmunizationConnection, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"patient": fmt.Sprintf("Patient/%s", patientID),
+		"_sort":   "-date",
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRImmunization(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	immunizations := []*dto.Immunization{}
+
+	for _, resource := range resources.Immunizations {
+		immunizations = append(immunizations, mapFHIRImmunizationToDTO(resource))
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreateImmunizationConnection(immunizations, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
+
+// PatientImmunizationRecommendations evaluates the national immunization schedule against the patient's date of birth
+// and immunization history, returning the vaccine series that are due, overdue, upcoming or complete.
+// The history covers every facility of the organisation and doses are matched on the CIEL and CVX codes the schedule lists
+func (c *UseCasesClinicalImpl) PatientImmunizationRecommendations(ctx context.Context, patientID string) ([]*dto.ImmunizationRecommendation, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	patient, err := c.infrastructure.FHIR.GetFHIRPatient(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if patient.Resource.BirthDate == nil {
+		return nil, fmt.Errorf("the patient's date of birth is required to evaluate the immunization schedule")
+	}
+
+	sex := ""
+	if patient.Resource.Gender != nil {
+		sex = string(*patient.Resource.Gender)
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"patient": fmt.Sprintf("Patient/%s", patientID),
+		"status":  dto.ImmunizationStatusCompleted.Code(),
+	}
+
+	// doses given at any of the organisation's facilities count towards the patient's series
+	resources, err := c.infrastructure.FHIR.SearchFHIROrganisationImmunization(ctx, params, identifiers.OrganizationID, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	history := []immunizationschedule.AdministeredDose{}
+
+	for _, resource := range resources.Immunizations {
+		date, ok := immunizationDate(resource)
+		if !ok {
+			continue
+		}
+
+		history = append(history, immunizationschedule.AdministeredDose{
+			Concepts: immunizationVaccineKeys(resource),
+			Date:     date,
+		})
+	}
+
+	results := c.infrastructure.Immunizations.Evaluate(patient.Resource.BirthDate.AsTime(), sex, history, time.Now())
+
+	recommendations := []*dto.ImmunizationRecommendation{}
+
+	for _, result := range results {
+		recommendations = append(recommendations, mapImmunizationRecommendationToDTO(result))
+	}
+
+	return recommendations, nil
+}
@@ -0,0 +1,226 @@
+package clinical
+
+import (
+	"strings"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/immunizationschedule"
+	"github.com/savannahghi/scalarutils"
+)
+
+const (
+	// actSiteSystem is the HL7 v3 code system of the body sites a vaccine is administered into
+	actSiteSystem = "http://terminology.hl7.org/CodeSystem/v3-ActSite"
+
+	// cvxSystem is the CDC code system of vaccines administered
+	cvxSystem = "http://hl7.org/fhir/sid/cvx"
+)
+
+var allImmunizationSites = []dto.ImmunizationSiteEnum{
+	dto.ImmunizationSiteLeftArm,
+	dto.ImmunizationSiteRightArm,
+	dto.ImmunizationSiteLeftDeltoid,
+	dto.ImmunizationSiteRightDeltoid,
+	dto.ImmunizationSiteLeftThigh,
+	dto.ImmunizationSiteRightThigh,
+	dto.ImmunizationSiteLeftVastusLateralis,
+	dto.ImmunizationSiteRightVastusLateralis,
+}
+
+func immunizationSiteCodeableConcept(site *dto.ImmunizationSiteEnum) *domain.FHIRCodeableConcept {
+	if site == nil {
+		return nil
+	}
+
+	system := scalarutils.URI(actSiteSystem)
+	code := scalarutils.Code(site.Code())
+
+	return &domain.FHIRCodeableConcept{
+		Coding: []*domain.FHIRCoding{
+			{
+				System:  &system,
+				Code:    &code,
+				Display: site.Display(),
+			},
+		},
+		Text: site.Display(),
+	}
+}
+
+func immunizationRouteCodeableConcept(route *dto.MedicationRouteEnum) *domain.FHIRCodeableConcept {
+	if route == nil {
+		return nil
+	}
+
+	system := scalarutils.URI(snomedCTSystem)
+	code := scalarutils.Code(route.Code())
+
+	return &domain.FHIRCodeableConcept{
+		Coding: []*domain.FHIRCoding{
+			{
+				System:  &system,
+				Code:    &code,
+				Display: route.Display(),
+			},
+		},
+		Text: route.Display(),
+	}
+}
+
+func immunizationSiteFromCode(code string) dto.ImmunizationSiteEnum {
+	for _, site := range allImmunizationSites {
+		if site.Code() == code {
+			return site
+		}
+	}
+
+	return ""
+}
+
+// immunizationStatus converts a FHIR immunization status code e.g `not-done` to its enum
+func immunizationStatus(resource domain.FHIRImmunization) dto.ImmunizationStatusEnum {
+	if resource.Status == nil {
+		return ""
+	}
+
+	return dto.ImmunizationStatusEnum(strings.ToUpper(strings.ReplaceAll(string(*resource.Status), "-", "_")))
+}
+
+// immunizationDate returns the date a vaccine was given. Reported immunizations are usually recorded with only a date
+func immunizationDate(resource domain.FHIRImmunization) (time.Time, bool) {
+	if resource.OccurrenceDateTime == nil {
+		return time.Time{}, false
+	}
+
+	date, err := time.Parse(time.RFC3339, *resource.OccurrenceDateTime)
+	if err == nil {
+		return date, true
+	}
+
+	date, err = time.Parse(time.DateOnly, *resource.OccurrenceDateTime)
+	if err == nil {
+		return date, true
+	}
+
+	return time.Time{}, false
+}
+
+// immunizationVaccineKeys returns the concept keys e.g `CIEL:886` or `CVX:19` of the codings of an immunization's vaccine
+func immunizationVaccineKeys(resource domain.FHIRImmunization) []string {
+	keys := []string{}
+
+	if resource.VaccineCode == nil {
+		return keys
+	}
+
+	for _, coding := range resource.VaccineCode.Coding {
+		if coding == nil || coding.System == nil || coding.Code == nil {
+			continue
+		}
+
+		source := sourceFromConceptURL(string(*coding.System))
+		if string(*coding.System) == cvxSystem {
+			source = "CVX"
+		}
+
+		if source == "" {
+			continue
+		}
+
+		keys = append(keys, conceptKey(source, string(*coding.Code)))
+	}
+
+	return keys
+}
+
+func mapFHIRImmunizationToDTO(resource domain.FHIRImmunization) *dto.Immunization {
+	output := &dto.Immunization{
+		Status: immunizationStatus(resource),
+	}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.Patient != nil && resource.Patient.ID != nil {
+		output.PatientID = *resource.Patient.ID
+	}
+
+	if resource.Encounter != nil && resource.Encounter.ID != nil {
+		output.EncounterID = *resource.Encounter.ID
+	}
+
+	if resource.VaccineCode != nil {
+		output.Vaccine.Name = resource.VaccineCode.Text
+
+		if len(resource.VaccineCode.Coding) > 0 && resource.VaccineCode.Coding[0].Code != nil {
+			output.Vaccine.Code = string(*resource.VaccineCode.Coding[0].Code)
+		}
+	}
+
+	if resource.OccurrenceDateTime != nil {
+		occurrence := scalarutils.DateTime(*resource.OccurrenceDateTime)
+		output.OccurrenceDateTime = &occurrence
+	}
+
+	if resource.PrimarySource != nil {
+		output.Reported = !*resource.PrimarySource
+	}
+
+	if resource.LotNumber != nil {
+		output.LotNumber = *resource.LotNumber
+	}
+
+	if resource.Site != nil && len(resource.Site.Coding) > 0 && resource.Site.Coding[0].Code != nil {
+		output.Site = immunizationSiteFromCode(string(*resource.Site.Coding[0].Code))
+	}
+
+	if resource.Route != nil && len(resource.Route.Coding) > 0 && resource.Route.Coding[0].Code != nil {
+		output.Route = medicationRouteFromCode(string(*resource.Route.Coding[0].Code))
+	}
+
+	for _, protocol := range resource.ProtocolApplied {
+		if protocol != nil && protocol.DoseNumberPositiveInt != nil {
+			output.DoseNumber = protocol.DoseNumberPositiveInt
+
+			break
+		}
+	}
+
+	if len(resource.Note) > 0 && resource.Note[0].Text != nil {
+		output.Note = string(*resource.Note[0].Text)
+	}
+
+	return output
+}
+
+func mapImmunizationRecommendationToDTO(recommendation immunizationschedule.Recommendation) *dto.ImmunizationRecommendation {
+	output := &dto.ImmunizationRecommendation{
+		VaccineCode: recommendation.Vaccine.Code,
+		VaccineName: recommendation.Vaccine.Name,
+		Status:      recommendation.Status,
+		DoseNumber:  recommendation.DoseNumber,
+		SeriesDoses: len(recommendation.Vaccine.Doses),
+		DosesGiven:  recommendation.DosesGiven,
+	}
+
+	if recommendation.DueDate != nil {
+		output.DueDate = timeToDate(*recommendation.DueDate)
+	}
+
+	if recommendation.LastDoseDate != nil {
+		output.LastDoseDate = timeToDate(*recommendation.LastDoseDate)
+	}
+
+	return output
+}
+
+func timeToDate(date time.Time) *scalarutils.Date {
+	return &scalarutils.Date{
+		Year:  date.Year(),
+		Month: int(date.Month()),
+		Day:   date.Day(),
+	}
+}
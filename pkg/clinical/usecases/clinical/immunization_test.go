@@ -0,0 +1,522 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+func TestUseCasesClinicalImpl_RecordImmunization(t *testing.T) {
+	site := dto.ImmunizationSiteLeftArm
+	invalidSite := dto.ImmunizationSiteEnum("LEFT_EAR")
+	route := dto.MedicationRouteIntradermal
+	doseNumber := 1
+	lastMonth := time.Now().AddDate(0, -1, 0)
+	nextMonth := time.Now().AddDate(0, 1, 0)
+
+	type args struct {
+		ctx   context.Context
+		input dto.ImmunizationInput
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantReported bool
+		wantErr      bool
+	}{
+		{
+			name: "Happy case: record an immunization given at the facility",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ImmunizationInput{
+					EncounterID:       gofakeit.UUID(),
+					VaccineCode:       "886",
+					TerminologySource: dto.TerminologySourceCIEL,
+					LotNumber:         "BCG-2024-001",
+					Site:              &site,
+					Route:             &route,
+					DoseNumber:        &doseNumber,
+					Note:              "No adverse reaction after 15 minutes",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: record an immunization from a child health card",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ImmunizationInput{
+					EncounterID:       gofakeit.UUID(),
+					VaccineCode:       "783",
+					TerminologySource: dto.TerminologySourceCIEL,
+					OccurrenceDate: &scalarutils.Date{
+						Year:  lastMonth.Year(),
+						Month: int(lastMonth.Month()),
+						Day:   lastMonth.Day(),
+					},
+					Reported: true,
+				},
+			},
+			wantReported: true,
+			wantErr:      false,
+		},
+		{
+			name: "Sad case: missing vaccine code",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ImmunizationInput{
+					EncounterID:       gofakeit.UUID(),
+					TerminologySource: dto.TerminologySourceCIEL,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid site",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ImmunizationInput{
+					EncounterID:       gofakeit.UUID(),
+					VaccineCode:       "886",
+					TerminologySource: dto.TerminologySourceCIEL,
+					Site:              &invalidSite,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: immunization in the future",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ImmunizationInput{
+					EncounterID:       gofakeit.UUID(),
+					VaccineCode:       "886",
+					TerminologySource: dto.TerminologySourceCIEL,
+					OccurrenceDate: &scalarutils.Date{
+						Year:  nextMonth.Year(),
+						Month: int(nextMonth.Month()),
+						Day:   nextMonth.Day(),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: finished encounter",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ImmunizationInput{
+					EncounterID:       gofakeit.UUID(),
+					VaccineCode:       "886",
+					TerminologySource: dto.TerminologySourceCIEL,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get encounter",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ImmunizationInput{
+					EncounterID:       gofakeit.UUID(),
+					VaccineCode:       "886",
+					TerminologySource: dto.TerminologySourceCIEL,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get vaccine concept",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ImmunizationInput{
+					EncounterID:       gofakeit.UUID(),
+					VaccineCode:       "886",
+					TerminologySource: dto.TerminologySourceCIEL,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create immunization",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ImmunizationInput{
+					EncounterID:       gofakeit.UUID(),
+					VaccineCode:       "886",
+					TerminologySource: dto.TerminologySourceCIEL,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: finished encounter" {
+				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+					patientID := gofakeit.UUID()
+
+					return &domain.FHIREncounterRelayPayload{
+						Resource: &domain.FHIREncounter{
+							ID:     &id,
+							Status: domain.EncounterStatusEnumFinished,
+							Subject: &domain.FHIRReference{
+								ID: &patientID,
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get encounter" {
+				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get vaccine concept" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create immunization" {
+				fakeFHIR.MockCreateFHIRImmunizationFn = func(ctx context.Context, input domain.FHIRImmunization) (*domain.FHIRImmunization, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.RecordImmunization(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RecordImmunization() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Status != dto.ImmunizationStatusCompleted {
+				t.Errorf("expected the immunization to be completed, got %v", got.Status)
+			}
+
+			if got.Reported != tt.wantReported {
+				t.Errorf("expected reported to be %v, got %v", tt.wantReported, got.Reported)
+			}
+
+			if tt.args.input.Site != nil && got.Site != *tt.args.input.Site {
+				t.Errorf("expected the immunization site to be %v, got %v", *tt.args.input.Site, got.Site)
+			}
+
+			if tt.args.input.Route != nil && got.Route != *tt.args.input.Route {
+				t.Errorf("expected the immunization route to be %v, got %v", *tt.args.input.Route, got.Route)
+			}
+
+			if tt.args.input.DoseNumber != nil && (got.DoseNumber == nil || *got.DoseNumber != *tt.args.input.DoseNumber) {
+				t.Errorf("expected dose number %d, got %v", *tt.args.input.DoseNumber, got.DoseNumber)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListPatientImmunizations(t *testing.T) {
+	first := 10
+
+	type args struct {
+		ctx        context.Context
+		patientID  string
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list patient immunizations",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid patient id",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  "invalid",
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search immunizations",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to search immunizations" {
+				fakeFHIR.MockSearchFHIRImmunizationFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.ListPatientImmunizations(tt.args.ctx, tt.args.patientID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListPatientImmunizations() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.TotalCount != 1 || len(got.Edges) != 1 {
+				t.Errorf("expected one immunization, got %v", got)
+				return
+			}
+
+			immunization := got.Edges[0].Node
+			if immunization.Vaccine.Code != "886" || immunization.Site != dto.ImmunizationSiteLeftArm || immunization.Route != dto.MedicationRouteIntradermal {
+				t.Errorf("expected an intradermal BCG immunization in the left arm, got %v", immunization)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_PatientImmunizationRecommendations(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		patientID string
+	}
+	tests := []struct {
+		name string
+		args args
+		// wantStatuses maps vaccines expected in the recommendations to their status
+		wantStatuses map[string]dto.ImmunizationRecommendationStatusEnum
+		wantErr      bool
+	}{
+		{
+			name: "Happy case: recommendations for an infant",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantStatuses: map[string]dto.ImmunizationRecommendationStatusEnum{
+				"BCG":   dto.ImmunizationRecommendationStatusComplete,
+				"OPV":   dto.ImmunizationRecommendationStatusDue,
+				"PENTA": dto.ImmunizationRecommendationStatusDue,
+				"MR":    dto.ImmunizationRecommendationStatusUpcoming,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: dose recorded with a CVX code",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantStatuses: map[string]dto.ImmunizationRecommendationStatusEnum{
+				"BCG": dto.ImmunizationRecommendationStatusComplete,
+				"OPV": dto.ImmunizationRecommendationStatusDue,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid patient id",
+			args: args{
+				ctx:       context.Background(),
+				patientID: "invalid",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: patient without a date of birth",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get patient",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search immunizations",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			// the infant is seven weeks old and was given BCG at birth
+			birthDate := time.Now().AddDate(0, 0, -49)
+
+			fakeFHIR.MockGetFHIRPatientFn = func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+				gender := domain.PatientGenderEnumMale
+
+				return &domain.FHIRPatientRelayPayload{
+					Resource: &domain.FHIRPatient{
+						ID:     &id,
+						Gender: &gender,
+						BirthDate: &scalarutils.Date{
+							Year:  birthDate.Year(),
+							Month: int(birthDate.Month()),
+							Day:   birthDate.Day(),
+						},
+					},
+				}, nil
+			}
+
+			// the doses are matched on their codes without looking up their mappings
+			fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+				return nil, fmt.Errorf("unexpected concept lookup")
+			}
+
+			// the doses given at all the organisation's facilities count towards the series
+			fakeFHIR.MockSearchFHIRImmunizationFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error) {
+				return nil, fmt.Errorf("expected the doses given at all the organisation's facilities")
+			}
+
+			fakeFHIR.MockSearchFHIROrganisationImmunizationFn = func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error) {
+				if organisationID == "" {
+					return nil, fmt.Errorf("expected the organisation to search the doses of")
+				}
+
+				id := gofakeit.UUID()
+				system := scalarutils.URI("/orgs/CIEL/sources/CIEL/concepts/886/")
+				code := scalarutils.Code("886")
+
+				if tt.name == "Happy case: dose recorded with a CVX code" {
+					system = scalarutils.URI("http://hl7.org/fhir/sid/cvx")
+					code = scalarutils.Code("19")
+				}
+
+				occurrence := birthDate.Format(time.DateOnly)
+
+				return &domain.PagedFHIRImmunization{
+					Immunizations: []domain.FHIRImmunization{
+						{
+							ID: &id,
+							VaccineCode: &domain.FHIRCodeableConcept{
+								Coding: []*domain.FHIRCoding{
+									{
+										System: &system,
+										Code:   &code,
+									},
+								},
+							},
+							OccurrenceDateTime: &occurrence,
+						},
+					},
+				}, nil
+			}
+
+			if tt.name == "Sad case: patient without a date of birth" {
+				fakeFHIR.MockGetFHIRPatientFn = func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+					return &domain.FHIRPatientRelayPayload{
+						Resource: &domain.FHIRPatient{
+							ID: &id,
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get patient" {
+				fakeFHIR.MockGetFHIRPatientFn = func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to search immunizations" {
+				fakeFHIR.MockSearchFHIROrganisationImmunizationFn = func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRImmunization, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.PatientImmunizationRecommendations(tt.args.ctx, tt.args.patientID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.PatientImmunizationRecommendations() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			statuses := map[string]dto.ImmunizationRecommendationStatusEnum{}
+			for _, recommendation := range got {
+				statuses[recommendation.VaccineCode] = recommendation.Status
+			}
+
+			for vaccine, status := range tt.wantStatuses {
+				if statuses[vaccine] != status {
+					t.Errorf("expected %s to be %v, got %v", vaccine, status, statuses[vaccine])
+				}
+			}
+
+			if _, ok := statuses["HPV"]; ok {
+				t.Errorf("expected no HPV recommendation for a male patient")
+			}
+		})
+	}
+}
@@ -0,0 +1,539 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/application/utils"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+)
+
+const (
+	interactionFindingExtensionURL  = "http://savannahghi.org/fhir/StructureDefinition/interaction-finding"
+	interactionOverrideExtensionURL = "http://savannahghi.org/fhir/StructureDefinition/interaction-override-reason"
+
+	// conceptMappingsTTL is how long the OCL mappings of a concept are reused before they are fetched again
+	conceptMappingsTTL = time.Hour
+)
+
+// ongoingMedicationStatementStatuses are the medication statement statuses of medications the patient may still be taking.
+// Medication statements received from myCareHub are recorded with an unknown status
+var ongoingMedicationStatementStatuses = []domain.MedicationStatementStatusEnum{
+	domain.MedicationStatementStatusEnumActive,
+	domain.MedicationStatementStatusEnumIntended,
+	domain.MedicationStatementStatusEnumUnknown,
+}
+
+// interactingConcept is an allergy or medication of the patient that a new medication is checked against
+type interactingConcept struct {
+	name string
+	keys []string
+
+	// severe is set for allergies whose reactions are severe or whose criticality is high
+	severe bool
+}
+
+// CheckMedicationInteractions checks a medication against the patient's allergies and current medications.
+// The medication and the patient's records are expanded through their OCL mappings (e.g ingredients and drug class) before
+// being matched against each other and the interaction table
+func (c *UseCasesClinicalImpl) CheckMedicationInteractions(ctx context.Context, patientID string, terminologySource dto.TerminologySource, medicationCode string) ([]*dto.InteractionFinding, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	concept, err := c.GetConceptWithMappings(ctx, terminologySource, medicationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.medicationInteractions(ctx, patientID, conceptKeys(concept, string(terminologySource)))
+}
+
+// medicationInteractions checks a medication, identified by its concept keys, against the patient's allergies and current medications
+// in the tenant of the context
+func (c *UseCasesClinicalImpl) medicationInteractions(ctx context.Context, patientID string, medicationKeys []string) ([]*dto.InteractionFinding, error) {
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	return c.tenantMedicationInteractions(ctx, patientID, medicationKeys, *identifiers)
+}
+
+// tenantMedicationInteractions checks a medication, identified by its concept keys, against the patient's allergies and current
+// medications in a tenant. It is used where the context has no tenant e.g when processing pubsub messages
+func (c *UseCasesClinicalImpl) tenantMedicationInteractions(ctx context.Context, patientID string, medicationKeys []string, identifiers dto.TenantIdentifiers) ([]*dto.InteractionFinding, error) {
+	allergies, err := c.patientAllergyConcepts(ctx, patientID, identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	medications, err := c.patientMedicationConcepts(ctx, patientID, identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	findings := []*dto.InteractionFinding{}
+	seen := map[dto.InteractionFinding]bool{}
+
+	record := func(finding dto.InteractionFinding) {
+		if seen[finding] {
+			return
+		}
+
+		seen[finding] = true
+		findings = append(findings, &finding)
+	}
+
+	for _, allergy := range allergies {
+		if hasCommonKey(medicationKeys, allergy.keys) {
+			finding := dto.InteractionFinding{
+				Type:          dto.InteractionTypeDrugAllergy,
+				Severity:      dto.InteractionSeverityModerate,
+				Action:        dto.InteractionActionWarning,
+				Description:   fmt.Sprintf("the patient has a recorded allergy to %s", allergy.name),
+				InteractsWith: allergy.name,
+			}
+
+			if allergy.severe {
+				finding.Severity = dto.InteractionSeverityHigh
+				finding.Action = dto.InteractionActionHardStop
+			}
+
+			record(finding)
+		}
+
+		for _, rule := range c.infrastructure.Interactions.FindInteractions(medicationKeys, allergy.keys) {
+			record(dto.InteractionFinding{
+				Type:          dto.InteractionTypeDrugAllergy,
+				Severity:      rule.Severity,
+				Action:        rule.Action,
+				Description:   rule.Description,
+				InteractsWith: allergy.name,
+			})
+		}
+	}
+
+	for _, medication := range medications {
+		for _, rule := range c.infrastructure.Interactions.FindInteractions(medicationKeys, medication.keys) {
+			record(dto.InteractionFinding{
+				Type:          dto.InteractionTypeDrugDrug,
+				Severity:      rule.Severity,
+				Action:        rule.Action,
+				Description:   rule.Description,
+				InteractsWith: medication.name,
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+// patientAllergyConcepts returns the allergies of a patient that have not been refuted or resolved
+func (c *UseCasesClinicalImpl) patientAllergyConcepts(ctx context.Context, patientID string, identifiers dto.TenantIdentifiers) ([]interactingConcept, error) {
+	allergies, err := c.infrastructure.FHIR.SearchPatientAllergyIntolerance(ctx, fmt.Sprintf("Patient/%s", patientID), identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	concepts := []interactingConcept{}
+
+	for _, allergy := range allergies.Allergies {
+		if hasCode(allergy.ClinicalStatus, "inactive", "resolved") || hasCode(allergy.VerificationStatus, "refuted", "entered-in-error") {
+			continue
+		}
+
+		codes := []*domain.FHIRCodeableConcept{allergy.Code}
+		severe := allergy.Criticality == domain.AllergyIntoleranceCriticalityEnumHigh
+
+		for _, reaction := range allergy.Reaction {
+			if reaction == nil {
+				continue
+			}
+
+			codes = append(codes, reaction.Substance)
+
+			if reaction.Severity != nil && *reaction.Severity == domain.AllergyIntoleranceReactionSeverityEnumSevere {
+				severe = true
+			}
+		}
+
+		concept := c.codeableConceptsInteractingConcept(ctx, codes...)
+		if len(concept.keys) == 0 {
+			continue
+		}
+
+		concept.severe = severe
+		concepts = append(concepts, concept)
+	}
+
+	return concepts, nil
+}
+
+// patientMedicationConcepts returns the medications the patient is currently prescribed or reported to be taking
+func (c *UseCasesClinicalImpl) patientMedicationConcepts(ctx context.Context, patientID string, identifiers dto.TenantIdentifiers) ([]interactingConcept, error) {
+	requests, statements, err := c.patientCurrentMedications(ctx, patientID, identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	concepts := []interactingConcept{}
+
+	for _, request := range requests {
+		concept := c.codeableConceptsInteractingConcept(ctx, request.MedicationCodeableConcept)
+		if len(concept.keys) > 0 {
+			concepts = append(concepts, concept)
+		}
+	}
+
+	for _, statement := range statements {
+		concept := c.codeableConceptsInteractingConcept(ctx, statement.MedicationCodeableConcept)
+		if len(concept.keys) > 0 {
+			concepts = append(concepts, concept)
+		}
+	}
+
+	return concepts, nil
+}
+
+// patientCurrentMedications returns the patient's active prescriptions and the medication statements of medications they may still be taking
+func (c *UseCasesClinicalImpl) patientCurrentMedications(ctx context.Context, patientID string, identifiers dto.TenantIdentifiers) ([]domain.FHIRMedicationRequest, []domain.FHIRMedicationStatement, error) {
+	requests, err := c.infrastructure.FHIR.SearchFHIRMedicationRequest(
+		ctx,
+		map[string]interface{}{
+			"subject": fmt.Sprintf("Patient/%s", patientID),
+			"status":  dto.MedicationRequestStatusActive.Code(),
+		},
+		identifiers,
+		dto.Pagination{Skip: true},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := []string{}
+	for _, status := range ongoingMedicationStatementStatuses {
+		statuses = append(statuses, string(status))
+	}
+
+	connection, err := c.infrastructure.FHIR.SearchFHIRMedicationStatement(
+		ctx,
+		map[string]interface{}{
+			"subject": fmt.Sprintf("Patient/%s", patientID),
+			"status":  strings.Join(statuses, ","),
+		},
+		identifiers,
+		dto.Pagination{Skip: true},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statements := []domain.FHIRMedicationStatement{}
+
+	for _, edge := range connection.Edges {
+		if edge == nil || edge.Node == nil {
+			continue
+		}
+
+		statements = append(statements, *edge.Node)
+	}
+
+	return requests.MedicationRequests, statements, nil
+}
+
+// codeableConceptsInteractingConcept collects the concept keys of the codings of a recorded allergy or medication.
+// Failing to fetch the mappings of a coding is not fatal since the coding itself can still be matched.
+// The mappings are cached since every check expands the same allergies and medications
+func (c *UseCasesClinicalImpl) codeableConceptsInteractingConcept(ctx context.Context, codeableConcepts ...*domain.FHIRCodeableConcept) interactingConcept {
+	concept := interactingConcept{}
+
+	for _, codeableConcept := range codeableConcepts {
+		if codeableConcept == nil {
+			continue
+		}
+
+		if concept.name == "" {
+			concept.name = codeableConcept.Text
+		}
+
+		for _, coding := range codeableConcept.Coding {
+			if coding == nil || coding.Code == nil || coding.System == nil {
+				continue
+			}
+
+			source := sourceFromConceptURL(string(*coding.System))
+			if source == "" {
+				continue
+			}
+
+			if concept.name == "" {
+				concept.name = coding.Display
+			}
+
+			code := string(*coding.Code)
+			concept.keys = append(concept.keys, conceptKey(source, code))
+
+			if source != string(dto.TerminologySourceCIEL) {
+				continue
+			}
+
+			keys, err := c.cielMappingKeys(ctx, code)
+			if err != nil {
+				utils.ReportErrorToSentry(fmt.Errorf("unable to get the mappings of concept %s: %w", code, err))
+				continue
+			}
+
+			concept.keys = append(concept.keys, keys...)
+		}
+	}
+
+	return concept
+}
+
+// cielMappingKeys returns the keys of the concepts that a CIEL concept maps to, fetching its mappings from OCL on a cache miss
+func (c *UseCasesClinicalImpl) cielMappingKeys(ctx context.Context, code string) ([]string, error) {
+	keys, ok := c.conceptMappings.get(code, time.Now())
+	if ok {
+		return keys, nil
+	}
+
+	mapped, err := c.GetConceptWithMappings(ctx, dto.TerminologySourceCIEL, code)
+	if err != nil {
+		return nil, err
+	}
+
+	keys = mappingKeys(mapped)
+	c.conceptMappings.set(code, keys, time.Now())
+
+	return keys, nil
+}
+
+// conceptKeys returns the keys of a concept and the concepts it maps to, which are used to match it against the interaction table
+func conceptKeys(concept *domain.Concept, source string) []string {
+	if concept.Source != "" {
+		source = concept.Source
+	}
+
+	return append([]string{conceptKey(source, concept.ID)}, mappingKeys(concept)...)
+}
+
+// mappingKeys returns the keys of the concepts that a concept maps to
+func mappingKeys(concept *domain.Concept) []string {
+	keys := []string{}
+
+	for _, mapping := range concept.Mappings {
+		if mapping == nil || mapping.ToSourceName == "" || mapping.ToConceptCode == "" {
+			continue
+		}
+
+		keys = append(keys, conceptKey(mapping.ToSourceName, mapping.ToConceptCode))
+	}
+
+	return keys
+}
+
+func conceptKey(source, code string) string {
+	return strings.ToUpper(fmt.Sprintf("%s:%s", source, code))
+}
+
+// sourceFromConceptURL extracts the source from an OCL concept URL e.g `/orgs/CIEL/sources/CIEL/concepts/1234/`
+func sourceFromConceptURL(url string) string {
+	parts := strings.Split(strings.Trim(url, "/"), "/")
+
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "sources" {
+			return parts[i+1]
+		}
+	}
+
+	return ""
+}
+
+func hasCommonKey(first, second []string) bool {
+	keys := map[string]bool{}
+	for _, key := range first {
+		keys[key] = true
+	}
+
+	for _, key := range second {
+		if keys[key] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasCode(codeableConcept domain.FHIRCodeableConcept, codes ...string) bool {
+	for _, coding := range codeableConcept.Coding {
+		if coding == nil || coding.Code == nil {
+			continue
+		}
+
+		for _, code := range codes {
+			if string(*coding.Code) == code {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hardStops returns the findings that prevent a medication from being given without an override
+func hardStops(findings []*dto.InteractionFinding) []string {
+	descriptions := []string{}
+
+	for _, finding := range findings {
+		if finding.Action == dto.InteractionActionHardStop {
+			descriptions = append(descriptions, finding.Description)
+		}
+	}
+
+	return descriptions
+}
+
+// composeInteractionExtensions records interaction findings, and the reason they were overridden, on a medication resource
+func composeInteractionExtensions(findings []*dto.InteractionFinding, overrideReason *string) []*domain.FHIRExtension {
+	extensions := []*domain.FHIRExtension{}
+
+	for _, finding := range findings {
+		extensions = append(extensions, &domain.FHIRExtension{
+			URL: interactionFindingExtensionURL,
+			Extension: []domain.Extension{
+				{
+					URL:       "type",
+					ValueCode: finding.Type.String(),
+				},
+				{
+					URL:       "severity",
+					ValueCode: finding.Severity.String(),
+				},
+				{
+					URL:       "action",
+					ValueCode: finding.Action.String(),
+				},
+				{
+					URL:         "description",
+					ValueString: finding.Description,
+				},
+				{
+					URL:         "interactsWith",
+					ValueString: finding.InteractsWith,
+				},
+			},
+		})
+	}
+
+	if overrideReason != nil && *overrideReason != "" {
+		extensions = append(extensions, &domain.FHIRExtension{
+			URL: interactionOverrideExtensionURL,
+			Extension: []domain.Extension{
+				{
+					URL:         "reason",
+					ValueString: *overrideReason,
+				},
+			},
+		})
+	}
+
+	return extensions
+}
+
+// mapInteractionExtensions reads back the interaction findings and override reason recorded on a medication resource
+func mapInteractionExtensions(extensions []*domain.FHIRExtension) ([]dto.InteractionFinding, string) {
+	findings := []dto.InteractionFinding{}
+	overrideReason := ""
+
+	for _, extension := range extensions {
+		if extension == nil {
+			continue
+		}
+
+		switch extension.URL {
+		case interactionFindingExtensionURL:
+			finding := dto.InteractionFinding{}
+
+			for _, ext := range extension.Extension {
+				switch ext.URL {
+				case "type":
+					finding.Type = dto.InteractionTypeEnum(ext.ValueCode)
+				case "severity":
+					finding.Severity = dto.InteractionSeverityEnum(ext.ValueCode)
+				case "action":
+					finding.Action = dto.InteractionActionEnum(ext.ValueCode)
+				case "description":
+					finding.Description = ext.ValueString
+				case "interactsWith":
+					finding.InteractsWith = ext.ValueString
+				}
+			}
+
+			findings = append(findings, finding)
+
+		case interactionOverrideExtensionURL:
+			for _, ext := range extension.Extension {
+				if ext.URL == "reason" {
+					overrideReason = ext.ValueString
+				}
+			}
+		}
+	}
+
+	return findings, overrideReason
+}
+
+// conceptMappingsCache holds the mapping keys of CIEL concepts so that interaction checks only call OCL
+// for concepts they have not seen recently
+type conceptMappingsCache struct {
+	mu      sync.RWMutex
+	entries map[string]conceptMappingsEntry
+}
+
+type conceptMappingsEntry struct {
+	keys    []string
+	expires time.Time
+}
+
+func newConceptMappingsCache() *conceptMappingsCache {
+	return &conceptMappingsCache{
+		entries: map[string]conceptMappingsEntry{},
+	}
+}
+
+func (m *conceptMappingsCache) get(code string, now time.Time) ([]string, bool) {
+	if m == nil {
+		return nil, false
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entry, ok := m.entries[code]
+	if !ok || !now.Before(entry.expires) {
+		return nil, false
+	}
+
+	return entry.keys, true
+}
+
+func (m *conceptMappingsCache) set(code string, keys []string, now time.Time) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[code] = conceptMappingsEntry{
+		keys:    keys,
+		expires: now.Add(conceptMappingsTTL),
+	}
+}
@@ -0,0 +1,272 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+func TestUseCasesClinicalImpl_CheckMedicationInteractions(t *testing.T) {
+	type args struct {
+		ctx               context.Context
+		patientID         string
+		terminologySource dto.TerminologySource
+		medicationCode    string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantAction dto.InteractionActionEnum
+		wantType   dto.InteractionTypeEnum
+		wantErr    bool
+	}{
+		{
+			name: "Happy case: no interactions",
+			args: args{
+				ctx:               context.Background(),
+				patientID:         gofakeit.UUID(),
+				terminologySource: dto.TerminologySourceCIEL,
+				medicationCode:    "71160",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: medication the patient is severely allergic to",
+			args: args{
+				ctx:               context.Background(),
+				patientID:         gofakeit.UUID(),
+				terminologySource: dto.TerminologySourceCIEL,
+				medicationCode:    "124",
+			},
+			wantAction: dto.InteractionActionHardStop,
+			wantType:   dto.InteractionTypeDrugAllergy,
+			wantErr:    false,
+		},
+		{
+			name: "Happy case: medication interacts with a current medication",
+			args: args{
+				ctx:               context.Background(),
+				patientID:         gofakeit.UUID(),
+				terminologySource: dto.TerminologySourceCIEL,
+				medicationCode:    "80513",
+			},
+			wantAction: dto.InteractionActionWarning,
+			wantType:   dto.InteractionTypeDrugDrug,
+			wantErr:    false,
+		},
+		{
+			name: "Sad case: invalid patient id",
+			args: args{
+				ctx:               context.Background(),
+				patientID:         "invalid",
+				terminologySource: dto.TerminologySourceCIEL,
+				medicationCode:    "71160",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get medication concept",
+			args: args{
+				ctx:               context.Background(),
+				patientID:         gofakeit.UUID(),
+				terminologySource: dto.TerminologySourceCIEL,
+				medicationCode:    "71160",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search medication requests",
+			args: args{
+				ctx:               context.Background(),
+				patientID:         gofakeit.UUID(),
+				terminologySource: dto.TerminologySourceCIEL,
+				medicationCode:    "71160",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search medication statements",
+			args: args{
+				ctx:               context.Background(),
+				patientID:         gofakeit.UUID(),
+				terminologySource: dto.TerminologySourceCIEL,
+				medicationCode:    "71160",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+				return &domain.Concept{
+					ID:     concept,
+					Source: source,
+				}, nil
+			}
+
+			if tt.name == "Happy case: medication interacts with a current medication" {
+				// warfarin is prescribed while the patient is taking aspirin
+				ingredients := map[string]string{
+					"80513": "11289",
+					"71617": "1191",
+				}
+
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return &domain.Concept{
+						ID:     concept,
+						Source: source,
+						Mappings: []*domain.ConceptMapping{
+							{
+								MapType:         "SAME-AS",
+								FromConceptCode: concept,
+								ToConceptCode:   ingredients[concept],
+								ToSourceName:    "RxNorm",
+							},
+						},
+					}, nil
+				}
+
+				fakeFHIR.MockSearchFHIRMedicationStatementFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationStatementRelayConnection, error) {
+					system := scalarutils.URI("/orgs/CIEL/sources/CIEL/concepts/71617/")
+					code := scalarutils.Code("71617")
+
+					return &domain.FHIRMedicationStatementRelayConnection{
+						Edges: []*domain.FHIRMedicationStatementRelayEdge{
+							{
+								Node: &domain.FHIRMedicationStatement{
+									MedicationCodeableConcept: &domain.FHIRCodeableConcept{
+										Coding: []*domain.FHIRCoding{
+											{
+												System: &system,
+												Code:   &code,
+											},
+										},
+										Text: "Aspirin",
+									},
+								},
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get medication concept" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to search medication requests" {
+				fakeFHIR.MockSearchFHIRMedicationRequestFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationRequest, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to search medication statements" {
+				fakeFHIR.MockSearchFHIRMedicationStatementFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationStatementRelayConnection, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.CheckMedicationInteractions(tt.args.ctx, tt.args.patientID, tt.args.terminologySource, tt.args.medicationCode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.CheckMedicationInteractions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if tt.wantAction == "" {
+				if len(got) != 0 {
+					t.Errorf("expected no interactions, got %v", got)
+				}
+				return
+			}
+
+			if len(got) != 1 || got[0].Action != tt.wantAction || got[0].Type != tt.wantType {
+				t.Errorf("expected a %v %v interaction, got %v", tt.wantType, tt.wantAction, got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_CheckMedicationInteractions_CachesConceptMappings(t *testing.T) {
+	fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+	fakeFHIR := fakeFHIRMock.NewFHIRMock()
+	fakeOCL := fakeOCLMock.NewFakeOCLMock()
+	fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+	fakeUpload := fakeUploadMock.NewFakeUploadMock()
+	fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+	infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+	c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+	lookups := map[string]int{}
+
+	fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+		lookups[concept]++
+
+		return &domain.Concept{
+			ID:     concept,
+			Source: source,
+		}, nil
+	}
+
+	// the patient reports taking aspirin twice
+	fakeFHIR.MockSearchFHIRMedicationStatementFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationStatementRelayConnection, error) {
+		system := scalarutils.URI("/orgs/CIEL/sources/CIEL/concepts/71617/")
+		code := scalarutils.Code("71617")
+
+		statement := &domain.FHIRMedicationStatement{
+			MedicationCodeableConcept: &domain.FHIRCodeableConcept{
+				Coding: []*domain.FHIRCoding{
+					{
+						System: &system,
+						Code:   &code,
+					},
+				},
+				Text: "Aspirin",
+			},
+		}
+
+		return &domain.FHIRMedicationStatementRelayConnection{
+			Edges: []*domain.FHIRMedicationStatementRelayEdge{{Node: statement}, {Node: statement}},
+		}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := c.CheckMedicationInteractions(context.Background(), gofakeit.UUID(), dto.TerminologySourceCIEL, "80513")
+		if err != nil {
+			t.Fatalf("UseCasesClinicalImpl.CheckMedicationInteractions() error = %v", err)
+		}
+	}
+
+	if lookups["71617"] != 1 {
+		t.Errorf("expected the mappings of the patient's medication to be fetched once, got %d", lookups["71617"])
+	}
+}
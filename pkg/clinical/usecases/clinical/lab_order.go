@@ -0,0 +1,374 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/application/utils"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// OrderLabTest orders a laboratory test for the patient of an encounter.
+// The order is recorded as a service request and published to the test orders topic for laboratory systems to pick up.
+// Results sent back for the order are linked to it and complete it
+func (c *UseCasesClinicalImpl) OrderLabTest(ctx context.Context, input dto.LabOrderInput) (*dto.LabOrder, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	encounter, err := c.infrastructure.FHIR.GetFHIREncounter(ctx, input.EncounterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if encounter.Resource.Status == domain.EncounterStatusEnumFinished {
+		return nil, fmt.Errorf("cannot order a lab test in a finished encounter")
+	}
+
+	test, err := c.GetConcept(ctx, dto.TerminologySourceCIEL, input.TestCode)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	patientReference := fmt.Sprintf("Patient/%s", *encounter.Resource.Subject.ID)
+	encounterReference := fmt.Sprintf("Encounter/%s", *encounter.Resource.ID)
+	authoredOn := time.Now()
+
+	priority := dto.LabOrderPriorityRoutine
+	if input.Priority != nil {
+		priority = *input.Priority
+	}
+
+	serviceRequest := labOrderInput(test, &domain.FHIRReferenceInput{
+		ID:        encounter.Resource.Subject.ID,
+		Reference: &patientReference,
+		Display:   encounter.Resource.Subject.Display,
+	}, priority, input.Note, authoredOn)
+
+	serviceRequest.Encounter = &domain.FHIRReferenceInput{
+		ID:        encounter.Resource.ID,
+		Reference: &encounterReference,
+	}
+
+	// The practitioner placing the order is the requester. Orders placed by users who are not practitioners are requested by the facility
+	requester, err := c.currentPractitionerReference(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceRequest.Requester = requester
+
+	if requester == nil && identifiers.FacilityID != "" {
+		facilityReference := fmt.Sprintf("Organization/%s", identifiers.FacilityID)
+		serviceRequest.Requester = &domain.FHIRReferenceInput{
+			ID:        &identifiers.FacilityID,
+			Reference: &facilityReference,
+		}
+	}
+
+	serviceRequest.Meta = domain.FHIRMetaInput{
+		Tag: tags,
+	}
+
+	resp, err := c.infrastructure.FHIR.CreateFHIRServiceRequest(ctx, serviceRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// The order has been recorded so failing to publish it should not fail the request and lead to it being placed again
+	err = c.infrastructure.Pubsub.NotifyTestOrder(ctx, dto.PatientTestOrderPubSubMessage{
+		ID:             *resp.Resource.ID,
+		Source:         common.ClinicalServiceName,
+		Name:           test.DisplayName,
+		ConceptID:      &test.ID,
+		Date:           authoredOn,
+		Priority:       priority,
+		Note:           input.Note,
+		PatientID:      *encounter.Resource.Subject.ID,
+		EncounterID:    *encounter.Resource.ID,
+		OrganizationID: identifiers.OrganizationID,
+		FacilityID:     identifiers.FacilityID,
+	})
+	if err != nil {
+		utils.ReportErrorToSentry(fmt.Errorf("unable to publish lab order %s: %w", *resp.Resource.ID, err))
+	}
+
+	return mapFHIRServiceRequestToLabOrderDTO(*resp.Resource, nil), nil
+}
+
+// ListPatientLabOrders lists the lab tests ordered for a patient, most recent first, together with the results received for them
+func (c *UseCasesClinicalImpl) ListPatientLabOrders(ctx context.Context, patientID string, status *dto.LabOrderStatusEnum, pagination dto.Pagination) (*dto.LabOrderConnection, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"subject":  fmt.Sprintf("Patient/%s", patientID),
+		"category": labOrderCategoryCode,
+		"_sort":    "-authored",
+	}
+
+	if status != nil {
+		if !status.IsValid() {
+			return nil, fmt.Errorf("invalid lab order status: %s", *status)
+		}
+
+		params["status"] = status.Code()
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRServiceRequest(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := c.labOrderResults(ctx, resources.ServiceRequests, *identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := []*dto.LabOrder{}
+
+	for _, resource := range resources.ServiceRequests {
+		orders = append(orders, mapFHIRServiceRequestToLabOrderDTO(resource, results[*resource.ID]))
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreateLabOrderConnection(orders, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
+
+// RevokeLabOrder cancels a lab order that has not yet been completed, recording the reason it was cancelled
+func (c *UseCasesClinicalImpl) RevokeLabOrder(ctx context.Context, orderID string, reason string) (*dto.LabOrder, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("a reason is required to revoke a lab order")
+	}
+
+	serviceRequest, err := c.infrastructure.FHIR.GetFHIRServiceRequest(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isLabOrder(*serviceRequest.Resource) {
+		return nil, fmt.Errorf("service request %s is not a lab order", orderID)
+	}
+
+	if labOrderStatus(*serviceRequest.Resource) != dto.LabOrderStatusActive {
+		return nil, fmt.Errorf("only active lab orders can be revoked")
+	}
+
+	input, err := serviceRequestInput(*serviceRequest.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	now := scalarutils.DateTime(time.Now().Format(time.RFC3339))
+	note := fmt.Sprintf("Revoked: %s", reason)
+
+	input.Status = domain.ServiceRequestStatusRevoked
+	input.Note = append(input.Note, &domain.FHIRAnnotationInput{
+		Time: &now,
+		Text: (*scalarutils.Markdown)(&note),
+	})
+
+	resp, err := c.infrastructure.FHIR.UpdateFHIRServiceRequest(ctx, *input)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRServiceRequestToLabOrderDTO(*resp.Resource, nil), nil
+}
+
+// CreatePubsubTestOrder records a lab order placed in another system.
+// Orders published by this service have already been recorded, so they are ignored
+func (c *UseCasesClinicalImpl) CreatePubsubTestOrder(ctx context.Context, data dto.PatientTestOrderPubSubMessage) error {
+	if data.Source == common.ClinicalServiceName {
+		return nil
+	}
+
+	if data.ConceptID == nil {
+		return fmt.Errorf("a lab order must specify the concept of the test ordered")
+	}
+
+	if data.Priority != "" && !data.Priority.IsValid() {
+		return fmt.Errorf("invalid lab order priority: %s", data.Priority)
+	}
+
+	patient, err := c.infrastructure.FHIR.GetFHIRPatient(ctx, data.PatientID)
+	if err != nil {
+		return err
+	}
+
+	test, err := c.GetConcept(ctx, dto.TerminologySourceCIEL, *data.ConceptID)
+	if err != nil {
+		return err
+	}
+
+	tags, err := c.CreateTenantMetaTags(ctx, data.OrganizationID, data.FacilityID)
+	if err != nil {
+		return err
+	}
+
+	patientReference := fmt.Sprintf("Patient/%s", *patient.Resource.ID)
+
+	authoredOn := data.Date
+	if authoredOn.IsZero() {
+		authoredOn = time.Now()
+	}
+
+	input := labOrderInput(test, &domain.FHIRReferenceInput{
+		ID:        patient.Resource.ID,
+		Reference: &patientReference,
+	}, data.Priority, data.Note, authoredOn)
+
+	if data.EncounterID != "" {
+		encounterReference := fmt.Sprintf("Encounter/%s", data.EncounterID)
+		input.Encounter = &domain.FHIRReferenceInput{
+			ID:        &data.EncounterID,
+			Reference: &encounterReference,
+		}
+	}
+
+	if data.FacilityID != "" {
+		facilityReference := fmt.Sprintf("Organization/%s", data.FacilityID)
+		input.Requester = &domain.FHIRReferenceInput{
+			ID:        &data.FacilityID,
+			Reference: &facilityReference,
+		}
+	}
+
+	input.Meta = domain.FHIRMetaInput{
+		Tag: tags,
+	}
+
+	_, err = c.infrastructure.FHIR.CreateFHIRServiceRequest(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// labOrderResults fetches the results of lab orders in a single search, keyed by the ID of the order each result is based on
+func (c *UseCasesClinicalImpl) labOrderResults(ctx context.Context, orders []domain.FHIRServiceRequest, identifiers dto.TenantIdentifiers) (map[string][]*dto.Observation, error) {
+	results := map[string][]*dto.Observation{}
+
+	references := []string{}
+
+	for _, order := range orders {
+		if order.ID != nil {
+			references = append(references, fmt.Sprintf("ServiceRequest/%s", *order.ID))
+		}
+	}
+
+	if len(references) == 0 {
+		return results, nil
+	}
+
+	params := map[string]interface{}{
+		"based-on": strings.Join(references, ","),
+	}
+
+	observations, err := c.infrastructure.FHIR.SearchFHIRObservation(ctx, params, identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, observation := range observations.Observations {
+		if observation.ID == nil || observation.Status == nil || observation.Code == nil || len(observation.Code.Coding) == 0 ||
+			observation.Subject == nil || observation.Subject.ID == nil || observation.EffectiveInstant == nil {
+			continue
+		}
+
+		for _, basedOn := range observation.BasedOn {
+			if basedOn == nil || basedOn.ID == nil {
+				continue
+			}
+
+			results[*basedOn.ID] = append(results[*basedOn.ID], mapFHIRObservationToObservationDTO(observation))
+		}
+	}
+
+	return results, nil
+}
+
+// resultLabOrder fetches the lab order a test result was sent for, ensuring the order belongs to the same organisation
+// and is for the same patient as the result
+func (c *UseCasesClinicalImpl) resultLabOrder(ctx context.Context, orderID string, patientID string, organisationID string) (*domain.FHIRServiceRequest, error) {
+	serviceRequest, err := c.infrastructure.FHIR.GetFHIRServiceRequest(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	order := serviceRequest.Resource
+
+	if !isLabOrder(*order) {
+		return nil, fmt.Errorf("service request %s is not a lab order", orderID)
+	}
+
+	if resourceOrganizationID(order.Meta) != organisationID {
+		return nil, fmt.Errorf("lab order %s does not belong to organisation %s", orderID, organisationID)
+	}
+
+	if order.Subject == nil || order.Subject.ID == nil || *order.Subject.ID != patientID {
+		return nil, fmt.Errorf("lab order %s is not for patient %s", orderID, patientID)
+	}
+
+	return order, nil
+}
+
+// completeLabOrder marks a lab order that has received its result as completed.
+// The order must have been fetched through resultLabOrder. An order that has been revoked in the meantime keeps its status
+func (c *UseCasesClinicalImpl) completeLabOrder(ctx context.Context, order domain.FHIRServiceRequest) error {
+	if labOrderStatus(order) != dto.LabOrderStatusActive {
+		return nil
+	}
+
+	input, err := serviceRequestInput(order)
+	if err != nil {
+		return err
+	}
+
+	input.Status = domain.ServiceRequestStatusCompleted
+
+	_, err = c.infrastructure.FHIR.UpdateFHIRServiceRequest(ctx, *input)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package clinical
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+const (
+	// labOrderCategoryCode is the SNOMED CT code of the service request category that lab orders are recorded under
+	labOrderCategoryCode    = "108252007"
+	labOrderCategoryDisplay = "Laboratory procedure"
+)
+
+// labOrderInput composes an active service request for a laboratory test
+func labOrderInput(test *domain.Concept, subject *domain.FHIRReferenceInput, priority dto.LabOrderPriorityEnum, note string, authoredOn time.Time) domain.FHIRServiceRequestInput {
+	categorySystem := scalarutils.URI(snomedCTSystem)
+	categoryCode := scalarutils.Code(labOrderCategoryCode)
+	authored := scalarutils.DateTime(authoredOn.Format(time.RFC3339))
+
+	if priority == "" {
+		priority = dto.LabOrderPriorityRoutine
+	}
+
+	input := domain.FHIRServiceRequestInput{
+		Status:   domain.ServiceRequestStatusActive,
+		Intent:   domain.ServiceRequestIntentOrder,
+		Priority: domain.ServiceRequestPriorityEnum(priority.Code()),
+		Category: []*domain.FHIRCodeableConcept{
+			{
+				Coding: []*domain.FHIRCoding{
+					{
+						System:  &categorySystem,
+						Code:    &categoryCode,
+						Display: labOrderCategoryDisplay,
+					},
+				},
+				Text: labOrderCategoryDisplay,
+			},
+		},
+		Code: &domain.FHIRCodeableConceptInput{
+			Coding: []*domain.FHIRCodingInput{
+				{
+					System:  (*scalarutils.URI)(&test.URL),
+					Code:    scalarutils.Code(test.ID),
+					Display: test.DisplayName,
+				},
+			},
+			Text: test.DisplayName,
+		},
+		Subject:    subject,
+		AuthoredOn: &authored,
+	}
+
+	if note != "" {
+		input.Note = []*domain.FHIRAnnotationInput{
+			{
+				Time: &authored,
+				Text: (*scalarutils.Markdown)(&note),
+			},
+		}
+	}
+
+	return input
+}
+
+// serviceRequestInput converts a stored service request into the input used to update it
+func serviceRequestInput(resource domain.FHIRServiceRequest) (*domain.FHIRServiceRequestInput, error) {
+	bs, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal service request: %w", err)
+	}
+
+	input := &domain.FHIRServiceRequestInput{}
+
+	err = json.Unmarshal(bs, input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal service request input: %w", err)
+	}
+
+	return input, nil
+}
+
+// isLabOrder checks whether a service request was recorded as a laboratory test order
+func isLabOrder(resource domain.FHIRServiceRequest) bool {
+	for _, category := range resource.Category {
+		if category == nil {
+			continue
+		}
+
+		for _, coding := range category.Coding {
+			if coding != nil && coding.Code != nil && string(*coding.Code) == labOrderCategoryCode {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// labOrderStatus converts a FHIR service request status code to its lab order status.
+// Statuses that lab orders are not placed in e.g `draft` have no lab order status
+func labOrderStatus(resource domain.FHIRServiceRequest) dto.LabOrderStatusEnum {
+	status := dto.LabOrderStatusEnum(strings.ToUpper(string(resource.Status)))
+	if !status.IsValid() {
+		return ""
+	}
+
+	return status
+}
+
+func mapFHIRServiceRequestToLabOrderDTO(resource domain.FHIRServiceRequest, results []*dto.Observation) *dto.LabOrder {
+	output := &dto.LabOrder{
+		Status:     labOrderStatus(resource),
+		Priority:   dto.LabOrderPriorityEnum(strings.ToUpper(string(resource.Priority))),
+		AuthoredOn: resource.AuthoredOn,
+		Results:    results,
+	}
+
+	if output.Results == nil {
+		output.Results = []*dto.Observation{}
+	}
+
+	output.SpecimenIDs = []string{}
+
+	for _, specimen := range resource.Specimen {
+		if specimen != nil && specimen.ID != nil {
+			output.SpecimenIDs = append(output.SpecimenIDs, *specimen.ID)
+		}
+	}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.Subject != nil && resource.Subject.ID != nil {
+		output.PatientID = *resource.Subject.ID
+	}
+
+	if resource.Encounter != nil && resource.Encounter.ID != nil {
+		output.EncounterID = *resource.Encounter.ID
+	}
+
+	if resource.Code != nil {
+		output.TestName = resource.Code.Text
+
+		if len(resource.Code.Coding) > 0 && resource.Code.Coding[0].Code != nil {
+			output.TestCode = string(*resource.Code.Coding[0].Code)
+		}
+	}
+
+	if len(resource.Note) > 0 && resource.Note[0].Text != nil {
+		output.Note = string(*resource.Note[0].Text)
+	}
+
+	return output
+}
@@ -0,0 +1,761 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+// fakeLabOrder returns a full blood count order for the given patient
+func fakeLabOrder(id, patientID string, status domain.ServiceRequestStatusEnum) *domain.FHIRServiceRequest {
+	patientReference := fmt.Sprintf("Patient/%s", patientID)
+	categorySystem := scalarutils.URI("http://snomed.info/sct")
+	categoryCode := scalarutils.Code("108252007")
+	testCode := scalarutils.Code("1019")
+
+	return &domain.FHIRServiceRequest{
+		ID:       &id,
+		Status:   status,
+		Intent:   domain.ServiceRequestIntentOrder,
+		Priority: domain.ServiceRequestPriorityRoutine,
+		Category: []*domain.FHIRCodeableConcept{
+			{
+				Coding: []*domain.FHIRCoding{
+					{
+						System:  &categorySystem,
+						Code:    &categoryCode,
+						Display: "Laboratory procedure",
+					},
+				},
+			},
+		},
+		Code: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					Code:    &testCode,
+					Display: "Full blood count",
+				},
+			},
+			Text: "Full blood count",
+		},
+		Subject: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+		},
+	}
+}
+
+func TestUseCasesClinicalImpl_OrderLabTest(t *testing.T) {
+	priority := dto.LabOrderPriorityStat
+	invalidPriority := dto.LabOrderPriorityEnum("WHENEVER")
+
+	type args struct {
+		ctx   context.Context
+		input dto.LabOrderInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: order a lab test",
+			args: args{
+				ctx: context.Background(),
+				input: dto.LabOrderInput{
+					EncounterID: gofakeit.UUID(),
+					TestCode:    "1019",
+					Priority:    &priority,
+					Note:        "Query anaemia",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: order is placed when it cannot be published",
+			args: args{
+				ctx: context.Background(),
+				input: dto.LabOrderInput{
+					EncounterID: gofakeit.UUID(),
+					TestCode:    "1019",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing test code",
+			args: args{
+				ctx: context.Background(),
+				input: dto.LabOrderInput{
+					EncounterID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid priority",
+			args: args{
+				ctx: context.Background(),
+				input: dto.LabOrderInput{
+					EncounterID: gofakeit.UUID(),
+					TestCode:    "1019",
+					Priority:    &invalidPriority,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: finished encounter",
+			args: args{
+				ctx: context.Background(),
+				input: dto.LabOrderInput{
+					EncounterID: gofakeit.UUID(),
+					TestCode:    "1019",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get encounter",
+			args: args{
+				ctx: context.Background(),
+				input: dto.LabOrderInput{
+					EncounterID: gofakeit.UUID(),
+					TestCode:    "1019",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get test concept",
+			args: args{
+				ctx: context.Background(),
+				input: dto.LabOrderInput{
+					EncounterID: gofakeit.UUID(),
+					TestCode:    "1019",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create service request",
+			args: args{
+				ctx: context.Background(),
+				input: dto.LabOrderInput{
+					EncounterID: gofakeit.UUID(),
+					TestCode:    "1019",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			var (
+				created   domain.FHIRServiceRequestInput
+				published *dto.PatientTestOrderPubSubMessage
+			)
+
+			fakeFHIR.MockCreateFHIRServiceRequestFn = func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+				created = input
+				id := gofakeit.UUID()
+
+				return &domain.FHIRServiceRequestRelayPayload{
+					Resource: fakeLabOrder(id, *input.Subject.ID, input.Status),
+				}, nil
+			}
+
+			fakePubSub.MockNotifyTestOrderFn = func(ctx context.Context, data dto.PatientTestOrderPubSubMessage) error {
+				published = &data
+
+				return nil
+			}
+
+			if tt.name == "Happy case: order is placed when it cannot be published" {
+				fakePubSub.MockNotifyTestOrderFn = func(ctx context.Context, data dto.PatientTestOrderPubSubMessage) error {
+					return fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: finished encounter" {
+				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+					patientID := gofakeit.UUID()
+
+					return &domain.FHIREncounterRelayPayload{
+						Resource: &domain.FHIREncounter{
+							ID:     &id,
+							Status: domain.EncounterStatusEnumFinished,
+							Subject: &domain.FHIRReference{
+								ID: &patientID,
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get encounter" {
+				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get test concept" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create service request" {
+				fakeFHIR.MockCreateFHIRServiceRequestFn = func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.OrderLabTest(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.OrderLabTest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Status != dto.LabOrderStatusActive {
+				t.Errorf("expected the lab order to be active, got %v", got.Status)
+			}
+
+			if len(created.Category) == 0 || string(*created.Category[0].Coding[0].Code) != "108252007" {
+				t.Errorf("expected the service request to be categorised as a laboratory procedure, got %v", created.Category)
+			}
+
+			wantPriority := dto.LabOrderPriorityRoutine
+			if tt.args.input.Priority != nil {
+				wantPriority = *tt.args.input.Priority
+			}
+
+			if string(created.Priority) != wantPriority.Code() {
+				t.Errorf("expected the service request priority to be %v, got %v", wantPriority.Code(), created.Priority)
+			}
+
+			if tt.name == "Happy case: order a lab test" && (published == nil || published.ID != got.ID || published.Source != common.ClinicalServiceName) {
+				t.Errorf("expected lab order %s to be published, got %v", got.ID, published)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListPatientLabOrders(t *testing.T) {
+	first := 10
+	status := dto.LabOrderStatusCompleted
+	invalidStatus := dto.LabOrderStatusEnum("PENDING")
+
+	type args struct {
+		ctx        context.Context
+		patientID  string
+		status     *dto.LabOrderStatusEnum
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list patient lab orders with their results",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: list completed lab orders",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				status:     &status,
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid patient id",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  "patient",
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid status",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				status:     &invalidStatus,
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search service requests",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search results",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			orderID := gofakeit.UUID()
+			resultID := gofakeit.UUID()
+
+			fakeFHIR.MockSearchFHIRServiceRequestFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRServiceRequest, error) {
+				return &domain.PagedFHIRServiceRequest{
+					ServiceRequests: []domain.FHIRServiceRequest{
+						*fakeLabOrder(orderID, tt.args.patientID, domain.ServiceRequestStatusCompleted),
+						*fakeLabOrder(gofakeit.UUID(), tt.args.patientID, domain.ServiceRequestStatusActive),
+					},
+					TotalCount: 2,
+				}, nil
+			}
+
+			fakeFHIR.MockSearchFHIRObservationFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				finalStatus := domain.ObservationStatusEnumFinal
+				orderReference := fmt.Sprintf("ServiceRequest/%s", orderID)
+				value := "12.5 g/dL"
+				instant := scalarutils.Instant(time.Now().Format(time.RFC3339))
+
+				return &domain.PagedFHIRObservations{
+					Observations: []domain.FHIRObservation{
+						{
+							ID:     &resultID,
+							Status: &finalStatus,
+							Code: &domain.FHIRCodeableConcept{
+								Coding: []*domain.FHIRCoding{
+									{
+										Display: "Haemoglobin",
+									},
+								},
+							},
+							BasedOn: []*domain.FHIRReference{
+								{
+									ID:        &orderID,
+									Reference: &orderReference,
+								},
+							},
+							ValueString:      &value,
+							EffectiveInstant: &instant,
+							Subject: &domain.FHIRReference{
+								ID: &tt.args.patientID,
+							},
+						},
+					},
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to search service requests" {
+				fakeFHIR.MockSearchFHIRServiceRequestFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRServiceRequest, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to search results" {
+				fakeFHIR.MockSearchFHIRObservationFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.ListPatientLabOrders(tt.args.ctx, tt.args.patientID, tt.args.status, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListPatientLabOrders() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if len(got.Edges) != 2 {
+				t.Errorf("expected 2 lab orders, got %d", len(got.Edges))
+				return
+			}
+
+			completed, active := got.Edges[0].Node, got.Edges[1].Node
+
+			if completed.Status != dto.LabOrderStatusCompleted || len(completed.Results) != 1 || completed.Results[0].ID != resultID {
+				t.Errorf("expected the completed lab order to have result %s, got %v", resultID, completed.Results)
+			}
+
+			if active.Status != dto.LabOrderStatusActive || len(active.Results) != 0 {
+				t.Errorf("expected the active lab order to have no results, got %v", active.Results)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_RevokeLabOrder(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		orderID string
+		reason  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: revoke an active lab order",
+			args: args{
+				ctx:     context.Background(),
+				orderID: gofakeit.UUID(),
+				reason:  "Ordered for the wrong patient",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing reason",
+			args: args{
+				ctx:     context.Background(),
+				orderID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: completed lab order",
+			args: args{
+				ctx:     context.Background(),
+				orderID: gofakeit.UUID(),
+				reason:  "Ordered for the wrong patient",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: service request is a referral",
+			args: args{
+				ctx:     context.Background(),
+				orderID: gofakeit.UUID(),
+				reason:  "Ordered for the wrong patient",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get service request",
+			args: args{
+				ctx:     context.Background(),
+				orderID: gofakeit.UUID(),
+				reason:  "Ordered for the wrong patient",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update service request",
+			args: args{
+				ctx:     context.Background(),
+				orderID: gofakeit.UUID(),
+				reason:  "Ordered for the wrong patient",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			var updated domain.FHIRServiceRequestInput
+
+			fakeFHIR.MockGetFHIRServiceRequestFn = func(_ context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error) {
+				return &domain.FHIRServiceRequestRelayPayload{
+					Resource: fakeLabOrder(id, gofakeit.UUID(), domain.ServiceRequestStatusActive),
+				}, nil
+			}
+
+			fakeFHIR.MockUpdateFHIRServiceRequestFn = func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+				updated = input
+
+				return &domain.FHIRServiceRequestRelayPayload{
+					Resource: fakeLabOrder(*input.ID, *input.Subject.ID, input.Status),
+				}, nil
+			}
+
+			if tt.name == "Sad case: completed lab order" {
+				fakeFHIR.MockGetFHIRServiceRequestFn = func(_ context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error) {
+					return &domain.FHIRServiceRequestRelayPayload{
+						Resource: fakeLabOrder(id, gofakeit.UUID(), domain.ServiceRequestStatusCompleted),
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: service request is a referral" {
+				fakeFHIR.MockGetFHIRServiceRequestFn = func(_ context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error) {
+					order := fakeLabOrder(id, gofakeit.UUID(), domain.ServiceRequestStatusActive)
+					order.Category = nil
+
+					return &domain.FHIRServiceRequestRelayPayload{
+						Resource: order,
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get service request" {
+				fakeFHIR.MockGetFHIRServiceRequestFn = func(_ context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to update service request" {
+				fakeFHIR.MockUpdateFHIRServiceRequestFn = func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.RevokeLabOrder(tt.args.ctx, tt.args.orderID, tt.args.reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RevokeLabOrder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Status != dto.LabOrderStatusRevoked {
+				t.Errorf("expected the lab order to be revoked, got %v", got.Status)
+			}
+
+			if len(updated.Note) == 0 || string(*updated.Note[len(updated.Note)-1].Text) != "Revoked: "+tt.args.reason {
+				t.Errorf("expected the reason the lab order was revoked to be noted, got %v", updated.Note)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_CreatePubsubTestOrder(t *testing.T) {
+	concept := "1019"
+
+	type args struct {
+		ctx  context.Context
+		data dto.PatientTestOrderPubSubMessage
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantCreated bool
+		wantErr     bool
+	}{
+		{
+			name: "Happy case: record a lab order placed in another system",
+			args: args{
+				ctx: context.Background(),
+				data: dto.PatientTestOrderPubSubMessage{
+					Name:           "Full blood count",
+					ConceptID:      &concept,
+					Date:           time.Now(),
+					Priority:       dto.LabOrderPriorityUrgent,
+					PatientID:      gofakeit.UUID(),
+					EncounterID:    gofakeit.UUID(),
+					OrganizationID: gofakeit.UUID(),
+					FacilityID:     gofakeit.UUID(),
+				},
+			},
+			wantCreated: true,
+			wantErr:     false,
+		},
+		{
+			name: "Happy case: record a lab order placed in another system with its own ID",
+			args: args{
+				ctx: context.Background(),
+				data: dto.PatientTestOrderPubSubMessage{
+					ID:        gofakeit.UUID(),
+					Source:    "laboratory",
+					ConceptID: &concept,
+					Priority:  dto.LabOrderPriorityRoutine,
+					PatientID: gofakeit.UUID(),
+				},
+			},
+			wantCreated: true,
+			wantErr:     false,
+		},
+		{
+			name: "Happy case: ignore a lab order placed in this service",
+			args: args{
+				ctx: context.Background(),
+				data: dto.PatientTestOrderPubSubMessage{
+					ID:        gofakeit.UUID(),
+					Source:    common.ClinicalServiceName,
+					ConceptID: &concept,
+					PatientID: gofakeit.UUID(),
+				},
+			},
+			wantCreated: false,
+			wantErr:     false,
+		},
+		{
+			name: "Sad case: missing concept",
+			args: args{
+				ctx: context.Background(),
+				data: dto.PatientTestOrderPubSubMessage{
+					PatientID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid priority",
+			args: args{
+				ctx: context.Background(),
+				data: dto.PatientTestOrderPubSubMessage{
+					ConceptID: &concept,
+					Priority:  dto.LabOrderPriorityEnum("WHENEVER"),
+					PatientID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get patient",
+			args: args{
+				ctx: context.Background(),
+				data: dto.PatientTestOrderPubSubMessage{
+					ConceptID: &concept,
+					PatientID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get test concept",
+			args: args{
+				ctx: context.Background(),
+				data: dto.PatientTestOrderPubSubMessage{
+					ConceptID: &concept,
+					PatientID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create service request",
+			args: args{
+				ctx: context.Background(),
+				data: dto.PatientTestOrderPubSubMessage{
+					ConceptID: &concept,
+					PatientID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			created := false
+
+			fakeFHIR.MockCreateFHIRServiceRequestFn = func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+				created = true
+
+				if string(input.Priority) != tt.args.data.Priority.Code() {
+					t.Errorf("expected the service request priority to be %v, got %v", tt.args.data.Priority.Code(), input.Priority)
+				}
+
+				return &domain.FHIRServiceRequestRelayPayload{
+					Resource: fakeLabOrder(gofakeit.UUID(), *input.Subject.ID, input.Status),
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to get patient" {
+				fakeFHIR.MockGetFHIRPatientFn = func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get test concept" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create service request" {
+				fakeFHIR.MockCreateFHIRServiceRequestFn = func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			err := c.CreatePubsubTestOrder(tt.args.ctx, tt.args.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.CreatePubsubTestOrder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && created != tt.wantCreated {
+				t.Errorf("expected a service request to be created: %v, got %v", tt.wantCreated, created)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// CreateLocation creates a department, ward or clinic of a facility. A location may be part of another location of the same facility
+// e.g a clinic within the outpatient department. The location is created in the current facility unless a facility is given
+func (c *UseCasesClinicalImpl) CreateLocation(ctx context.Context, input dto.LocationInput) (*dto.Location, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	facilityID := identifiers.FacilityID
+	if input.FacilityID != "" {
+		facilityID = input.FacilityID
+	}
+
+	facility, err := c.infrastructure.FHIR.GetFHIROrganization(ctx, facilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	facilityReference := organizationReference(facilityID)
+	if facility.Resource.Name != nil {
+		facilityReference.Display = *facility.Resource.Name
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := scalarutils.Code(dto.LocationStatusActive.Code())
+	mode := scalarutils.Code(locationInstanceMode)
+	location := domain.FHIRLocation{
+		Status:               &status,
+		Name:                 &input.Name,
+		Description:          optionalString(input.Description),
+		Mode:                 &mode,
+		Type:                 locationType(input.Type),
+		ManagingOrganization: facilityReference,
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+	}
+
+	if input.PartOf != "" {
+		parent, err := c.infrastructure.FHIR.GetFHIRLocation(ctx, input.PartOf)
+		if err != nil {
+			return nil, err
+		}
+
+		if !sameReference(parent.Resource.ManagingOrganization, facilityReference) {
+			return nil, fmt.Errorf("a location can only be part of a location in the same facility")
+		}
+
+		location.PartOf = locationReference(input.PartOf)
+		if parent.Resource.Name != nil {
+			location.PartOf.Display = *parent.Resource.Name
+		}
+	}
+
+	setLocationAdminUnits(&location, optionalString(input.County), optionalString(input.SubCounty), optionalString(input.Ward))
+
+	resource, err := c.infrastructure.FHIR.CreateFHIRLocation(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRLocationToDTO(*resource), nil
+}
+
+// GetLocation retrieves a location
+func (c *UseCasesClinicalImpl) GetLocation(ctx context.Context, id string) (*dto.Location, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid location id: %s", id)
+	}
+
+	resource, err := c.infrastructure.FHIR.GetFHIRLocation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRLocationToDTO(*resource.Resource), nil
+}
+
+// UpdateLocation renames a location, changes its status e.g when a ward is closed for renovation, or updates its admin units
+func (c *UseCasesClinicalImpl) UpdateLocation(ctx context.Context, id string, input dto.LocationUpdateInput) (*dto.Location, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid location id: %s", id)
+	}
+
+	err = input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := c.infrastructure.FHIR.GetFHIRLocation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	location := *resource.Resource
+
+	if input.Name != nil {
+		location.Name = input.Name
+	}
+
+	if input.Description != nil {
+		location.Description = optionalString(*input.Description)
+	}
+
+	if input.Status != nil {
+		status := scalarutils.Code(input.Status.Code())
+		location.Status = &status
+	}
+
+	setLocationAdminUnits(&location, input.County, input.SubCounty, input.Ward)
+
+	updated, err := c.infrastructure.FHIR.UpdateFHIRLocation(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRLocationToDTO(*updated), nil
+}
+
+// ListFacilityLocations lists the active locations of a facility by name
+func (c *UseCasesClinicalImpl) ListFacilityLocations(ctx context.Context, facilityID string, pagination dto.Pagination) (*dto.LocationConnection, error) {
+	_, err := uuid.Parse(facilityID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid facility id: %s", facilityID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"organization": fmt.Sprintf("Organization/%s", facilityID),
+		"status":       dto.LocationStatusActive.Code(),
+		"_sort":        "name",
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRLocation(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := []*dto.Location{}
+
+	for _, resource := range resources.Locations {
+		locations = append(locations, mapFHIRLocationToDTO(resource))
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreateLocationConnection(locations, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
+
+// encounterLocation checks that a location is open and belongs to the facility providing an encounter before the encounter is recorded as taking place in it
+func (c *UseCasesClinicalImpl) encounterLocation(ctx context.Context, locationID string, facility *domain.FHIRReference) (*domain.FHIREncounterLocationInput, error) {
+	_, err := uuid.Parse(locationID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid location id: %s", locationID)
+	}
+
+	location, err := c.infrastructure.FHIR.GetFHIRLocation(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if location.Resource.Status != nil && string(*location.Resource.Status) != dto.LocationStatusActive.Code() {
+		return nil, fmt.Errorf("an encounter can not take place in a location that is %s", *location.Resource.Status)
+	}
+
+	if facility != nil && facility.Reference != nil && location.Resource.ManagingOrganization != nil && !sameReference(location.Resource.ManagingOrganization, facility) {
+		return nil, fmt.Errorf("the location is not in the facility providing the encounter")
+	}
+
+	reference := fmt.Sprintf("Location/%s", locationID)
+	status := domain.EncounterLocationStatusEnumActive
+
+	encounterLocation := &domain.FHIREncounterLocationInput{
+		Location: &domain.FHIRReferenceInput{
+			ID:        &locationID,
+			Reference: &reference,
+		},
+		Status: &status,
+	}
+
+	if location.Resource.Name != nil {
+		encounterLocation.Location.Display = *location.Resource.Name
+	}
+
+	return encounterLocation, nil
+}
@@ -0,0 +1,135 @@
+package clinical
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// locationInstanceMode is the mode of a location that is a specific place e.g a ward, as opposed to a class of locations
+const locationInstanceMode = "instance"
+
+// locationType codes the kind of location e.g a ward
+func locationType(kind dto.LocationTypeEnum) []*domain.FHIRCodeableConcept {
+	code := scalarutils.Code(kind.Code())
+
+	return []*domain.FHIRCodeableConcept{
+		{
+			Coding: []*domain.FHIRCoding{
+				{
+					Code:    &code,
+					Display: kind.String(),
+				},
+			},
+			Text: kind.String(),
+		},
+	}
+}
+
+// locationReference references a location from the resources that take place in it e.g an encounter
+func locationReference(locationID string) *domain.FHIRReference {
+	reference := fmt.Sprintf("Location/%s", locationID)
+
+	return &domain.FHIRReference{
+		ID:        &locationID,
+		Reference: &reference,
+	}
+}
+
+// setLocationAdminUnits records the county, sub-county and ward of a location as the state, district and city of its address.
+// Admin units that are not given are left unchanged
+func setLocationAdminUnits(location *domain.FHIRLocation, county, subCounty, ward *string) {
+	if county == nil && subCounty == nil && ward == nil {
+		return
+	}
+
+	if location.Address == nil {
+		country := "KE"
+		location.Address = &domain.FHIRAddress{
+			Country: &country,
+		}
+	}
+
+	if county != nil {
+		location.Address.State = county
+	}
+
+	if subCounty != nil {
+		location.Address.District = subCounty
+	}
+
+	if ward != nil {
+		location.Address.City = ward
+	}
+}
+
+// optionalString returns nil for an empty string so that it is not recorded
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+// sameReference checks whether two references are to the same resource
+func sameReference(a, b *domain.FHIRReference) bool {
+	return a != nil && b != nil && a.Reference != nil && b.Reference != nil && *a.Reference == *b.Reference
+}
+
+func mapFHIRLocationToDTO(resource domain.FHIRLocation) *dto.Location {
+	output := &dto.Location{}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.Status != nil {
+		output.Status = dto.LocationStatusEnum(strings.ToUpper(string(*resource.Status)))
+	}
+
+	if resource.Name != nil {
+		output.Name = *resource.Name
+	}
+
+	if resource.Description != nil {
+		output.Description = *resource.Description
+	}
+
+	for _, kind := range resource.Type {
+		if kind == nil || len(kind.Coding) == 0 || kind.Coding[0] == nil || kind.Coding[0].Code == nil {
+			continue
+		}
+
+		output.Type = dto.LocationTypeEnum(strings.ToUpper(string(*kind.Coding[0].Code)))
+
+		break
+	}
+
+	if resource.ManagingOrganization != nil && resource.ManagingOrganization.ID != nil {
+		output.FacilityID = *resource.ManagingOrganization.ID
+	}
+
+	if resource.PartOf != nil && resource.PartOf.ID != nil {
+		output.PartOf = *resource.PartOf.ID
+	}
+
+	if resource.Address != nil {
+		if resource.Address.State != nil {
+			output.County = *resource.Address.State
+		}
+
+		if resource.Address.District != nil {
+			output.SubCounty = *resource.Address.District
+		}
+
+		if resource.Address.City != nil {
+			output.Ward = *resource.Address.City
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,500 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+func TestUseCasesClinicalImpl_CreateLocation(t *testing.T) {
+	ctx := context.Background()
+	facilityID := gofakeit.UUID()
+
+	type args struct {
+		ctx   context.Context
+		input dto.LocationInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case - create a ward in the current facility",
+			args: args{
+				ctx: ctx,
+				input: dto.LocationInput{
+					Name:      "Maternity Ward",
+					Type:      dto.LocationTypeWard,
+					County:    "Nairobi",
+					SubCounty: "Westlands",
+					Ward:      "Parklands",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case - create a clinic within a department",
+			args: args{
+				ctx: ctx,
+				input: dto.LocationInput{
+					Name:       "Diabetes Clinic",
+					Type:       dto.LocationTypeClinic,
+					FacilityID: facilityID,
+					PartOf:     gofakeit.UUID(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case - invalid location type",
+			args: args{
+				ctx: ctx,
+				input: dto.LocationInput{
+					Name: "Maternity Ward",
+					Type: "THEATRE",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - missing name",
+			args: args{
+				ctx: ctx,
+				input: dto.LocationInput{
+					Type: dto.LocationTypeWard,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - failed to get the facility",
+			args: args{
+				ctx: ctx,
+				input: dto.LocationInput{
+					Name: "Maternity Ward",
+					Type: dto.LocationTypeWard,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - parent location is in another facility",
+			args: args{
+				ctx: ctx,
+				input: dto.LocationInput{
+					Name:       "Diabetes Clinic",
+					Type:       dto.LocationTypeClinic,
+					FacilityID: facilityID,
+					PartOf:     gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - failed to create location",
+			args: args{
+				ctx: ctx,
+				input: dto.LocationInput{
+					Name: "Maternity Ward",
+					Type: dto.LocationTypeWard,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Happy case - create a clinic within a department" {
+				fakeFHIR.MockGetFHIRLocationFn = func(ctx context.Context, id string) (*domain.FHIRLocationRelayPayload, error) {
+					name := "Outpatient Department"
+					reference := fmt.Sprintf("Organization/%s", facilityID)
+
+					return &domain.FHIRLocationRelayPayload{
+						Resource: &domain.FHIRLocation{
+							ID:   &id,
+							Name: &name,
+							ManagingOrganization: &domain.FHIRReference{
+								ID:        &facilityID,
+								Reference: &reference,
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case - failed to get the facility" {
+				fakeFHIR.MockGetFHIROrganizationFn = func(ctx context.Context, organisationID string) (*domain.FHIROrganizationRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get organization")
+				}
+			}
+
+			if tt.name == "Sad case - failed to create location" {
+				fakeFHIR.MockCreateFHIRLocationFn = func(ctx context.Context, input domain.FHIRLocation) (*domain.FHIRLocation, error) {
+					return nil, fmt.Errorf("failed to create location")
+				}
+			}
+
+			got, err := u.CreateLocation(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.CreateLocation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.name == "Happy case - create a ward in the current facility" && got.County != "Nairobi" {
+				t.Errorf("expected the county of the location to be recorded, got %v", got.County)
+			}
+
+			if tt.name == "Happy case - create a clinic within a department" && (got.PartOf != tt.args.input.PartOf || got.FacilityID != facilityID) {
+				t.Errorf("expected the clinic to be part of the department in facility %s, got %v", facilityID, got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_GetLocation(t *testing.T) {
+	ctx := context.Background()
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case - get location",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case - invalid location id",
+			args: args{
+				ctx: ctx,
+				id:  "ward",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - failed to get location",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case - failed to get location" {
+				fakeFHIR.MockGetFHIRLocationFn = func(ctx context.Context, id string) (*domain.FHIRLocationRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get location")
+				}
+			}
+
+			got, err := u.GetLocation(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.GetLocation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (got.Type != dto.LocationTypeWard || got.Status != dto.LocationStatusActive) {
+				t.Errorf("expected an active ward, got %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_UpdateLocation(t *testing.T) {
+	ctx := context.Background()
+	name := "Antenatal Ward"
+	emptyName := ""
+	suspended := dto.LocationStatusSuspended
+	invalidStatus := dto.LocationStatusEnum("CLOSED")
+
+	type args struct {
+		ctx   context.Context
+		id    string
+		input dto.LocationUpdateInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case - rename and suspend a location",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+				input: dto.LocationUpdateInput{
+					Name:   &name,
+					Status: &suspended,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case - invalid location id",
+			args: args{
+				ctx: ctx,
+				id:  "ward",
+				input: dto.LocationUpdateInput{
+					Name: &name,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - empty name",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+				input: dto.LocationUpdateInput{
+					Name: &emptyName,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - invalid status",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+				input: dto.LocationUpdateInput{
+					Status: &invalidStatus,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - failed to get location",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+				input: dto.LocationUpdateInput{
+					Name: &name,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - failed to update location",
+			args: args{
+				ctx: ctx,
+				id:  gofakeit.UUID(),
+				input: dto.LocationUpdateInput{
+					Name: &name,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case - failed to get location" {
+				fakeFHIR.MockGetFHIRLocationFn = func(ctx context.Context, id string) (*domain.FHIRLocationRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get location")
+				}
+			}
+
+			if tt.name == "Sad case - failed to update location" {
+				fakeFHIR.MockUpdateFHIRLocationFn = func(ctx context.Context, input domain.FHIRLocation) (*domain.FHIRLocation, error) {
+					return nil, fmt.Errorf("failed to update location")
+				}
+			}
+
+			got, err := u.UpdateLocation(tt.args.ctx, tt.args.id, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.UpdateLocation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (got.Name != name || got.Status != dto.LocationStatusSuspended) {
+				t.Errorf("expected a suspended location named %s, got %v", name, got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListFacilityLocations(t *testing.T) {
+	ctx := context.Background()
+	first := 10
+	invalidFirst := -1
+
+	type args struct {
+		ctx        context.Context
+		facilityID string
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case - list the locations of a facility",
+			args: args{
+				ctx:        ctx,
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{
+					First: &first,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case - invalid facility id",
+			args: args{
+				ctx:        ctx,
+				facilityID: "facility",
+				pagination: dto.Pagination{
+					First: &first,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - invalid pagination",
+			args: args{
+				ctx:        ctx,
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{
+					First: &invalidFirst,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - failed to get tenant identifiers",
+			args: args{
+				ctx:        ctx,
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{
+					First: &first,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - failed to search locations",
+			args: args{
+				ctx:        ctx,
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{
+					First: &first,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Happy case - list the locations of a facility" {
+				fakeFHIR.MockSearchFHIRLocationFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRLocation, error) {
+					if params["organization"] != fmt.Sprintf("Organization/%s", tt.args.facilityID) || params["status"] != "active" {
+						return nil, fmt.Errorf("expected to search the active locations of the facility")
+					}
+
+					id := gofakeit.UUID()
+					name := "Maternity Ward"
+					status := scalarutils.Code("active")
+
+					return &domain.PagedFHIRLocation{
+						Locations: []domain.FHIRLocation{
+							{
+								ID:     &id,
+								Name:   &name,
+								Status: &status,
+							},
+						},
+						TotalCount: 1,
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case - failed to get tenant identifiers" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("failed to get tenant identifiers")
+				}
+			}
+
+			if tt.name == "Sad case - failed to search locations" {
+				fakeFHIR.MockSearchFHIRLocationFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRLocation, error) {
+					return nil, fmt.Errorf("failed to search locations")
+				}
+			}
+
+			got, err := u.ListFacilityLocations(tt.args.ctx, tt.args.facilityID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListFacilityLocations() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got.TotalCount != 1 {
+				t.Errorf("expected one location, got %v", got.TotalCount)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package clinical
 
 import (
 	"context"
+	"crypto/sha1" //nolint:gosec
+	"encoding/base64"
 	"fmt"
 	"io"
 	"time"
@@ -39,11 +41,18 @@ func (c *UseCasesClinicalImpl) UploadMedia(ctx context.Context, encounterID stri
 		return nil, err
 	}
 
-	mediaUploadOutput, err := c.infrastructure.Upload.UploadMedia(ctx, mediaObjectName, file, contentType)
+	// the attachment hash is the base64 encoded SHA-1 of the content as specified in https://hl7.org/fhir/R4/datatypes.html#Attachment
+	hasher := sha1.New() //nolint:gosec
+	counter := &byteCounter{}
+
+	mediaUploadOutput, err := c.infrastructure.Upload.UploadMedia(ctx, mediaObjectName, io.TeeReader(file, io.MultiWriter(hasher, counter)), contentType)
 	if err != nil {
 		return nil, err
 	}
 
+	hash := scalarutils.Base64Binary(base64.StdEncoding.EncodeToString(hasher.Sum(nil)))
+	size := counter.count
+
 	now := time.Now()
 	id := uuid.New().String()
 	mediaSystem := scalarutils.URI("http://terminology.hl7.org/CodeSystem/media-type")
@@ -71,6 +80,8 @@ func (c *UseCasesClinicalImpl) UploadMedia(ctx context.Context, encounterID stri
 			ContentType: (*scalarutils.Code)(&mediaUploadOutput.ContentType),
 			URL:         (*scalarutils.URL)(&mediaUploadOutput.URL),
 			Title:       &mediaUploadOutput.Name,
+			Hash:        &hash,
+			Size:        &size,
 		},
 		Issued: &now,
 		Height: 465,
@@ -168,3 +179,14 @@ func mapFHIRMediaToMediaDTO(fhirMedia domain.FHIRMedia) *dto.Media {
 
 	return media
 }
+
+// byteCounter counts the bytes written to it
+type byteCounter struct {
+	count int
+}
+
+func (b *byteCounter) Write(p []byte) (int, error) {
+	b.count += len(p)
+
+	return len(p), nil
+}
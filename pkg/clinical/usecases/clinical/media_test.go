@@ -43,6 +43,16 @@ func TestUseCasesClinicalImpl_UploadMedia(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "happy case: upload media with content hash and size",
+			args: args{
+				ctx:         addTenantIdentifierContext(context.Background()),
+				encounterID: uuid.NewString(),
+				file:        strings.NewReader("test"),
+				contentType: "application/pdf",
+			},
+			wantErr: false,
+		},
 		{
 			name: "sad case: unable to get encounter",
 			args: args{
@@ -132,6 +142,33 @@ func TestUseCasesClinicalImpl_UploadMedia(t *testing.T) {
 					return nil, fmt.Errorf("an error occurred")
 				}
 			}
+			if tt.name == "happy case: upload media with content hash and size" {
+				fakeUpload.MockUploadMediaFn = func(ctx context.Context, name string, file io.Reader, contentType string) (*dto.Media, error) {
+					_, err := io.ReadAll(file)
+					if err != nil {
+						return nil, err
+					}
+
+					return &dto.Media{
+						URL:         "https://google.com",
+						Name:        name,
+						ContentType: contentType,
+					}, nil
+				}
+
+				createMedia := fakeFHIR.MockCreateFHIRMediaFn
+				fakeFHIR.MockCreateFHIRMediaFn = func(ctx context.Context, input domain.FHIRMedia) (*domain.FHIRMedia, error) {
+					if input.Content.Hash == nil || *input.Content.Hash != "qUqP5cyxm6YcTAhz05Hph5gvu9M=" {
+						return nil, fmt.Errorf("unexpected content hash")
+					}
+
+					if input.Content.Size == nil || *input.Content.Size != 4 {
+						return nil, fmt.Errorf("unexpected content size")
+					}
+
+					return createMedia(ctx, input)
+				}
+			}
 			if tt.name == "sad case: unable to upload media" {
 				fakeUpload.MockUploadMediaFn = func(ctx context.Context, name string, file io.Reader, contentType string) (*dto.Media, error) {
 					return nil, fmt.Errorf("an error occurred")
@@ -0,0 +1,291 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// DispenseMedication records the supply of a prescribed medication by the pharmacy.
+// A prescription may be dispensed in parts; once the whole prescribed quantity, including refills, has been dispensed
+// the prescription is marked as completed
+func (c *UseCasesClinicalImpl) DispenseMedication(ctx context.Context, input dto.MedicationDispenseInput) (*dto.MedicationDispense, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	medicationRequest, err := c.infrastructure.FHIR.GetFHIRMedicationRequest(ctx, input.PrescriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	prescription := *medicationRequest.Resource
+
+	if medicationRequestStatus(prescription) != dto.MedicationRequestStatusActive {
+		return nil, fmt.Errorf("only active prescriptions can be dispensed")
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	previous, err := c.prescriptionDispenses(ctx, input.PrescriptionID, *identifiers, true)
+	if err != nil {
+		return nil, err
+	}
+
+	supply := newPrescriptionSupply(prescription, previous)
+
+	remaining := supply.remaining()
+	if remaining != nil && input.Quantity > *remaining {
+		return nil, fmt.Errorf("the quantity to dispense exceeds the remaining %v %s of the prescription", *remaining, supply.unit)
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	status := scalarutils.Code(dto.MedicationDispenseStatusCompleted.Code())
+	prescriptionReference := fmt.Sprintf("MedicationRequest/%s", input.PrescriptionID)
+	daysSupplyUnit := dto.TimeUnitDays.Code()
+
+	dispense := domain.FHIRMedicationDispense{
+		Status:                    &status,
+		Type:                      supply.dispenseType(input.Quantity),
+		MedicationCodeableConcept: prescription.MedicationCodeableConcept,
+		Subject:                   prescription.Subject,
+		Context:                   prescription.Encounter,
+		AuthorizingPrescription: []*domain.FHIRReference{
+			{
+				ID:        &input.PrescriptionID,
+				Reference: &prescriptionReference,
+			},
+		},
+		Quantity: &domain.FHIRQuantity{
+			Value:  input.Quantity,
+			Unit:   supply.unit,
+			System: scalarutils.URI(ucumSystem),
+			Code:   scalarutils.Code(supply.unit),
+		},
+		DaysSupply: &domain.FHIRQuantity{
+			Value:  float64(input.DaysSupply),
+			Unit:   daysSupplyUnit,
+			System: scalarutils.URI(ucumSystem),
+			Code:   scalarutils.Code(daysSupplyUnit),
+		},
+		WhenPrepared:      &now,
+		WhenHandedOver:    &now,
+		DosageInstruction: prescription.DosageInstruction,
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+	}
+
+	if identifiers.FacilityID != "" {
+		facilityReference := fmt.Sprintf("Organization/%s", identifiers.FacilityID)
+		dispense.Performer = []*domain.FHIRMedicationDispensePerformer{
+			{
+				Actor: &domain.FHIRReference{
+					ID:        &identifiers.FacilityID,
+					Reference: &facilityReference,
+				},
+			},
+		}
+	}
+
+	if input.Note != "" {
+		dispense.Note = []*domain.FHIRAnnotation{
+			{
+				Text: (*scalarutils.Markdown)(&input.Note),
+			},
+		}
+	}
+
+	resource, err := c.infrastructure.FHIR.CreateFHIRMedicationDispense(ctx, dispense)
+	if err != nil {
+		return nil, err
+	}
+
+	if supply.isFulfilledBy(input.Quantity) {
+		request, err := medicationRequestInput(prescription)
+		if err != nil {
+			return nil, err
+		}
+
+		completed := scalarutils.Code(dto.MedicationRequestStatusCompleted.Code())
+		request.Status = &completed
+
+		_, err = c.infrastructure.FHIR.UpdateFHIRMedicationRequest(ctx, *request)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return mapFHIRMedicationDispenseToDTO(*resource), nil
+}
+
+// ListPrescriptionDispenses lists the medication dispensed against a prescription
+func (c *UseCasesClinicalImpl) ListPrescriptionDispenses(ctx context.Context, prescriptionID string) ([]*dto.MedicationDispense, error) {
+	_, err := uuid.Parse(prescriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prescription id: %s", prescriptionID)
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	resources, err := c.prescriptionDispenses(ctx, prescriptionID, *identifiers, false)
+	if err != nil {
+		return nil, err
+	}
+
+	dispenses := []*dto.MedicationDispense{}
+
+	for _, resource := range resources {
+		dispenses = append(dispenses, mapFHIRMedicationDispenseToDTO(resource))
+	}
+
+	return dispenses, nil
+}
+
+// PharmacyWorklist lists a facility's active prescriptions that are yet to be fully dispensed, oldest first.
+// Only the worklist of the facility in the tenant context can be listed
+func (c *UseCasesClinicalImpl) PharmacyWorklist(ctx context.Context, facilityID string, pagination dto.Pagination) (*dto.PharmacyWorklistConnection, error) {
+	_, err := uuid.Parse(facilityID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid facility id: %s", facilityID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	if identifiers.FacilityID != facilityID {
+		return nil, fmt.Errorf("the worklist of facility %s can only be listed from the facility", facilityID)
+	}
+
+	params := map[string]interface{}{
+		"status": dto.MedicationRequestStatusActive.Code(),
+		"intent": "order",
+		"_sort":  "authoredon",
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRMedicationRequest(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	prescriptionIDs := []string{}
+
+	for _, resource := range resources.MedicationRequests {
+		if resource.ID != nil {
+			prescriptionIDs = append(prescriptionIDs, *resource.ID)
+		}
+	}
+
+	dispenses, err := c.prescriptionsCompletedDispenses(ctx, prescriptionIDs, *identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*dto.PharmacyWorklistItem{}
+
+	for _, resource := range resources.MedicationRequests {
+		if resource.ID == nil {
+			continue
+		}
+
+		supply := newPrescriptionSupply(resource, dispenses[*resource.ID])
+
+		items = append(items, &dto.PharmacyWorklistItem{
+			Prescription:      *mapFHIRMedicationRequestToPrescriptionDTO(resource),
+			DispensedQuantity: supply.dispensed,
+			RemainingQuantity: supply.remaining(),
+			LastDispensedOn:   supply.lastDispensedOn,
+		})
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreatePharmacyWorklistConnection(items, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
+
+// prescriptionsCompletedDispenses returns the completed dispenses of several prescriptions by their prescription using a single search
+func (c *UseCasesClinicalImpl) prescriptionsCompletedDispenses(ctx context.Context, prescriptionIDs []string, identifiers dto.TenantIdentifiers) (map[string][]domain.FHIRMedicationDispense, error) {
+	dispenses := map[string][]domain.FHIRMedicationDispense{}
+
+	if len(prescriptionIDs) == 0 {
+		return dispenses, nil
+	}
+
+	references := []string{}
+	for _, id := range prescriptionIDs {
+		references = append(references, fmt.Sprintf("MedicationRequest/%s", id))
+	}
+
+	params := map[string]interface{}{
+		"prescription": strings.Join(references, ","),
+		"status":       dto.MedicationDispenseStatusCompleted.Code(),
+		"_sort":        "-whenhandedover",
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRMedicationDispense(ctx, params, identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.MedicationDispenses {
+		prescriptionID := dispensePrescriptionID(resource)
+		if prescriptionID == "" {
+			continue
+		}
+
+		dispenses[prescriptionID] = append(dispenses[prescriptionID], resource)
+	}
+
+	return dispenses, nil
+}
+
+// prescriptionDispenses returns the medication dispensed against a prescription, optionally only the completed dispenses
+func (c *UseCasesClinicalImpl) prescriptionDispenses(ctx context.Context, prescriptionID string, identifiers dto.TenantIdentifiers, completedOnly bool) ([]domain.FHIRMedicationDispense, error) {
+	params := map[string]interface{}{
+		"prescription": fmt.Sprintf("MedicationRequest/%s", prescriptionID),
+		"_sort":        "-whenhandedover",
+	}
+
+	if completedOnly {
+		params["status"] = dto.MedicationDispenseStatusCompleted.Code()
+	}
+
+	dispenses, err := c.infrastructure.FHIR.SearchFHIRMedicationDispense(ctx, params, identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return dispenses.MedicationDispenses, nil
+}
@@ -0,0 +1,222 @@
+package clinical
+
+import (
+	"math"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// pharmacySupplyTypeSystem is the code system of the kinds of dispenses e.g a first fill or a partial refill
+const pharmacySupplyTypeSystem = "http://terminology.hl7.org/CodeSystem/v3-ActCode"
+
+const (
+	firstFillCode     = "FF"
+	firstFillPartCode = "FFP"
+	refillCode        = "RF"
+	refillPartCode    = "RFP"
+)
+
+var pharmacySupplyTypeDisplays = map[string]string{
+	firstFillCode:     "First Fill",
+	firstFillPartCode: "First Fill - Part Fill",
+	refillCode:        "Refill",
+	refillPartCode:    "Refill - Part Fill",
+}
+
+// prescriptionSupply summarises how much of a prescription has been dispensed
+type prescriptionSupply struct {
+	unit string
+
+	// perFill is the quantity to dispense on each fill. It is not set when the prescription does not specify a quantity
+	perFill *float64
+	fills   int
+
+	dispensed       float64
+	dispenses       int
+	lastDispensedOn *scalarutils.DateTime
+}
+
+func newPrescriptionSupply(prescription domain.FHIRMedicationRequest, dispenses []domain.FHIRMedicationDispense) prescriptionSupply {
+	supply := prescriptionSupply{
+		fills: 1,
+	}
+
+	if prescription.DispenseRequest != nil {
+		if prescription.DispenseRequest.NumberOfRepeatsAllowed != nil {
+			supply.fills += *prescription.DispenseRequest.NumberOfRepeatsAllowed
+		}
+
+		if prescription.DispenseRequest.Quantity != nil {
+			supply.perFill = &prescription.DispenseRequest.Quantity.Value
+			supply.unit = prescription.DispenseRequest.Quantity.Unit
+		}
+	}
+
+	if supply.unit == "" && len(prescription.DosageInstruction) > 0 && prescription.DosageInstruction[0] != nil {
+		doseAndRate := prescription.DosageInstruction[0].DoseAndRate
+		if len(doseAndRate) > 0 && doseAndRate[0].DoseQuantity != nil {
+			supply.unit = doseAndRate[0].DoseQuantity.Unit
+		}
+	}
+
+	for _, dispense := range dispenses {
+		if dispense.Quantity != nil {
+			supply.dispensed += dispense.Quantity.Value
+		}
+
+		supply.dispenses++
+
+		if dispense.WhenHandedOver != nil && (supply.lastDispensedOn == nil || *dispense.WhenHandedOver > string(*supply.lastDispensedOn)) {
+			lastDispensedOn := scalarutils.DateTime(*dispense.WhenHandedOver)
+			supply.lastDispensedOn = &lastDispensedOn
+		}
+	}
+
+	return supply
+}
+
+// remaining returns the quantity of the prescription, including refills, that is yet to be dispensed
+func (s prescriptionSupply) remaining() *float64 {
+	if s.perFill == nil {
+		return nil
+	}
+
+	remaining := math.Max(*s.perFill*float64(s.fills)-s.dispensed, 0)
+
+	return &remaining
+}
+
+// isFulfilledBy checks whether dispensing a quantity completes the prescription.
+// Prescriptions without a quantity are fulfilled once every fill has been dispensed
+func (s prescriptionSupply) isFulfilledBy(quantity float64) bool {
+	remaining := s.remaining()
+	if remaining == nil {
+		return s.dispenses+1 >= s.fills
+	}
+
+	return quantity >= *remaining
+}
+
+// dispenseType classifies a dispense of a quantity as a first fill or a refill and whether it is partial
+func (s prescriptionSupply) dispenseType(quantity float64) *domain.FHIRCodeableConcept {
+	refill := s.dispenses > 0
+	partial := false
+
+	if s.perFill != nil && *s.perFill > 0 {
+		refill = s.dispensed >= *s.perFill
+		partial = quantity < *s.perFill-math.Mod(s.dispensed, *s.perFill)
+	}
+
+	code := firstFillCode
+
+	switch {
+	case refill && partial:
+		code = refillPartCode
+	case refill:
+		code = refillCode
+	case partial:
+		code = firstFillPartCode
+	}
+
+	system := scalarutils.URI(pharmacySupplyTypeSystem)
+	typeCode := scalarutils.Code(code)
+
+	return &domain.FHIRCodeableConcept{
+		Coding: []*domain.FHIRCoding{
+			{
+				System:  &system,
+				Code:    &typeCode,
+				Display: pharmacySupplyTypeDisplays[code],
+			},
+		},
+		Text: pharmacySupplyTypeDisplays[code],
+	}
+}
+
+// medicationDispenseStatus converts a FHIR medication dispense status code e.g `in-progress` to its enum
+func medicationDispenseStatus(resource domain.FHIRMedicationDispense) dto.MedicationDispenseStatusEnum {
+	if resource.Status == nil {
+		return dto.MedicationDispenseStatusUnknown
+	}
+
+	for _, status := range []dto.MedicationDispenseStatusEnum{
+		dto.MedicationDispenseStatusPreparation, dto.MedicationDispenseStatusInProgress, dto.MedicationDispenseStatusCancelled,
+		dto.MedicationDispenseStatusOnHold, dto.MedicationDispenseStatusCompleted, dto.MedicationDispenseStatusEnteredInError,
+		dto.MedicationDispenseStatusStopped, dto.MedicationDispenseStatusDeclined,
+	} {
+		if status.Code() == string(*resource.Status) {
+			return status
+		}
+	}
+
+	return dto.MedicationDispenseStatusUnknown
+}
+
+// dispensePrescriptionID returns the ID of the prescription a dispense was made against
+func dispensePrescriptionID(resource domain.FHIRMedicationDispense) string {
+	for _, prescription := range resource.AuthorizingPrescription {
+		if prescription != nil && prescription.ID != nil {
+			return *prescription.ID
+		}
+	}
+
+	return ""
+}
+
+func mapFHIRMedicationDispenseToDTO(resource domain.FHIRMedicationDispense) *dto.MedicationDispense {
+	output := &dto.MedicationDispense{
+		Status: medicationDispenseStatus(resource),
+	}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	output.PrescriptionID = dispensePrescriptionID(resource)
+
+	if resource.Subject != nil && resource.Subject.ID != nil {
+		output.PatientID = *resource.Subject.ID
+	}
+
+	if resource.MedicationCodeableConcept != nil {
+		output.Medication.Name = resource.MedicationCodeableConcept.Text
+
+		if len(resource.MedicationCodeableConcept.Coding) > 0 && resource.MedicationCodeableConcept.Coding[0].Code != nil {
+			output.Medication.Code = string(*resource.MedicationCodeableConcept.Coding[0].Code)
+		}
+	}
+
+	if resource.Quantity != nil {
+		output.Quantity = resource.Quantity.Value
+		output.QuantityUnit = resource.Quantity.Unit
+	}
+
+	if resource.DaysSupply != nil {
+		output.DaysSupply = int(resource.DaysSupply.Value)
+	}
+
+	if resource.Type != nil && len(resource.Type.Coding) > 0 && resource.Type.Coding[0].Code != nil {
+		switch string(*resource.Type.Coding[0].Code) {
+		case firstFillPartCode:
+			output.Partial = true
+		case refillCode:
+			output.Refill = true
+		case refillPartCode:
+			output.Refill = true
+			output.Partial = true
+		}
+	}
+
+	if resource.WhenHandedOver != nil {
+		whenHandedOver := scalarutils.DateTime(*resource.WhenHandedOver)
+		output.WhenHandedOver = &whenHandedOver
+	}
+
+	if len(resource.Note) > 0 && resource.Note[0].Text != nil {
+		output.Note = string(*resource.Note[0].Text)
+	}
+
+	return output
+}
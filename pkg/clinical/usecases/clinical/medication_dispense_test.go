@@ -0,0 +1,442 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+func TestUseCasesClinicalImpl_DispenseMedication(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.MedicationDispenseInput
+	}
+	tests := []struct {
+		name                      string
+		args                      args
+		wantPartial               bool
+		wantPrescriptionCompleted bool
+		wantErr                   bool
+	}{
+		{
+			name: "Happy case: partially dispense a prescription",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationDispenseInput{
+					PrescriptionID: gofakeit.UUID(),
+					Quantity:       5,
+					DaysSupply:     2,
+					Note:           "Balance to be collected next week",
+				},
+			},
+			wantPartial:               true,
+			wantPrescriptionCompleted: false,
+			wantErr:                   false,
+		},
+		{
+			name: "Happy case: dispense the remaining quantity of a prescription",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationDispenseInput{
+					PrescriptionID: gofakeit.UUID(),
+					Quantity:       10,
+					DaysSupply:     4,
+				},
+			},
+			wantPartial:               false,
+			wantPrescriptionCompleted: true,
+			wantErr:                   false,
+		},
+		{
+			name: "Sad case: quantity exceeds the remaining quantity",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationDispenseInput{
+					PrescriptionID: gofakeit.UUID(),
+					Quantity:       20,
+					DaysSupply:     5,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: missing days supply",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationDispenseInput{
+					PrescriptionID: gofakeit.UUID(),
+					Quantity:       5,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: prescription is not active",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationDispenseInput{
+					PrescriptionID: gofakeit.UUID(),
+					Quantity:       5,
+					DaysSupply:     2,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get prescription",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationDispenseInput{
+					PrescriptionID: gofakeit.UUID(),
+					Quantity:       5,
+					DaysSupply:     2,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search previous dispenses",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationDispenseInput{
+					PrescriptionID: gofakeit.UUID(),
+					Quantity:       5,
+					DaysSupply:     2,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create dispense",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationDispenseInput{
+					PrescriptionID: gofakeit.UUID(),
+					Quantity:       5,
+					DaysSupply:     2,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to complete prescription",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationDispenseInput{
+					PrescriptionID: gofakeit.UUID(),
+					Quantity:       10,
+					DaysSupply:     4,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			prescriptionCompleted := false
+			fakeFHIR.MockUpdateFHIRMedicationRequestFn = func(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error) {
+				prescriptionCompleted = input.Status != nil && *input.Status == "completed"
+
+				return &domain.FHIRMedicationRequestRelayPayload{
+					Resource: &domain.FHIRMedicationRequest{
+						ID:     input.ID,
+						Status: input.Status,
+					},
+				}, nil
+			}
+
+			if tt.name == "Sad case: prescription is not active" {
+				fakeFHIR.MockGetFHIRMedicationRequestFn = func(ctx context.Context, id string) (*domain.FHIRMedicationRequestRelayPayload, error) {
+					status := scalarutils.Code("stopped")
+
+					return &domain.FHIRMedicationRequestRelayPayload{
+						Resource: &domain.FHIRMedicationRequest{
+							ID:     &id,
+							Status: &status,
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get prescription" {
+				fakeFHIR.MockGetFHIRMedicationRequestFn = func(ctx context.Context, id string) (*domain.FHIRMedicationRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to search previous dispenses" {
+				fakeFHIR.MockSearchFHIRMedicationDispenseFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationDispense, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create dispense" {
+				fakeFHIR.MockCreateFHIRMedicationDispenseFn = func(ctx context.Context, input domain.FHIRMedicationDispense) (*domain.FHIRMedicationDispense, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to complete prescription" {
+				fakeFHIR.MockUpdateFHIRMedicationRequestFn = func(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.DispenseMedication(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.DispenseMedication() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Partial != tt.wantPartial {
+				t.Errorf("expected partial to be %v, got %v", tt.wantPartial, got.Partial)
+			}
+
+			if prescriptionCompleted != tt.wantPrescriptionCompleted {
+				t.Errorf("expected the prescription completion to be %v, got %v", tt.wantPrescriptionCompleted, prescriptionCompleted)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListPrescriptionDispenses(t *testing.T) {
+	type args struct {
+		ctx            context.Context
+		prescriptionID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list prescription dispenses",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid prescription id",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: "invalid",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search dispenses",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to search dispenses" {
+				fakeFHIR.MockSearchFHIRMedicationDispenseFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationDispense, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.ListPrescriptionDispenses(tt.args.ctx, tt.args.prescriptionID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListPrescriptionDispenses() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && len(got) != 1 {
+				t.Errorf("expected one dispense, got %d", len(got))
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_PharmacyWorklist(t *testing.T) {
+	first := 10
+	invalidFirst := -1
+
+	type args struct {
+		ctx        context.Context
+		facilityID string
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list the pharmacy worklist",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid facility id",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: "invalid",
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: worklist of another facility",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid pagination",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &invalidFirst},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search prescriptions",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search dispenses",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name != "Sad case: worklist of another facility" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return &dto.TenantIdentifiers{
+						OrganizationID: gofakeit.UUID(),
+						FacilityID:     tt.args.facilityID,
+					}, nil
+				}
+			}
+
+			// the dispenses of every prescription on the page are fetched in one search
+			dispenseSearches := 0
+			searchDispenses := fakeFHIR.MockSearchFHIRMedicationDispenseFn
+			fakeFHIR.MockSearchFHIRMedicationDispenseFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationDispense, error) {
+				dispenseSearches++
+
+				dispenses, err := searchDispenses(ctx, params, tenant, pagination)
+				if err != nil {
+					return nil, err
+				}
+
+				prescriptionID := strings.TrimPrefix(strings.Split(params["prescription"].(string), ",")[0], "MedicationRequest/")
+				for _, dispense := range dispenses.MedicationDispenses {
+					dispense.AuthorizingPrescription[0].ID = &prescriptionID
+				}
+
+				return dispenses, nil
+			}
+
+			if tt.name == "Sad case: failed to search prescriptions" {
+				fakeFHIR.MockSearchFHIRMedicationRequestFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationRequest, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to search dispenses" {
+				fakeFHIR.MockSearchFHIRMedicationDispenseFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationDispense, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.PharmacyWorklist(tt.args.ctx, tt.args.facilityID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.PharmacyWorklist() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if len(got.Edges) != 1 {
+				t.Errorf("expected one prescription in the worklist, got %d", len(got.Edges))
+				return
+			}
+
+			if dispenseSearches != 1 {
+				t.Errorf("expected the dispenses to be fetched in one search, got %d", dispenseSearches)
+			}
+
+			remaining := got.Edges[0].Node.RemainingQuantity
+			if remaining == nil || *remaining != 10 {
+				t.Errorf("expected 10 to remain to be dispensed, got %v", remaining)
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// PrescribeMedication creates a medication request for the patient in an encounter.
+// The medication is coded through OCL and the prescription is linked to the encounter's conditions as the reason for the prescription
+func (c *UseCasesClinicalImpl) PrescribeMedication(ctx context.Context, input dto.PrescriptionInput) (*dto.Prescription, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	encounter, err := c.infrastructure.FHIR.GetFHIREncounter(ctx, input.EncounterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if encounter.Resource.Status == domain.EncounterStatusEnumFinished {
+		return nil, fmt.Errorf("cannot prescribe a medication in a finished encounter")
+	}
+
+	patientID := *encounter.Resource.Subject.ID
+
+	medicationConcept, err := c.GetConceptWithMappings(ctx, input.TerminologySource, input.MedicationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	findings, err := c.medicationInteractions(ctx, patientID, conceptKeys(medicationConcept, string(input.TerminologySource)))
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkInteractionOverride(findings, input.OverrideReason)
+	if err != nil {
+		return nil, err
+	}
+
+	reasons, err := c.prescriptionReasons(ctx, patientID, input.EncounterID, input.ConditionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	patientReference := fmt.Sprintf("Patient/%s", patientID)
+	encounterReference := fmt.Sprintf("Encounter/%s", *encounter.Resource.ID)
+	authoredOn := scalarutils.DateTime(time.Now().Format(time.RFC3339))
+	status := scalarutils.Code(dto.MedicationRequestStatusActive.Code())
+	intent := scalarutils.Code("order")
+
+	medicationRequest := domain.FHIRMedicationRequestInput{
+		Status: &status,
+		Intent: &intent,
+		MedicationCodeableConcept: &domain.FHIRCodeableConceptInput{
+			Coding: []*domain.FHIRCodingInput{
+				{
+					System:  (*scalarutils.URI)(&medicationConcept.URL),
+					Code:    scalarutils.Code(medicationConcept.ID),
+					Display: medicationConcept.DisplayName,
+				},
+			},
+			Text: medicationConcept.DisplayName,
+		},
+		Subject: &domain.FHIRReferenceInput{
+			ID:        &patientID,
+			Reference: &patientReference,
+			Display:   encounter.Resource.Subject.Display,
+		},
+		Encounter: &domain.FHIRReferenceInput{
+			ID:        encounter.Resource.ID,
+			Reference: &encounterReference,
+		},
+		AuthoredOn:        &authoredOn,
+		ReasonReference:   reasons,
+		DosageInstruction: []*domain.FHIRDosageInput{composeDosageInstruction(*input.Dosage)},
+		DispenseRequest:   composeDispenseRequest(input),
+		Extension:         composeInteractionExtensions(findings, input.OverrideReason),
+	}
+
+	if input.Note != "" {
+		medicationRequest.Note = []*domain.FHIRAnnotationInput{
+			{
+				Time: &authoredOn,
+				Text: (*scalarutils.Markdown)(&input.Note),
+			},
+		}
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	medicationRequest.Meta = domain.FHIRMetaInput{
+		Tag: tags,
+	}
+
+	resp, err := c.infrastructure.FHIR.CreateFHIRMedicationRequest(ctx, medicationRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRMedicationRequestToPrescriptionDTO(*resp.Resource), nil
+}
+
+// ListPatientPrescriptions lists a patient's prescriptions, optionally filtered by status
+func (c *UseCasesClinicalImpl) ListPatientPrescriptions(ctx context.Context, patientID string, status *dto.MedicationRequestStatusEnum, pagination dto.Pagination) (*dto.PrescriptionConnection, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"subject": fmt.Sprintf("Patient/%s", patientID),
+		"_sort":   "-authoredon",
+	}
+
+	if status != nil {
+		if !status.IsValid() {
+			return nil, fmt.Errorf("invalid medication request status: %s", *status)
+		}
+
+		params["status"] = status.Code()
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRMedicationRequest(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	prescriptions := []*dto.Prescription{}
+
+	for _, resource := range resources.MedicationRequests {
+		prescriptions = append(prescriptions, mapFHIRMedicationRequestToPrescriptionDTO(resource))
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreatePrescriptionConnection(prescriptions, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
+
+// DiscontinuePrescription stops an ongoing prescription, recording the reason it was stopped
+func (c *UseCasesClinicalImpl) DiscontinuePrescription(ctx context.Context, prescriptionID string, reason string) (*dto.Prescription, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("a reason is required to discontinue a prescription")
+	}
+
+	medicationRequest, err := c.infrastructure.FHIR.GetFHIRMedicationRequest(ctx, prescriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch medicationRequestStatus(*medicationRequest.Resource) {
+	case dto.MedicationRequestStatusActive, dto.MedicationRequestStatusOnHold, dto.MedicationRequestStatusDraft:
+	default:
+		return nil, fmt.Errorf("only active, on hold or draft prescriptions can be discontinued")
+	}
+
+	input, err := medicationRequestInput(*medicationRequest.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	status := scalarutils.Code(dto.MedicationRequestStatusStopped.Code())
+	input.Status = &status
+	input.StatusReason = &domain.FHIRCodeableConceptInput{
+		Text: reason,
+	}
+
+	resp, err := c.infrastructure.FHIR.UpdateFHIRMedicationRequest(ctx, *input)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRMedicationRequestToPrescriptionDTO(*resp.Resource), nil
+}
+
+// RenewPrescription issues a new prescription in the given encounter with the same medication and dosage as an earlier one.
+// The new prescription references the earlier one as its prior prescription and the earlier one is marked as completed.
+// The medication is checked for interactions again since the patient's allergies and medications may have changed
+func (c *UseCasesClinicalImpl) RenewPrescription(ctx context.Context, prescriptionID string, encounterID string, overrideReason *string) (*dto.Prescription, error) {
+	medicationRequest, err := c.infrastructure.FHIR.GetFHIRMedicationRequest(ctx, prescriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch medicationRequestStatus(*medicationRequest.Resource) {
+	case dto.MedicationRequestStatusStopped, dto.MedicationRequestStatusCancelled, dto.MedicationRequestStatusEnteredInError:
+		return nil, fmt.Errorf("a %s prescription cannot be renewed", medicationRequestStatus(*medicationRequest.Resource).Code())
+	}
+
+	encounter, err := c.infrastructure.FHIR.GetFHIREncounter(ctx, encounterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if encounter.Resource.Status == domain.EncounterStatusEnumFinished {
+		return nil, fmt.Errorf("cannot renew a prescription in a finished encounter")
+	}
+
+	subject := medicationRequest.Resource.Subject
+	if subject == nil || subject.ID == nil || *subject.ID != *encounter.Resource.Subject.ID {
+		return nil, fmt.Errorf("the prescription does not belong to the encounter's patient")
+	}
+
+	medication := c.codeableConceptsInteractingConcept(ctx, medicationRequest.Resource.MedicationCodeableConcept)
+
+	findings, err := c.medicationInteractions(ctx, *subject.ID, medication.keys)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkInteractionOverride(findings, overrideReason)
+	if err != nil {
+		return nil, err
+	}
+
+	renewal, err := medicationRequestInput(*medicationRequest.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	encounterReference := fmt.Sprintf("Encounter/%s", *encounter.Resource.ID)
+	priorReference := fmt.Sprintf("MedicationRequest/%s", prescriptionID)
+	authoredOn := scalarutils.DateTime(time.Now().Format(time.RFC3339))
+	status := scalarutils.Code(dto.MedicationRequestStatusActive.Code())
+
+	renewal.ID = nil
+	renewal.Status = &status
+	renewal.StatusReason = nil
+	renewal.Note = nil
+	renewal.Extension = composeInteractionExtensions(findings, overrideReason)
+	renewal.AuthoredOn = &authoredOn
+	renewal.Encounter = &domain.FHIRReferenceInput{
+		ID:        encounter.Resource.ID,
+		Reference: &encounterReference,
+	}
+	renewal.PriorPrescription = &domain.FHIRReferenceInput{
+		ID:        &prescriptionID,
+		Reference: &priorReference,
+	}
+
+	if renewal.DispenseRequest != nil {
+		renewal.DispenseRequest.ValidityPeriod = &domain.FHIRPeriodInput{
+			Start: authoredOn,
+		}
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	renewal.Meta = domain.FHIRMetaInput{
+		Tag: tags,
+	}
+
+	resp, err := c.infrastructure.FHIR.CreateFHIRMedicationRequest(ctx, *renewal)
+	if err != nil {
+		return nil, err
+	}
+
+	if medicationRequestStatus(*medicationRequest.Resource) != dto.MedicationRequestStatusCompleted {
+		previous, err := medicationRequestInput(*medicationRequest.Resource)
+		if err != nil {
+			return nil, err
+		}
+
+		completed := scalarutils.Code(dto.MedicationRequestStatusCompleted.Code())
+		previous.Status = &completed
+
+		_, err = c.infrastructure.FHIR.UpdateFHIRMedicationRequest(ctx, *previous)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return mapFHIRMedicationRequestToPrescriptionDTO(*resp.Resource), nil
+}
+
+// prescriptionReasons builds the reason references of a prescription.
+// When no conditions are specified, all the conditions recorded in the encounter are used
+func (c *UseCasesClinicalImpl) prescriptionReasons(ctx context.Context, patientID, encounterID string, conditionIDs []string) ([]*domain.FHIRReferenceInput, error) {
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"subject": fmt.Sprintf("Patient/%s", patientID),
+	}
+
+	if len(conditionIDs) > 0 {
+		params["_id"] = strings.Join(conditionIDs, ",")
+	} else {
+		params["encounter"] = fmt.Sprintf("Encounter/%s", encounterID)
+	}
+
+	conditions, err := c.infrastructure.FHIR.SearchFHIRCondition(ctx, params, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(conditionIDs) > 0 && len(conditions.Conditions) != len(conditionIDs) {
+		return nil, fmt.Errorf("some of the conditions do not exist or do not belong to the patient")
+	}
+
+	conditionType := scalarutils.URI("Condition")
+	reasons := []*domain.FHIRReferenceInput{}
+
+	for _, condition := range conditions.Conditions {
+		conditionReference := fmt.Sprintf("Condition/%s", *condition.ID)
+		reason := &domain.FHIRReferenceInput{
+			ID:        condition.ID,
+			Reference: &conditionReference,
+			Type:      &conditionType,
+		}
+
+		if condition.Code != nil {
+			reason.Display = condition.Code.Text
+		}
+
+		reasons = append(reasons, reason)
+	}
+
+	return reasons, nil
+}
+
+// checkInteractionOverride blocks a prescription with hard stop interactions unless the prescriber gives a reason to override them
+func checkInteractionOverride(findings []*dto.InteractionFinding, overrideReason *string) error {
+	stops := hardStops(findings)
+	if len(stops) > 0 && (overrideReason == nil || strings.TrimSpace(*overrideReason) == "") {
+		return fmt.Errorf("the prescription has interactions that require an override reason: %s", strings.Join(stops, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package clinical
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+const (
+	ucumSystem     = "http://unitsofmeasure.org"
+	snomedCTSystem = "http://snomed.info/sct"
+)
+
+var allMedicationRoutes = []dto.MedicationRouteEnum{
+	dto.MedicationRouteOral,
+	dto.MedicationRouteSublingual,
+	dto.MedicationRouteIntravenous,
+	dto.MedicationRouteIntramuscular,
+	dto.MedicationRouteSubcutaneous,
+	dto.MedicationRouteTopical,
+	dto.MedicationRouteRectal,
+	dto.MedicationRouteVaginal,
+	dto.MedicationRouteNasal,
+	dto.MedicationRouteOphthalmic,
+	dto.MedicationRouteInhalation,
+	dto.MedicationRouteIntradermal,
+}
+
+var allTimeUnits = []dto.TimeUnitEnum{
+	dto.TimeUnitMinutes,
+	dto.TimeUnitHours,
+	dto.TimeUnitDays,
+	dto.TimeUnitWeeks,
+	dto.TimeUnitMonths,
+}
+
+// composeDosageInstruction converts a structured dosage into a FHIR dosage
+func composeDosageInstruction(dosage dto.DosageInput) *domain.FHIRDosageInput {
+	text := dosageText(dosage)
+	routeSystem := scalarutils.URI(snomedCTSystem)
+	periodUnit := domain.TimingRepeatPeriodUnitEnum(dosage.PeriodUnit.Code())
+	durationUnit := dosage.DurationUnit.Code()
+	durationCode := scalarutils.Code(durationUnit)
+	durationSystem := scalarutils.URI(ucumSystem)
+
+	instruction := &domain.FHIRDosageInput{
+		Text: &text,
+		Timing: &domain.FHIRTimingInput{
+			Repeat: &domain.FHIRTimingRepeatInput{
+				BoundsDuration: &domain.FHIRDurationInput{
+					Value:  &dosage.Duration,
+					Unit:   &durationUnit,
+					System: &durationSystem,
+					Code:   &durationCode,
+				},
+				Frequency:  &dosage.Frequency,
+				Period:     &dosage.Period,
+				PeriodUnit: &periodUnit,
+			},
+		},
+		AsNeededBoolean: &dosage.AsNeeded,
+		Route: &domain.FHIRCodeableConceptInput{
+			Coding: []*domain.FHIRCodingInput{
+				{
+					System:  &routeSystem,
+					Code:    scalarutils.Code(dosage.Route.Code()),
+					Display: dosage.Route.Display(),
+				},
+			},
+			Text: dosage.Route.Display(),
+		},
+		DoseAndRate: []*domain.FHIRDosageDoseandrateInput{
+			{
+				DoseQuantity: &domain.FHIRQuantityInput{
+					Value:  dosage.Dose,
+					Unit:   dosage.DoseUnit,
+					System: scalarutils.URI(ucumSystem),
+					Code:   scalarutils.Code(dosage.DoseUnit),
+				},
+			},
+		},
+	}
+
+	if dosage.PatientInstruction != "" {
+		instruction.PatientInstruction = &dosage.PatientInstruction
+	}
+
+	return instruction
+}
+
+// composeDispenseRequest builds the dispense details of a prescription. The quantity is expressed in the dose unit
+func composeDispenseRequest(input dto.PrescriptionInput) *domain.FHIRMedicationrequestDispenserequestInput {
+	durationUnit := input.Dosage.DurationUnit.Code()
+	durationCode := scalarutils.Code(durationUnit)
+	durationSystem := scalarutils.URI(ucumSystem)
+
+	dispenseRequest := &domain.FHIRMedicationrequestDispenserequestInput{
+		ExpectedSupplyDuration: &domain.FHIRDurationInput{
+			Value:  &input.Dosage.Duration,
+			Unit:   &durationUnit,
+			System: &durationSystem,
+			Code:   &durationCode,
+		},
+		NumberOfRepeatsAllowed: input.NumberOfRefills,
+	}
+
+	if input.Quantity != nil {
+		dispenseRequest.Quantity = &domain.FHIRQuantityInput{
+			Value:  *input.Quantity,
+			Unit:   input.Dosage.DoseUnit,
+			System: scalarutils.URI(ucumSystem),
+			Code:   scalarutils.Code(input.Dosage.DoseUnit),
+		}
+	}
+
+	return dispenseRequest
+}
+
+// dosageText renders a structured dosage as a human readable instruction e.g `500 mg oral route, 3 time(s) every 1 d for 5 d`
+func dosageText(dosage dto.DosageInput) string {
+	text := fmt.Sprintf(
+		"%v %s %s, %d time(s) every %v %s for %v %s",
+		dosage.Dose, dosage.DoseUnit, strings.ToLower(dosage.Route.Display()), dosage.Frequency,
+		dosage.Period, dosage.PeriodUnit.Code(), dosage.Duration, dosage.DurationUnit.Code(),
+	)
+
+	if dosage.AsNeeded {
+		text += ", as needed"
+	}
+
+	return text
+}
+
+// medicationRequestInput converts a stored medication request into the input used to update or copy it
+func medicationRequestInput(resource domain.FHIRMedicationRequest) (*domain.FHIRMedicationRequestInput, error) {
+	bs, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal medication request: %w", err)
+	}
+
+	input := &domain.FHIRMedicationRequestInput{}
+
+	err = json.Unmarshal(bs, input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal medication request input: %w", err)
+	}
+
+	return input, nil
+}
+
+// medicationRequestStatus converts a FHIR medication request status code e.g `on-hold` to its enum
+func medicationRequestStatus(resource domain.FHIRMedicationRequest) dto.MedicationRequestStatusEnum {
+	if resource.Status == nil {
+		return dto.MedicationRequestStatusUnknown
+	}
+
+	status := dto.MedicationRequestStatusEnum(strings.ToUpper(strings.ReplaceAll(string(*resource.Status), "-", "_")))
+	if !status.IsValid() {
+		return dto.MedicationRequestStatusUnknown
+	}
+
+	return status
+}
+
+func medicationRouteFromCode(code string) dto.MedicationRouteEnum {
+	for _, route := range allMedicationRoutes {
+		if route.Code() == code {
+			return route
+		}
+	}
+
+	return ""
+}
+
+func timeUnitFromCode(code string) dto.TimeUnitEnum {
+	for _, unit := range allTimeUnits {
+		if unit.Code() == code {
+			return unit
+		}
+	}
+
+	return ""
+}
+
+func mapFHIRDosageToDosageDTO(dosage domain.FHIRDosage) *dto.Dosage {
+	output := &dto.Dosage{}
+
+	if dosage.Text != nil {
+		output.Text = *dosage.Text
+	}
+
+	if dosage.PatientInstruction != nil {
+		output.PatientInstruction = *dosage.PatientInstruction
+	}
+
+	if dosage.AsNeededBoolean != nil {
+		output.AsNeeded = *dosage.AsNeededBoolean
+	}
+
+	if dosage.Route != nil && len(dosage.Route.Coding) > 0 && dosage.Route.Coding[0].Code != nil {
+		output.Route = medicationRouteFromCode(string(*dosage.Route.Coding[0].Code))
+	}
+
+	if len(dosage.DoseAndRate) > 0 && dosage.DoseAndRate[0].DoseQuantity != nil {
+		output.Dose = dosage.DoseAndRate[0].DoseQuantity.Value
+		output.DoseUnit = dosage.DoseAndRate[0].DoseQuantity.Unit
+	}
+
+	if dosage.Timing != nil && dosage.Timing.Repeat != nil {
+		repeat := dosage.Timing.Repeat
+
+		if repeat.Frequency != nil {
+			output.Frequency = *repeat.Frequency
+		}
+
+		if repeat.Period != nil {
+			output.Period = *repeat.Period
+		}
+
+		if repeat.PeriodUnit != nil {
+			output.PeriodUnit = timeUnitFromCode(string(*repeat.PeriodUnit))
+		}
+
+		if repeat.BoundsDuration != nil {
+			if repeat.BoundsDuration.Value != nil {
+				output.Duration = *repeat.BoundsDuration.Value
+			}
+
+			if repeat.BoundsDuration.Code != nil {
+				output.DurationUnit = timeUnitFromCode(string(*repeat.BoundsDuration.Code))
+			}
+		}
+	}
+
+	return output
+}
+
+func mapFHIRMedicationRequestToPrescriptionDTO(resource domain.FHIRMedicationRequest) *dto.Prescription {
+	output := &dto.Prescription{
+		Status:       medicationRequestStatus(resource),
+		AuthoredOn:   resource.AuthoredOn,
+		ConditionIDs: []string{},
+	}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.StatusReason != nil {
+		output.StatusReason = resource.StatusReason.Text
+	}
+
+	if resource.MedicationCodeableConcept != nil {
+		output.Medication.Name = resource.MedicationCodeableConcept.Text
+
+		if len(resource.MedicationCodeableConcept.Coding) > 0 && resource.MedicationCodeableConcept.Coding[0].Code != nil {
+			output.Medication.Code = string(*resource.MedicationCodeableConcept.Coding[0].Code)
+		}
+	}
+
+	if len(resource.DosageInstruction) > 0 && resource.DosageInstruction[0] != nil {
+		output.Dosage = mapFHIRDosageToDosageDTO(*resource.DosageInstruction[0])
+	}
+
+	if resource.DispenseRequest != nil {
+		output.NumberOfRefills = resource.DispenseRequest.NumberOfRepeatsAllowed
+
+		if resource.DispenseRequest.Quantity != nil {
+			output.Quantity = &resource.DispenseRequest.Quantity.Value
+		}
+	}
+
+	for _, reason := range resource.ReasonReference {
+		if reason != nil && reason.ID != nil {
+			output.ConditionIDs = append(output.ConditionIDs, *reason.ID)
+		}
+	}
+
+	if resource.PriorPrescription != nil && resource.PriorPrescription.ID != nil {
+		output.PriorPrescriptionID = *resource.PriorPrescription.ID
+	}
+
+	if len(resource.Note) > 0 && resource.Note[0].Text != nil {
+		output.Note = string(*resource.Note[0].Text)
+	}
+
+	if resource.Subject != nil && resource.Subject.ID != nil {
+		output.PatientID = *resource.Subject.ID
+	}
+
+	if resource.Encounter != nil && resource.Encounter.ID != nil {
+		output.EncounterID = *resource.Encounter.ID
+	}
+
+	output.Interactions, output.OverrideReason = mapInteractionExtensions(resource.Extension)
+
+	return output
+}
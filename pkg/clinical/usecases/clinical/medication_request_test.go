@@ -0,0 +1,719 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/interactions"
+	fakeInteractionsMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/interactions/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+func fakeDosageInput() *dto.DosageInput {
+	return &dto.DosageInput{
+		Dose:               500,
+		DoseUnit:           "mg",
+		Route:              dto.MedicationRouteOral,
+		Frequency:          3,
+		Period:             1,
+		PeriodUnit:         dto.TimeUnitDays,
+		Duration:           5,
+		DurationUnit:       dto.TimeUnitDays,
+		PatientInstruction: "Take after meals",
+	}
+}
+
+func TestUseCasesClinicalImpl_PrescribeMedication(t *testing.T) {
+	quantity := 15.0
+	refills := 1
+	overrideReason := "Benefits outweigh the risks, patient to be monitored"
+
+	type args struct {
+		ctx   context.Context
+		input dto.PrescriptionInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: prescribe medication for the encounter's conditions",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PrescriptionInput{
+					EncounterID:       gofakeit.UUID(),
+					MedicationCode:    "71160",
+					TerminologySource: dto.TerminologySourceCIEL,
+					Dosage:            fakeDosageInput(),
+					Quantity:          &quantity,
+					NumberOfRefills:   &refills,
+					Note:              "Review after the course",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: prescribe medication for specific conditions",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PrescriptionInput{
+					EncounterID:       gofakeit.UUID(),
+					MedicationCode:    "71160",
+					TerminologySource: dto.TerminologySourceCIEL,
+					Dosage:            fakeDosageInput(),
+					ConditionIDs:      []string{gofakeit.UUID()},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: hard stop interaction overridden",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PrescriptionInput{
+					EncounterID:       gofakeit.UUID(),
+					MedicationCode:    "71160",
+					TerminologySource: dto.TerminologySourceCIEL,
+					Dosage:            fakeDosageInput(),
+					OverrideReason:    &overrideReason,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: hard stop interaction without an override reason",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PrescriptionInput{
+					EncounterID:       gofakeit.UUID(),
+					MedicationCode:    "71160",
+					TerminologySource: dto.TerminologySourceCIEL,
+					Dosage:            fakeDosageInput(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to check interactions",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PrescriptionInput{
+					EncounterID:       gofakeit.UUID(),
+					MedicationCode:    "71160",
+					TerminologySource: dto.TerminologySourceCIEL,
+					Dosage:            fakeDosageInput(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: missing dosage",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PrescriptionInput{
+					EncounterID:       gofakeit.UUID(),
+					MedicationCode:    "71160",
+					TerminologySource: dto.TerminologySourceCIEL,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid route",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PrescriptionInput{
+					EncounterID:       gofakeit.UUID(),
+					MedicationCode:    "71160",
+					TerminologySource: dto.TerminologySourceCIEL,
+					Dosage: &dto.DosageInput{
+						Dose:         500,
+						DoseUnit:     "mg",
+						Route:        "INVALID",
+						Frequency:    3,
+						Period:       1,
+						PeriodUnit:   dto.TimeUnitDays,
+						Duration:     5,
+						DurationUnit: dto.TimeUnitDays,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: some conditions do not belong to the patient",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PrescriptionInput{
+					EncounterID:       gofakeit.UUID(),
+					MedicationCode:    "71160",
+					TerminologySource: dto.TerminologySourceCIEL,
+					Dosage:            fakeDosageInput(),
+					ConditionIDs:      []string{gofakeit.UUID(), gofakeit.UUID()},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get encounter",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PrescriptionInput{
+					EncounterID:       gofakeit.UUID(),
+					MedicationCode:    "71160",
+					TerminologySource: dto.TerminologySourceCIEL,
+					Dosage:            fakeDosageInput(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: finished encounter",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PrescriptionInput{
+					EncounterID:       gofakeit.UUID(),
+					MedicationCode:    "71160",
+					TerminologySource: dto.TerminologySourceCIEL,
+					Dosage:            fakeDosageInput(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get medication concept",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PrescriptionInput{
+					EncounterID:       gofakeit.UUID(),
+					MedicationCode:    "71160",
+					TerminologySource: dto.TerminologySourceCIEL,
+					Dosage:            fakeDosageInput(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search conditions",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PrescriptionInput{
+					EncounterID:       gofakeit.UUID(),
+					MedicationCode:    "71160",
+					TerminologySource: dto.TerminologySourceCIEL,
+					Dosage:            fakeDosageInput(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create medication request",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PrescriptionInput{
+					EncounterID:       gofakeit.UUID(),
+					MedicationCode:    "71160",
+					TerminologySource: dto.TerminologySourceCIEL,
+					Dosage:            fakeDosageInput(),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			fakeInteractions := fakeInteractionsMock.NewFakeInteractionsMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			infra.Interactions = fakeInteractions
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Happy case: hard stop interaction overridden" || tt.name == "Sad case: hard stop interaction without an override reason" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return &domain.Concept{
+						ID:     concept,
+						Source: source,
+						Mappings: []*domain.ConceptMapping{
+							{
+								MapType:         "SAME-AS",
+								FromConceptCode: concept,
+								ToConceptCode:   "36567",
+								ToSourceName:    "RxNorm",
+							},
+						},
+					}, nil
+				}
+				fakeInteractions.MockFindInteractionsFn = func(first, second []string) []interactions.Rule {
+					return []interactions.Rule{
+						{
+							First:       "RXNORM:36567",
+							Second:      "CIEL:124",
+							Severity:    dto.InteractionSeverityHigh,
+							Action:      dto.InteractionActionHardStop,
+							Description: "Contraindicated",
+						},
+					}
+				}
+			}
+
+			if tt.name == "Sad case: failed to check interactions" {
+				fakeFHIR.MockSearchPatientAllergyIntoleranceFn = func(ctx context.Context, patientReference string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get encounter" {
+				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: finished encounter" {
+				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+					patientID := gofakeit.UUID()
+
+					return &domain.FHIREncounterRelayPayload{
+						Resource: &domain.FHIREncounter{
+							ID:     &id,
+							Status: domain.EncounterStatusEnumFinished,
+							Subject: &domain.FHIRReference{
+								ID: &patientID,
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get medication concept" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to search conditions" {
+				fakeFHIR.MockSearchFHIRConditionFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCondition, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create medication request" {
+				fakeFHIR.MockCreateFHIRMedicationRequestFn = func(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.PrescribeMedication(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.PrescribeMedication() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got.Status != dto.MedicationRequestStatusActive {
+				t.Errorf("expected an active prescription, got %v", got.Status)
+			}
+
+			if tt.args.input.OverrideReason != nil && (got.OverrideReason != *tt.args.input.OverrideReason || len(got.Interactions) == 0) {
+				t.Errorf("expected the interactions and override reason to be recorded, got %v and %q", got.Interactions, got.OverrideReason)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListPatientPrescriptions(t *testing.T) {
+	first := 10
+	status := dto.MedicationRequestStatusActive
+	invalidStatus := dto.MedicationRequestStatusEnum("INVALID")
+
+	type args struct {
+		ctx        context.Context
+		patientID  string
+		status     *dto.MedicationRequestStatusEnum
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list patient prescriptions",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: list patient prescriptions by status",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				status:     &status,
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid patient ID",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  "invalid",
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid status",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				status:     &invalidStatus,
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get tenant identifiers",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search medication requests",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to get tenant identifiers" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to search medication requests" {
+				fakeFHIR.MockSearchFHIRMedicationRequestFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationRequest, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.ListPatientPrescriptions(tt.args.ctx, tt.args.patientID, tt.args.status, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListPatientPrescriptions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				if len(got.Edges) != 1 {
+					t.Errorf("expected 1 prescription, got %v", len(got.Edges))
+					return
+				}
+
+				dosage := got.Edges[0].Node.Dosage
+				if dosage == nil || dosage.Route != dto.MedicationRouteOral || dosage.PeriodUnit != dto.TimeUnitDays {
+					t.Errorf("expected the structured dosage to be mapped, got %v", dosage)
+				}
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_DiscontinuePrescription(t *testing.T) {
+	type args struct {
+		ctx            context.Context
+		prescriptionID string
+		reason         string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: discontinue prescription",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+				reason:         "Adverse reaction",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing reason",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: prescription already completed",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+				reason:         "Adverse reaction",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get prescription",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+				reason:         "Adverse reaction",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update prescription",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+				reason:         "Adverse reaction",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: prescription already completed" {
+				fakeFHIR.MockGetFHIRMedicationRequestFn = func(ctx context.Context, id string) (*domain.FHIRMedicationRequestRelayPayload, error) {
+					status := scalarutils.Code("completed")
+
+					return &domain.FHIRMedicationRequestRelayPayload{
+						Resource: &domain.FHIRMedicationRequest{
+							ID:     &id,
+							Status: &status,
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get prescription" {
+				fakeFHIR.MockGetFHIRMedicationRequestFn = func(ctx context.Context, id string) (*domain.FHIRMedicationRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to update prescription" {
+				fakeFHIR.MockUpdateFHIRMedicationRequestFn = func(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.DiscontinuePrescription(tt.args.ctx, tt.args.prescriptionID, tt.args.reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.DiscontinuePrescription() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (got.Status != dto.MedicationRequestStatusStopped || got.StatusReason != tt.args.reason) {
+				t.Errorf("expected a stopped prescription with a reason, got %v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_RenewPrescription(t *testing.T) {
+	type args struct {
+		ctx            context.Context
+		prescriptionID string
+		encounterID    string
+		overrideReason *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: renew prescription",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+				encounterID:    gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: prescription was stopped",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+				encounterID:    gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: prescription belongs to another patient",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+				encounterID:    gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get prescription",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+				encounterID:    gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get encounter",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+				encounterID:    gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create renewal",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+				encounterID:    gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to complete previous prescription",
+			args: args{
+				ctx:            context.Background(),
+				prescriptionID: gofakeit.UUID(),
+				encounterID:    gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: prescription was stopped" {
+				fakeFHIR.MockGetFHIRMedicationRequestFn = func(ctx context.Context, id string) (*domain.FHIRMedicationRequestRelayPayload, error) {
+					status := scalarutils.Code("stopped")
+
+					return &domain.FHIRMedicationRequestRelayPayload{
+						Resource: &domain.FHIRMedicationRequest{
+							ID:     &id,
+							Status: &status,
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: prescription belongs to another patient" {
+				fakeFHIR.MockGetFHIRMedicationRequestFn = func(ctx context.Context, id string) (*domain.FHIRMedicationRequestRelayPayload, error) {
+					status := scalarutils.Code("active")
+					patientID := gofakeit.UUID()
+
+					return &domain.FHIRMedicationRequestRelayPayload{
+						Resource: &domain.FHIRMedicationRequest{
+							ID:     &id,
+							Status: &status,
+							Subject: &domain.FHIRReference{
+								ID: &patientID,
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get prescription" {
+				fakeFHIR.MockGetFHIRMedicationRequestFn = func(ctx context.Context, id string) (*domain.FHIRMedicationRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get encounter" {
+				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create renewal" {
+				fakeFHIR.MockCreateFHIRMedicationRequestFn = func(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to complete previous prescription" {
+				fakeFHIR.MockUpdateFHIRMedicationRequestFn = func(ctx context.Context, input domain.FHIRMedicationRequestInput) (*domain.FHIRMedicationRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.RenewPrescription(tt.args.ctx, tt.args.prescriptionID, tt.args.encounterID, tt.args.overrideReason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RenewPrescription() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got.PriorPrescriptionID != tt.args.prescriptionID {
+				t.Errorf("expected the renewal to reference %s, got %s", tt.args.prescriptionID, got.PriorPrescriptionID)
+			}
+		})
+	}
+}
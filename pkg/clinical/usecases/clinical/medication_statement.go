@@ -0,0 +1,403 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// ListPatientMedicationStatements lists the medications a patient is recorded to be taking, optionally filtered by status
+func (c *UseCasesClinicalImpl) ListPatientMedicationStatements(ctx context.Context, patientID string, status *dto.MedicationStatementStatusEnum, pagination dto.Pagination) (*dto.MedicationStatementConnection, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"subject": fmt.Sprintf("Patient/%s", patientID),
+		"_sort":   "-_lastUpdated",
+	}
+
+	if status != nil {
+		if !status.IsValid() {
+			return nil, fmt.Errorf("invalid medication statement status: %s", *status)
+		}
+
+		params["status"] = status.Code()
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRMedicationStatement(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	statements := []*dto.MedicationStatement{}
+
+	for _, edge := range resources.Edges {
+		if edge == nil || edge.Node == nil || edge.Node.ID == nil {
+			continue
+		}
+
+		statements = append(statements, mapFHIRMedicationStatementToMedicationStatementDTO(edge.Node))
+	}
+
+	pageInfo := dto.PageInfo{}
+
+	if resources.PageInfo != nil {
+		pageInfo = dto.PageInfo{
+			HasNextPage:     resources.PageInfo.HasNextPage,
+			EndCursor:       resources.PageInfo.EndCursor,
+			HasPreviousPage: resources.PageInfo.HasPreviousPage,
+			StartCursor:     resources.PageInfo.StartCursor,
+		}
+	}
+
+	connection := dto.CreateMedicationStatementConnection(statements, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
+
+// UpdateMedicationStatement changes the status, dosage or note of a medication the patient is recorded to be taking.
+// Medications are stopped using StopMedicationStatement so that the reason they were stopped is recorded
+func (c *UseCasesClinicalImpl) UpdateMedicationStatement(ctx context.Context, id string, input dto.MedicationStatementInput) (*dto.MedicationStatement, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Status != nil && *input.Status == dto.MedicationStatementStatusEnumStopped {
+		return nil, fmt.Errorf("a medication statement is stopped using stopMedicationStatement")
+	}
+
+	medicationStatement, err := c.infrastructure.FHIR.GetFHIRMedicationStatement(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if medicationStatementStatus(*medicationStatement.Resource) == dto.MedicationStatementStatusEnumEnteredInError {
+		return nil, fmt.Errorf("a medication statement entered in error cannot be updated")
+	}
+
+	statement, err := medicationStatementInput(*medicationStatement.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Status != nil {
+		status := domain.MedicationStatementStatusEnum(input.Status.Code())
+		statement.Status = &status
+	}
+
+	if input.Dosage != nil {
+		dosage := composeDosageInstruction(*input.Dosage)
+		statement.Dosage = []*domain.FHIRDosageInput{dosage}
+	}
+
+	if input.Note != nil {
+		statement.Note = []*domain.FHIRAnnotationInput{
+			{
+				Text: (*scalarutils.Markdown)(input.Note),
+			},
+		}
+	}
+
+	resp, err := c.infrastructure.FHIR.UpdateFHIRMedicationStatement(ctx, *statement)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRMedicationStatementToMedicationStatementDTO(resp.Resource), nil
+}
+
+// StopMedicationStatement records that the patient has stopped taking a medication, and the reason it was stopped
+func (c *UseCasesClinicalImpl) StopMedicationStatement(ctx context.Context, id string, reason string) (*dto.MedicationStatement, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("a reason is required to stop a medication statement")
+	}
+
+	medicationStatement, err := c.infrastructure.FHIR.GetFHIRMedicationStatement(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch medicationStatementStatus(*medicationStatement.Resource) {
+	case dto.MedicationStatementStatusEnumActive, dto.MedicationStatementStatusEnumIntended,
+		dto.MedicationStatementStatusEnumOnHold, dto.MedicationStatementStatusEnumUnknown:
+	default:
+		return nil, fmt.Errorf("only active, intended, on hold or unknown medication statements can be stopped")
+	}
+
+	statement, err := medicationStatementInput(*medicationStatement.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	status := domain.MedicationStatementStatusEnumStopped
+	statement.Status = &status
+	statement.StatusReason = []*domain.FHIRCodeableConceptInput{
+		{
+			Text: reason,
+		},
+	}
+
+	resp, err := c.infrastructure.FHIR.UpdateFHIRMedicationStatement(ctx, *statement)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRMedicationStatementToMedicationStatementDTO(resp.Resource), nil
+}
+
+// RecordMedicationAdherence records an assessment of how well a patient takes a medication in an encounter.
+// The assessment is saved as an observation that is part of the assessed medication statement
+func (c *UseCasesClinicalImpl) RecordMedicationAdherence(ctx context.Context, input dto.MedicationAdherenceInput) (*dto.MedicationAdherence, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	medicationStatement, err := c.infrastructure.FHIR.GetFHIRMedicationStatement(ctx, input.MedicationStatementID)
+	if err != nil {
+		return nil, err
+	}
+
+	assessment := assessAdherence(input)
+
+	observation, err := c.RecordObservation(
+		ctx,
+		dto.ObservationInput{
+			Status:      dto.ObservationStatusFinal,
+			EncounterID: input.EncounterID,
+			Note:        input.Note,
+		},
+		common.MedicationAdherenceCIELTerminologyCode,
+		[]ObservationInputMutatorFunc{
+			addObservationCategory("therapy"),
+			addMedicationAdherence(*medicationStatement.Resource, input.Method, assessment),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MedicationAdherence{
+		ID:                    observation.ID,
+		MedicationStatementID: input.MedicationStatementID,
+		PatientID:             observation.PatientID,
+		EncounterID:           observation.EncounterID,
+		Method:                input.Method,
+		Score:                 assessment.score,
+		Level:                 assessment.level,
+		TimeRecorded:          observation.TimeRecorded,
+		Note:                  observation.Note,
+	}, nil
+}
+
+// ListMedicationAdherence lists the adherence assessments of a medication statement, latest first
+func (c *UseCasesClinicalImpl) ListMedicationAdherence(ctx context.Context, medicationStatementID string) ([]*dto.MedicationAdherence, error) {
+	_, err := uuid.Parse(medicationStatementID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid medication statement id: %s", medicationStatementID)
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"part-of": fmt.Sprintf("MedicationStatement/%s", medicationStatementID),
+		"code":    common.MedicationAdherenceCIELTerminologyCode,
+		"_sort":   "-date",
+	}
+
+	observations, err := c.infrastructure.FHIR.SearchPatientObservations(ctx, params, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	assessments := []*dto.MedicationAdherence{}
+
+	for _, observation := range observations.Observations {
+		assessments = append(assessments, mapFHIRObservationToMedicationAdherenceDTO(observation))
+	}
+
+	return assessments, nil
+}
+
+// reconciledMedication is a medication in a patient's current medication list and the keys used to match it
+type reconciledMedication struct {
+	medication *dto.ReconciledMedication
+	keys       []string
+}
+
+// MedicationReconciliation compiles a patient's current medication list from their active prescriptions and the
+// medications they are recorded to be taking. Records of the same medication are merged and discrepancies between
+// them, as well as interactions between the medications, are flagged for review
+func (c *UseCasesClinicalImpl) MedicationReconciliation(ctx context.Context, patientID string) (*dto.MedicationReconciliation, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	requests, statements, err := c.patientCurrentMedications(ctx, patientID, *identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	medications := []*reconciledMedication{}
+	index := map[string]*reconciledMedication{}
+
+	// find returns the entry of a medication in the list, adding it if it is not yet in the list
+	find := func(codeableConcept *domain.FHIRCodeableConcept) *reconciledMedication {
+		concept := c.codeableConceptsInteractingConcept(ctx, codeableConcept)
+
+		key := strings.ToUpper(concept.name)
+		if len(concept.keys) > 0 {
+			key = concept.keys[0]
+		}
+
+		if key == "" {
+			return nil
+		}
+
+		if entry, ok := index[key]; ok {
+			return entry
+		}
+
+		entry := &reconciledMedication{
+			medication: &dto.ReconciledMedication{
+				Medication: dto.Medication{
+					Name: concept.name,
+				},
+				PrescriptionIDs:        []string{},
+				MedicationStatementIDs: []string{},
+				Dosages:                []string{},
+				Conflicts:              []dto.MedicationConflict{},
+			},
+			keys: concept.keys,
+		}
+
+		if len(codeableConcept.Coding) > 0 && codeableConcept.Coding[0] != nil && codeableConcept.Coding[0].Code != nil {
+			entry.medication.Medication.Code = string(*codeableConcept.Coding[0].Code)
+		}
+
+		index[key] = entry
+		medications = append(medications, entry)
+
+		return entry
+	}
+
+	for _, request := range requests {
+		entry := find(request.MedicationCodeableConcept)
+		if entry == nil || request.ID == nil {
+			continue
+		}
+
+		entry.medication.PrescriptionIDs = append(entry.medication.PrescriptionIDs, *request.ID)
+
+		if len(request.DosageInstruction) > 0 {
+			entry.addDosage(request.DosageInstruction[0])
+		}
+	}
+
+	for _, statement := range statements {
+		entry := find(statement.MedicationCodeableConcept)
+		if entry == nil || statement.ID == nil {
+			continue
+		}
+
+		entry.medication.MedicationStatementIDs = append(entry.medication.MedicationStatementIDs, *statement.ID)
+
+		if len(statement.Dosage) > 0 {
+			entry.addDosage(statement.Dosage[0])
+		}
+	}
+
+	output := &dto.MedicationReconciliation{
+		PatientID:   patientID,
+		Medications: []*dto.ReconciledMedication{},
+	}
+
+	for i, entry := range medications {
+		medication := entry.medication
+
+		if len(medication.PrescriptionIDs) > 1 {
+			medication.Conflicts = append(medication.Conflicts, dto.MedicationConflict{
+				Type:        dto.MedicationConflictDuplicatePrescription,
+				Description: fmt.Sprintf("%s has %d active prescriptions", medication.Medication.Name, len(medication.PrescriptionIDs)),
+			})
+		}
+
+		if len(medication.Dosages) > 1 {
+			medication.Conflicts = append(medication.Conflicts, dto.MedicationConflict{
+				Type:        dto.MedicationConflictDosageMismatch,
+				Description: fmt.Sprintf("%s is recorded with different dosages: %s", medication.Medication.Name, strings.Join(medication.Dosages, "; ")),
+			})
+		}
+
+		if len(medication.PrescriptionIDs) == 0 {
+			medication.Conflicts = append(medication.Conflicts, dto.MedicationConflict{
+				Type:        dto.MedicationConflictNotPrescribed,
+				Description: fmt.Sprintf("%s is reported as taken but has no active prescription", medication.Medication.Name),
+			})
+		}
+
+		for j, other := range medications {
+			if i == j {
+				continue
+			}
+
+			for _, rule := range c.infrastructure.Interactions.FindInteractions(entry.keys, other.keys) {
+				medication.Conflicts = append(medication.Conflicts, dto.MedicationConflict{
+					Type:        dto.MedicationConflictInteraction,
+					Description: fmt.Sprintf("interacts with %s: %s", other.medication.Medication.Name, rule.Description),
+				})
+			}
+		}
+
+		if len(medication.Conflicts) > 0 {
+			output.HasConflicts = true
+		}
+
+		output.Medications = append(output.Medications, medication)
+	}
+
+	return output, nil
+}
+
+// addDosage records the dosage of one of the records of a medication, ignoring dosages that were already recorded
+func (r *reconciledMedication) addDosage(dosage *domain.FHIRDosage) {
+	if dosage == nil || dosage.Text == nil || *dosage.Text == "" {
+		return
+	}
+
+	for _, text := range r.medication.Dosages {
+		if strings.EqualFold(text, *dosage.Text) {
+			return
+		}
+	}
+
+	r.medication.Dosages = append(r.medication.Dosages, *dosage.Text)
+}
@@ -0,0 +1,351 @@
+package clinical
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// medicationAdherenceSystem is the code system of the methods, measurements and levels of a medication adherence assessment
+const medicationAdherenceSystem = "http://savannahghi.org/fhir/CodeSystem/medication-adherence"
+
+// the percentage of expected doses a patient needs to take for their adherence to be good or fair
+const (
+	goodAdherencePercentage = 95
+	fairAdherencePercentage = 85
+)
+
+var adherenceMethodDisplays = map[dto.AdherenceMethodEnum]string{
+	dto.AdherenceMethodPillCount:   "Pill count",
+	dto.AdherenceMethodMissedDoses: "Missed doses",
+	dto.AdherenceMethodMMAS4:       "Morisky medication adherence scale (4 item)",
+}
+
+var adherenceLevelDisplays = map[dto.AdherenceLevelEnum]string{
+	dto.AdherenceLevelGood: "Good adherence",
+	dto.AdherenceLevelFair: "Fair adherence",
+	dto.AdherenceLevelPoor: "Poor adherence",
+}
+
+// adherenceAssessment is the outcome of an adherence assessment and the measurements it was computed from
+type adherenceAssessment struct {
+	score      float64
+	unit       string
+	level      dto.AdherenceLevelEnum
+	components []*domain.FHIRObservationComponentInput
+}
+
+// assessAdherence scores an adherence assessment.
+// Pill counts and missed doses are scored as the percentage of expected doses taken, capped at 100%.
+// The questionnaire is scored as the number of yes answers where 0 is good, 1 to 2 is fair and 3 to 4 is poor adherence
+func assessAdherence(input dto.MedicationAdherenceInput) adherenceAssessment {
+	switch input.Method {
+	case dto.AdherenceMethodPillCount:
+		count := input.PillCount
+		taken := float64(count.PillsDispensed - count.PillsRemaining)
+		score := percentageOf(taken, float64(count.PillsExpected))
+
+		return adherenceAssessment{
+			score: score,
+			unit:  "%",
+			level: percentageAdherenceLevel(score),
+			components: []*domain.FHIRObservationComponentInput{
+				adherenceQuantityComponent("pills-dispensed", "Pills dispensed", float64(count.PillsDispensed)),
+				adherenceQuantityComponent("pills-remaining", "Pills remaining", float64(count.PillsRemaining)),
+				adherenceQuantityComponent("pills-expected", "Pills expected to be taken", float64(count.PillsExpected)),
+			},
+		}
+
+	case dto.AdherenceMethodMissedDoses:
+		doses := input.MissedDoses
+		score := percentageOf(float64(doses.ExpectedDoses-doses.MissedDoses), float64(doses.ExpectedDoses))
+
+		return adherenceAssessment{
+			score: score,
+			unit:  "%",
+			level: percentageAdherenceLevel(score),
+			components: []*domain.FHIRObservationComponentInput{
+				adherenceQuantityComponent("missed-doses", "Missed doses", float64(doses.MissedDoses)),
+				adherenceQuantityComponent("expected-doses", "Expected doses", float64(doses.ExpectedDoses)),
+			},
+		}
+
+	default:
+		answers := input.Questionnaire
+		questions := []struct {
+			code    string
+			display string
+			answer  bool
+		}{
+			{"forgets-to-take", "Do you ever forget to take your medicine?", answers.ForgetsToTake},
+			{"careless-about-taking", "Are you careless at times about taking your medicine?", answers.CarelessAboutTaking},
+			{"stops-when-feeling-better", "When you feel better do you sometimes stop taking your medicine?", answers.StopsWhenFeelingBetter},
+			{"stops-when-feeling-worse", "Sometimes if you feel worse when you take the medicine, do you stop taking it?", answers.StopsWhenFeelingWorse},
+		}
+
+		assessment := adherenceAssessment{
+			unit: "{score}",
+		}
+
+		for _, question := range questions {
+			answer := question.answer
+			component := adherenceComponent(question.code, question.display)
+			component.ValueBoolean = &answer
+
+			assessment.components = append(assessment.components, component)
+
+			if answer {
+				assessment.score++
+			}
+		}
+
+		switch {
+		case assessment.score == 0:
+			assessment.level = dto.AdherenceLevelGood
+		case assessment.score <= 2:
+			assessment.level = dto.AdherenceLevelFair
+		default:
+			assessment.level = dto.AdherenceLevelPoor
+		}
+
+		return assessment
+	}
+}
+
+func percentageOf(value, total float64) float64 {
+	percentage := math.Min(value/total*100, 100)
+
+	return math.Round(percentage*10) / 10
+}
+
+func percentageAdherenceLevel(percentage float64) dto.AdherenceLevelEnum {
+	switch {
+	case percentage >= goodAdherencePercentage:
+		return dto.AdherenceLevelGood
+	case percentage >= fairAdherencePercentage:
+		return dto.AdherenceLevelFair
+	default:
+		return dto.AdherenceLevelPoor
+	}
+}
+
+func adherenceCoding(code, display string) *domain.FHIRCodeableConceptInput {
+	system := scalarutils.URI(medicationAdherenceSystem)
+
+	return &domain.FHIRCodeableConceptInput{
+		Coding: []*domain.FHIRCodingInput{
+			{
+				System:  &system,
+				Code:    scalarutils.Code(code),
+				Display: display,
+			},
+		},
+		Text: display,
+	}
+}
+
+func adherenceComponent(code, display string) *domain.FHIRObservationComponentInput {
+	return &domain.FHIRObservationComponentInput{
+		Code: *adherenceCoding(code, display),
+	}
+}
+
+// adherenceQuantityComponent records a count of pills or doses. Counts are expressed with the UCUM `{count}` annotation
+func adherenceQuantityComponent(code, display string, value float64) *domain.FHIRObservationComponentInput {
+	component := adherenceComponent(code, display)
+	component.ValueQuantity = &domain.FHIRQuantityInput{
+		Value:  value,
+		Unit:   "{count}",
+		System: scalarutils.URI(ucumSystem),
+		Code:   scalarutils.Code("{count}"),
+	}
+
+	return component
+}
+
+// addMedicationAdherence records an adherence assessment on an observation and links the observation to the
+// assessed medication statement. The medication statement must belong to the patient the observation is recorded for
+func addMedicationAdherence(statement domain.FHIRMedicationStatement, method dto.AdherenceMethodEnum, assessment adherenceAssessment) ObservationInputMutatorFunc {
+	return func(ctx context.Context, observation *domain.FHIRObservationInput) error {
+		if statement.Subject == nil || statement.Subject.ID == nil || observation.Subject == nil || observation.Subject.ID == nil ||
+			*statement.Subject.ID != *observation.Subject.ID {
+			return fmt.Errorf("the medication statement does not belong to the patient of the encounter")
+		}
+
+		statementReference := fmt.Sprintf("MedicationStatement/%s", *statement.ID)
+
+		observation.PartOf = append(observation.PartOf, &domain.FHIRReferenceInput{
+			ID:        statement.ID,
+			Reference: &statementReference,
+		})
+		observation.Method = adherenceCoding(method.Code(), adherenceMethodDisplays[method])
+		observation.ValueString = nil
+		observation.ValueQuantity = &domain.FHIRQuantityInput{
+			Value:  assessment.score,
+			Unit:   assessment.unit,
+			System: scalarutils.URI(ucumSystem),
+			Code:   scalarutils.Code(assessment.unit),
+		}
+		observation.Interpretation = append(
+			observation.Interpretation, adherenceCoding(assessment.level.Code(), adherenceLevelDisplays[assessment.level]),
+		)
+		observation.Component = append(observation.Component, assessment.components...)
+
+		return nil
+	}
+}
+
+func mapFHIRObservationToMedicationAdherenceDTO(observation domain.FHIRObservation) *dto.MedicationAdherence {
+	output := &dto.MedicationAdherence{}
+
+	if observation.ID != nil {
+		output.ID = *observation.ID
+	}
+
+	for _, partOf := range observation.PartOf {
+		if partOf != nil && partOf.ID != nil {
+			output.MedicationStatementID = *partOf.ID
+
+			break
+		}
+	}
+
+	if observation.Subject != nil && observation.Subject.ID != nil {
+		output.PatientID = *observation.Subject.ID
+	}
+
+	if observation.Encounter != nil && observation.Encounter.ID != nil {
+		output.EncounterID = *observation.Encounter.ID
+	}
+
+	if observation.Method != nil {
+		code := adherenceCode(*observation.Method)
+
+		for method := range adherenceMethodDisplays {
+			if method.Code() == code {
+				output.Method = method
+			}
+		}
+	}
+
+	for _, interpretation := range observation.Interpretation {
+		if interpretation == nil {
+			continue
+		}
+
+		level := dto.AdherenceLevelEnum(strings.ToUpper(adherenceCode(*interpretation)))
+		if level.IsValid() {
+			output.Level = level
+		}
+	}
+
+	if observation.ValueQuantity != nil {
+		output.Score = observation.ValueQuantity.Value
+	}
+
+	if observation.EffectiveInstant != nil {
+		output.TimeRecorded = string(*observation.EffectiveInstant)
+	}
+
+	if len(observation.Note) > 0 && observation.Note[0].Text != nil {
+		output.Note = string(*observation.Note[0].Text)
+	}
+
+	return output
+}
+
+// adherenceCode returns the code of a codeable concept from the medication adherence code system
+func adherenceCode(codeableConcept domain.FHIRCodeableConcept) string {
+	for _, coding := range codeableConcept.Coding {
+		if coding != nil && coding.System != nil && coding.Code != nil && string(*coding.System) == medicationAdherenceSystem {
+			return string(*coding.Code)
+		}
+	}
+
+	return ""
+}
+
+// medicationStatementInput converts a stored medication statement into the input used to update it
+func medicationStatementInput(resource domain.FHIRMedicationStatement) (*domain.FHIRMedicationStatementInput, error) {
+	bs, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal medication statement: %w", err)
+	}
+
+	input := &domain.FHIRMedicationStatementInput{}
+
+	err = json.Unmarshal(bs, input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal medication statement input: %w", err)
+	}
+
+	return input, nil
+}
+
+// medicationStatementStatus converts a FHIR medication statement status code e.g `on-hold` to its enum
+func medicationStatementStatus(resource domain.FHIRMedicationStatement) dto.MedicationStatementStatusEnum {
+	if resource.Status == nil {
+		return dto.MedicationStatementStatusEnumUnknown
+	}
+
+	status := dto.MedicationStatementStatusEnum(strings.ToUpper(strings.ReplaceAll(string(*resource.Status), "-", "_")))
+	if !status.IsValid() {
+		return dto.MedicationStatementStatusEnumUnknown
+	}
+
+	return status
+}
+
+func mapFHIRMedicationStatementToMedicationStatementDTO(resource *domain.FHIRMedicationStatement) *dto.MedicationStatement {
+	output := &dto.MedicationStatement{
+		Status: medicationStatementStatus(*resource),
+	}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	for _, reason := range resource.StatusReason {
+		if reason != nil && reason.Text != "" {
+			output.StatusReason = reason.Text
+
+			break
+		}
+	}
+
+	if resource.MedicationCodeableConcept != nil {
+		output.Medication.Name = resource.MedicationCodeableConcept.Text
+
+		if len(resource.MedicationCodeableConcept.Coding) > 0 && resource.MedicationCodeableConcept.Coding[0] != nil {
+			coding := resource.MedicationCodeableConcept.Coding[0]
+
+			if output.Medication.Name == "" {
+				output.Medication.Name = coding.Display
+			}
+
+			if coding.Code != nil {
+				output.Medication.Code = string(*coding.Code)
+			}
+		}
+	}
+
+	if len(resource.Dosage) > 0 && resource.Dosage[0] != nil {
+		output.Dosage = mapFHIRDosageToDosageDTO(*resource.Dosage[0])
+	}
+
+	if len(resource.Note) > 0 && resource.Note[0] != nil && resource.Note[0].Text != nil {
+		output.Note = string(*resource.Note[0].Text)
+	}
+
+	if resource.Subject != nil && resource.Subject.ID != nil {
+		output.PatientID = *resource.Subject.ID
+	}
+
+	return output
+}
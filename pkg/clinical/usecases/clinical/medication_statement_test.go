@@ -0,0 +1,982 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+func TestUseCasesClinicalImpl_ListPatientMedicationStatements(t *testing.T) {
+	active := dto.MedicationStatementStatusEnumActive
+	invalidStatus := dto.MedicationStatementStatusEnumRelapse
+	first := 10
+
+	type args struct {
+		ctx        context.Context
+		patientID  string
+		status     *dto.MedicationStatementStatusEnum
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantStatus string
+		wantErr    bool
+	}{
+		{
+			name: "Happy case: list patient medication statements",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: list active patient medication statements",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				status:     &active,
+				pagination: dto.Pagination{First: &first},
+			},
+			wantStatus: "active",
+			wantErr:    false,
+		},
+		{
+			name: "Sad case: invalid patient id",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  "invalid",
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid status",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				status:     &invalidStatus,
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search medication statements",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			var searchedStatus interface{}
+			fakeFHIR.MockSearchFHIRMedicationStatementFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationStatementRelayConnection, error) {
+				searchedStatus = params["status"]
+				statement := fakeMedicationStatement()
+
+				return &domain.FHIRMedicationStatementRelayConnection{
+					Edges: []*domain.FHIRMedicationStatementRelayEdge{
+						{
+							Node: &statement,
+						},
+					},
+					TotalCount: 1,
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to search medication statements" {
+				fakeFHIR.MockSearchFHIRMedicationStatementFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationStatementRelayConnection, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.ListPatientMedicationStatements(tt.args.ctx, tt.args.patientID, tt.args.status, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListPatientMedicationStatements() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.TotalCount != 1 || len(got.Edges) != 1 {
+				t.Errorf("expected one medication statement, got %v", got)
+				return
+			}
+
+			if got.Edges[0].Node.Status != dto.MedicationStatementStatusEnumActive {
+				t.Errorf("expected the medication statement to be active, got %v", got.Edges[0].Node.Status)
+			}
+
+			if tt.wantStatus != "" && searchedStatus != tt.wantStatus {
+				t.Errorf("expected to search for %s medication statements, got %v", tt.wantStatus, searchedStatus)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_UpdateMedicationStatement(t *testing.T) {
+	onHold := dto.MedicationStatementStatusEnumOnHold
+	stopped := dto.MedicationStatementStatusEnumStopped
+	note := "Takes the medication after meals"
+
+	type args struct {
+		ctx   context.Context
+		id    string
+		input dto.MedicationStatementInput
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantStatus dto.MedicationStatementStatusEnum
+		wantErr    bool
+	}{
+		{
+			name: "Happy case: put a medication statement on hold",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.MedicationStatementInput{
+					Status: &onHold,
+					Note:   &note,
+				},
+			},
+			wantStatus: dto.MedicationStatementStatusEnumOnHold,
+			wantErr:    false,
+		},
+		{
+			name: "Happy case: change the dosage of a medication statement",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.MedicationStatementInput{
+					Dosage: &dto.DosageInput{
+						Dose:         250,
+						DoseUnit:     "mg",
+						Route:        dto.MedicationRouteOral,
+						Frequency:    2,
+						Period:       1,
+						PeriodUnit:   dto.TimeUnitDays,
+						Duration:     30,
+						DurationUnit: dto.TimeUnitDays,
+					},
+				},
+			},
+			wantStatus: dto.MedicationStatementStatusEnumActive,
+			wantErr:    false,
+		},
+		{
+			name: "Sad case: invalid dosage",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.MedicationStatementInput{
+					Dosage: &dto.DosageInput{
+						DoseUnit: "mg",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: stop a medication statement",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.MedicationStatementInput{
+					Status: &stopped,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: medication statement entered in error",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.MedicationStatementInput{
+					Note: &note,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get medication statement",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.MedicationStatementInput{
+					Note: &note,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update medication statement",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.MedicationStatementInput{
+					Note: &note,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: medication statement entered in error" {
+				fakeFHIR.MockGetFHIRMedicationStatementFn = func(ctx context.Context, id string) (*domain.FHIRMedicationStatementRelayPayload, error) {
+					statement := fakeMedicationStatement()
+					status := domain.MedicationStatementStatusEnumEnteredInError
+					statement.Status = &status
+
+					return &domain.FHIRMedicationStatementRelayPayload{
+						Resource: &statement,
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get medication statement" {
+				fakeFHIR.MockGetFHIRMedicationStatementFn = func(ctx context.Context, id string) (*domain.FHIRMedicationStatementRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to update medication statement" {
+				fakeFHIR.MockUpdateFHIRMedicationStatementFn = func(ctx context.Context, input domain.FHIRMedicationStatementInput) (*domain.FHIRMedicationStatementRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.UpdateMedicationStatement(tt.args.ctx, tt.args.id, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.UpdateMedicationStatement() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got.Status != tt.wantStatus {
+				t.Errorf("expected the medication statement status to be %v, got %v", tt.wantStatus, got.Status)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_StopMedicationStatement(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		id     string
+		reason string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: stop a medication statement",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				reason: "Side effects",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing reason",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: medication statement already stopped",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				reason: "Side effects",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get medication statement",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				reason: "Side effects",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update medication statement",
+			args: args{
+				ctx:    context.Background(),
+				id:     gofakeit.UUID(),
+				reason: "Side effects",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			var reason string
+			fakeFHIR.MockUpdateFHIRMedicationStatementFn = func(ctx context.Context, input domain.FHIRMedicationStatementInput) (*domain.FHIRMedicationStatementRelayPayload, error) {
+				if len(input.StatusReason) > 0 {
+					reason = input.StatusReason[0].Text
+				}
+
+				statement := fakeMedicationStatement()
+				statement.Status = input.Status
+				statement.StatusReason = []*domain.FHIRCodeableConcept{
+					{
+						Text: reason,
+					},
+				}
+
+				return &domain.FHIRMedicationStatementRelayPayload{
+					Resource: &statement,
+				}, nil
+			}
+
+			if tt.name == "Sad case: medication statement already stopped" {
+				fakeFHIR.MockGetFHIRMedicationStatementFn = func(ctx context.Context, id string) (*domain.FHIRMedicationStatementRelayPayload, error) {
+					statement := fakeMedicationStatement()
+					status := domain.MedicationStatementStatusEnumStopped
+					statement.Status = &status
+
+					return &domain.FHIRMedicationStatementRelayPayload{
+						Resource: &statement,
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get medication statement" {
+				fakeFHIR.MockGetFHIRMedicationStatementFn = func(ctx context.Context, id string) (*domain.FHIRMedicationStatementRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to update medication statement" {
+				fakeFHIR.MockUpdateFHIRMedicationStatementFn = func(ctx context.Context, input domain.FHIRMedicationStatementInput) (*domain.FHIRMedicationStatementRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.StopMedicationStatement(tt.args.ctx, tt.args.id, tt.args.reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.StopMedicationStatement() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Status != dto.MedicationStatementStatusEnumStopped {
+				t.Errorf("expected the medication statement to be stopped, got %v", got.Status)
+			}
+
+			if got.StatusReason != tt.args.reason {
+				t.Errorf("expected the stop reason to be %s, got %s", tt.args.reason, got.StatusReason)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_RecordMedicationAdherence(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.MedicationAdherenceInput
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantScore float64
+		wantLevel dto.AdherenceLevelEnum
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: record a pill count",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationAdherenceInput{
+					MedicationStatementID: gofakeit.UUID(),
+					EncounterID:           gofakeit.UUID(),
+					Method:                dto.AdherenceMethodPillCount,
+					PillCount: &dto.PillCountInput{
+						PillsDispensed: 30,
+						PillsRemaining: 3,
+						PillsExpected:  28,
+					},
+				},
+			},
+			wantScore: 96.4,
+			wantLevel: dto.AdherenceLevelGood,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: record missed doses",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationAdherenceInput{
+					MedicationStatementID: gofakeit.UUID(),
+					EncounterID:           gofakeit.UUID(),
+					Method:                dto.AdherenceMethodMissedDoses,
+					MissedDoses: &dto.MissedDosesInput{
+						MissedDoses:   6,
+						ExpectedDoses: 30,
+					},
+					Note: "Ran out of medication while travelling",
+				},
+			},
+			wantScore: 80,
+			wantLevel: dto.AdherenceLevelPoor,
+			wantErr:   false,
+		},
+		{
+			name: "Happy case: record an adherence questionnaire",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationAdherenceInput{
+					MedicationStatementID: gofakeit.UUID(),
+					EncounterID:           gofakeit.UUID(),
+					Method:                dto.AdherenceMethodMMAS4,
+					Questionnaire: &dto.AdherenceQuestionnaireInput{
+						ForgetsToTake:          true,
+						StopsWhenFeelingBetter: true,
+					},
+				},
+			},
+			wantScore: 2,
+			wantLevel: dto.AdherenceLevelFair,
+			wantErr:   false,
+		},
+		{
+			name: "Sad case: missing pill count",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationAdherenceInput{
+					MedicationStatementID: gofakeit.UUID(),
+					EncounterID:           gofakeit.UUID(),
+					Method:                dto.AdherenceMethodPillCount,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: more pills remaining than were dispensed",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationAdherenceInput{
+					MedicationStatementID: gofakeit.UUID(),
+					EncounterID:           gofakeit.UUID(),
+					Method:                dto.AdherenceMethodPillCount,
+					PillCount: &dto.PillCountInput{
+						PillsDispensed: 30,
+						PillsRemaining: 31,
+						PillsExpected:  28,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid method",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationAdherenceInput{
+					MedicationStatementID: gofakeit.UUID(),
+					EncounterID:           gofakeit.UUID(),
+					Method:                "INVALID",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: medication statement belongs to another patient",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationAdherenceInput{
+					MedicationStatementID: gofakeit.UUID(),
+					EncounterID:           gofakeit.UUID(),
+					Method:                dto.AdherenceMethodMissedDoses,
+					MissedDoses: &dto.MissedDosesInput{
+						ExpectedDoses: 30,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get medication statement",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationAdherenceInput{
+					MedicationStatementID: gofakeit.UUID(),
+					EncounterID:           gofakeit.UUID(),
+					Method:                dto.AdherenceMethodMissedDoses,
+					MissedDoses: &dto.MissedDosesInput{
+						ExpectedDoses: 30,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create observation",
+			args: args{
+				ctx: context.Background(),
+				input: dto.MedicationAdherenceInput{
+					MedicationStatementID: gofakeit.UUID(),
+					EncounterID:           gofakeit.UUID(),
+					Method:                dto.AdherenceMethodMissedDoses,
+					MissedDoses: &dto.MissedDosesInput{
+						ExpectedDoses: 30,
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: medication statement belongs to another patient" {
+				fakeFHIR.MockGetFHIRMedicationStatementFn = func(ctx context.Context, id string) (*domain.FHIRMedicationStatementRelayPayload, error) {
+					statement := fakeMedicationStatement()
+					patientID := gofakeit.UUID()
+					statement.Subject.ID = &patientID
+
+					return &domain.FHIRMedicationStatementRelayPayload{
+						Resource: &statement,
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get medication statement" {
+				fakeFHIR.MockGetFHIRMedicationStatementFn = func(ctx context.Context, id string) (*domain.FHIRMedicationStatementRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create observation" {
+				fakeFHIR.MockCreateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.RecordMedicationAdherence(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RecordMedicationAdherence() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Score != tt.wantScore {
+				t.Errorf("expected an adherence score of %v, got %v", tt.wantScore, got.Score)
+			}
+
+			if got.Level != tt.wantLevel {
+				t.Errorf("expected %v adherence, got %v", tt.wantLevel, got.Level)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListMedicationAdherence(t *testing.T) {
+	type args struct {
+		ctx                   context.Context
+		medicationStatementID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list medication adherence",
+			args: args{
+				ctx:                   context.Background(),
+				medicationStatementID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid medication statement id",
+			args: args{
+				ctx:                   context.Background(),
+				medicationStatementID: "invalid",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search observations",
+			args: args{
+				ctx:                   context.Background(),
+				medicationStatementID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				id := gofakeit.UUID()
+				statementReference := fmt.Sprintf("MedicationStatement/%s", tt.args.medicationStatementID)
+				system := scalarutils.URI("http://savannahghi.org/fhir/CodeSystem/medication-adherence")
+				method := scalarutils.Code("pill-count")
+				level := scalarutils.Code("fair")
+
+				return &domain.PagedFHIRObservations{
+					Observations: []domain.FHIRObservation{
+						{
+							ID: &id,
+							PartOf: []*domain.FHIRReference{
+								{
+									ID:        &tt.args.medicationStatementID,
+									Reference: &statementReference,
+								},
+							},
+							Method: &domain.FHIRCodeableConcept{
+								Coding: []*domain.FHIRCoding{
+									{
+										System: &system,
+										Code:   &method,
+									},
+								},
+							},
+							Interpretation: []*domain.FHIRCodeableConcept{
+								{
+									Coding: []*domain.FHIRCoding{
+										{
+											System: &system,
+											Code:   &level,
+										},
+									},
+								},
+							},
+							ValueQuantity: &domain.FHIRQuantity{
+								Value: 90,
+								Unit:  "%",
+							},
+						},
+					},
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to search observations" {
+				fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.ListMedicationAdherence(tt.args.ctx, tt.args.medicationStatementID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListMedicationAdherence() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if len(got) != 1 {
+				t.Errorf("expected one adherence assessment, got %v", got)
+				return
+			}
+
+			if got[0].Method != dto.AdherenceMethodPillCount || got[0].Level != dto.AdherenceLevelFair || got[0].Score != 90 {
+				t.Errorf("expected a fair pill count adherence of 90%%, got %v", got[0])
+			}
+
+			if got[0].MedicationStatementID != tt.args.medicationStatementID {
+				t.Errorf("expected the assessment of medication statement %s, got %s", tt.args.medicationStatementID, got[0].MedicationStatementID)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_MedicationReconciliation(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		patientID string
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantConflicts []dto.MedicationConflictTypeEnum
+		wantErr       bool
+	}{
+		{
+			name: "Happy case: reconcile medications without conflicts",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantConflicts: []dto.MedicationConflictTypeEnum{},
+			wantErr:       false,
+		},
+		{
+			name: "Happy case: reconcile medications with conflicts",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantConflicts: []dto.MedicationConflictTypeEnum{
+				dto.MedicationConflictDuplicatePrescription,
+				dto.MedicationConflictDosageMismatch,
+				dto.MedicationConflictInteraction,
+				dto.MedicationConflictNotPrescribed,
+				dto.MedicationConflictInteraction,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid patient id",
+			args: args{
+				ctx:       context.Background(),
+				patientID: "invalid",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search medication requests",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Happy case: reconcile medications with conflicts" {
+				// warfarin is prescribed twice with different dosages while the patient reports taking aspirin
+				ingredients := map[string]string{
+					"80513": "11289",
+					"71617": "1191",
+				}
+
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return &domain.Concept{
+						ID:     concept,
+						Source: source,
+						Mappings: []*domain.ConceptMapping{
+							{
+								MapType:         "SAME-AS",
+								FromConceptCode: concept,
+								ToConceptCode:   ingredients[concept],
+								ToSourceName:    "RxNorm",
+							},
+						},
+					}, nil
+				}
+
+				fakeFHIR.MockSearchFHIRMedicationRequestFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationRequest, error) {
+					return &domain.PagedFHIRMedicationRequest{
+						MedicationRequests: []domain.FHIRMedicationRequest{
+							cielMedicationRequest("80513", "Warfarin", "5 mg oral route, 1 time(s) every 1 d for 30 d"),
+							cielMedicationRequest("80513", "Warfarin", "2.5 mg oral route, 1 time(s) every 1 d for 30 d"),
+						},
+					}, nil
+				}
+
+				fakeFHIR.MockSearchFHIRMedicationStatementFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationStatementRelayConnection, error) {
+					id := gofakeit.UUID()
+					system := scalarutils.URI("/orgs/CIEL/sources/CIEL/concepts/71617/")
+					code := scalarutils.Code("71617")
+
+					return &domain.FHIRMedicationStatementRelayConnection{
+						Edges: []*domain.FHIRMedicationStatementRelayEdge{
+							{
+								Node: &domain.FHIRMedicationStatement{
+									ID: &id,
+									MedicationCodeableConcept: &domain.FHIRCodeableConcept{
+										Coding: []*domain.FHIRCoding{
+											{
+												System: &system,
+												Code:   &code,
+											},
+										},
+										Text: "Aspirin",
+									},
+								},
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to search medication requests" {
+				fakeFHIR.MockSearchFHIRMedicationRequestFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRMedicationRequest, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.MedicationReconciliation(tt.args.ctx, tt.args.patientID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.MedicationReconciliation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			conflicts := []dto.MedicationConflictTypeEnum{}
+			for _, medication := range got.Medications {
+				for _, conflict := range medication.Conflicts {
+					conflicts = append(conflicts, conflict.Type)
+				}
+			}
+
+			if fmt.Sprint(conflicts) != fmt.Sprint(tt.wantConflicts) {
+				t.Errorf("expected conflicts %v, got %v", tt.wantConflicts, conflicts)
+			}
+
+			if got.HasConflicts != (len(tt.wantConflicts) > 0) {
+				t.Errorf("expected has conflicts to be %v, got %v", len(tt.wantConflicts) > 0, got.HasConflicts)
+			}
+		})
+	}
+}
+
+// fakeMedicationStatement returns an active medication statement for the patient of the default encounter
+func fakeMedicationStatement() domain.FHIRMedicationStatement {
+	id := gofakeit.UUID()
+	patientID := "12345678905432345"
+	status := domain.MedicationStatementStatusEnumActive
+	code := scalarutils.Code("71160")
+	text := "500 mg oral route, 3 time(s) every 1 d for 5 d"
+
+	return domain.FHIRMedicationStatement{
+		ID:     &id,
+		Status: &status,
+		MedicationCodeableConcept: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					Code:    &code,
+					Display: "Amoxicillin",
+				},
+			},
+			Text: "Amoxicillin",
+		},
+		Subject: &domain.FHIRReference{
+			ID: &patientID,
+		},
+		Dosage: []*domain.FHIRDosage{
+			{
+				Text: &text,
+			},
+		},
+	}
+}
+
+// cielMedicationRequest returns an active prescription of a CIEL coded medication
+func cielMedicationRequest(code, name, dosage string) domain.FHIRMedicationRequest {
+	id := gofakeit.UUID()
+	status := scalarutils.Code("active")
+	system := scalarutils.URI(fmt.Sprintf("/orgs/CIEL/sources/CIEL/concepts/%s/", code))
+	medicationCode := scalarutils.Code(code)
+
+	return domain.FHIRMedicationRequest{
+		ID:     &id,
+		Status: &status,
+		MedicationCodeableConcept: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System: &system,
+					Code:   &medicationCode,
+				},
+			},
+			Text: name,
+		},
+		DosageInstruction: []*domain.FHIRDosage{
+			{
+				Text: &dosage,
+			},
+		},
+	}
+}
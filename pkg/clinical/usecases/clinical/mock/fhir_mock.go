@@ -3,12 +3,15 @@ package mock
 import (
 	"context"
 
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 	"github.com/savannahghi/clinical/pkg/clinical/domain"
 )
 
 // FHIRUsecaseMock struct implements mocks of FHIR methods.
 type FHIRUsecaseMock struct {
-	MockGetFHIROrganizationFn func(ctx context.Context, organisationID string) (*domain.FHIROrganizationRelayPayload, error)
+	MockGetFHIROrganizationFn           func(ctx context.Context, organisationID string) (*domain.FHIROrganizationRelayPayload, error)
+	MockSearchFHIRPatientByIdentifierFn func(ctx context.Context, identifier string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error)
 }
 
 // NewFHIRUsecaseMock initializes a new instance of FHIR mock
@@ -17,6 +20,18 @@ func NewFHIRUsecaseMock() *FHIRUsecaseMock {
 		MockGetFHIROrganizationFn: func(ctx context.Context, organisationID string) (*domain.FHIROrganizationRelayPayload, error) {
 			return &domain.FHIROrganizationRelayPayload{}, nil
 		},
+		MockSearchFHIRPatientByIdentifierFn: func(ctx context.Context, identifier string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error) {
+			patientID := gofakeit.UUID()
+			return &domain.PatientConnection{
+				Edges: []*domain.PatientEdge{
+					{
+						Node: &domain.FHIRPatient{
+							ID: &patientID,
+						},
+					},
+				},
+			}, nil
+		},
 	}
 }
 
@@ -24,3 +39,8 @@ func NewFHIRUsecaseMock() *FHIRUsecaseMock {
 func (fh *FHIRUsecaseMock) GetFHIROrganization(ctx context.Context, organizationID string) (*domain.FHIROrganizationRelayPayload, error) {
 	return fh.MockGetFHIROrganizationFn(ctx, organizationID)
 }
+
+// SearchFHIRPatientByIdentifier is a mock implementation of SearchFHIRPatientByIdentifier method
+func (fh *FHIRUsecaseMock) SearchFHIRPatientByIdentifier(ctx context.Context, identifier string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PatientConnection, error) {
+	return fh.MockSearchFHIRPatientByIdentifierFn(ctx, identifier, tenant, pagination)
+}
@@ -3,11 +3,14 @@ package clinical
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/savannahghi/clinical/pkg/clinical/application/common"
 	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/application/utils"
 	"github.com/savannahghi/clinical/pkg/clinical/domain"
 	"github.com/savannahghi/scalarutils"
 )
@@ -27,13 +30,18 @@ func (c *UseCasesClinicalImpl) RecordTemperature(ctx context.Context, input dto.
 	return temperatureObservation, nil
 }
 
-// RecordMuac is used to record a patient's Muac
+// RecordMuac is used to record a patient's Muac. The acute malnutrition of a child is classified from it
 func (c *UseCasesClinicalImpl) RecordMuac(ctx context.Context, input dto.ObservationInput) (*dto.Observation, error) {
 	muacObservation, err := c.RecordObservation(ctx, input, common.MuacCIELTerminologyCode, []ObservationInputMutatorFunc{addObservationCategory("vital-signs")})
 	if err != nil {
 		return nil, err
 	}
 
+	err = c.deriveGrowth(ctx, muacObservation.EncounterID, muacObservation.PatientID)
+	if err != nil {
+		utils.ReportErrorToSentry(fmt.Errorf("unable to classify malnutrition from MUAC %s: %w", muacObservation.ID, err))
+	}
+
 	return muacObservation, nil
 }
 
@@ -52,13 +60,24 @@ func (c *UseCasesClinicalImpl) GetPatientTemperatureEntries(ctx context.Context,
 	return c.GetPatientObservations(ctx, patientID, encounterID, date, common.TemperatureCIELTerminologyCode, pagination)
 }
 
-// RecordHeight records a patient's height and saves it to fhir
+// RecordHeight records a patient's height and saves it to fhir. The patient's BMI is derived from the height and their most recent weight,
+// and the growth of a child is scored against the growth standards
 func (c *UseCasesClinicalImpl) RecordHeight(ctx context.Context, input dto.ObservationInput) (*dto.Observation, error) {
 	heightObservation, err := c.RecordObservation(ctx, input, common.HeightCIELTerminologyCode, []ObservationInputMutatorFunc{addObservationCategory("vital-signs")})
 	if err != nil {
 		return nil, err
 	}
 
+	err = c.deriveBMI(ctx, heightObservation.EncounterID, heightObservation.PatientID)
+	if err != nil {
+		utils.ReportErrorToSentry(fmt.Errorf("unable to derive BMI from height %s: %w", heightObservation.ID, err))
+	}
+
+	err = c.deriveGrowth(ctx, heightObservation.EncounterID, heightObservation.PatientID)
+	if err != nil {
+		utils.ReportErrorToSentry(fmt.Errorf("unable to score the growth of the patient from height %s: %w", heightObservation.ID, err))
+	}
+
 	return heightObservation, nil
 }
 
@@ -67,18 +86,63 @@ func (c *UseCasesClinicalImpl) GetPatientHeightEntries(ctx context.Context, pati
 	return c.GetPatientObservations(ctx, patientID, encounterID, date, common.HeightCIELTerminologyCode, pagination)
 }
 
-// PatchPatientHeight patches the height record of a patient
+// PatchPatientHeight patches the height record of a patient. The BMIs and growth scores derived from the height are recomputed
 func (c *UseCasesClinicalImpl) PatchPatientHeight(ctx context.Context, id string, value string) (*dto.Observation, error) {
-	return c.PatchPatientObservations(ctx, id, value)
+	return c.patchBodyMeasurement(ctx, id, value)
 }
 
-// PatchPatientWeight patches the weight record of a patient
+// PatchPatientWeight patches the weight record of a patient. The BMIs and growth scores derived from the weight are recomputed
 func (c *UseCasesClinicalImpl) PatchPatientWeight(ctx context.Context, id string, value string) (*dto.Observation, error) {
-	return c.PatchPatientObservations(ctx, id, value)
+	return c.patchBodyMeasurement(ctx, id, value)
+}
+
+func (c *UseCasesClinicalImpl) patchBodyMeasurement(ctx context.Context, id string, value string) (*dto.Observation, error) {
+	observation, err := c.PatchPatientObservations(ctx, id, value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.rederiveBMI(ctx, observation.ID)
+	if err != nil {
+		utils.ReportErrorToSentry(fmt.Errorf("unable to recompute the BMI derived from %s: %w", observation.ID, err))
+	}
+
+	err = c.deriveGrowth(ctx, observation.EncounterID, observation.PatientID)
+	if err != nil {
+		utils.ReportErrorToSentry(fmt.Errorf("unable to rescore the growth of the patient from %s: %w", observation.ID, err))
+	}
+
+	return observation, nil
 }
 
-// PatchPatientBMI patches the BMI record of a patient
+// PatchPatientBMI patches the BMI record of a patient. The BMI must agree with the BMI derived from the patient's most recent
+// height and weight, and a derived BMI can not be patched since it is recomputed when the height or weight is corrected
 func (c *UseCasesClinicalImpl) PatchPatientBMI(ctx context.Context, id string, value string) (*dto.Observation, error) {
+	if id == "" {
+		return nil, fmt.Errorf("an observation id is required")
+	}
+
+	observation, err := c.infrastructure.FHIR.GetFHIRObservation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(observation.Resource.DerivedFrom) > 0 {
+		return nil, fmt.Errorf("a BMI derived from height and weight can not be patched, correct the height or weight instead")
+	}
+
+	quantity, err := observationQuantity(common.BMICIELTerminologyCode, value, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if quantity != nil && observation.Resource.Subject != nil && observation.Resource.Subject.ID != nil {
+		err = c.validateBMI(ctx, *observation.Resource.Subject.ID, quantity.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return c.PatchPatientObservations(ctx, id, value)
 }
 
@@ -117,9 +181,19 @@ func (c *UseCasesClinicalImpl) PatchPatientViralLoad(ctx context.Context, id str
 	return c.PatchPatientObservations(ctx, id, value)
 }
 
-// PatchPatientMuac patches the muac record of a patient
+// PatchPatientMuac patches the muac record of a patient. The acute malnutrition of a child is reclassified
 func (c *UseCasesClinicalImpl) PatchPatientMuac(ctx context.Context, id string, value string) (*dto.Observation, error) {
-	return c.PatchPatientObservations(ctx, id, value)
+	observation, err := c.PatchPatientObservations(ctx, id, value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.deriveGrowth(ctx, observation.EncounterID, observation.PatientID)
+	if err != nil {
+		utils.ReportErrorToSentry(fmt.Errorf("unable to reclassify malnutrition from MUAC %s: %w", observation.ID, err))
+	}
+
+	return observation, nil
 }
 
 // PatchPatientLastMenstrualPeriod patches the last menstrual record of a patient
@@ -132,13 +206,24 @@ func (c *UseCasesClinicalImpl) PatchPatientBloodSugar(ctx context.Context, id st
 	return c.PatchPatientObservations(ctx, id, value)
 }
 
-// RecordWeight records a patient's weight
+// RecordWeight records a patient's weight. The patient's BMI is derived from the weight and their most recent height,
+// and the growth of a child is scored against the growth standards
 func (c *UseCasesClinicalImpl) RecordWeight(ctx context.Context, input dto.ObservationInput) (*dto.Observation, error) {
 	weightObservation, err := c.RecordObservation(ctx, input, common.WeightCIELTerminologyCode, []ObservationInputMutatorFunc{addObservationCategory("vital-signs")})
 	if err != nil {
 		return nil, err
 	}
 
+	err = c.deriveBMI(ctx, weightObservation.EncounterID, weightObservation.PatientID)
+	if err != nil {
+		utils.ReportErrorToSentry(fmt.Errorf("unable to derive BMI from weight %s: %w", weightObservation.ID, err))
+	}
+
+	err = c.deriveGrowth(ctx, weightObservation.EncounterID, weightObservation.PatientID)
+	if err != nil {
+		utils.ReportErrorToSentry(fmt.Errorf("unable to score the growth of the patient from weight %s: %w", weightObservation.ID, err))
+	}
+
 	return weightObservation, nil
 }
 
@@ -217,9 +302,17 @@ func (c *UseCasesClinicalImpl) GetPatientBloodPressureEntries(ctx context.Contex
 	return c.GetPatientObservations(ctx, patientID, encounterID, date, common.BloodPressureCIELTerminologyCode, pagination)
 }
 
-// RecordBMI records a patient's BMI
+// RecordBMI records a patient's BMI. The BMI must agree with the BMI derived from the patient's most recent height and weight
 func (c *UseCasesClinicalImpl) RecordBMI(ctx context.Context, input dto.ObservationInput) (*dto.Observation, error) {
-	bmiObservation, err := c.RecordObservation(ctx, input, common.BMICIELTerminologyCode, []ObservationInputMutatorFunc{addObservationCategory("vital-signs")})
+	validateBMI := func(ctx context.Context, observation *domain.FHIRObservationInput) error {
+		if observation.ValueQuantity == nil {
+			return nil
+		}
+
+		return c.validateBMI(ctx, *observation.Subject.ID, observation.ValueQuantity.Value)
+	}
+
+	bmiObservation, err := c.RecordObservation(ctx, input, common.BMICIELTerminologyCode, []ObservationInputMutatorFunc{addObservationCategory("vital-signs"), validateBMI})
 	if err != nil {
 		return nil, err
 	}
@@ -318,7 +411,8 @@ func (c *UseCasesClinicalImpl) GetPatientDiastolicBloodPressureEntries(ctx conte
 }
 
 // RecordObservation is an extracted function that takes any observation input and saves it to FHIR.
-// A concept ID is also passed so that we can get the concept code of the passed observation
+// A concept ID is also passed so that we can get the concept code of the passed observation.
+// The early warning scores of the encounter are recomputed when they are computed from the concept
 func (c *UseCasesClinicalImpl) RecordObservation(ctx context.Context, input dto.ObservationInput, vitalSignConceptID string, mutators []ObservationInputMutatorFunc) (*dto.Observation, error) {
 	err := input.Validate()
 	if err != nil {
@@ -344,6 +438,11 @@ func (c *UseCasesClinicalImpl) RecordObservation(ctx context.Context, input dto.
 		return nil, err
 	}
 
+	quantity, err := observationQuantity(vitalSignConceptID, input.Value, input.Unit)
+	if err != nil {
+		return nil, err
+	}
+
 	instant := scalarutils.Instant(time.Now().Format(time.RFC3339))
 
 	observation := domain.FHIRObservationInput{
@@ -373,6 +472,16 @@ func (c *UseCasesClinicalImpl) RecordObservation(ctx context.Context, input dto.
 		Interpretation: []*domain.FHIRCodeableConceptInput{},
 	}
 
+	if quantity != nil {
+		observation.ValueString = nil
+		observation.ValueQuantity = quantity
+
+		err = c.interpretMeasurement(ctx, &observation, vitalSignConceptID, *patientID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if input.Note != "" {
 		note := domain.FHIRAnnotationInput{
 			Text: (*scalarutils.Markdown)(&input.Note),
@@ -405,7 +514,16 @@ func (c *UseCasesClinicalImpl) RecordObservation(ctx context.Context, input dto.
 		return nil, err
 	}
 
-	return mapFHIRObservationToObservationDTO(*fhirObservation), nil
+	output := mapFHIRObservationToObservationDTO(*fhirObservation)
+
+	if c.contributesToEarlyWarningScores(vitalSignConceptID) {
+		err = c.deriveEarlyWarningScores(ctx, output.EncounterID, output.PatientID)
+		if err != nil {
+			utils.ReportErrorToSentry(fmt.Errorf("unable to compute the early warning scores of encounter %s: %w", output.EncounterID, err))
+		}
+	}
+
+	return output, nil
 }
 
 // GetPatientObservations is a helper function used to fetch patient's observations based on the passed CIEL
@@ -431,8 +549,8 @@ func (c *UseCasesClinicalImpl) GetPatientObservations(ctx context.Context, patie
 
 	searchParams := map[string]interface{}{
 		"patient": patientReference,
-		"code":    observationCode,
 	}
+	searchParams[observationCodeSearchParam(observationCode)] = observationCode
 
 	if encounterID != nil {
 		encounterReference := fmt.Sprintf("Encounter/%s", *encounterID)
@@ -457,7 +575,7 @@ func (c *UseCasesClinicalImpl) GetPatientObservations(ctx context.Context, patie
 			continue
 		}
 
-		observations = append(observations, mapFHIRObservationToObservationDTO(obs))
+		observations = append(observations, mapFHIRObservationToObservationDTO(bloodPressureComponentObservation(obs, observationCode)))
 	}
 
 	pageInfo := dto.PageInfo{
@@ -472,7 +590,9 @@ func (c *UseCasesClinicalImpl) GetPatientObservations(ctx context.Context, patie
 	return &connection, nil
 }
 
-// PatchPatientObservations update a patient's observation resource
+// PatchPatientObservations update a patient's observation resource. Measurements are converted to the unit
+// their concept is recorded in and stored as quantities, replacing the value the observation was recorded with.
+// The early warning scores of the encounter are recomputed when they are computed from the measurement
 func (c *UseCasesClinicalImpl) PatchPatientObservations(ctx context.Context, id string, value string) (*dto.Observation, error) {
 	if value == "" {
 		return nil, fmt.Errorf("observation value required")
@@ -496,14 +616,53 @@ func (c *UseCasesClinicalImpl) PatchPatientObservations(ctx context.Context, id
 		return nil, fmt.Errorf("cannot patch an observation in a finished encounter")
 	}
 
+	quantity, err := observationQuantity(observationConceptCode(observation.Resource.Code), value, "")
+	if err != nil {
+		return nil, err
+	}
+
 	instant := scalarutils.Instant(time.Now().Format(time.RFC3339))
 
-	observationInput := &domain.FHIRObservationInput{
+	if quantity != nil {
+		input, err := observationInput(*observation.Resource)
+		if err != nil {
+			return nil, err
+		}
+
+		input.EffectiveInstant = &instant
+		input.ValueString = nil
+		input.ValueQuantity = quantity
+
+		if observation.Resource.Subject != nil && observation.Resource.Subject.ID != nil {
+			err = c.interpretMeasurement(ctx, input, observationConceptCode(observation.Resource.Code), *observation.Resource.Subject.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		output, err := c.infrastructure.FHIR.UpdateFHIRObservation(ctx, *input)
+		if err != nil {
+			return nil, err
+		}
+
+		result := mapFHIRObservationToObservationDTO(*output)
+
+		if c.contributesToEarlyWarningScores(observationConceptCode(observation.Resource.Code)) {
+			err = c.deriveEarlyWarningScores(ctx, result.EncounterID, result.PatientID)
+			if err != nil {
+				utils.ReportErrorToSentry(fmt.Errorf("unable to compute the early warning scores of encounter %s: %w", result.EncounterID, err))
+			}
+		}
+
+		return result, nil
+	}
+
+	patchInput := &domain.FHIRObservationInput{
 		EffectiveInstant: &instant,
 		ValueString:      &value,
 	}
 
-	output, err := c.infrastructure.FHIR.PatchFHIRObservation(ctx, id, *observationInput)
+	output, err := c.infrastructure.FHIR.PatchFHIRObservation(ctx, id, *patchInput)
 	if err != nil {
 		return nil, err
 	}
@@ -513,6 +672,73 @@ func (c *UseCasesClinicalImpl) PatchPatientObservations(ctx context.Context, id
 	return result, nil
 }
 
+// MigratePatientObservationQuantities converts the measurements of a patient that were recorded as strings e.g a weight of `70`
+// to quantities in the unit their concept is recorded in. Values that can not be read as measurements e.g a viral load of `LDL`
+// are left as recorded. It returns the number of observations that were converted
+func (c *UseCasesClinicalImpl) MigratePatientObservationQuantities(ctx context.Context, patientID string) (int, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	_, err = c.infrastructure.FHIR.GetFHIRPatient(ctx, patientID)
+	if err != nil {
+		return 0, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	conceptCodes := []string{}
+	for conceptID := range observationUnits {
+		conceptCodes = append(conceptCodes, conceptID)
+	}
+
+	sort.Strings(conceptCodes)
+
+	searchParams := map[string]interface{}{
+		"patient": fmt.Sprintf("Patient/%s", patientID),
+		"code":    strings.Join(conceptCodes, ","),
+	}
+
+	observations, err := c.infrastructure.FHIR.SearchPatientObservations(ctx, searchParams, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		return 0, err
+	}
+
+	migrated := 0
+
+	for _, observation := range observations.Observations {
+		if observation.ID == nil || observation.ValueString == nil || observation.ValueQuantity != nil {
+			continue
+		}
+
+		quantity, err := observationQuantity(observationConceptCode(observation.Code), *observation.ValueString, "")
+		if err != nil || quantity == nil {
+			continue
+		}
+
+		input, err := observationInput(observation)
+		if err != nil {
+			return migrated, err
+		}
+
+		input.ValueString = nil
+		input.ValueQuantity = quantity
+
+		_, err = c.infrastructure.FHIR.UpdateFHIRObservation(ctx, *input)
+		if err != nil {
+			return migrated, err
+		}
+
+		migrated++
+	}
+
+	return migrated, nil
+}
+
 // RecordHPV is used to record HPV test results. We record it as observations as specified in https://build.fhir.org/ig/HL7/cqf-measures/Measure-EXM124-FHIR.html
 // Check whether the gender of the patient is valid and that the patient is within the acceptable age range
 func (c *UseCasesClinicalImpl) RecordHPV(ctx context.Context, input dto.ObservationInput) (*dto.Observation, error) {
@@ -2,8 +2,19 @@ package clinical
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common/helpers"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/referenceranges"
 	"github.com/savannahghi/scalarutils"
 )
 
@@ -27,6 +38,10 @@ func addObservationCategory(code string) ObservationInputMutatorFunc {
 		display = "Laboratory"
 	case "vital-signs":
 		display = "Vital Signs"
+	case "therapy":
+		display = "Therapy"
+	case "survey":
+		display = "Survey"
 	}
 
 	return func(ctx context.Context, observation *domain.FHIRObservationInput) error {
@@ -50,3 +65,481 @@ func addObservationCategory(code string) ObservationInputMutatorFunc {
 		return nil
 	}
 }
+
+// observationUnit is the UCUM unit that the measurements of a CIEL concept are recorded in, and how measurements taken
+// in other common units are converted to it
+type observationUnit struct {
+	code    string
+	display string
+
+	// conversions convert a measurement in another UCUM unit e.g `[lb_av]` to the recorded unit
+	conversions map[string]func(value float64) float64
+
+	// textResults is set for lab results that are also reported as text e.g a viral load that is `LDL`
+	textResults bool
+
+	// unitRequired is set for measurements that are commonly taken in more than one unit e.g blood sugar in mmol/L or mg/dL,
+	// where a measurement without a unit can not safely be assumed to be in the recorded unit
+	unitRequired bool
+}
+
+// observationUnits are the units that measurements are recorded in, by the CIEL concept of the measurement
+var observationUnits = map[string]observationUnit{
+	common.WeightCIELTerminologyCode: {
+		code:    "kg",
+		display: "kg",
+		conversions: map[string]func(float64) float64{
+			"g":       func(value float64) float64 { return value / 1000 },
+			"[lb_av]": func(value float64) float64 { return value * 0.45359237 },
+		},
+	},
+	common.HeightCIELTerminologyCode: {
+		code:    "cm",
+		display: "cm",
+		conversions: map[string]func(float64) float64{
+			"m":      func(value float64) float64 { return value * 100 },
+			"mm":     func(value float64) float64 { return value / 10 },
+			"[in_i]": func(value float64) float64 { return value * 2.54 },
+			"[ft_i]": func(value float64) float64 { return value * 30.48 },
+		},
+	},
+	common.MuacCIELTerminologyCode: {
+		code:    "cm",
+		display: "cm",
+		conversions: map[string]func(float64) float64{
+			"mm":     func(value float64) float64 { return value / 10 },
+			"[in_i]": func(value float64) float64 { return value * 2.54 },
+		},
+	},
+	common.TemperatureCIELTerminologyCode: {
+		code:    "Cel",
+		display: "°C",
+		conversions: map[string]func(float64) float64{
+			"[degF]": func(value float64) float64 { return (value - 32) * 5 / 9 },
+		},
+	},
+	common.BloodPressureCIELTerminologyCode: {
+		code:    "mm[Hg]",
+		display: "mmHg",
+		conversions: map[string]func(float64) float64{
+			"kPa": func(value float64) float64 { return value * 7.50062 },
+		},
+	},
+	common.DiastolicBloodPressureCIELTerminologyCode: {
+		code:    "mm[Hg]",
+		display: "mmHg",
+		conversions: map[string]func(float64) float64{
+			"kPa": func(value float64) float64 { return value * 7.50062 },
+		},
+	},
+	common.PulseCIELTerminologyCode: {
+		code:    "/min",
+		display: "beats/min",
+	},
+	common.RespiratoryRateCIELTerminologyCode: {
+		code:    "/min",
+		display: "breaths/min",
+	},
+	common.OxygenSaturationCIELTerminologyCode: {
+		code:    "%",
+		display: "%",
+	},
+	common.BMICIELTerminologyCode: {
+		code:    "kg/m2",
+		display: "kg/m²",
+	},
+	common.BloodSugarCIELTerminologyCode: {
+		code:         "mmol/L",
+		display:      "mmol/L",
+		unitRequired: true,
+		conversions: map[string]func(float64) float64{
+			// glucose has a molar mass of 180.16 g/mol
+			"mg/dL": func(value float64) float64 { return value / 18.016 },
+		},
+	},
+	common.ViralLoadCIELTerminologyCode: {
+		code:        "{copies}/mL",
+		display:     "copies/mL",
+		textResults: true,
+	},
+	common.CD4CountCIELTerminologyCode: {
+		code:    "/uL",
+		display: "cells/µL",
+		conversions: map[string]func(float64) float64{
+			"/mm3": func(value float64) float64 { return value },
+		},
+		textResults: true,
+	},
+}
+
+// observationUnitAliases are the ways units are commonly written e.g on paper forms, and the UCUM units they stand for
+var observationUnitAliases = map[string]string{
+	"kgs":         "kg",
+	"lb":          "[lb_av]",
+	"lbs":         "[lb_av]",
+	"in":          "[in_i]",
+	"ft":          "[ft_i]",
+	"cel":         "Cel",
+	"c":           "Cel",
+	"°c":          "Cel",
+	"f":           "[degF]",
+	"°f":          "[degF]",
+	"mm[hg]":      "mm[Hg]",
+	"mmhg":        "mm[Hg]",
+	"kpa":         "kPa",
+	"bpm":         "/min",
+	"beats/min":   "/min",
+	"breaths/min": "/min",
+	"kg/m²":       "kg/m2",
+	"mg/dl":       "mg/dL",
+	"mmol/l":      "mmol/L",
+	"{copies}/ml": "{copies}/mL",
+	"copies/ml":   "{copies}/mL",
+	"/ul":         "/uL",
+	"cells/ul":    "/uL",
+	"cells/µl":    "/uL",
+	"cells/mm3":   "/mm3",
+}
+
+// observationMeasurementPattern reads a measurement and the unit written after it, if any e.g `70`, `70kg`, `98.6 °F` or `1,200 copies/ml`
+var observationMeasurementPattern = regexp.MustCompile(`^([-+]?(?:\d{1,3}(?:,\d{3})+(?:\.\d*)?|\d+\.?\d*|\.\d+))\s*(.*)$`)
+
+// ucumUnit reads a unit as the UCUM unit it stands for
+func ucumUnit(unit string) string {
+	unit = strings.TrimSpace(unit)
+
+	if code, ok := observationUnitAliases[strings.ToLower(unit)]; ok {
+		return code
+	}
+
+	return unit
+}
+
+// observationQuantity reads a measurement of a CIEL concept as a quantity in the unit the concept is recorded in.
+// The unit may be passed or written after the measurement e.g `154 lb`, and is the recorded unit when neither is set unless the concept
+// requires one.
+// No quantity is returned for concepts that are not measured e.g colposcopy findings, or for lab results reported as text
+func observationQuantity(conceptID string, value string, unit string) (*domain.FHIRQuantityInput, error) {
+	recordedUnit, measured := observationUnits[conceptID]
+	if !measured {
+		if unit != "" {
+			return nil, fmt.Errorf("observations of concept %s are not measured in units", conceptID)
+		}
+
+		return nil, nil
+	}
+
+	match := observationMeasurementPattern.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		if recordedUnit.textResults && unit == "" {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("%q is not a valid measurement, a number is expected", value)
+	}
+
+	measurement, err := strconv.ParseFloat(strings.ReplaceAll(match[1], ",", ""), 64)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid measurement: %w", value, err)
+	}
+
+	unit = ucumUnit(unit)
+	writtenUnit := ucumUnit(match[2])
+
+	switch {
+	case unit == "":
+		unit = writtenUnit
+	case writtenUnit != "" && writtenUnit != unit:
+		return nil, fmt.Errorf("the unit %s written in %q does not match the unit %s", writtenUnit, value, unit)
+	}
+
+	if unit == "" && recordedUnit.unitRequired {
+		return nil, fmt.Errorf("the unit of %q is required e.g %s", value, recordedUnit.display)
+	}
+
+	if unit != "" && unit != recordedUnit.code {
+		convert, ok := recordedUnit.conversions[unit]
+		if !ok {
+			return nil, fmt.Errorf("a measurement in %s can not be converted to %s", unit, recordedUnit.code)
+		}
+
+		measurement = math.Round(convert(measurement)*100) / 100
+	}
+
+	return &domain.FHIRQuantityInput{
+		Value:  measurement,
+		Unit:   recordedUnit.display,
+		System: scalarutils.URI(ucumSystem),
+		Code:   scalarutils.Code(recordedUnit.code),
+	}, nil
+}
+
+// observationConceptCode is the code of the CIEL concept an observation was recorded for
+func observationConceptCode(code *domain.FHIRCodeableConcept) string {
+	if code == nil {
+		return ""
+	}
+
+	for _, coding := range code.Coding {
+		if coding != nil && coding.Code != nil {
+			return string(*coding.Code)
+		}
+	}
+
+	return ""
+}
+
+// observationValueText is the value of an observation as it is displayed. Measurements are displayed as their number e.g `70`,
+// with their unit read from observationValueUnit
+func observationValueText(observation domain.FHIRObservation) string {
+	switch {
+	case observation.Code != nil && hasCode(*observation.Code, common.LOINCBloodPressurePanel):
+		return bloodPressureText(mapFHIRObservationToBloodPressureReading(observation))
+	case observation.ValueString != nil:
+		return *observation.ValueString
+	case observation.ValueQuantity != nil:
+		return strconv.FormatFloat(observation.ValueQuantity.Value, 'f', -1, 64)
+	}
+
+	return ""
+}
+
+// observationValueUnit is the UCUM code of the unit a measurement was recorded in e.g `kg`
+func observationValueUnit(observation domain.FHIRObservation) string {
+	switch {
+	case observation.Code != nil && hasCode(*observation.Code, common.LOINCBloodPressurePanel):
+		return mapFHIRObservationToBloodPressureReading(observation).Unit
+	case observation.ValueQuantity != nil:
+		return string(observation.ValueQuantity.Code)
+	}
+
+	return ""
+}
+
+// observationFlag is how an observation compares to the reference range of the patient.
+// A blood pressure panel is flagged with the most severe flag of its pressures
+func observationFlag(observation domain.FHIRObservation) *dto.ObservationInterpretationEnum {
+	if observation.Code != nil && hasCode(*observation.Code, common.LOINCBloodPressurePanel) {
+		return bloodPressureFlag(mapFHIRObservationToBloodPressureReading(observation))
+	}
+
+	return observationInterpretationFlag(observation.Interpretation)
+}
+
+// observationInput converts a stored observation into the input used to update it
+func observationInput(resource domain.FHIRObservation) (*domain.FHIRObservationInput, error) {
+	bs, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal observation: %w", err)
+	}
+
+	input := &domain.FHIRObservationInput{}
+
+	err = json.Unmarshal(bs, input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal observation input: %w", err)
+	}
+
+	return input, nil
+}
+
+// observationInterpretationSystem is the HL7 v3 code system of the flags set on measurements e.g `H` for a high value
+const observationInterpretationSystem = "http://terminology.hl7.org/CodeSystem/v3-ObservationInterpretation"
+
+var observationInterpretationDisplays = map[dto.ObservationInterpretationEnum]string{
+	dto.ObservationInterpretationNormal:       "Normal",
+	dto.ObservationInterpretationHigh:         "High",
+	dto.ObservationInterpretationLow:          "Low",
+	dto.ObservationInterpretationCriticalHigh: "Critical high",
+	dto.ObservationInterpretationCriticalLow:  "Critical low",
+}
+
+// interpretMeasurement checks a measurement against the reference ranges of its concept for the sex and age of the patient.
+// Measurements that are not physiologically possible are rejected with a field error. Otherwise the flag and the reference range
+// of the patient's band replace those the observation had
+func (c *UseCasesClinicalImpl) interpretMeasurement(ctx context.Context, observation *domain.FHIRObservationInput, conceptID string, patientID string) error {
+	if observation.ValueQuantity == nil {
+		return nil
+	}
+
+	patient, err := c.referenceRangesPatient(ctx, patientID)
+	if err != nil {
+		return err
+	}
+
+	interpretation, referenceRange, err := c.interpretQuantity(conceptID, *observation.ValueQuantity, patient)
+	if err != nil {
+		return err
+	}
+
+	interpretations := []*domain.FHIRCodeableConceptInput{}
+
+	for _, existing := range observation.Interpretation {
+		if existing != nil && isObservationInterpretationFlag(existing.Coding) {
+			continue
+		}
+
+		interpretations = append(interpretations, existing)
+	}
+
+	observation.Interpretation = interpretations
+	observation.ReferenceRange = nil
+
+	if interpretation != nil {
+		observation.Interpretation = append(observation.Interpretation, interpretation)
+	}
+
+	if referenceRange != nil {
+		observation.ReferenceRange = append(observation.ReferenceRange, referenceRange)
+	}
+
+	return nil
+}
+
+// referenceRangesPatient is the sex and age of a patient that reference ranges are chosen by
+func (c *UseCasesClinicalImpl) referenceRangesPatient(ctx context.Context, patientID string) (referenceranges.Patient, error) {
+	fhirPatient, err := c.infrastructure.FHIR.GetFHIRPatient(ctx, patientID)
+	if err != nil {
+		return referenceranges.Patient{}, err
+	}
+
+	patient := referenceranges.Patient{}
+
+	if fhirPatient.Resource.Gender != nil {
+		patient.Sex = string(*fhirPatient.Resource.Gender)
+	}
+
+	if fhirPatient.Resource.BirthDate != nil {
+		birthDate := fhirPatient.Resource.BirthDate.AsTime()
+		patient.BirthDate = &birthDate
+	}
+
+	return patient, nil
+}
+
+// interpretQuantity returns the interpretation flag of a measurement and the reference range it was interpreted against.
+// Neither is returned when the concept has no reference range for the patient
+func (c *UseCasesClinicalImpl) interpretQuantity(conceptID string, quantity domain.FHIRQuantityInput, patient referenceranges.Patient) (*domain.FHIRCodeableConceptInput, *domain.FHIRObservationReferencerangeInput, error) {
+	interpretation, err := c.infrastructure.ReferenceRanges.Interpret(conceptID, quantity.Value, string(quantity.Code), patient, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if interpretation == nil {
+		return nil, nil, nil
+	}
+
+	flag := observationInterpretationConcept(interpretation.Flag)
+
+	referenceRange := &domain.FHIRObservationReferencerangeInput{}
+
+	if interpretation.Range.Low != nil {
+		referenceRange.Low = &domain.FHIRQuantityInput{
+			Value:  *interpretation.Range.Low,
+			Unit:   quantity.Unit,
+			System: quantity.System,
+			Code:   quantity.Code,
+		}
+	}
+
+	if interpretation.Range.High != nil {
+		referenceRange.High = &domain.FHIRQuantityInput{
+			Value:  *interpretation.Range.High,
+			Unit:   quantity.Unit,
+			System: quantity.System,
+			Code:   quantity.Code,
+		}
+	}
+
+	if referenceRange.Low == nil && referenceRange.High == nil {
+		return flag, nil, nil
+	}
+
+	return flag, referenceRange, nil
+}
+
+// observationInterpretationConcept codes an interpretation flag in the HL7 v3 ObservationInterpretation code system
+func observationInterpretationConcept(flag dto.ObservationInterpretationEnum) *domain.FHIRCodeableConceptInput {
+	system := scalarutils.URI(observationInterpretationSystem)
+	display := observationInterpretationDisplays[flag]
+
+	return &domain.FHIRCodeableConceptInput{
+		Coding: []*domain.FHIRCodingInput{
+			{
+				System:  &system,
+				Code:    scalarutils.Code(flag.Code()),
+				Display: display,
+			},
+		},
+		Text: display,
+	}
+}
+
+func isObservationInterpretationFlag(codings []*domain.FHIRCodingInput) bool {
+	for _, coding := range codings {
+		if coding != nil && coding.System != nil && string(*coding.System) == observationInterpretationSystem {
+			return true
+		}
+	}
+
+	return false
+}
+
+// observationInterpretationFlag reads the flag a measurement was interpreted with, if any
+func observationInterpretationFlag(interpretations []*domain.FHIRCodeableConcept) *dto.ObservationInterpretationEnum {
+	for _, interpretation := range interpretations {
+		if interpretation == nil {
+			continue
+		}
+
+		for _, coding := range interpretation.Coding {
+			if coding == nil || coding.System == nil || coding.Code == nil || string(*coding.System) != observationInterpretationSystem {
+				continue
+			}
+
+			flag, ok := dto.ObservationInterpretationFromCode(string(*coding.Code))
+			if ok {
+				return &flag
+			}
+		}
+	}
+
+	return nil
+}
+
+// measurementValue is the value of a measurement of a CIEL concept in the unit the concept is recorded in
+func measurementValue(observation domain.FHIRObservation, conceptID string) (float64, bool) {
+	if observation.ID == nil || observationConceptCode(observation.Code) != conceptID {
+		return 0, false
+	}
+
+	return observationNumericValue(observation)
+}
+
+// addObservationDerivedFrom references the observations that an observation was derived from e.g the height and weight of a BMI
+func addObservationDerivedFrom(sources ...domain.FHIRObservation) ObservationInputMutatorFunc {
+	return func(ctx context.Context, observation *domain.FHIRObservationInput) error {
+		observation.DerivedFrom = []*domain.FHIRReferenceInput{}
+
+		for _, source := range sources {
+			reference := fmt.Sprintf("Observation/%s", *source.ID)
+
+			observation.DerivedFrom = append(observation.DerivedFrom, &domain.FHIRReferenceInput{
+				ID:        source.ID,
+				Reference: &reference,
+				Display:   source.Code.Text,
+			})
+		}
+
+		return nil
+	}
+}
+
+// isMoreRecent checks whether an observation was taken after another
+func isMoreRecent(observation domain.FHIRObservation, other domain.FHIRObservation) bool {
+	if observation.EffectiveInstant == nil || other.EffectiveInstant == nil {
+		return other.EffectiveInstant == nil
+	}
+
+	return helpers.ParseDate(string(*observation.EffectiveInstant)).After(helpers.ParseDate(string(*other.EffectiveInstant)))
+}
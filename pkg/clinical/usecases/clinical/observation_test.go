@@ -2,12 +2,14 @@ package clinical_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
 	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
 	"github.com/savannahghi/clinical/pkg/clinical/domain"
@@ -186,6 +188,179 @@ func TestUseCasesClinicalImpl_RecordObservation(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Happy Case - record a weight in pounds as kilograms",
+			args: args{
+				ctx: ctx,
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "150",
+					Unit:        "lb",
+				},
+				vitalSignConceptID: common.WeightCIELTerminologyCode,
+				mutators:           []clinicalUsecase.ObservationInputMutatorFunc{addLabCategory},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - record a temperature with the unit written in the value",
+			args: args{
+				ctx: ctx,
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "98.6 °F",
+				},
+				vitalSignConceptID: common.TemperatureCIELTerminologyCode,
+				mutators:           []clinicalUsecase.ObservationInputMutatorFunc{addLabCategory},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - record a blood sugar in mg/dL as mmol/L",
+			args: args{
+				ctx: ctx,
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "90",
+					Unit:        "mg/dL",
+				},
+				vitalSignConceptID: common.BloodSugarCIELTerminologyCode,
+				mutators:           []clinicalUsecase.ObservationInputMutatorFunc{addLabCategory},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - record a viral load written with a thousands separator",
+			args: args{
+				ctx: ctx,
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "1,200 copies/ml",
+				},
+				vitalSignConceptID: common.ViralLoadCIELTerminologyCode,
+				mutators:           []clinicalUsecase.ObservationInputMutatorFunc{addLabCategory},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - record a textual viral load result",
+			args: args{
+				ctx: ctx,
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "LDL",
+				},
+				vitalSignConceptID: common.ViralLoadCIELTerminologyCode,
+				mutators:           []clinicalUsecase.ObservationInputMutatorFunc{addLabCategory},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - flag a high temperature",
+			args: args{
+				ctx: ctx,
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "38.5",
+				},
+				vitalSignConceptID: common.TemperatureCIELTerminologyCode,
+				mutators:           []clinicalUsecase.ObservationInputMutatorFunc{addLabCategory},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case - temperature that is not possible",
+			args: args{
+				ctx: ctx,
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "370",
+				},
+				vitalSignConceptID: common.TemperatureCIELTerminologyCode,
+				mutators:           []clinicalUsecase.ObservationInputMutatorFunc{addLabCategory},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - Fail to get patient to interpret a measurement",
+			args: args{
+				ctx: ctx,
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "36.8",
+				},
+				vitalSignConceptID: common.TemperatureCIELTerminologyCode,
+				mutators:           []clinicalUsecase.ObservationInputMutatorFunc{addLabCategory},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - weight that is not a number",
+			args: args{
+				ctx: ctx,
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "heavy",
+				},
+				vitalSignConceptID: common.WeightCIELTerminologyCode,
+				mutators:           []clinicalUsecase.ObservationInputMutatorFunc{addLabCategory},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - unit that can not be converted",
+			args: args{
+				ctx: ctx,
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "70",
+					Unit:        "mmol/L",
+				},
+				vitalSignConceptID: common.WeightCIELTerminologyCode,
+				mutators:           []clinicalUsecase.ObservationInputMutatorFunc{addLabCategory},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - unit that does not match the unit written in the value",
+			args: args{
+				ctx: ctx,
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "70 kg",
+					Unit:        "lb",
+				},
+				vitalSignConceptID: common.WeightCIELTerminologyCode,
+				mutators:           []clinicalUsecase.ObservationInputMutatorFunc{addLabCategory},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - unit for a concept that is not measured",
+			args: args{
+				ctx: ctx,
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "Normal",
+					Unit:        "kg",
+				},
+				vitalSignConceptID: common.ColposcopyCIELTerminologyCode,
+				mutators:           []clinicalUsecase.ObservationInputMutatorFunc{addLabCategory},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -234,11 +409,60 @@ func TestUseCasesClinicalImpl_RecordObservation(t *testing.T) {
 				}
 			}
 
+			if tt.name == "Sad Case - Fail to get patient to interpret a measurement" {
+				fakeFHIR.MockGetFHIRPatientFn = func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get patient")
+				}
+			}
+
+			wantQuantities := map[string]domain.FHIRQuantityInput{
+				"Happy Case - record a weight in pounds as kilograms":                  {Value: 68.04, Code: "kg"},
+				"Happy Case - record a temperature with the unit written in the value": {Value: 37, Code: "Cel"},
+				"Happy Case - record a blood sugar in mg/dL as mmol/L":                 {Value: 5, Code: "mmol/L"},
+				"Happy Case - record a viral load written with a thousands separator":  {Value: 1200, Code: "{copies}/mL"},
+			}
+
+			createObservation := fakeFHIR.MockCreateFHIRObservationFn
+			fakeFHIR.MockCreateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				if want, ok := wantQuantities[tt.name]; ok {
+					if input.ValueString != nil || input.ValueQuantity == nil {
+						t.Errorf("expected the measurement to be recorded as a quantity")
+					} else if input.ValueQuantity.Value != want.Value || input.ValueQuantity.Code != want.Code {
+						t.Errorf("expected %v %v but got %v %v", want.Value, want.Code, input.ValueQuantity.Value, input.ValueQuantity.Code)
+					}
+				}
+
+				if tt.name == "Happy Case - flag a high temperature" {
+					flagged := false
+					for _, interpretation := range input.Interpretation {
+						for _, coding := range interpretation.Coding {
+							flagged = flagged || coding.Code == "H"
+						}
+					}
+
+					if !flagged || len(input.ReferenceRange) != 1 {
+						t.Errorf("expected the temperature to be flagged high with its reference range")
+					}
+				}
+
+				if tt.name == "Happy Case - record a textual viral load result" && (input.ValueQuantity != nil || input.ValueString == nil || *input.ValueString != "LDL") {
+					t.Errorf("expected the viral load to be recorded as text")
+				}
+
+				return createObservation(ctx, input)
+			}
+
 			got, err := u.RecordObservation(tt.args.ctx, tt.args.input, tt.args.vitalSignConceptID, tt.args.mutators)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesClinicalImpl.RecordObservation() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+
+			var fieldErr dto.FieldError
+			if tt.name == "Sad Case - temperature that is not possible" && !errors.As(err, &fieldErr) {
+				t.Errorf("expected a field error but got %v", err)
+				return
+			}
 			if !tt.wantErr {
 				if got == nil {
 					t.Errorf("expected a response but got %v", got)
@@ -267,7 +491,7 @@ func TestUseCasesClinicalImpl_RecordTemperature(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "12",
+					Value:       "36.8",
 				},
 			},
 			wantErr: false,
@@ -278,7 +502,7 @@ func TestUseCasesClinicalImpl_RecordTemperature(t *testing.T) {
 				ctx: ctx,
 				input: dto.ObservationInput{
 					EncounterID: uuid.New().String(),
-					Value:       "12",
+					Value:       "36.8",
 				},
 			},
 			wantErr: true,
@@ -290,7 +514,7 @@ func TestUseCasesClinicalImpl_RecordTemperature(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "36.8",
 				},
 			},
 			wantErr: true,
@@ -302,7 +526,7 @@ func TestUseCasesClinicalImpl_RecordTemperature(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "36.8",
 				},
 			},
 			wantErr: true,
@@ -314,7 +538,7 @@ func TestUseCasesClinicalImpl_RecordTemperature(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "36.8",
 				},
 			},
 			wantErr: true,
@@ -326,7 +550,7 @@ func TestUseCasesClinicalImpl_RecordTemperature(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "36.8",
 				},
 			},
 			wantErr: true,
@@ -338,7 +562,7 @@ func TestUseCasesClinicalImpl_RecordTemperature(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "36.8",
 				},
 			},
 			wantErr: true,
@@ -583,7 +807,7 @@ func TestUseCasesClinicalImpl_RecordOxygenSaturation(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "12",
+					Value:       "98",
 				},
 			},
 			wantErr: false,
@@ -594,7 +818,7 @@ func TestUseCasesClinicalImpl_RecordOxygenSaturation(t *testing.T) {
 				ctx: ctx,
 				input: dto.ObservationInput{
 					EncounterID: uuid.New().String(),
-					Value:       "12",
+					Value:       "98",
 				},
 			},
 			wantErr: true,
@@ -606,7 +830,7 @@ func TestUseCasesClinicalImpl_RecordOxygenSaturation(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "98",
 				},
 			},
 			wantErr: true,
@@ -618,7 +842,7 @@ func TestUseCasesClinicalImpl_RecordOxygenSaturation(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "98",
 				},
 			},
 			wantErr: true,
@@ -630,7 +854,7 @@ func TestUseCasesClinicalImpl_RecordOxygenSaturation(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "98",
 				},
 			},
 			wantErr: true,
@@ -642,7 +866,7 @@ func TestUseCasesClinicalImpl_RecordOxygenSaturation(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "98",
 				},
 			},
 			wantErr: true,
@@ -654,7 +878,7 @@ func TestUseCasesClinicalImpl_RecordOxygenSaturation(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "98",
 				},
 			},
 			wantErr: true,
@@ -1057,7 +1281,7 @@ func TestUseCasesClinicalImpl_RecordRespiratoryRate(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "185.21",
+					Value:       "18",
 				},
 			},
 			wantErr: false,
@@ -1080,7 +1304,7 @@ func TestUseCasesClinicalImpl_RecordRespiratoryRate(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "18",
 				},
 			},
 			wantErr: true,
@@ -1092,7 +1316,7 @@ func TestUseCasesClinicalImpl_RecordRespiratoryRate(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "18",
 				},
 			},
 			wantErr: true,
@@ -1104,7 +1328,7 @@ func TestUseCasesClinicalImpl_RecordRespiratoryRate(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "18",
 				},
 			},
 			wantErr: true,
@@ -1116,7 +1340,7 @@ func TestUseCasesClinicalImpl_RecordRespiratoryRate(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "18",
 				},
 			},
 			wantErr: true,
@@ -1128,7 +1352,7 @@ func TestUseCasesClinicalImpl_RecordRespiratoryRate(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "18",
 				},
 			},
 			wantErr: true,
@@ -2369,7 +2593,7 @@ func TestUseCasesClinicalImpl_RecordBMI(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "185.21",
+					Value:       "22.5",
 				},
 			},
 			wantErr: false,
@@ -2392,7 +2616,7 @@ func TestUseCasesClinicalImpl_RecordBMI(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "22.5",
 				},
 			},
 			wantErr: true,
@@ -2404,7 +2628,7 @@ func TestUseCasesClinicalImpl_RecordBMI(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "22.5",
 				},
 			},
 			wantErr: true,
@@ -2416,7 +2640,7 @@ func TestUseCasesClinicalImpl_RecordBMI(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "22.5",
 				},
 			},
 			wantErr: true,
@@ -2428,7 +2652,7 @@ func TestUseCasesClinicalImpl_RecordBMI(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "22.5",
 				},
 			},
 			wantErr: true,
@@ -2440,7 +2664,7 @@ func TestUseCasesClinicalImpl_RecordBMI(t *testing.T) {
 				input: dto.ObservationInput{
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
-					Value:       "1234",
+					Value:       "22.5",
 				},
 			},
 			wantErr: true,
@@ -3256,6 +3480,18 @@ func TestUseCasesClinicalImpl_GetPatientBloodPressureEntries(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Happy Case - Read the systolic pressure of a blood pressure panel",
+			args: args{
+				ctx:         ctx,
+				patientID:   uuid.New().String(),
+				encounterID: &encounterId,
+				pagination: &dto.Pagination{
+					First: &first,
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "Sad Case - Invalid patient ID",
 			args: args{
@@ -3342,6 +3578,19 @@ func TestUseCasesClinicalImpl_GetPatientBloodPressureEntries(t *testing.T) {
 				}
 			}
 
+			if tt.name == "Happy Case - Read the systolic pressure of a blood pressure panel" {
+				fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+					if searchParameters["combo-code"] != common.BloodPressureCIELTerminologyCode {
+						return nil, fmt.Errorf("expected the systolic pressure to be searched in the components of panels too")
+					}
+
+					return &domain.PagedFHIRObservations{
+						Observations: []domain.FHIRObservation{fakeBloodPressurePanel(encounterId, 150, 85, time.Now())},
+						TotalCount:   1,
+					}, nil
+				}
+			}
+
 			got, err := u.GetPatientBloodPressureEntries(tt.args.ctx, tt.args.patientID, tt.args.encounterID, tt.args.date, tt.args.pagination)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesClinicalImpl.GetPatientBloodPressureEntries() error = %v, wantErr %v", err, tt.wantErr)
@@ -3353,6 +3602,13 @@ func TestUseCasesClinicalImpl_GetPatientBloodPressureEntries(t *testing.T) {
 					return
 				}
 			}
+
+			if tt.name == "Happy Case - Read the systolic pressure of a blood pressure panel" {
+				systolic := got.Edges[0].Node
+				if systolic.Value != "150" || systolic.Unit != "mm[Hg]" || systolic.Flag == nil || *systolic.Flag != dto.ObservationInterpretationHigh || !systolic.Abnormal {
+					t.Errorf("expected a high systolic pressure of 150 mm[Hg], got %v", systolic)
+				}
+			}
 		})
 	}
 }
@@ -5280,10 +5536,23 @@ func TestUseCasesClinicalImpl_RecordBloodSugar(t *testing.T) {
 					Status:      dto.ObservationStatusFinal,
 					EncounterID: uuid.New().String(),
 					Value:       "12",
+					Unit:        "mmol/L",
 				},
 			},
 			wantErr: false,
 		},
+		{
+			name: "Sad Case - Record blood sugar without a unit",
+			args: args{
+				ctx: ctx,
+				input: dto.ObservationInput{
+					Status:      dto.ObservationStatusFinal,
+					EncounterID: uuid.New().String(),
+					Value:       "12",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Sad Case - Fail to record blood sugar",
 			args: args{
@@ -6073,6 +6342,37 @@ func TestUseCasesClinicalImpl_GetPatientLastMenstrualPeriodEntries(t *testing.T)
 	}
 }
 
+// fakeWeightObservation is a weight that was recorded as a string before measurements were stored as quantities
+func fakeWeightObservation(id string, value string) domain.FHIRObservation {
+	patientID := uuid.New().String()
+	encounterID := uuid.New().String()
+	status := domain.ObservationStatusEnumFinal
+	code := scalarutils.Code(common.WeightCIELTerminologyCode)
+	instant := scalarutils.Instant(time.Now().Format(time.RFC3339))
+
+	return domain.FHIRObservation{
+		ID:     &id,
+		Status: &status,
+		Code: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					Code:    &code,
+					Display: "Weight (kg)",
+				},
+			},
+			Text: "Weight (kg)",
+		},
+		Subject: &domain.FHIRReference{
+			ID: &patientID,
+		},
+		Encounter: &domain.FHIRReference{
+			ID: &encounterID,
+		},
+		EffectiveInstant: &instant,
+		ValueString:      &value,
+	}
+}
+
 func TestUseCasesClinicalImpl_PatchPatientObservations(t *testing.T) {
 	type args struct {
 		ctx   context.Context
@@ -6146,6 +6446,42 @@ func TestUseCasesClinicalImpl_PatchPatientObservations(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Happy Case - patch a weight as a quantity",
+			args: args{
+				ctx:   context.Background(),
+				id:    gofakeit.UUID(),
+				value: "154 lbs",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case - patch a weight that is not a number",
+			args: args{
+				ctx:   context.Background(),
+				id:    gofakeit.UUID(),
+				value: "heavy",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - patch a weight that is not possible",
+			args: args{
+				ctx:   context.Background(),
+				id:    gofakeit.UUID(),
+				value: "0.1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - fail to update a weight",
+			args: args{
+				ctx:   context.Background(),
+				id:    gofakeit.UUID(),
+				value: "70",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
@@ -6196,6 +6532,38 @@ func TestUseCasesClinicalImpl_PatchPatientObservations(t *testing.T) {
 			}
 		}
 
+		if tt.name == "Happy Case - patch a weight as a quantity" || tt.name == "Sad Case - patch a weight that is not a number" ||
+			tt.name == "Sad Case - fail to update a weight" || tt.name == "Sad Case - patch a weight that is not possible" {
+			fakeFHIR.MockGetFHIRObservationFn = func(ctx context.Context, id string) (*domain.FHIRObservationRelayPayload, error) {
+				observation := fakeWeightObservation(id, "70")
+
+				return &domain.FHIRObservationRelayPayload{
+					Resource: &observation,
+				}, nil
+			}
+			fakeFHIR.MockPatchFHIRObservationFn = func(ctx context.Context, id string, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				t.Errorf("expected the weight to be updated as a quantity rather than patched")
+				return nil, fmt.Errorf("an error occurred")
+			}
+		}
+
+		if tt.name == "Happy Case - patch a weight as a quantity" {
+			updateObservation := fakeFHIR.MockUpdateFHIRObservationFn
+			fakeFHIR.MockUpdateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				if input.ValueString != nil || input.ValueQuantity == nil || input.ValueQuantity.Value != 69.85 || input.ValueQuantity.Code != "kg" {
+					t.Errorf("expected the weight to be updated to 69.85 kg")
+				}
+
+				return updateObservation(ctx, input)
+			}
+		}
+
+		if tt.name == "Sad Case - fail to update a weight" {
+			fakeFHIR.MockUpdateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				return nil, fmt.Errorf("an error occurred")
+			}
+		}
+
 		_, err := u.PatchPatientObservations(tt.args.ctx, tt.args.id, tt.args.value)
 		if (err != nil) != tt.wantErr {
 			t.Errorf("UseCasesClinicalImpl.PatchPatientObservations() error = %v, wantErr %v", err, tt.wantErr)
@@ -6439,6 +6807,134 @@ func TestUseCasesClinicalImpl_RecordVIA(t *testing.T) {
 	}
 }
 
+func TestUseCasesClinicalImpl_MigratePatientObservationQuantities(t *testing.T) {
+	ctx := context.Background()
+
+	type args struct {
+		ctx       context.Context
+		patientID string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantMigrated int
+		wantErr      bool
+	}{
+		{
+			name: "Happy Case - migrate string measurements to quantities",
+			args: args{
+				ctx:       ctx,
+				patientID: uuid.New().String(),
+			},
+			wantMigrated: 2,
+			wantErr:      false,
+		},
+		{
+			name: "Sad Case - invalid patient id",
+			args: args{
+				ctx:       ctx,
+				patientID: "invalid",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - fail to get patient",
+			args: args{
+				ctx:       ctx,
+				patientID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - fail to get tenant identifiers",
+			args: args{
+				ctx:       ctx,
+				patientID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - fail to search observations",
+			args: args{
+				ctx:       ctx,
+				patientID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - fail to update observation",
+			args: args{
+				ctx:       ctx,
+				patientID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+				textual := fakeWeightObservation(uuid.New().String(), "not weighed")
+				migrated := fakeWeightObservation(uuid.New().String(), "70")
+				migrated.ValueString = nil
+				migrated.ValueQuantity = &domain.FHIRQuantity{Value: 70, Unit: "kg", Code: "kg"}
+
+				return &domain.PagedFHIRObservations{
+					Observations: []domain.FHIRObservation{
+						fakeWeightObservation(uuid.New().String(), "70"),
+						fakeWeightObservation(uuid.New().String(), "154 lb"),
+						textual,
+						migrated,
+					},
+				}, nil
+			}
+
+			if tt.name == "Sad Case - fail to get patient" {
+				fakeFHIR.MockGetFHIRPatientFn = func(ctx context.Context, id string) (*domain.FHIRPatientRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get patient")
+				}
+			}
+
+			if tt.name == "Sad Case - fail to get tenant identifiers" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("failed to get tenant identifiers")
+				}
+			}
+
+			if tt.name == "Sad Case - fail to search observations" {
+				fakeFHIR.MockSearchPatientObservationsFn = func(ctx context.Context, searchParameters map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+					return nil, fmt.Errorf("failed to search observations")
+				}
+			}
+
+			if tt.name == "Sad Case - fail to update observation" {
+				fakeFHIR.MockUpdateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+					return nil, fmt.Errorf("failed to update observation")
+				}
+			}
+
+			got, err := u.MigratePatientObservationQuantities(tt.args.ctx, tt.args.patientID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.MigratePatientObservationQuantities() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.wantMigrated {
+				t.Errorf("expected %v observations to be migrated but got %v", tt.wantMigrated, got)
+			}
+		})
+	}
+}
+
 func TestUseCasesClinicalImpl_RecordHPV(t *testing.T) {
 	type args struct {
 		ctx   context.Context
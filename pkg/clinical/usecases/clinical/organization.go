@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/savannahghi/clinical/pkg/clinical/application/common"
 	"github.com/savannahghi/clinical/pkg/clinical/application/common/helpers"
 	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
@@ -101,9 +102,23 @@ func mapOrganizationInputToFHIROrganizationInput(organization dto.OrganizationIn
 		org.Identifier = append(org.Identifier, identifier)
 	}
 
+	if organization.County != "" {
+		org.Address = append(org.Address, mapCountyToFHIRAddressInput(organization.County))
+	}
+
 	return &org
 }
 
+// mapCountyToFHIRAddressInput records the county of an organization as the state of its address
+func mapCountyToFHIRAddressInput(county string) *domain.FHIRAddressInput {
+	country := "KE"
+
+	return &domain.FHIRAddressInput{
+		State:   &county,
+		Country: &country,
+	}
+}
+
 func mapFHIROrganizationToDTOOrganization(organisation *domain.FHIROrganization) *dto.Organization {
 	org := &dto.Organization{
 		ID:           *organisation.ID,
@@ -124,6 +139,18 @@ func mapFHIROrganizationToDTOOrganization(organisation *domain.FHIROrganization)
 		org.PhoneNumbers = append(org.PhoneNumbers, *telecom.Value)
 	}
 
+	if organisation.PartOf != nil && organisation.PartOf.ID != nil {
+		org.PartOf = *organisation.PartOf.ID
+	}
+
+	for _, address := range organisation.Address {
+		if address != nil && address.State != nil {
+			org.County = *address.State
+
+			break
+		}
+	}
+
 	return org
 }
 
@@ -155,6 +182,23 @@ func (c *UseCasesClinicalImpl) RegisterFacility(ctx context.Context, input dto.O
 
 	payload := mapOrganizationInputToFHIROrganizationInput(input)
 
+	if input.PartOf != "" {
+		parent, err := c.infrastructure.FHIR.GetFHIROrganization(ctx, input.PartOf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the organization the facility is part of: %w", err)
+		}
+
+		parentReference := fmt.Sprintf("Organization/%s", input.PartOf)
+		payload.PartOf = &domain.FHIRReferenceInput{
+			ID:        &input.PartOf,
+			Reference: &parentReference,
+		}
+
+		if parent.Resource.Name != nil {
+			payload.PartOf.Display = *parent.Resource.Name
+		}
+	}
+
 	organisationPayload, err := c.infrastructure.FHIR.CreateFHIROrganization(ctx, *payload)
 	if err != nil {
 		return nil, err
@@ -162,3 +206,41 @@ func (c *UseCasesClinicalImpl) RegisterFacility(ctx context.Context, input dto.O
 
 	return mapFHIROrganizationToDTOOrganization(organisationPayload.Resource), nil
 }
+
+// ListFacilities lists the facilities that are part of an organization e.g the facilities a tenant runs its program in
+func (c *UseCasesClinicalImpl) ListFacilities(ctx context.Context, organizationID string) ([]*dto.Organization, error) {
+	_, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid organization id: %s", organizationID)
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	if identifiers.OrganizationID != organizationID {
+		return nil, fmt.Errorf("the facilities of organization %s can only be listed from the organization", organizationID)
+	}
+
+	params := map[string]interface{}{
+		"partof": fmt.Sprintf("Organization/%s", organizationID),
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIROrganization(ctx, params, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	facilities := []*dto.Organization{}
+
+	for _, edge := range resources.Edges {
+		if edge == nil || edge.Node == nil || edge.Node.ID == nil {
+			continue
+		}
+
+		facilities = append(facilities, mapFHIROrganizationToDTOOrganization(edge.Node))
+	}
+
+	return facilities, nil
+}
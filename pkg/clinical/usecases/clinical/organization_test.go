@@ -3,8 +3,10 @@ package clinical_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/brianvoe/gofakeit"
 	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
 	"github.com/savannahghi/clinical/pkg/clinical/domain"
@@ -126,6 +128,8 @@ func TestUseCasesClinicalImpl_RegisterTenant(t *testing.T) {
 
 func TestUseCasesClinicalImpl_RegisterFacility(t *testing.T) {
 	ctx := context.Background()
+	tenantID := gofakeit.UUID()
+
 	type args struct {
 		ctx   context.Context
 		input dto.OrganizationInput
@@ -152,6 +156,43 @@ func TestUseCasesClinicalImpl_RegisterFacility(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Happy case - register facility that is part of a tenant",
+			args: args{
+				ctx: ctx,
+				input: dto.OrganizationInput{
+					Name:        "Test",
+					PhoneNumber: "Number",
+					Identifiers: []dto.OrganizationIdentifier{
+						{
+							Type:  "MFLCode",
+							Value: "1234",
+						},
+					},
+					PartOf: tenantID,
+					County: "Kiambu",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case - fail to get the organization the facility is part of",
+			args: args{
+				ctx: ctx,
+				input: dto.OrganizationInput{
+					Name:        "Test",
+					PhoneNumber: "Number",
+					Identifiers: []dto.OrganizationIdentifier{
+						{
+							Type:  "MFLCode",
+							Value: "1234",
+						},
+					},
+					PartOf: tenantID,
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Sad case - fail to register facility",
 			args: args{
@@ -222,6 +263,27 @@ func TestUseCasesClinicalImpl_RegisterFacility(t *testing.T) {
 				}
 			}
 
+			if tt.name == "Happy case - register facility that is part of a tenant" {
+				createOrganization := fakeFHIR.MockCreateFHIROrganizationFn
+				fakeFHIR.MockCreateFHIROrganizationFn = func(ctx context.Context, input domain.FHIROrganizationInput) (*domain.FHIROrganizationRelayPayload, error) {
+					if input.PartOf == nil || *input.PartOf.ID != tenantID {
+						return nil, fmt.Errorf("expected the facility to be part of tenant %s", tenantID)
+					}
+
+					if len(input.Address) != 1 || *input.Address[0].State != "Kiambu" {
+						return nil, fmt.Errorf("expected the county of the facility to be recorded")
+					}
+
+					return createOrganization(ctx, input)
+				}
+			}
+
+			if tt.name == "Sad case - fail to get the organization the facility is part of" {
+				fakeFHIR.MockGetFHIROrganizationFn = func(ctx context.Context, organisationID string) (*domain.FHIROrganizationRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get organization")
+				}
+			}
+
 			got, err := u.RegisterFacility(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesClinicalImpl.RegisterFacility() error = %v, wantErr %v", err, tt.wantErr)
@@ -236,3 +298,126 @@ func TestUseCasesClinicalImpl_RegisterFacility(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCasesClinicalImpl_ListFacilities(t *testing.T) {
+	ctx := context.Background()
+	type args struct {
+		ctx            context.Context
+		organizationID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case - list the facilities of an organization",
+			args: args{
+				ctx:            ctx,
+				organizationID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case - invalid organization id",
+			args: args{
+				ctx:            ctx,
+				organizationID: "organization",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - failed to get tenant identifiers",
+			args: args{
+				ctx:            ctx,
+				organizationID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - facilities of another organization",
+			args: args{
+				ctx:            ctx,
+				organizationID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case - failed to search organizations",
+			args: args{
+				ctx:            ctx,
+				organizationID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name != "Sad case - facilities of another organization" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return &dto.TenantIdentifiers{
+						OrganizationID: tt.args.organizationID,
+						FacilityID:     gofakeit.UUID(),
+					}, nil
+				}
+			}
+
+			fakeFHIR.MockSearchFHIROrganizationFn = func(ctx context.Context, params map[string]interface{}, pagination dto.Pagination) (*domain.FHIROrganizationRelayConnection, error) {
+				id := gofakeit.UUID()
+				name := "Kiambu Level 5 Hospital"
+				active := true
+				parentReference := params["partof"].(string)
+				parentID := strings.TrimPrefix(parentReference, "Organization/")
+
+				return &domain.FHIROrganizationRelayConnection{
+					Edges: []*domain.FHIROrganizationRelayEdge{
+						{
+							Node: &domain.FHIROrganization{
+								ID:     &id,
+								Name:   &name,
+								Active: &active,
+								PartOf: &domain.FHIRReference{
+									ID:        &parentID,
+									Reference: &parentReference,
+								},
+							},
+						},
+					},
+				}, nil
+			}
+
+			if tt.name == "Sad case - failed to get tenant identifiers" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("failed to get tenant identifiers")
+				}
+			}
+
+			if tt.name == "Sad case - failed to search organizations" {
+				fakeFHIR.MockSearchFHIROrganizationFn = func(ctx context.Context, params map[string]interface{}, pagination dto.Pagination) (*domain.FHIROrganizationRelayConnection, error) {
+					return nil, fmt.Errorf("failed to search organizations")
+				}
+			}
+
+			got, err := u.ListFacilities(tt.args.ctx, tt.args.organizationID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListFacilities() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (len(got) != 1 || got[0].PartOf != tt.args.organizationID) {
+				t.Errorf("expected the facility that is part of organization %s, got %v", tt.args.organizationID, got)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package clinical
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/savannahghi/clinical/pkg/clinical/application/extensions"
@@ -18,12 +20,20 @@ import (
 // UseCasesClinicalImpl represents the patient usecase implementation
 type UseCasesClinicalImpl struct {
 	infrastructure infrastructure.Infrastructure
+
+	// conceptMappings caches the OCL mappings of the concepts that medications are checked against
+	conceptMappings *conceptMappingsCache
+
+	// serviceAccountOperations are the GraphQL operations and REST routes that service accounts can be granted
+	serviceAccountOperations *serviceAccountOperations
 }
 
 // NewUseCasesClinicalImpl initializes new Clinical/Patient implementation
 func NewUseCasesClinicalImpl(infra infrastructure.Infrastructure) *UseCasesClinicalImpl {
 	return &UseCasesClinicalImpl{
-		infrastructure: infra,
+		infrastructure:           infra,
+		conceptMappings:          newConceptMappingsCache(),
+		serviceAccountOperations: newServiceAccountOperations(),
 	}
 }
 
@@ -42,6 +52,8 @@ func (c *UseCasesClinicalImpl) GetMedicalData(ctx context.Context, patientID str
 	fields := []string{
 		"Regimen",
 		"AllergyIntolerance",
+		"Procedures",
+		"FamilyHistory",
 		"Weight",
 		"BMI",
 		"ViralLoad",
@@ -128,6 +140,43 @@ func (c *UseCasesClinicalImpl) GetMedicalData(ctx context.Context, patientID str
 				data.Allergies = append(data.Allergies, mapFHIRAllergyIntoleranceToAllergyIntoleranceDTO(edge))
 			}
 
+		case "Procedures":
+			conn, err := c.infrastructure.FHIR.SearchFHIRProcedure(ctx, filterParams, *identifiers, dto.Pagination{Skip: true})
+			if err != nil {
+				utils.ReportErrorToSentry(err)
+				return nil, fmt.Errorf("%s search error: %w", field, err)
+			}
+
+			for _, procedure := range conn.Procedures {
+				if procedure.ID == nil {
+					continue
+				}
+
+				data.Procedures = append(data.Procedures, mapFHIRProcedureToDTO(procedure))
+			}
+
+		case "FamilyHistory":
+			params := map[string]interface{}{
+				"patient":    filterParams["patient"],
+				"status:not": dto.FamilyMemberHistoryStatusEnteredInError.Code(),
+				"_count":     common.MedicalDataCount,
+				"_sort":      "-date",
+			}
+
+			conn, err := c.infrastructure.FHIR.SearchFHIRFamilyMemberHistory(ctx, params, *identifiers, dto.Pagination{Skip: true})
+			if err != nil {
+				utils.ReportErrorToSentry(err)
+				return nil, fmt.Errorf("%s search error: %w", field, err)
+			}
+
+			for _, history := range conn.FamilyMemberHistories {
+				if history.ID == nil {
+					continue
+				}
+
+				data.FamilyHistory = append(data.FamilyHistory, mapFHIRFamilyMemberHistoryToDTO(history))
+			}
+
 		case "Weight":
 			filterParams["code"] = common.WeightCIELTerminologyCode
 
@@ -221,25 +270,17 @@ func hasNilInObservation(observation domain.FHIRObservation) bool {
 	return false
 }
 
-func mapFHIRMedicationStatementToMedicationStatementDTO(fhirAllergyIntolerance *domain.FHIRMedicationStatement) *dto.MedicationStatement {
-	return &dto.MedicationStatement{
-		ID:     *fhirAllergyIntolerance.ID,
-		Status: dto.MedicationStatementStatusEnum(*fhirAllergyIntolerance.Status),
-		Medication: dto.Medication{
-			Name: fhirAllergyIntolerance.MedicationCodeableConcept.Coding[0].Display,
-			Code: fhirAllergyIntoleranceClinicalStatusURL,
-		},
-		PatientID: *fhirAllergyIntolerance.Subject.ID,
-	}
-}
-
 func mapFHIRAllergyIntoleranceToAllergyIntoleranceDTO(fhirAllergyIntolerance domain.FHIRAllergyIntolerance) *dto.Allergy {
 	allergyIntolerance := &dto.Allergy{
-		ID:        *fhirAllergyIntolerance.ID,
-		PatientID: *fhirAllergyIntolerance.Patient.ID,
-		Code:      string(*fhirAllergyIntolerance.Code.Coding[0].Code),
-		Name:      string(fhirAllergyIntolerance.Code.Coding[0].Display),
-		System:    string(fhirAllergyIntolerance.Code.Text),
+		ID:                 *fhirAllergyIntolerance.ID,
+		PatientID:          *fhirAllergyIntolerance.Patient.ID,
+		Code:               string(*fhirAllergyIntolerance.Code.Coding[0].Code),
+		Name:               string(fhirAllergyIntolerance.Code.Coding[0].Display),
+		System:             string(fhirAllergyIntolerance.Code.Text),
+		ClinicalStatus:     dto.AllergyClinicalStatusEnum(allergyStatusValue(fhirAllergyIntolerance.ClinicalStatus)),
+		VerificationStatus: dto.AllergyVerificationStatusEnum(allergyStatusValue(fhirAllergyIntolerance.VerificationStatus)),
+		LastOccurrence:     fhirAllergyIntolerance.LastOccurrence,
+		Reactions:          []dto.Reaction{},
 	}
 
 	if fhirAllergyIntolerance.Encounter != nil && fhirAllergyIntolerance.Encounter.ID != nil {
@@ -250,34 +291,49 @@ func mapFHIRAllergyIntoleranceToAllergyIntoleranceDTO(fhirAllergyIntolerance dom
 		allergyIntolerance.OnsetDateTime = fhirAllergyIntolerance.OnsetPeriod.Start
 	}
 
-	if len(fhirAllergyIntolerance.Reaction) > 0 {
-		reaction := fhirAllergyIntolerance.Reaction[0]
-		if reaction.Severity != nil {
-			allergyIntolerance.Reaction.Severity = dto.AllergyIntoleranceReactionSeverityEnum(*reaction.Severity)
+	for _, reaction := range fhirAllergyIntolerance.Reaction {
+		if reaction == nil {
+			continue
 		}
 
-		if len(reaction.Manifestation) > 0 {
-			manifestation := reaction.Manifestation[0]
-			if len(manifestation.Coding) > 0 {
-				coding := manifestation.Coding[0]
-				if coding.System != nil {
-					allergyIntolerance.Reaction.System = string(*coding.System)
-				}
+		allergyIntolerance.Reactions = append(allergyIntolerance.Reactions, mapFHIRAllergyReactionToReactionDTO(*reaction))
+	}
 
-				allergyIntolerance.Reaction.Code = string(*coding.Code)
-				allergyIntolerance.Reaction.Name = string(coding.Display)
+	if len(allergyIntolerance.Reactions) > 0 {
+		allergyIntolerance.Reaction = allergyIntolerance.Reactions[0]
+	}
+
+	return allergyIntolerance
+}
+
+func mapFHIRAllergyReactionToReactionDTO(reaction domain.FHIRAllergyintoleranceReaction) dto.Reaction {
+	output := dto.Reaction{}
+
+	if reaction.Severity != nil {
+		output.Severity = dto.AllergyIntoleranceReactionSeverityEnum(strings.ToUpper(string(*reaction.Severity)))
+	}
+
+	if len(reaction.Manifestation) > 0 {
+		manifestation := reaction.Manifestation[0]
+		if len(manifestation.Coding) > 0 {
+			coding := manifestation.Coding[0]
+			if coding.System != nil {
+				output.System = string(*coding.System)
 			}
+
+			output.Code = string(*coding.Code)
+			output.Name = string(coding.Display)
 		}
 	}
 
-	return allergyIntolerance
+	return output
 }
 
 func mapFHIRObservationToObservationDTO(fhirObservation domain.FHIRObservation) *dto.Observation {
 	var value string
 
 	if fhirObservation.ValueQuantity != nil {
-		value = fmt.Sprintf("%v %v", fhirObservation.ValueQuantity.Value, fhirObservation.ValueQuantity.Unit)
+		value = strconv.FormatFloat(fhirObservation.ValueQuantity.Value, 'f', -1, 64)
 	}
 
 	if fhirObservation.ValueCodeableConcept != nil {
@@ -320,6 +376,10 @@ func mapFHIRObservationToObservationDTO(fhirObservation domain.FHIRObservation)
 		value = fmt.Sprintf("%v - %v", fhirObservation.ValuePeriod.Start, fhirObservation.ValuePeriod.End)
 	}
 
+	if fhirObservation.Code != nil && hasCode(*fhirObservation.Code, common.LOINCBloodPressurePanel) {
+		value = bloodPressureText(mapFHIRObservationToBloodPressureReading(fhirObservation))
+	}
+
 	obs := &dto.Observation{
 		ID:           *fhirObservation.ID,
 		Status:       dto.ObservationStatus(*fhirObservation.Status),
@@ -337,10 +397,33 @@ func mapFHIRObservationToObservationDTO(fhirObservation domain.FHIRObservation)
 		obs.Note = string(*fhirObservation.Note[0].Text)
 	}
 
+	if fhirObservation.ValueQuantity != nil {
+		obs.Quantity = &fhirObservation.ValueQuantity.Value
+		obs.Unit = string(fhirObservation.ValueQuantity.Code)
+	}
+
+	// measurements recorded before they were stored as quantities are read in the unit their concept is recorded in
+	if fhirObservation.ValueQuantity == nil && fhirObservation.ValueString != nil {
+		quantity, err := observationQuantity(observationConceptCode(fhirObservation.Code), *fhirObservation.ValueString, "")
+		if err == nil && quantity != nil {
+			obs.Quantity = &quantity.Value
+			obs.Unit = string(quantity.Code)
+		}
+	}
+
 	for _, interpretation := range fhirObservation.Interpretation {
 		obs.Interpretation = append(obs.Interpretation, interpretation.Text)
 	}
 
+	obs.Flag = observationFlag(fhirObservation)
+	obs.Abnormal = obs.Flag != nil && obs.Flag.IsAbnormal()
+
+	for _, reference := range fhirObservation.DerivedFrom {
+		if reference != nil && reference.ID != nil {
+			obs.DerivedFrom = append(obs.DerivedFrom, *reference.ID)
+		}
+	}
+
 	return obs
 }
 
@@ -131,6 +131,22 @@ func TestClinicalUseCaseImpl_GetMedicalData(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Sad Case - Fail to search procedures",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - Fail to search family history",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
 		{
 			name: "Sad Case - Fail to search allergy intolerance - nil node",
 			args: args{
@@ -554,6 +570,18 @@ func TestClinicalUseCaseImpl_GetMedicalData(t *testing.T) {
 				}
 			}
 
+			if tt.name == "Sad Case - Fail to search procedures" {
+				fakeFHIR.MockSearchFHIRProcedureFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRProcedure, error) {
+					return nil, fmt.Errorf("failed to search procedures")
+				}
+			}
+
+			if tt.name == "Sad Case - Fail to search family history" {
+				fakeFHIR.MockSearchFHIRFamilyMemberHistoryFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRFamilyMemberHistory, error) {
+					return nil, fmt.Errorf("failed to search family member histories")
+				}
+			}
+
 			if tt.name == "Happy Case - Successfully search allergy intolerance" {
 				fakeFHIR.MockSearchFHIRAllergyIntoleranceFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error) {
 					code := "123"
@@ -0,0 +1,434 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// RegisterPractitioner is used by an administrator to register a practitioner together with their qualifications and registration-board numbers.
+// A registration number or user can only belong to one practitioner
+func (c *UseCasesClinicalImpl) RegisterPractitioner(ctx context.Context, input dto.PractitionerInput) (*dto.Practitioner, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers := practitionerRegistrationIdentifiers(input.Registrations)
+
+	if input.UserID != "" {
+		identifiers = append(identifiers, practitionerIdentifier(domain.IdentifierUseEnumSecondary, scalarutils.URI(common.PractitionerUserIdentifierSystem), input.UserID))
+	}
+
+	return c.registerPractitioner(ctx, input.Name, input.PhoneNumber, input.Email, identifiers, input.Qualifications)
+}
+
+// RegisterCurrentPractitioner registers the logged in user as a practitioner. Their name and contacts are read from their sign in identity.
+// A user who is already registered gets back their existing practitioner record
+func (c *UseCasesClinicalImpl) RegisterCurrentPractitioner(ctx context.Context, input dto.PractitionerProfileInput) (*dto.Practitioner, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := c.infrastructure.BaseExtension.GetLoggedInUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the logged in user: %w", err)
+	}
+
+	if user.UID == "" || user.DisplayName == "" {
+		return nil, fmt.Errorf("the logged in user does not have a name to register as a practitioner")
+	}
+
+	existing, err := c.findPractitionerByIdentifier(ctx, scalarutils.URI(common.PractitionerUserIdentifierSystem), user.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		return mapFHIRPractitionerToDTO(*existing), nil
+	}
+
+	identifiers := append(
+		practitionerRegistrationIdentifiers(input.Registrations),
+		practitionerIdentifier(domain.IdentifierUseEnumSecondary, scalarutils.URI(common.PractitionerUserIdentifierSystem), user.UID),
+	)
+
+	return c.registerPractitioner(ctx, user.DisplayName, user.PhoneNumber, user.Email, identifiers, input.Qualifications)
+}
+
+// registerPractitioner creates an active practitioner after checking that none of their identifiers belong to another practitioner
+func (c *UseCasesClinicalImpl) registerPractitioner(ctx context.Context, name, phoneNumber, email string, identifiers []*domain.FHIRIdentifier, qualifications []*dto.QualificationInput) (*dto.Practitioner, error) {
+	err := c.checkPractitionerIdentifiers(ctx, identifiers, "")
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	active := true
+	practitioner := domain.FHIRPractitioner{
+		Active:     &active,
+		Identifier: identifiers,
+		Name: []*domain.FHIRHumanName{
+			{
+				Use:  domain.HumanNameUseEnumOfficial,
+				Text: name,
+			},
+		},
+		Qualification: practitionerQualifications(qualifications),
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+	}
+
+	setPractitionerContact(&practitioner, domain.ContactPointSystemEnumPhone, phoneNumber)
+	setPractitionerContact(&practitioner, domain.ContactPointSystemEnumEmail, email)
+
+	resource, err := c.infrastructure.FHIR.CreateFHIRPractitioner(ctx, practitioner)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRPractitionerToDTO(*resource), nil
+}
+
+// checkPractitionerIdentifiers ensures the registration numbers and user of a practitioner do not belong to another practitioner
+func (c *UseCasesClinicalImpl) checkPractitionerIdentifiers(ctx context.Context, identifiers []*domain.FHIRIdentifier, practitionerID string) error {
+	for _, identifier := range identifiers {
+		if identifier == nil || identifier.System == nil {
+			continue
+		}
+
+		existing, err := c.findPractitionerByIdentifier(ctx, *identifier.System, identifier.Value)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil && (existing.ID == nil || *existing.ID != practitionerID) {
+			return fmt.Errorf("identifier %s is already registered to another practitioner", identifier.Value)
+		}
+	}
+
+	return nil
+}
+
+// findPractitionerByIdentifier finds the practitioner with an identifier e.g a registration number or the user they sign in as.
+// Practitioners belong to the organisation rather than a facility, so they are found from any of its facilities.
+// A nil practitioner is returned when none is found
+func (c *UseCasesClinicalImpl) findPractitionerByIdentifier(ctx context.Context, system scalarutils.URI, value string) (*domain.FHIRPractitioner, error) {
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"identifier": fmt.Sprintf("%s|%s", system, value),
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIROrganisationPractitioner(ctx, params, identifiers.OrganizationID, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.Practitioners {
+		if hasPractitionerIdentifier(resource, system, value) {
+			practitioner := resource
+
+			return &practitioner, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// currentPractitioner finds the practitioner record of the logged in user.
+// A nil practitioner is returned when the user is not known or is not registered as a practitioner e.g a service account
+func (c *UseCasesClinicalImpl) currentPractitioner(ctx context.Context) (*domain.FHIRPractitioner, error) {
+	uid, err := c.infrastructure.BaseExtension.GetLoggedInUserUID(ctx)
+	if err != nil || uid == "" {
+		return nil, nil
+	}
+
+	return c.findPractitionerByIdentifier(ctx, scalarutils.URI(common.PractitionerUserIdentifierSystem), uid)
+}
+
+// currentPractitionerReference references the practitioner record of the logged in user, if any, from the resources they record
+func (c *UseCasesClinicalImpl) currentPractitionerReference(ctx context.Context) (*domain.FHIRReferenceInput, error) {
+	practitioner, err := c.currentPractitioner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if practitioner == nil || practitioner.ID == nil {
+		return nil, nil
+	}
+
+	return practitionerReferenceInput(*practitioner), nil
+}
+
+// GetPractitioner retrieves a practitioner
+func (c *UseCasesClinicalImpl) GetPractitioner(ctx context.Context, id string) (*dto.Practitioner, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid practitioner id: %s", id)
+	}
+
+	resource, err := c.infrastructure.FHIR.GetFHIRPractitioner(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRPractitionerToDTO(*resource.Resource), nil
+}
+
+// GetCurrentPractitioner retrieves the practitioner record of the logged in user
+func (c *UseCasesClinicalImpl) GetCurrentPractitioner(ctx context.Context) (*dto.Practitioner, error) {
+	practitioner, err := c.currentPractitioner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if practitioner == nil {
+		return nil, fmt.Errorf("the logged in user is not registered as a practitioner")
+	}
+
+	return mapFHIRPractitionerToDTO(*practitioner), nil
+}
+
+// UpdatePractitioner updates the status, contacts, qualifications or registration numbers of a practitioner.
+// The user a practitioner signs in as can not be changed
+func (c *UseCasesClinicalImpl) UpdatePractitioner(ctx context.Context, id string, input dto.PractitionerUpdateInput) (*dto.Practitioner, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid practitioner id: %s", id)
+	}
+
+	err = input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := c.infrastructure.FHIR.GetFHIRPractitioner(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	practitioner := *resource.Resource
+
+	if input.Active != nil {
+		practitioner.Active = input.Active
+	}
+
+	if input.PhoneNumber != nil {
+		setPractitionerContact(&practitioner, domain.ContactPointSystemEnumPhone, *input.PhoneNumber)
+	}
+
+	if input.Email != nil {
+		setPractitionerContact(&practitioner, domain.ContactPointSystemEnumEmail, *input.Email)
+	}
+
+	if input.Qualifications != nil {
+		practitioner.Qualification = practitionerQualifications(input.Qualifications)
+	}
+
+	if input.Registrations != nil {
+		registrations := practitionerRegistrationIdentifiers(input.Registrations)
+
+		err = c.checkPractitionerIdentifiers(ctx, registrations, id)
+		if err != nil {
+			return nil, err
+		}
+
+		practitioner.Identifier = append(practitionerUserIdentifiers(practitioner), registrations...)
+	}
+
+	updated, err := c.infrastructure.FHIR.UpdateFHIRPractitioner(ctx, practitioner)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRPractitionerToDTO(*updated), nil
+}
+
+// AssignPractitionerRole assigns an active practitioner to a facility in a role e.g a Clinical Officer.
+// The practitioner is assigned to the current facility unless a facility is given
+func (c *UseCasesClinicalImpl) AssignPractitionerRole(ctx context.Context, input dto.PractitionerRoleInput) (*dto.PractitionerRole, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	practitioner, err := c.infrastructure.FHIR.GetFHIRPractitioner(ctx, input.PractitionerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if practitioner.Resource.Active != nil && !*practitioner.Resource.Active {
+		return nil, fmt.Errorf("an inactive practitioner can not be assigned a role")
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	facilityID := identifiers.FacilityID
+	if input.FacilityID != "" {
+		facilityID = input.FacilityID
+	}
+
+	facility, err := c.infrastructure.FHIR.GetFHIROrganization(ctx, facilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if facilityID != identifiers.FacilityID {
+		partOf := facility.Resource.PartOf
+		if partOf == nil || partOf.ID == nil || *partOf.ID != identifiers.OrganizationID {
+			return nil, fmt.Errorf("facility %s is not part of organisation %s", facilityID, identifiers.OrganizationID)
+		}
+	}
+
+	// The role is tagged with the facility the practitioner is assigned to so that it is listed with the facility's practitioners
+	tags, err := c.CreateTenantMetaTags(ctx, identifiers.OrganizationID, facilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	facilityReference := organizationReference(facilityID)
+	if facility.Resource.Name != nil {
+		facilityReference.Display = *facility.Resource.Name
+	}
+
+	practitionerReference := fmt.Sprintf("Practitioner/%s", input.PractitionerID)
+
+	startDate := time.Now()
+	if input.StartDate != nil {
+		startDate = input.StartDate.AsTime()
+	}
+
+	active := true
+	role := domain.FHIRPractitionerRole{
+		Active: &active,
+		Period: &domain.FHIRPeriod{
+			Start: scalarutils.DateTime(startDate.Format(time.DateOnly)),
+		},
+		Practitioner: &domain.FHIRReference{
+			ID:        &input.PractitionerID,
+			Reference: &practitionerReference,
+			Display:   practitioner.Resource.Names(),
+		},
+		Organization: facilityReference,
+		Code: []*domain.FHIRCodeableConcept{
+			{
+				Text: input.Role,
+			},
+		},
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+	}
+
+	resource, err := c.infrastructure.FHIR.CreateFHIRPractitionerRole(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRPractitionerRoleToDTO(*resource), nil
+}
+
+// EndPractitionerRole ends the assignment of a practitioner to a facility e.g when they are transferred
+func (c *UseCasesClinicalImpl) EndPractitionerRole(ctx context.Context, id string) (*dto.PractitionerRole, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid practitioner role id: %s", id)
+	}
+
+	resource, err := c.infrastructure.FHIR.GetFHIRPractitionerRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	role := *resource.Resource
+
+	if role.Active != nil && !*role.Active {
+		return nil, fmt.Errorf("the practitioner role has already ended")
+	}
+
+	active := false
+	role.Active = &active
+
+	if role.Period == nil {
+		role.Period = &domain.FHIRPeriod{}
+	}
+
+	role.Period.End = scalarutils.DateTime(time.Now().Format(time.DateOnly))
+
+	updated, err := c.infrastructure.FHIR.UpdateFHIRPractitionerRole(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRPractitionerRoleToDTO(*updated), nil
+}
+
+// ListFacilityPractitioners lists the practitioners currently assigned to a facility together with their roles
+func (c *UseCasesClinicalImpl) ListFacilityPractitioners(ctx context.Context, facilityID string, pagination dto.Pagination) (*dto.PractitionerRoleConnection, error) {
+	_, err := uuid.Parse(facilityID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid facility id: %s", facilityID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"organization": fmt.Sprintf("Organization/%s", facilityID),
+		"active":       "true",
+	}
+
+	// the roles are tagged with the facility the practitioner is assigned to
+	tenant := dto.TenantIdentifiers{
+		OrganizationID: identifiers.OrganizationID,
+		FacilityID:     facilityID,
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRPractitionerRole(ctx, params, tenant, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := []*dto.PractitionerRole{}
+
+	for _, resource := range resources.PractitionerRoles {
+		roles = append(roles, mapFHIRPractitionerRoleToDTO(resource))
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreatePractitionerRoleConnection(roles, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
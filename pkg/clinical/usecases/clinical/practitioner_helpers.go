@@ -0,0 +1,282 @@
+package clinical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// registrationBoardSystemFormat is the naming system of the registration numbers issued by a regulatory board
+const registrationBoardSystemFormat = "http://savannahghi.org/fhir/NamingSystem/%s-registration-number"
+
+// registrationBoards are the regulatory boards whose registration numbers are recorded on practitioners
+var registrationBoards = []dto.RegistrationBoardEnum{
+	dto.RegistrationBoardKMPDC,
+	dto.RegistrationBoardNCK,
+	dto.RegistrationBoardCOC,
+	dto.RegistrationBoardPPB,
+}
+
+// registrationBoardSystem is the identifier system of the registration numbers issued by a board
+func registrationBoardSystem(board dto.RegistrationBoardEnum) scalarutils.URI {
+	return scalarutils.URI(fmt.Sprintf(registrationBoardSystemFormat, strings.ToLower(board.String())))
+}
+
+// registrationBoardFromSystem reads the board that issued a registration number from the number's identifier system
+func registrationBoardFromSystem(system scalarutils.URI) (dto.RegistrationBoardEnum, bool) {
+	for _, board := range registrationBoards {
+		if system == registrationBoardSystem(board) {
+			return board, true
+		}
+	}
+
+	return "", false
+}
+
+// practitionerIdentifier creates an identifier of a practitioner
+func practitionerIdentifier(use domain.IdentifierUseEnum, system scalarutils.URI, value string) *domain.FHIRIdentifier {
+	return &domain.FHIRIdentifier{
+		Use:    use,
+		System: &system,
+		Value:  value,
+	}
+}
+
+// practitionerRegistrationIdentifiers records the registration numbers of a practitioner as official identifiers
+func practitionerRegistrationIdentifiers(registrations []*dto.PractitionerRegistrationInput) []*domain.FHIRIdentifier {
+	identifiers := []*domain.FHIRIdentifier{}
+
+	for _, registration := range registrations {
+		if registration == nil {
+			continue
+		}
+
+		identifiers = append(identifiers, practitionerIdentifier(domain.IdentifierUseEnumOfficial, registrationBoardSystem(registration.Board), registration.Number))
+	}
+
+	return identifiers
+}
+
+// practitionerUserIdentifiers are the identifiers of a practitioner other than their registration numbers e.g the user they sign in as
+func practitionerUserIdentifiers(practitioner domain.FHIRPractitioner) []*domain.FHIRIdentifier {
+	identifiers := []*domain.FHIRIdentifier{}
+
+	for _, identifier := range practitioner.Identifier {
+		if identifier == nil {
+			continue
+		}
+
+		if identifier.System != nil {
+			if _, ok := registrationBoardFromSystem(*identifier.System); ok {
+				continue
+			}
+		}
+
+		identifiers = append(identifiers, identifier)
+	}
+
+	return identifiers
+}
+
+// practitionerQualifications records the qualifications obtained by a practitioner
+func practitionerQualifications(inputs []*dto.QualificationInput) []*domain.FHIRPractitionerQualification {
+	qualifications := []*domain.FHIRPractitionerQualification{}
+
+	for _, input := range inputs {
+		if input == nil {
+			continue
+		}
+
+		qualification := &domain.FHIRPractitionerQualification{
+			Code: &domain.FHIRCodeableConcept{
+				Text: input.Name,
+			},
+		}
+
+		if input.Issuer != "" {
+			qualification.Issuer = &domain.FHIRReference{
+				Display: input.Issuer,
+			}
+		}
+
+		if input.AwardedOn != nil {
+			qualification.Period = &domain.FHIRPeriod{
+				Start: scalarutils.DateTime(input.AwardedOn.AsTime().Format(time.DateOnly)),
+			}
+		}
+
+		qualifications = append(qualifications, qualification)
+	}
+
+	return qualifications
+}
+
+// setPractitionerContact sets the phone number or email of a practitioner, replacing the existing one
+func setPractitionerContact(practitioner *domain.FHIRPractitioner, system domain.ContactPointSystemEnum, value string) {
+	telecom := []*domain.FHIRContactPoint{}
+
+	for _, contact := range practitioner.Telecom {
+		if contact != nil && contact.System != nil && *contact.System == system {
+			continue
+		}
+
+		telecom = append(telecom, contact)
+	}
+
+	if value != "" {
+		telecom = append(telecom, &domain.FHIRContactPoint{
+			System: &system,
+			Value:  &value,
+		})
+	}
+
+	practitioner.Telecom = telecom
+}
+
+// practitionerContact reads the phone number or email of a practitioner
+func practitionerContact(practitioner domain.FHIRPractitioner, system domain.ContactPointSystemEnum) string {
+	for _, contact := range practitioner.Telecom {
+		if contact != nil && contact.System != nil && *contact.System == system && contact.Value != nil {
+			return *contact.Value
+		}
+	}
+
+	return ""
+}
+
+// hasPractitionerIdentifier checks whether a practitioner has an identifier in a system e.g a registration number
+func hasPractitionerIdentifier(practitioner domain.FHIRPractitioner, system scalarutils.URI, value string) bool {
+	for _, identifier := range practitioner.Identifier {
+		if identifier != nil && identifier.System != nil && *identifier.System == system && identifier.Value == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// practitionerReferenceInput references a practitioner from the resources they record e.g the participants of an encounter
+func practitionerReferenceInput(practitioner domain.FHIRPractitioner) *domain.FHIRReferenceInput {
+	reference := fmt.Sprintf("Practitioner/%s", *practitioner.ID)
+	referenceType := scalarutils.URI("Practitioner")
+
+	return &domain.FHIRReferenceInput{
+		ID:        practitioner.ID,
+		Reference: &reference,
+		Type:      &referenceType,
+		Display:   practitioner.Names(),
+	}
+}
+
+// isPractitionerReference checks whether a reference is to a practitioner
+func isPractitionerReference(reference *domain.FHIRReference) bool {
+	return reference != nil && reference.ID != nil && reference.Reference != nil && strings.HasPrefix(*reference.Reference, "Practitioner/")
+}
+
+func mapFHIRPractitionerToDTO(resource domain.FHIRPractitioner) *dto.Practitioner {
+	output := &dto.Practitioner{
+		Name:           resource.Names(),
+		PhoneNumber:    practitionerContact(resource, domain.ContactPointSystemEnumPhone),
+		Email:          practitionerContact(resource, domain.ContactPointSystemEnumEmail),
+		Qualifications: []*dto.Qualification{},
+		Registrations:  []*dto.PractitionerRegistration{},
+	}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.Active != nil {
+		output.Active = *resource.Active
+	}
+
+	for _, identifier := range resource.Identifier {
+		if identifier == nil || identifier.System == nil {
+			continue
+		}
+
+		if *identifier.System == scalarutils.URI(common.PractitionerUserIdentifierSystem) {
+			output.UserID = identifier.Value
+
+			continue
+		}
+
+		if board, ok := registrationBoardFromSystem(*identifier.System); ok {
+			output.Registrations = append(output.Registrations, &dto.PractitionerRegistration{
+				Board:  board,
+				Number: identifier.Value,
+			})
+		}
+	}
+
+	for _, qualification := range resource.Qualification {
+		if qualification == nil || qualification.Code == nil {
+			continue
+		}
+
+		output.Qualifications = append(output.Qualifications, mapFHIRPractitionerQualificationToDTO(*qualification))
+	}
+
+	return output
+}
+
+func mapFHIRPractitionerQualificationToDTO(resource domain.FHIRPractitionerQualification) *dto.Qualification {
+	output := &dto.Qualification{
+		Name: resource.Code.Text,
+	}
+
+	if resource.Issuer != nil {
+		output.Issuer = resource.Issuer.Display
+	}
+
+	if resource.Period != nil && resource.Period.Start != "" {
+		output.AwardedOn = parseFHIRDate(string(resource.Period.Start))
+	}
+
+	return output
+}
+
+func mapFHIRPractitionerRoleToDTO(resource domain.FHIRPractitionerRole) *dto.PractitionerRole {
+	output := &dto.PractitionerRole{}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.Active != nil {
+		output.Active = *resource.Active
+	}
+
+	if resource.Practitioner != nil {
+		if resource.Practitioner.ID != nil {
+			output.PractitionerID = *resource.Practitioner.ID
+		}
+
+		output.PractitionerName = resource.Practitioner.Display
+	}
+
+	if resource.Organization != nil && resource.Organization.ID != nil {
+		output.FacilityID = *resource.Organization.ID
+	}
+
+	if len(resource.Code) > 0 && resource.Code[0] != nil {
+		output.Role = resource.Code[0].Text
+	}
+
+	if resource.Period != nil {
+		if resource.Period.Start != "" {
+			output.StartDate = parseFHIRDate(string(resource.Period.Start))
+		}
+
+		if resource.Period.End != "" {
+			output.EndDate = parseFHIRDate(string(resource.Period.End))
+		}
+	}
+
+	return output
+}
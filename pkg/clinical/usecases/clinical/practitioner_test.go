@@ -0,0 +1,1125 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/profileutils"
+	"github.com/savannahghi/scalarutils"
+)
+
+// registeredPractitioner returns an active practitioner who signs in as the given user
+func registeredPractitioner(userID string) domain.FHIRPractitioner {
+	id := gofakeit.UUID()
+	active := true
+	system := scalarutils.URI(common.PractitionerUserIdentifierSystem)
+
+	return domain.FHIRPractitioner{
+		ID:     &id,
+		Active: &active,
+		Identifier: []*domain.FHIRIdentifier{
+			{
+				Use:    domain.IdentifierUseEnumSecondary,
+				System: &system,
+				Value:  userID,
+			},
+		},
+		Name: []*domain.FHIRHumanName{
+			{
+				Text: gofakeit.Name(),
+			},
+		},
+	}
+}
+
+// practitionerWithSearchedIdentifier returns a practitioner who has the identifier searched for e.g an already registered number
+func practitionerWithSearchedIdentifier(params map[string]interface{}) domain.FHIRPractitioner {
+	practitioner := registeredPractitioner(gofakeit.UUID())
+
+	identifier, _ := params["identifier"].(string)
+	system, value, _ := strings.Cut(identifier, "|")
+	identifierSystem := scalarutils.URI(system)
+
+	practitioner.Identifier = append(practitioner.Identifier, &domain.FHIRIdentifier{
+		Use:    domain.IdentifierUseEnumOfficial,
+		System: &identifierSystem,
+		Value:  value,
+	})
+
+	return practitioner
+}
+
+func TestUseCasesClinicalImpl_RegisterPractitioner(t *testing.T) {
+	awardedOn := scalarutils.Date{Year: 2019, Month: 11, Day: 22}
+
+	type args struct {
+		ctx   context.Context
+		input dto.PractitionerInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: register practitioner",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerInput{
+					Name:        gofakeit.Name(),
+					PhoneNumber: "+254722000111",
+					Email:       "clinician@example.com",
+					UserID:      gofakeit.UUID(),
+					Qualifications: []*dto.QualificationInput{
+						{
+							Name:      "Diploma in Clinical Medicine and Surgery",
+							Issuer:    "Kenya Medical Training College",
+							AwardedOn: &awardedOn,
+						},
+					},
+					Registrations: []*dto.PractitionerRegistrationInput{
+						{
+							Board:  dto.RegistrationBoardCOC,
+							Number: "CO-12345",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing name",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerInput{
+					PhoneNumber: "+254722000111",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid email",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerInput{
+					Name:  gofakeit.Name(),
+					Email: "clinician",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid registration board",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerInput{
+					Name: gofakeit.Name(),
+					Registrations: []*dto.PractitionerRegistrationInput{
+						{
+							Board:  dto.RegistrationBoardEnum("MEDICAL_BOARD"),
+							Number: "12345",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: two registration numbers from one board",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerInput{
+					Name: gofakeit.Name(),
+					Registrations: []*dto.PractitionerRegistrationInput{
+						{
+							Board:  dto.RegistrationBoardNCK,
+							Number: "12345",
+						},
+						{
+							Board:  dto.RegistrationBoardNCK,
+							Number: "54321",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: registration number belongs to another practitioner",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerInput{
+					Name: gofakeit.Name(),
+					Registrations: []*dto.PractitionerRegistrationInput{
+						{
+							Board:  dto.RegistrationBoardKMPDC,
+							Number: "A1234",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search practitioners",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerInput{
+					Name:   gofakeit.Name(),
+					UserID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create practitioner",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerInput{
+					Name: gofakeit.Name(),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: registration number belongs to another practitioner" {
+				fakeFHIR.MockSearchFHIROrganisationPractitionerFn = func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+					return &domain.PagedFHIRPractitioner{
+						Practitioners: []domain.FHIRPractitioner{practitionerWithSearchedIdentifier(params)},
+						TotalCount:    1,
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to search practitioners" {
+				fakeFHIR.MockSearchFHIROrganisationPractitionerFn = func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create practitioner" {
+				fakeFHIR.MockCreateFHIRPractitionerFn = func(ctx context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.RegisterPractitioner(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RegisterPractitioner() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if !got.Active || got.Name != tt.args.input.Name {
+				t.Errorf("expected an active practitioner named %s, got %+v", tt.args.input.Name, got)
+			}
+
+			if got.UserID != tt.args.input.UserID || got.PhoneNumber != tt.args.input.PhoneNumber || got.Email != tt.args.input.Email {
+				t.Errorf("expected the practitioner's user and contacts to be recorded, got %+v", got)
+			}
+
+			if len(got.Registrations) != 1 || got.Registrations[0].Board != dto.RegistrationBoardCOC || got.Registrations[0].Number != "CO-12345" {
+				t.Errorf("expected the COC registration number to be recorded, got %+v", got.Registrations)
+			}
+
+			if len(got.Qualifications) != 1 || got.Qualifications[0].AwardedOn == nil || *got.Qualifications[0].AwardedOn != awardedOn {
+				t.Errorf("expected the qualification to be recorded, got %+v", got.Qualifications)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_RegisterCurrentPractitioner(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.PractitionerProfileInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: register the logged in user",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerProfileInput{
+					Registrations: []*dto.PractitionerRegistrationInput{
+						{
+							Board:  dto.RegistrationBoardNCK,
+							Number: "NCK-98765",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: logged in user is already registered",
+			args: args{
+				ctx:   context.Background(),
+				input: dto.PractitionerProfileInput{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid registration",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerProfileInput{
+					Registrations: []*dto.PractitionerRegistrationInput{
+						{
+							Board: dto.RegistrationBoardNCK,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get logged in user",
+			args: args{
+				ctx:   context.Background(),
+				input: dto.PractitionerProfileInput{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: logged in user has no name",
+			args: args{
+				ctx:   context.Background(),
+				input: dto.PractitionerProfileInput{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create practitioner",
+			args: args{
+				ctx:   context.Background(),
+				input: dto.PractitionerProfileInput{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			user := &profileutils.UserInfo{
+				UID:         gofakeit.UUID(),
+				DisplayName: gofakeit.Name(),
+				Email:       "nurse@example.com",
+				PhoneNumber: "+254733000222",
+			}
+
+			fakeExt.GetLoggedInUserFn = func(ctx context.Context) (*profileutils.UserInfo, error) {
+				return user, nil
+			}
+
+			existing := registeredPractitioner(user.UID)
+
+			if tt.name == "Happy case: logged in user is already registered" {
+				fakeFHIR.MockSearchFHIROrganisationPractitionerFn = func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+					// A practitioner registered from another facility of the organisation is found
+					return &domain.PagedFHIRPractitioner{
+						Practitioners: []domain.FHIRPractitioner{existing},
+						TotalCount:    1,
+					}, nil
+				}
+				fakeFHIR.MockCreateFHIRPractitionerFn = func(ctx context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error) {
+					return nil, fmt.Errorf("an already registered practitioner should not be created again")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get logged in user" {
+				fakeExt.GetLoggedInUserFn = func(ctx context.Context) (*profileutils.UserInfo, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: logged in user has no name" {
+				user.DisplayName = ""
+			}
+
+			if tt.name == "Sad case: failed to create practitioner" {
+				fakeFHIR.MockCreateFHIRPractitionerFn = func(ctx context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.RegisterCurrentPractitioner(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RegisterCurrentPractitioner() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if tt.name == "Happy case: logged in user is already registered" && got.ID != *existing.ID {
+				t.Errorf("expected the existing practitioner %s, got %s", *existing.ID, got.ID)
+			}
+
+			if got.UserID != user.UID {
+				t.Errorf("expected a practitioner for the logged in user, got %+v", got)
+			}
+
+			if tt.name == "Happy case: register the logged in user" && (got.Name != user.DisplayName || got.PhoneNumber != user.PhoneNumber || got.Email != user.Email || len(got.Registrations) != 1) {
+				t.Errorf("expected the user's name, contacts and registration to be recorded, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_GetPractitioner(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get practitioner",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid practitioner id",
+			args: args{
+				ctx: context.Background(),
+				id:  "practitioner",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get practitioner",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to get practitioner" {
+				fakeFHIR.MockGetFHIRPractitionerFn = func(ctx context.Context, id string) (*domain.FHIRPractitionerRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.GetPractitioner(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.GetPractitioner() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.ID != tt.args.id || len(got.Registrations) != 1 || got.Registrations[0].Board != dto.RegistrationBoardCOC || got.UserID == "" {
+				t.Errorf("expected the practitioner's registration and user to be read, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_GetCurrentPractitioner(t *testing.T) {
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: get the logged in practitioner",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: logged in user is not a practitioner",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search practitioners",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			userID := gofakeit.UUID()
+
+			fakeExt.GetLoggedInUserUIDFn = func(ctx context.Context) (string, error) {
+				return userID, nil
+			}
+
+			if tt.name == "Happy case: get the logged in practitioner" {
+				fakeFHIR.MockSearchFHIROrganisationPractitionerFn = func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+					return &domain.PagedFHIRPractitioner{
+						Practitioners: []domain.FHIRPractitioner{registeredPractitioner(userID)},
+						TotalCount:    1,
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to search practitioners" {
+				fakeFHIR.MockSearchFHIROrganisationPractitionerFn = func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.GetCurrentPractitioner(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.GetCurrentPractitioner() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got.UserID != userID {
+				t.Errorf("expected the practitioner of user %s, got %+v", userID, got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_UpdatePractitioner(t *testing.T) {
+	inactive := false
+	phoneNumber := "+254744000333"
+
+	type args struct {
+		ctx   context.Context
+		id    string
+		input dto.PractitionerUpdateInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: update practitioner",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.PractitionerUpdateInput{
+					Active:      &inactive,
+					PhoneNumber: &phoneNumber,
+					Registrations: []*dto.PractitionerRegistrationInput{
+						{
+							Board:  dto.RegistrationBoardKMPDC,
+							Number: "A7654",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid practitioner id",
+			args: args{
+				ctx:   context.Background(),
+				id:    "practitioner",
+				input: dto.PractitionerUpdateInput{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid registration",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.PractitionerUpdateInput{
+					Registrations: []*dto.PractitionerRegistrationInput{
+						{
+							Number: "A7654",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get practitioner",
+			args: args{
+				ctx:   context.Background(),
+				id:    gofakeit.UUID(),
+				input: dto.PractitionerUpdateInput{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: registration number belongs to another practitioner",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+				input: dto.PractitionerUpdateInput{
+					Registrations: []*dto.PractitionerRegistrationInput{
+						{
+							Board:  dto.RegistrationBoardKMPDC,
+							Number: "A7654",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update practitioner",
+			args: args{
+				ctx:   context.Background(),
+				id:    gofakeit.UUID(),
+				input: dto.PractitionerUpdateInput{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to get practitioner" {
+				fakeFHIR.MockGetFHIRPractitionerFn = func(ctx context.Context, id string) (*domain.FHIRPractitionerRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: registration number belongs to another practitioner" {
+				fakeFHIR.MockSearchFHIROrganisationPractitionerFn = func(ctx context.Context, params map[string]interface{}, organisationID string, pagination dto.Pagination) (*domain.PagedFHIRPractitioner, error) {
+					return &domain.PagedFHIRPractitioner{
+						Practitioners: []domain.FHIRPractitioner{practitionerWithSearchedIdentifier(params)},
+						TotalCount:    1,
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to update practitioner" {
+				fakeFHIR.MockUpdateFHIRPractitionerFn = func(ctx context.Context, input domain.FHIRPractitioner) (*domain.FHIRPractitioner, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.UpdatePractitioner(tt.args.ctx, tt.args.id, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.UpdatePractitioner() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Active || got.PhoneNumber != phoneNumber {
+				t.Errorf("expected an inactive practitioner with the new phone number, got %+v", got)
+			}
+
+			if len(got.Registrations) != 1 || got.Registrations[0].Board != dto.RegistrationBoardKMPDC || got.Registrations[0].Number != "A7654" {
+				t.Errorf("expected the registrations to be replaced, got %+v", got.Registrations)
+			}
+
+			if got.UserID == "" {
+				t.Errorf("expected the practitioner's user to be kept")
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_AssignPractitionerRole(t *testing.T) {
+	startDate := scalarutils.Date{Year: 2026, Month: 10, Day: 1}
+
+	type args struct {
+		ctx   context.Context
+		input dto.PractitionerRoleInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: assign practitioner to a facility",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerRoleInput{
+					PractitionerID: gofakeit.UUID(),
+					FacilityID:     gofakeit.UUID(),
+					Role:           "Clinical Officer",
+					StartDate:      &startDate,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: assign practitioner to the current facility",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerRoleInput{
+					PractitionerID: gofakeit.UUID(),
+					Role:           "Nurse",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: facility in another organisation",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerRoleInput{
+					PractitionerID: gofakeit.UUID(),
+					FacilityID:     gofakeit.UUID(),
+					Role:           "Nurse",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: missing role",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerRoleInput{
+					PractitionerID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get practitioner",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerRoleInput{
+					PractitionerID: gofakeit.UUID(),
+					Role:           "Nurse",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: inactive practitioner",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerRoleInput{
+					PractitionerID: gofakeit.UUID(),
+					Role:           "Nurse",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get facility",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerRoleInput{
+					PractitionerID: gofakeit.UUID(),
+					Role:           "Nurse",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create practitioner role",
+			args: args{
+				ctx: context.Background(),
+				input: dto.PractitionerRoleInput{
+					PractitionerID: gofakeit.UUID(),
+					Role:           "Nurse",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			organizationID := gofakeit.UUID()
+			facilityID := gofakeit.UUID()
+
+			fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+				return &dto.TenantIdentifiers{
+					OrganizationID: organizationID,
+					FacilityID:     facilityID,
+				}, nil
+			}
+
+			fakeFHIR.MockGetFHIROrganizationFn = func(ctx context.Context, organisationID string) (*domain.FHIROrganizationRelayPayload, error) {
+				name := "Test Organisation"
+				partOf := organizationID
+
+				if tt.name == "Sad case: facility in another organisation" {
+					partOf = gofakeit.UUID()
+				}
+
+				return &domain.FHIROrganizationRelayPayload{
+					Resource: &domain.FHIROrganization{
+						ID:     &organisationID,
+						Name:   &name,
+						PartOf: &domain.FHIRReference{ID: &partOf},
+					},
+				}, nil
+			}
+
+			var facilityTag string
+
+			createRole := fakeFHIR.MockCreateFHIRPractitionerRoleFn
+			fakeFHIR.MockCreateFHIRPractitionerRoleFn = func(ctx context.Context, input domain.FHIRPractitionerRole) (*domain.FHIRPractitionerRole, error) {
+				for _, tag := range input.Meta.Tag {
+					if tag.System != nil && string(*tag.System) == common.FacilityTagSystem {
+						facilityTag = string(tag.Code)
+					}
+				}
+
+				return createRole(ctx, input)
+			}
+
+			if tt.name == "Sad case: failed to get practitioner" {
+				fakeFHIR.MockGetFHIRPractitionerFn = func(ctx context.Context, id string) (*domain.FHIRPractitionerRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: inactive practitioner" {
+				fakeFHIR.MockGetFHIRPractitionerFn = func(ctx context.Context, id string) (*domain.FHIRPractitionerRelayPayload, error) {
+					practitioner := registeredPractitioner(gofakeit.UUID())
+					active := false
+					practitioner.Active = &active
+
+					return &domain.FHIRPractitionerRelayPayload{
+						Resource: &practitioner,
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get facility" {
+				fakeFHIR.MockGetFHIROrganizationFn = func(ctx context.Context, organisationID string) (*domain.FHIROrganizationRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create practitioner role" {
+				fakeFHIR.MockCreateFHIRPractitionerRoleFn = func(ctx context.Context, input domain.FHIRPractitionerRole) (*domain.FHIRPractitionerRole, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.AssignPractitionerRole(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.AssignPractitionerRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			wantFacilityID := facilityID
+			if tt.args.input.FacilityID != "" {
+				wantFacilityID = tt.args.input.FacilityID
+			}
+
+			if !got.Active || got.FacilityID != wantFacilityID || got.PractitionerID != tt.args.input.PractitionerID || got.Role != tt.args.input.Role {
+				t.Errorf("expected an active %s role at facility %s, got %+v", tt.args.input.Role, wantFacilityID, got)
+			}
+
+			if facilityTag != wantFacilityID {
+				t.Errorf("expected the role to be tagged with facility %s, got %s", wantFacilityID, facilityTag)
+			}
+
+			if got.PractitionerName == "" || got.StartDate == nil {
+				t.Errorf("expected the practitioner's name and start date to be recorded, got %+v", got)
+			}
+
+			if tt.args.input.StartDate != nil && *got.StartDate != startDate {
+				t.Errorf("expected the role to start on %v, got %v", startDate, *got.StartDate)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_EndPractitionerRole(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: end practitioner role",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid practitioner role id",
+			args: args{
+				ctx: context.Background(),
+				id:  "role",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get practitioner role",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: practitioner role has already ended",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update practitioner role",
+			args: args{
+				ctx: context.Background(),
+				id:  gofakeit.UUID(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to get practitioner role" {
+				fakeFHIR.MockGetFHIRPractitionerRoleFn = func(ctx context.Context, id string) (*domain.FHIRPractitionerRoleRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: practitioner role has already ended" {
+				fakeFHIR.MockGetFHIRPractitionerRoleFn = func(ctx context.Context, id string) (*domain.FHIRPractitionerRoleRelayPayload, error) {
+					active := false
+
+					return &domain.FHIRPractitionerRoleRelayPayload{
+						Resource: &domain.FHIRPractitionerRole{
+							ID:     &id,
+							Active: &active,
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to update practitioner role" {
+				fakeFHIR.MockUpdateFHIRPractitionerRoleFn = func(ctx context.Context, input domain.FHIRPractitionerRole) (*domain.FHIRPractitionerRole, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.EndPractitionerRole(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.EndPractitionerRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (got.Active || got.EndDate == nil) {
+				t.Errorf("expected an ended practitioner role, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListFacilityPractitioners(t *testing.T) {
+	first := 10
+	invalidFirst := -1
+
+	type args struct {
+		ctx        context.Context
+		facilityID string
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list facility practitioners",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{
+					First: &first,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid facility id",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: "facility",
+				pagination: dto.Pagination{
+					First: &first,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid pagination",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{
+					First: &invalidFirst,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search practitioner roles",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{
+					First: &first,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			searchRoles := fakeFHIR.MockSearchFHIRPractitionerRoleFn
+			fakeFHIR.MockSearchFHIRPractitionerRoleFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRPractitionerRole, error) {
+				if tenant.FacilityID != tt.args.facilityID {
+					t.Errorf("expected the practitioner roles of facility %s to be searched, got facility %s", tt.args.facilityID, tenant.FacilityID)
+				}
+
+				return searchRoles(ctx, params, tenant, pagination)
+			}
+
+			if tt.name == "Sad case: failed to search practitioner roles" {
+				fakeFHIR.MockSearchFHIRPractitionerRoleFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRPractitionerRole, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.ListFacilityPractitioners(tt.args.ctx, tt.args.facilityID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListFacilityPractitioners() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (len(got.Edges) != 1 || got.Edges[0].Node.Role != "Clinical Officer") {
+				t.Errorf("expected the clinical officer assigned to the facility, got %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// RecordProcedure records a procedure performed on the patient of an encounter e.g cryotherapy in a screen-and-treat visit.
+// The finding that led to the procedure is referenced as its reason and must be for the same patient
+func (c *UseCasesClinicalImpl) RecordProcedure(ctx context.Context, input dto.ProcedureInput) (*dto.Procedure, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	encounter, err := c.infrastructure.FHIR.GetFHIREncounter(ctx, input.EncounterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if encounter.Resource.Status == domain.EncounterStatusEnumFinished {
+		return nil, fmt.Errorf("cannot record a procedure in a finished encounter")
+	}
+
+	patientID := *encounter.Resource.Subject.ID
+
+	procedureConcept, err := c.GetConcept(ctx, dto.TerminologySourceCIEL, input.ProcedureCode)
+	if err != nil {
+		return nil, err
+	}
+
+	reasons, err := c.procedureReasons(ctx, input, patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	performedAt := time.Now().Format(time.RFC3339)
+	if input.PerformedAt != nil {
+		performed, err := time.Parse(time.RFC3339, string(*input.PerformedAt))
+		if err != nil {
+			return nil, fmt.Errorf("invalid procedure time: %w", err)
+		}
+
+		performedAt = performed.Format(time.RFC3339)
+	}
+
+	recordedStatus := dto.ProcedureStatusCompleted
+	if input.Status != nil {
+		recordedStatus = *input.Status
+	}
+
+	status := scalarutils.Code(recordedStatus.Code())
+	patientReference := fmt.Sprintf("Patient/%s", patientID)
+	encounterReference := fmt.Sprintf("Encounter/%s", *encounter.Resource.ID)
+
+	procedure := domain.FHIRProcedure{
+		Status: &status,
+		Code:   conceptCodeableConcept(procedureConcept),
+		Subject: &domain.FHIRReference{
+			ID:        &patientID,
+			Reference: &patientReference,
+			Display:   encounter.Resource.Subject.Display,
+		},
+		Encounter: &domain.FHIRReference{
+			ID:        encounter.Resource.ID,
+			Reference: &encounterReference,
+		},
+		PerformedDateTime: &performedAt,
+		ReasonReference:   reasons,
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+	}
+
+	if recordedStatus == dto.ProcedureStatusNotDone {
+		procedure.StatusReason = &domain.FHIRCodeableConcept{
+			Text: input.StatusReason,
+		}
+	}
+
+	if performer := procedurePerformer(input.Performer, identifiers.FacilityID); performer != nil {
+		procedure.Performer = []*domain.FHIRProcedurePerformer{performer}
+	}
+
+	if input.BodySiteCode != "" {
+		bodySite, err := c.GetConcept(ctx, dto.TerminologySourceCIEL, input.BodySiteCode)
+		if err != nil {
+			return nil, err
+		}
+
+		procedure.BodySite = []*domain.FHIRCodeableConcept{conceptCodeableConcept(bodySite)}
+	}
+
+	if input.Outcome != nil {
+		procedure.Outcome = procedureOutcomeCodeableConcept(*input.Outcome)
+	}
+
+	for _, code := range input.ComplicationCodes {
+		complication, err := c.GetConcept(ctx, dto.TerminologySourceCIEL, code)
+		if err != nil {
+			return nil, err
+		}
+
+		procedure.Complication = append(procedure.Complication, conceptCodeableConcept(complication))
+	}
+
+	if input.Note != "" {
+		procedure.Note = []*domain.FHIRAnnotation{
+			{
+				Text: (*scalarutils.Markdown)(&input.Note),
+			},
+		}
+	}
+
+	resource, err := c.infrastructure.FHIR.CreateFHIRProcedure(ctx, procedure)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRProcedureToDTO(*resource), nil
+}
+
+// ListPatientProcedures lists the procedures performed on a patient, most recent first
+func (c *UseCasesClinicalImpl) ListPatientProcedures(ctx context.Context, patientID string, pagination dto.Pagination) (*dto.ProcedureConnection, error) {
+	_, err := uuid.Parse(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient id: %s", patientID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"patient": fmt.Sprintf("Patient/%s", patientID),
+		"_sort":   "-date",
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRProcedure(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	procedures := []*dto.Procedure{}
+
+	for _, resource := range resources.Procedures {
+		procedures = append(procedures, mapFHIRProcedureToDTO(resource))
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreateProcedureConnection(procedures, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
+
+// procedureReasons fetches the observation and diagnostic report that led to a procedure, ensuring they are for the patient
+// the procedure is performed on
+func (c *UseCasesClinicalImpl) procedureReasons(ctx context.Context, input dto.ProcedureInput, patientID string) ([]*domain.FHIRReference, error) {
+	reasons := []*domain.FHIRReference{}
+
+	if input.ReasonObservationID != "" {
+		observation, err := c.infrastructure.FHIR.GetFHIRObservation(ctx, input.ReasonObservationID)
+		if err != nil {
+			return nil, err
+		}
+
+		subject := observation.Resource.Subject
+		if subject == nil || subject.ID == nil || *subject.ID != patientID {
+			return nil, fmt.Errorf("observation %s is not for patient %s", input.ReasonObservationID, patientID)
+		}
+
+		reference := fmt.Sprintf("Observation/%s", input.ReasonObservationID)
+		reasons = append(reasons, &domain.FHIRReference{
+			ID:        &input.ReasonObservationID,
+			Reference: &reference,
+		})
+	}
+
+	if input.ReasonDiagnosticReportID != "" {
+		report, err := c.infrastructure.FHIR.GetFHIRDiagnosticReport(ctx, input.ReasonDiagnosticReportID)
+		if err != nil {
+			return nil, err
+		}
+
+		subject := report.Resource.Subject
+		if subject == nil || subject.ID == nil || *subject.ID != patientID {
+			return nil, fmt.Errorf("diagnostic report %s is not for patient %s", input.ReasonDiagnosticReportID, patientID)
+		}
+
+		reference := fmt.Sprintf("DiagnosticReport/%s", input.ReasonDiagnosticReportID)
+		reasons = append(reasons, &domain.FHIRReference{
+			ID:        &input.ReasonDiagnosticReportID,
+			Reference: &reference,
+		})
+	}
+
+	return reasons, nil
+}
@@ -0,0 +1,158 @@
+package clinical
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// procedureOutcomeCodes are the SNOMED CT codes of the procedure outcomes
+var procedureOutcomeCodes = map[dto.ProcedureOutcomeEnum]string{
+	dto.ProcedureOutcomeSuccessful:          "385669000",
+	dto.ProcedureOutcomePartiallySuccessful: "385670004",
+	dto.ProcedureOutcomeUnsuccessful:        "385671000",
+}
+
+var procedureOutcomeDisplays = map[dto.ProcedureOutcomeEnum]string{
+	dto.ProcedureOutcomeSuccessful:          "Successful",
+	dto.ProcedureOutcomePartiallySuccessful: "Partially successful",
+	dto.ProcedureOutcomeUnsuccessful:        "Unsuccessful",
+}
+
+func procedureOutcomeCodeableConcept(outcome dto.ProcedureOutcomeEnum) *domain.FHIRCodeableConcept {
+	system := scalarutils.URI(snomedCTSystem)
+	code := scalarutils.Code(procedureOutcomeCodes[outcome])
+
+	return &domain.FHIRCodeableConcept{
+		Coding: []*domain.FHIRCoding{
+			{
+				System:  &system,
+				Code:    &code,
+				Display: procedureOutcomeDisplays[outcome],
+			},
+		},
+		Text: procedureOutcomeDisplays[outcome],
+	}
+}
+
+// procedureOutcomeFromCode converts a SNOMED CT procedure outcome code to its procedure outcome
+func procedureOutcomeFromCode(code string) dto.ProcedureOutcomeEnum {
+	for outcome, outcomeCode := range procedureOutcomeCodes {
+		if outcomeCode == code {
+			return outcome
+		}
+	}
+
+	return ""
+}
+
+// procedureStatus converts a FHIR procedure status code to its procedure status.
+// Statuses that procedures are not recorded in e.g `in-progress` have no procedure status
+func procedureStatus(resource domain.FHIRProcedure) dto.ProcedureStatusEnum {
+	if resource.Status == nil {
+		return ""
+	}
+
+	status := dto.ProcedureStatusEnum(strings.ToUpper(strings.ReplaceAll(string(*resource.Status), "-", "_")))
+	if !status.IsValid() {
+		return ""
+	}
+
+	return status
+}
+
+// procedurePerformer composes who performed a procedure. A named clinician is recorded as performing it on behalf of the facility,
+// otherwise the facility is recorded as the performer
+func procedurePerformer(name string, facilityID string) *domain.FHIRProcedurePerformer {
+	var facility *domain.FHIRReference
+
+	if facilityID != "" {
+		facilityReference := fmt.Sprintf("Organization/%s", facilityID)
+		facility = &domain.FHIRReference{
+			ID:        &facilityID,
+			Reference: &facilityReference,
+		}
+	}
+
+	switch {
+	case name != "":
+		return &domain.FHIRProcedurePerformer{
+			Actor: &domain.FHIRReference{
+				Display: name,
+			},
+			OnBehalfOf: facility,
+		}
+	case facility != nil:
+		return &domain.FHIRProcedurePerformer{
+			Actor: facility,
+		}
+	default:
+		return nil
+	}
+}
+
+func mapFHIRProcedureToDTO(resource domain.FHIRProcedure) *dto.Procedure {
+	output := &dto.Procedure{
+		Status:        procedureStatus(resource),
+		PerformedAt:   (*scalarutils.DateTime)(resource.PerformedDateTime),
+		Complications: []string{},
+		ReasonIDs:     []string{},
+	}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.StatusReason != nil {
+		output.StatusReason = resource.StatusReason.Text
+	}
+
+	if resource.Code != nil {
+		output.Name = resource.Code.Text
+
+		if len(resource.Code.Coding) > 0 && resource.Code.Coding[0].Code != nil {
+			output.Code = string(*resource.Code.Coding[0].Code)
+		}
+	}
+
+	if resource.Subject != nil && resource.Subject.ID != nil {
+		output.PatientID = *resource.Subject.ID
+	}
+
+	if resource.Encounter != nil && resource.Encounter.ID != nil {
+		output.EncounterID = *resource.Encounter.ID
+	}
+
+	if len(resource.Performer) > 0 && resource.Performer[0].Actor != nil {
+		output.Performer = resource.Performer[0].Actor.Display
+	}
+
+	if len(resource.BodySite) > 0 && resource.BodySite[0] != nil {
+		output.BodySite = resource.BodySite[0].Text
+	}
+
+	if resource.Outcome != nil && len(resource.Outcome.Coding) > 0 && resource.Outcome.Coding[0].Code != nil {
+		output.Outcome = procedureOutcomeFromCode(string(*resource.Outcome.Coding[0].Code))
+	}
+
+	for _, complication := range resource.Complication {
+		if complication != nil {
+			output.Complications = append(output.Complications, complication.Text)
+		}
+	}
+
+	for _, reason := range resource.ReasonReference {
+		if reason != nil && reason.ID != nil {
+			output.ReasonIDs = append(output.ReasonIDs, *reason.ID)
+		}
+	}
+
+	if len(resource.Note) > 0 && resource.Note[0].Text != nil {
+		output.Note = string(*resource.Note[0].Text)
+	}
+
+	return output
+}
@@ -0,0 +1,495 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+func TestUseCasesClinicalImpl_RecordProcedure(t *testing.T) {
+	performedAt := scalarutils.DateTime(time.Now().Add(-time.Hour).Format(time.RFC3339))
+	invalidPerformedAt := scalarutils.DateTime("this morning")
+	notDone := dto.ProcedureStatusNotDone
+	successful := dto.ProcedureOutcomeSuccessful
+
+	type args struct {
+		ctx   context.Context
+		input dto.ProcedureInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: record cryotherapy after a positive VIA screening",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID:              gofakeit.UUID(),
+					ProcedureCode:            "162812",
+					PerformedAt:              &performedAt,
+					Performer:                "Jane Wanjiru",
+					BodySiteCode:             "159877",
+					Outcome:                  &successful,
+					ComplicationCodes:        []string{"150802"},
+					ReasonObservationID:      gofakeit.UUID(),
+					ReasonDiagnosticReportID: gofakeit.UUID(),
+					Note:                     "Double freeze technique",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: record a procedure that was not done",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID:   gofakeit.UUID(),
+					ProcedureCode: "162812",
+					Status:        &notDone,
+					StatusReason:  "Lesion too large for cryotherapy",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid input",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID: "encounter",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: procedure not done without a reason",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID:   gofakeit.UUID(),
+					ProcedureCode: "162812",
+					Status:        &notDone,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid procedure time",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID:   gofakeit.UUID(),
+					ProcedureCode: "162812",
+					PerformedAt:   &invalidPerformedAt,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get encounter",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID:   gofakeit.UUID(),
+					ProcedureCode: "162812",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: encounter is finished",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID:   gofakeit.UUID(),
+					ProcedureCode: "162812",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get procedure concept",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID:   gofakeit.UUID(),
+					ProcedureCode: "162812",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get reason observation",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID:         gofakeit.UUID(),
+					ProcedureCode:       "162812",
+					ReasonObservationID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: reason observation is for another patient",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID:         gofakeit.UUID(),
+					ProcedureCode:       "162812",
+					ReasonObservationID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get reason diagnostic report",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID:              gofakeit.UUID(),
+					ProcedureCode:            "162812",
+					ReasonDiagnosticReportID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: reason diagnostic report is for another patient",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID:              gofakeit.UUID(),
+					ProcedureCode:            "162812",
+					ReasonDiagnosticReportID: gofakeit.UUID(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get body site",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID:   gofakeit.UUID(),
+					ProcedureCode: "162812",
+					BodySiteCode:  "159877",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create procedure",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ProcedureInput{
+					EncounterID:   gofakeit.UUID(),
+					ProcedureCode: "162812",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			patientID := gofakeit.UUID()
+
+			fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+				return &domain.FHIREncounterRelayPayload{
+					Resource: &domain.FHIREncounter{
+						ID:     &id,
+						Status: domain.EncounterStatusEnumInProgress,
+						Subject: &domain.FHIRReference{
+							ID: &patientID,
+						},
+					},
+				}, nil
+			}
+
+			fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+				return &domain.Concept{
+					ID:          concept,
+					DisplayName: gofakeit.Word(),
+				}, nil
+			}
+
+			fakeFHIR.MockGetFHIRDiagnosticReportFn = func(ctx context.Context, id string) (*domain.FHIRDiagnosticReportRelayPayload, error) {
+				return &domain.FHIRDiagnosticReportRelayPayload{
+					Resource: &domain.FHIRDiagnosticReport{
+						ID: &id,
+						Subject: &domain.FHIRReference{
+							ID: &patientID,
+						},
+					},
+				}, nil
+			}
+
+			fakeFHIR.MockGetFHIRObservationFn = func(ctx context.Context, id string) (*domain.FHIRObservationRelayPayload, error) {
+				return &domain.FHIRObservationRelayPayload{
+					Resource: &domain.FHIRObservation{
+						ID: &id,
+						Subject: &domain.FHIRReference{
+							ID: &patientID,
+						},
+					},
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to get encounter" {
+				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: encounter is finished" {
+				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
+					return &domain.FHIREncounterRelayPayload{
+						Resource: &domain.FHIREncounter{
+							ID:     &id,
+							Status: domain.EncounterStatusEnumFinished,
+							Subject: &domain.FHIRReference{
+								ID: &patientID,
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get procedure concept" || tt.name == "Sad case: failed to get body site" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					if concept == tt.args.input.ProcedureCode && tt.name == "Sad case: failed to get body site" {
+						return &domain.Concept{
+							ID:          concept,
+							DisplayName: "Cryotherapy of cervix",
+						}, nil
+					}
+
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get reason observation" {
+				fakeFHIR.MockGetFHIRObservationFn = func(ctx context.Context, id string) (*domain.FHIRObservationRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: reason observation is for another patient" {
+				fakeFHIR.MockGetFHIRObservationFn = func(ctx context.Context, id string) (*domain.FHIRObservationRelayPayload, error) {
+					otherPatientID := gofakeit.UUID()
+
+					return &domain.FHIRObservationRelayPayload{
+						Resource: &domain.FHIRObservation{
+							ID: &id,
+							Subject: &domain.FHIRReference{
+								ID: &otherPatientID,
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get reason diagnostic report" {
+				fakeFHIR.MockGetFHIRDiagnosticReportFn = func(ctx context.Context, id string) (*domain.FHIRDiagnosticReportRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: reason diagnostic report is for another patient" {
+				fakeFHIR.MockGetFHIRDiagnosticReportFn = func(ctx context.Context, id string) (*domain.FHIRDiagnosticReportRelayPayload, error) {
+					otherPatientID := gofakeit.UUID()
+
+					return &domain.FHIRDiagnosticReportRelayPayload{
+						Resource: &domain.FHIRDiagnosticReport{
+							ID: &id,
+							Subject: &domain.FHIRReference{
+								ID: &otherPatientID,
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to create procedure" {
+				fakeFHIR.MockCreateFHIRProcedureFn = func(ctx context.Context, input domain.FHIRProcedure) (*domain.FHIRProcedure, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.RecordProcedure(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RecordProcedure() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.PatientID != patientID || got.EncounterID != tt.args.input.EncounterID {
+				t.Errorf("expected the procedure to be for patient %s in encounter %s, got %s and %s", patientID, tt.args.input.EncounterID, got.PatientID, got.EncounterID)
+			}
+
+			if tt.name == "Happy case: record a procedure that was not done" {
+				if got.Status != dto.ProcedureStatusNotDone || got.StatusReason != tt.args.input.StatusReason {
+					t.Errorf("expected the procedure to not be done because %s, got %s because %s", tt.args.input.StatusReason, got.Status, got.StatusReason)
+				}
+
+				return
+			}
+
+			if got.Status != dto.ProcedureStatusCompleted || got.Outcome != dto.ProcedureOutcomeSuccessful {
+				t.Errorf("expected a successful completed procedure, got %s with outcome %s", got.Status, got.Outcome)
+			}
+
+			if got.Performer != tt.args.input.Performer || got.PerformedAt == nil || *got.PerformedAt != performedAt {
+				t.Errorf("expected the procedure to be performed by %s at %s, got %s at %v", tt.args.input.Performer, performedAt, got.Performer, got.PerformedAt)
+			}
+
+			if len(got.Complications) != 1 || got.BodySite == "" {
+				t.Errorf("expected a body site and a complication, got %q and %v", got.BodySite, got.Complications)
+			}
+
+			if len(got.ReasonIDs) != 2 || got.ReasonIDs[0] != tt.args.input.ReasonObservationID || got.ReasonIDs[1] != tt.args.input.ReasonDiagnosticReportID {
+				t.Errorf("expected the procedure to reference the observation and diagnostic report that led to it, got %v", got.ReasonIDs)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListPatientProcedures(t *testing.T) {
+	first := 10
+	invalidFirst := -1
+
+	type args struct {
+		ctx        context.Context
+		patientID  string
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list patient procedures",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid patient id",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  "patient",
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid pagination",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &invalidFirst},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search procedures",
+			args: args{
+				ctx:        context.Background(),
+				patientID:  gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			var searched map[string]interface{}
+
+			fakeFHIR.MockSearchFHIRProcedureFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRProcedure, error) {
+				searched = params
+				id := gofakeit.UUID()
+				status := scalarutils.Code("completed")
+
+				return &domain.PagedFHIRProcedure{
+					Procedures: []domain.FHIRProcedure{
+						{
+							ID:     &id,
+							Status: &status,
+							Code: &domain.FHIRCodeableConcept{
+								Text: "Cryotherapy of cervix",
+							},
+						},
+					},
+					TotalCount: 1,
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to search procedures" {
+				fakeFHIR.MockSearchFHIRProcedureFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRProcedure, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.ListPatientProcedures(tt.args.ctx, tt.args.patientID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListPatientProcedures() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if searched["patient"] != fmt.Sprintf("Patient/%s", tt.args.patientID) {
+				t.Errorf("expected the procedures of patient %s to be searched, got %v", tt.args.patientID, searched)
+			}
+
+			if len(got.Edges) != 1 || got.Edges[0].Node.Status != dto.ProcedureStatusCompleted {
+				t.Errorf("expected one completed procedure, got %v", got.Edges)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package clinical
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -68,7 +69,7 @@ func (c *UseCasesClinicalImpl) CreatePubsubPatient(ctx context.Context, payload
 
 	patientInput.Identifier = append(patientInput.Identifier, clientIdentifier)
 
-	userSystem := scalarutils.URI("mycarehub.user.id")
+	userSystem := scalarutils.URI(common.MyCareHubUserIdentifierSystem)
 
 	userIdentifier := &domain.FHIRIdentifierInput{
 		Use:   domain.IdentifierUseEnumOfficial,
@@ -136,6 +137,14 @@ func (c *UseCasesClinicalImpl) CreatePubsubOrganization(ctx context.Context, dat
 		},
 	}
 
+	if data.Code != 0 {
+		input.Identifier = append(input.Identifier, mapIdentifierToFHIRIdentifierInput(string(dto.MFLCode), strconv.Itoa(data.Code)))
+	}
+
+	if data.County != "" {
+		input.Address = append(input.Address, mapCountyToFHIRAddressInput(data.County))
+	}
+
 	response, err := c.infrastructure.FHIR.CreateFHIROrganization(ctx, input)
 	if err != nil {
 		return err
@@ -192,6 +201,20 @@ func (c *UseCasesClinicalImpl) CreatePubsubAllergyIntolerance(ctx context.Contex
 		Tag: tags,
 	}
 
+	tenant := dto.TenantIdentifiers{
+		OrganizationID: data.OrganizationID,
+		FacilityID:     data.FacilityID,
+	}
+
+	existing, err := c.existingAllergyIntolerance(ctx, data.PatientID, input.Code, tenant)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		return c.updatePubsubAllergyIntolerance(ctx, *existing, *input)
+	}
+
 	_, err = c.infrastructure.FHIR.CreateFHIRAllergyIntolerance(ctx, *input)
 	if err != nil {
 		return err
@@ -200,13 +223,31 @@ func (c *UseCasesClinicalImpl) CreatePubsubAllergyIntolerance(ctx context.Contex
 	return nil
 }
 
-// CreatePubsubTestResult creates a test result as an observation
+// CreatePubsubTestResult creates a test result as an observation.
+// A result sent for a lab order is recorded as based on the order and completes it
 func (c *UseCasesClinicalImpl) CreatePubsubTestResult(ctx context.Context, data dto.PatientTestResultPubSubMessage) error {
 	input, err := c.ComposeTestResultInput(ctx, data)
 	if err != nil {
 		return err
 	}
 
+	var order *domain.FHIRServiceRequest
+
+	if data.OrderID != "" {
+		order, err = c.resultLabOrder(ctx, data.OrderID, data.PatientID, data.OrganizationID)
+		if err != nil {
+			return err
+		}
+
+		orderReference := fmt.Sprintf("ServiceRequest/%s", *order.ID)
+		input.BasedOn = []*domain.FHIRReferenceInput{
+			{
+				ID:        order.ID,
+				Reference: &orderReference,
+			},
+		}
+	}
+
 	tags, err := c.CreateTenantMetaTags(ctx, data.OrganizationID, data.FacilityID)
 	if err != nil {
 		return err
@@ -221,6 +262,10 @@ func (c *UseCasesClinicalImpl) CreatePubsubTestResult(ctx context.Context, data
 		return err
 	}
 
+	if order != nil {
+		return c.completeLabOrder(ctx, *order)
+	}
+
 	return nil
 }
 
@@ -240,6 +285,19 @@ func (c *UseCasesClinicalImpl) CreatePubsubMedicationStatement(ctx context.Conte
 		Tag: tags,
 	}
 
+	tenant := dto.TenantIdentifiers{
+		OrganizationID: data.OrganizationID,
+		FacilityID:     data.FacilityID,
+	}
+
+	// The patient is already taking the medication so interactions are recorded for review rather than blocking the statement
+	findings, err := c.publishedMedicationInteractions(ctx, data.PatientID, *data.Drug.ConceptID, tenant)
+	if err != nil {
+		utils.ReportErrorToSentry(fmt.Errorf("unable to check the interactions of medication %s: %w", *data.Drug.ConceptID, err))
+	}
+
+	input.Extension = composeInteractionExtensions(findings, nil)
+
 	_, err = c.infrastructure.FHIR.CreateFHIRMedicationStatement(ctx, *input)
 	if err != nil {
 		return err
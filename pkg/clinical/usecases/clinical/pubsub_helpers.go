@@ -14,6 +14,15 @@ import (
 
 // GetConcept is a helper function that returns a concept associated the terminology source passed
 func (c *UseCasesClinicalImpl) GetConcept(ctx context.Context, terminologySource dto.TerminologySource, conceptID string) (*domain.Concept, error) {
+	return c.getConcept(ctx, terminologySource, conceptID, false)
+}
+
+// GetConceptWithMappings returns a concept together with its mappings to related concepts e.g a drug's ingredients and drug class
+func (c *UseCasesClinicalImpl) GetConceptWithMappings(ctx context.Context, terminologySource dto.TerminologySource, conceptID string) (*domain.Concept, error) {
+	return c.getConcept(ctx, terminologySource, conceptID, true)
+}
+
+func (c *UseCasesClinicalImpl) getConcept(ctx context.Context, terminologySource dto.TerminologySource, conceptID string, includeMappings bool) (*domain.Concept, error) {
 	var (
 		organisation string
 		source       string
@@ -45,7 +54,7 @@ func (c *UseCasesClinicalImpl) GetConcept(ctx context.Context, terminologySource
 		organisation,
 		source,
 		conceptID,
-		false,
+		includeMappings,
 		false,
 	)
 	if err != nil {
@@ -140,29 +149,11 @@ func (c *UseCasesClinicalImpl) ComposeAllergyIntoleranceInput(ctx context.Contex
 	allergyType := domain.AllergyIntoleranceTypeEnumAllergy
 	allergyCategory := domain.AllergyIntoleranceCategoryEnumMedication
 	allergy := &domain.FHIRAllergyIntoleranceInput{
-		Type:     &allergyType,
-		Category: []*domain.AllergyIntoleranceCategoryEnum{&allergyCategory},
-		ClinicalStatus: domain.FHIRCodeableConceptInput{
-			Coding: []*domain.FHIRCodingInput{
-				{
-					System:  (*scalarutils.URI)(&fhirAllergyIntoleranceClinicalStatusURL),
-					Code:    scalarutils.Code("active"),
-					Display: "Active",
-				},
-			},
-			Text: "Active",
-		},
-		VerificationStatus: domain.FHIRCodeableConceptInput{
-			Coding: []*domain.FHIRCodingInput{
-				{
-					System:  (*scalarutils.URI)(&fhirAllergyIntoleranceVerificationStatusURL),
-					Code:    scalarutils.Code("confirmed"),
-					Display: "Confirmed",
-				},
-			},
-			Text: "Confirmed",
-		},
-		Reaction: []*domain.FHIRAllergyintoleranceReactionInput{},
+		Type:               &allergyType,
+		Category:           []*domain.AllergyIntoleranceCategoryEnum{&allergyCategory},
+		ClinicalStatus:     allergyClinicalStatusCodeableConcept(dto.AllergyClinicalStatusActive),
+		VerificationStatus: allergyVerificationStatusCodeableConcept(dto.AllergyVerificationStatusConfirmed),
+		Reaction:           []*domain.FHIRAllergyintoleranceReactionInput{},
 	}
 
 	year, month, day := input.Date.Date()
@@ -251,6 +242,87 @@ func (c *UseCasesClinicalImpl) ComposeAllergyIntoleranceInput(ctx context.Contex
 	return allergy, nil
 }
 
+// publishedMedicationInteractions checks a CIEL medication published on a topic against the patient's allergies and current
+// medications in the tenant the message was published for
+func (c *UseCasesClinicalImpl) publishedMedicationInteractions(ctx context.Context, patientID string, conceptID string, tenant dto.TenantIdentifiers) ([]*dto.InteractionFinding, error) {
+	concept, err := c.GetConceptWithMappings(ctx, dto.TerminologySourceCIEL, conceptID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.tenantMedicationInteractions(ctx, patientID, conceptKeys(concept, string(dto.TerminologySourceCIEL)), tenant)
+}
+
+// existingAllergyIntolerance finds the allergy already recorded for a patient with the same allergen.
+// Allergies that were entered in error are ignored
+func (c *UseCasesClinicalImpl) existingAllergyIntolerance(ctx context.Context, patientID string, allergen domain.FHIRCodeableConceptInput, tenant dto.TenantIdentifiers) (*domain.FHIRAllergyIntolerance, error) {
+	if len(allergen.Coding) == 0 || allergen.Coding[0] == nil {
+		return nil, nil
+	}
+
+	params := map[string]interface{}{
+		"patient":                 fmt.Sprintf("Patient/%s", patientID),
+		"code":                    allergenSearchCode(*allergen.Coding[0]),
+		"verification-status:not": dto.AllergyVerificationStatusEnteredInError.Code(),
+	}
+
+	allergies, err := c.infrastructure.FHIR.SearchFHIRAllergyIntolerance(ctx, params, tenant, dto.Pagination{Skip: true})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, allergy := range allergies.Allergies {
+		if dto.AllergyVerificationStatusEnum(allergyStatusValue(allergy.VerificationStatus)) == dto.AllergyVerificationStatusEnteredInError {
+			continue
+		}
+
+		existing := allergy
+
+		return &existing, nil
+	}
+
+	return nil, nil
+}
+
+// allergenSearchCode searches for an allergen by its system and code so that the same code in another code system does not match e.g `system|code`
+func allergenSearchCode(coding domain.FHIRCodingInput) string {
+	if coding.System == nil || *coding.System == "" {
+		return string(coding.Code)
+	}
+
+	return fmt.Sprintf("%s|%s", *coding.System, coding.Code)
+}
+
+// updatePubsubAllergyIntolerance updates an allergy that is published again with its latest reactions instead of recording it twice.
+// An allergy that a clinician has refuted, resolved or marked inactive keeps the statuses they set
+func (c *UseCasesClinicalImpl) updatePubsubAllergyIntolerance(ctx context.Context, existing domain.FHIRAllergyIntolerance, published domain.FHIRAllergyIntoleranceInput) error {
+	input, err := allergyIntoleranceInput(existing)
+	if err != nil {
+		return err
+	}
+
+	input.Code = published.Code
+	input.Reaction = published.Reaction
+	input.Meta = published.Meta
+
+	refuted := dto.AllergyVerificationStatusEnum(allergyStatusValue(existing.VerificationStatus)) == dto.AllergyVerificationStatusRefuted
+
+	clinicalStatus := dto.AllergyClinicalStatusEnum(allergyStatusValue(existing.ClinicalStatus))
+	notActive := clinicalStatus == dto.AllergyClinicalStatusInactive || clinicalStatus == dto.AllergyClinicalStatusResolved
+
+	if !refuted && !notActive {
+		input.ClinicalStatus = published.ClinicalStatus
+		input.VerificationStatus = published.VerificationStatus
+	}
+
+	_, err = c.infrastructure.FHIR.UpdateFHIRAllergyIntolerance(ctx, *input)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ComposeTestResultInput composes a test result input from data received
 func (c *UseCasesClinicalImpl) ComposeTestResultInput(ctx context.Context, input dto.PatientTestResultPubSubMessage) (*domain.FHIRObservationInput, error) {
 	var patientName string
@@ -8,6 +8,7 @@ import (
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
 	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
 	"github.com/savannahghi/clinical/pkg/clinical/domain"
@@ -18,6 +19,7 @@ import (
 	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
 	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
 	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
 )
 
 func TestUseCasesClinicalImpl_CreatePubsubPatient(t *testing.T) {
@@ -420,6 +422,10 @@ func TestUseCasesClinicalImpl_CreatePubsubVitals(t *testing.T) {
 
 func TestUseCasesClinicalImpl_CreatePubsubTestResult(t *testing.T) {
 	ctx := context.Background()
+	orderPatientID := uuid.NewString()
+	orderOrganizationID := uuid.NewString()
+	organizationTagSystem := scalarutils.URI(common.OrganizationTagSystem)
+
 	type args struct {
 		ctx  context.Context
 		data dto.PatientTestResultPubSubMessage
@@ -497,6 +503,66 @@ func TestUseCasesClinicalImpl_CreatePubsubTestResult(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Happy Case - Successfully create test result for a lab order",
+			args: args{
+				ctx: ctx,
+				data: dto.PatientTestResultPubSubMessage{
+					PatientID:      orderPatientID,
+					ConceptID:      new(string),
+					Date:           time.Now(),
+					Result:         dto.TestResult{},
+					OrderID:        uuid.NewString(),
+					OrganizationID: orderOrganizationID,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case - lab order is for another patient",
+			args: args{
+				ctx: ctx,
+				data: dto.PatientTestResultPubSubMessage{
+					PatientID:      uuid.NewString(),
+					ConceptID:      new(string),
+					Date:           time.Now(),
+					Result:         dto.TestResult{},
+					OrderID:        uuid.NewString(),
+					OrganizationID: orderOrganizationID,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - lab order belongs to another organisation",
+			args: args{
+				ctx: ctx,
+				data: dto.PatientTestResultPubSubMessage{
+					PatientID:      orderPatientID,
+					ConceptID:      new(string),
+					Date:           time.Now(),
+					Result:         dto.TestResult{},
+					OrderID:        uuid.NewString(),
+					OrganizationID: uuid.NewString(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - fail to complete lab order",
+			args: args{
+				ctx: ctx,
+				data: dto.PatientTestResultPubSubMessage{
+					PatientID:      orderPatientID,
+					ConceptID:      new(string),
+					Date:           time.Now(),
+					Result:         dto.TestResult{},
+					OrderID:        uuid.NewString(),
+					OrganizationID: orderOrganizationID,
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Sad Case - fail to get fhir patient",
 			args: args{
@@ -571,6 +637,49 @@ func TestUseCasesClinicalImpl_CreatePubsubTestResult(t *testing.T) {
 			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
 			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
 
+			var (
+				basedOn   []*domain.FHIRReferenceInput
+				completed bool
+			)
+
+			fakeFHIR.MockGetFHIRServiceRequestFn = func(_ context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error) {
+				order := fakeLabOrder(id, orderPatientID, domain.ServiceRequestStatusActive)
+				organizationTag := scalarutils.Code(orderOrganizationID)
+				order.Meta = &domain.FHIRMeta{
+					Tag: []domain.FHIRCoding{
+						{
+							System: &organizationTagSystem,
+							Code:   &organizationTag,
+						},
+					},
+				}
+
+				return &domain.FHIRServiceRequestRelayPayload{
+					Resource: order,
+				}, nil
+			}
+
+			fakeFHIR.MockUpdateFHIRServiceRequestFn = func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+				completed = input.Status == domain.ServiceRequestStatusCompleted
+
+				return &domain.FHIRServiceRequestRelayPayload{
+					Resource: fakeLabOrder(*input.ID, orderPatientID, input.Status),
+				}, nil
+			}
+
+			createObservation := fakeFHIR.MockCreateFHIRObservationFn
+			fakeFHIR.MockCreateFHIRObservationFn = func(ctx context.Context, input domain.FHIRObservationInput) (*domain.FHIRObservation, error) {
+				basedOn = input.BasedOn
+
+				return createObservation(ctx, input)
+			}
+
+			if tt.name == "Sad Case - fail to complete lab order" {
+				fakeFHIR.MockUpdateFHIRServiceRequestFn = func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+					return nil, fmt.Errorf("failed to update service request")
+				}
+			}
+
 			if tt.name == "Sad Case - fail create pubsub vitals with facilityID" {
 				fakeFHIR.MockGetFHIROrganizationFn = func(ctx context.Context, organisationID string) (*domain.FHIROrganizationRelayPayload, error) {
 					return nil, fmt.Errorf("failed to create observation")
@@ -607,6 +716,17 @@ func TestUseCasesClinicalImpl_CreatePubsubTestResult(t *testing.T) {
 
 			if err := u.CreatePubsubTestResult(tt.args.ctx, tt.args.data); (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesClinicalImpl.CreatePubsubTestResult() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.name == "Happy Case - Successfully create test result for a lab order" {
+				if len(basedOn) != 1 || *basedOn[0].ID != tt.args.data.OrderID {
+					t.Errorf("expected the result to be based on lab order %s, got %v", tt.args.data.OrderID, basedOn)
+				}
+
+				if !completed {
+					t.Errorf("expected lab order %s to be completed", tt.args.data.OrderID)
+				}
 			}
 		})
 	}
@@ -615,6 +735,7 @@ func TestUseCasesClinicalImpl_CreatePubsubTestResult(t *testing.T) {
 func TestUseCasesClinicalImpl_CreatePubsubMedicationStatement(t *testing.T) {
 	ctx := context.Background()
 	conceptID := "12345"
+	organisationID := uuid.NewString()
 	type args struct {
 		ctx  context.Context
 		data dto.MedicationPubSubMessage
@@ -679,6 +800,22 @@ func TestUseCasesClinicalImpl_CreatePubsubMedicationStatement(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Happy Case - check interactions in the tenant of the message",
+			args: args{
+				ctx: context.Background(),
+				data: dto.MedicationPubSubMessage{
+					PatientID:      uuid.NewString(),
+					OrganizationID: organisationID,
+					FacilityID:     uuid.NewString(),
+					ConceptID:      &conceptID,
+					Drug: &dto.MedicationDrug{
+						ConceptID: &conceptID,
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "Sad Case - fail to create medication statement with facilityID",
 			args: args{
@@ -783,6 +920,20 @@ func TestUseCasesClinicalImpl_CreatePubsubMedicationStatement(t *testing.T) {
 			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
 			u := clinicalUsecase.NewUseCasesClinicalImpl(infra)
 
+			checkedTenants := []dto.TenantIdentifiers{}
+
+			if tt.name == "Happy Case - check interactions in the tenant of the message" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("the context has no tenant")
+				}
+
+				fakeFHIR.MockSearchPatientAllergyIntoleranceFn = func(ctx context.Context, patientReference string, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error) {
+					checkedTenants = append(checkedTenants, tenant)
+
+					return &domain.PagedFHIRAllergy{}, nil
+				}
+			}
+
 			if tt.name == "Sad Case - fail to create medication statement with facilityID" {
 				fakeFHIR.MockGetFHIROrganizationFn = func(ctx context.Context, organisationID string) (*domain.FHIROrganizationRelayPayload, error) {
 					return nil, fmt.Errorf("failed to create observation")
@@ -816,6 +967,12 @@ func TestUseCasesClinicalImpl_CreatePubsubMedicationStatement(t *testing.T) {
 			if err := u.CreatePubsubMedicationStatement(tt.args.ctx, tt.args.data); (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesClinicalImpl.CreatePubsubMedicationStatement() error = %v, wantErr %v", err, tt.wantErr)
 			}
+
+			if tt.name == "Happy Case - check interactions in the tenant of the message" {
+				if len(checkedTenants) != 1 || checkedTenants[0].OrganizationID != organisationID || checkedTenants[0].FacilityID != tt.args.data.FacilityID {
+					t.Errorf("expected the interactions to be checked in the tenant of the message, got %v", checkedTenants)
+				}
+			}
 		})
 	}
 }
@@ -925,6 +1082,86 @@ func TestUseCasesClinicalImpl_CreatePubsubAllergyIntolerance(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Happy Case - Update an allergy that is published again",
+			args: args{
+				ctx: ctx,
+				data: dto.PatientAllergyPubSubMessage{
+					PatientID:      uuid.New().String(),
+					OrganizationID: "",
+					Name:           "",
+					ConceptID:      new(string),
+					Date:           time.Time{},
+					Reaction:       dto.AllergyReaction{},
+					Severity:       dto.AllergySeverity{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - Keep a refuted allergy refuted when it is published again",
+			args: args{
+				ctx: ctx,
+				data: dto.PatientAllergyPubSubMessage{
+					PatientID:      uuid.New().String(),
+					OrganizationID: "",
+					Name:           "",
+					ConceptID:      new(string),
+					Date:           time.Time{},
+					Reaction:       dto.AllergyReaction{},
+					Severity:       dto.AllergySeverity{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - Keep a resolved allergy resolved when it is published again",
+			args: args{
+				ctx: ctx,
+				data: dto.PatientAllergyPubSubMessage{
+					PatientID:      uuid.New().String(),
+					OrganizationID: "",
+					Name:           "",
+					ConceptID:      new(string),
+					Date:           time.Time{},
+					Reaction:       dto.AllergyReaction{},
+					Severity:       dto.AllergySeverity{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case - Fail to search recorded allergies",
+			args: args{
+				ctx: ctx,
+				data: dto.PatientAllergyPubSubMessage{
+					PatientID:      uuid.New().String(),
+					OrganizationID: "",
+					Name:           "",
+					ConceptID:      new(string),
+					Date:           time.Time{},
+					Reaction:       dto.AllergyReaction{},
+					Severity:       dto.AllergySeverity{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad Case - Fail to update an allergy that is published again",
+			args: args{
+				ctx: ctx,
+				data: dto.PatientAllergyPubSubMessage{
+					PatientID:      uuid.New().String(),
+					OrganizationID: "",
+					Name:           "",
+					ConceptID:      new(string),
+					Date:           time.Time{},
+					Reaction:       dto.AllergyReaction{},
+					Severity:       dto.AllergySeverity{},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Sad Case - fail to get ciel concept",
 			args: args{
@@ -993,6 +1230,9 @@ func TestUseCasesClinicalImpl_CreatePubsubAllergyIntolerance(t *testing.T) {
 			}
 
 			if tt.name == "Sad Case - Fail to create allergy intolerance" {
+				fakeFHIR.MockSearchFHIRAllergyIntoleranceFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error) {
+					return &domain.PagedFHIRAllergy{}, nil
+				}
 				fakeFHIR.MockCreateFHIRAllergyIntoleranceFn = func(ctx context.Context, input domain.FHIRAllergyIntoleranceInput) (*domain.FHIRAllergyIntoleranceRelayPayload, error) {
 					return nil, fmt.Errorf("failed to create allergy intolerance")
 				}
@@ -1004,6 +1244,83 @@ func TestUseCasesClinicalImpl_CreatePubsubAllergyIntolerance(t *testing.T) {
 				}
 			}
 
+			if tt.name == "Happy Case - Update an allergy that is published again" {
+				getConcept := fakeOCL.MockGetConceptFn
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					result, err := getConcept(ctx, org, source, concept, includeMappings, includeInverseMappings)
+					if err != nil {
+						return nil, err
+					}
+
+					result.URL = "/orgs/CIEL/sources/CIEL/concepts/1234/"
+
+					return result, nil
+				}
+				searchAllergies := fakeFHIR.MockSearchFHIRAllergyIntoleranceFn
+				fakeFHIR.MockSearchFHIRAllergyIntoleranceFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error) {
+					if params["code"] != "/orgs/CIEL/sources/CIEL/concepts/1234/|1234" {
+						return nil, fmt.Errorf("expected the allergen to be searched by its system and code, got %v", params["code"])
+					}
+
+					return searchAllergies(ctx, params, tenant, pagination)
+				}
+				fakeFHIR.MockCreateFHIRAllergyIntoleranceFn = func(ctx context.Context, input domain.FHIRAllergyIntoleranceInput) (*domain.FHIRAllergyIntoleranceRelayPayload, error) {
+					return nil, fmt.Errorf("the allergy should not be recorded twice")
+				}
+			}
+
+			if tt.name == "Happy Case - Keep a refuted allergy refuted when it is published again" {
+				searchAllergies := fakeFHIR.MockSearchFHIRAllergyIntoleranceFn
+				fakeFHIR.MockSearchFHIRAllergyIntoleranceFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error) {
+					allergies, err := searchAllergies(ctx, params, tenant, pagination)
+					refuted := scalarutils.Code("refuted")
+					allergies.Allergies[0].VerificationStatus = domain.FHIRCodeableConcept{
+						Coding: []*domain.FHIRCoding{{Code: &refuted}},
+					}
+
+					return allergies, err
+				}
+				fakeFHIR.MockUpdateFHIRAllergyIntoleranceFn = func(ctx context.Context, input domain.FHIRAllergyIntoleranceInput) (*domain.FHIRAllergyIntoleranceRelayPayload, error) {
+					if len(input.VerificationStatus.Coding) == 0 || input.VerificationStatus.Coding[0].Code != "refuted" {
+						return nil, fmt.Errorf("expected the allergy to remain refuted")
+					}
+
+					return &domain.FHIRAllergyIntoleranceRelayPayload{}, nil
+				}
+			}
+
+			if tt.name == "Happy Case - Keep a resolved allergy resolved when it is published again" {
+				searchAllergies := fakeFHIR.MockSearchFHIRAllergyIntoleranceFn
+				fakeFHIR.MockSearchFHIRAllergyIntoleranceFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error) {
+					allergies, err := searchAllergies(ctx, params, tenant, pagination)
+					resolved := scalarutils.Code("resolved")
+					allergies.Allergies[0].ClinicalStatus = domain.FHIRCodeableConcept{
+						Coding: []*domain.FHIRCoding{{Code: &resolved}},
+					}
+
+					return allergies, err
+				}
+				fakeFHIR.MockUpdateFHIRAllergyIntoleranceFn = func(ctx context.Context, input domain.FHIRAllergyIntoleranceInput) (*domain.FHIRAllergyIntoleranceRelayPayload, error) {
+					if input.ClinicalStatus == nil || len(input.ClinicalStatus.Coding) == 0 || input.ClinicalStatus.Coding[0].Code != "resolved" {
+						return nil, fmt.Errorf("expected the allergy to remain resolved")
+					}
+
+					return &domain.FHIRAllergyIntoleranceRelayPayload{}, nil
+				}
+			}
+
+			if tt.name == "Sad Case - Fail to search recorded allergies" {
+				fakeFHIR.MockSearchFHIRAllergyIntoleranceFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRAllergy, error) {
+					return nil, fmt.Errorf("failed to search allergies")
+				}
+			}
+
+			if tt.name == "Sad Case - Fail to update an allergy that is published again" {
+				fakeFHIR.MockUpdateFHIRAllergyIntoleranceFn = func(ctx context.Context, input domain.FHIRAllergyIntoleranceInput) (*domain.FHIRAllergyIntoleranceRelayPayload, error) {
+					return nil, fmt.Errorf("failed to update allergy")
+				}
+			}
+
 			if err := u.CreatePubsubAllergyIntolerance(tt.args.ctx, tt.args.data); (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesClinicalImpl.CreatePubsubAllergyIntolerance() error = %v, wantErr %v", err, tt.wantErr)
 			}
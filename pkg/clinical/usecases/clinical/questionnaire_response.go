@@ -8,6 +8,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/savannahghi/clinical/pkg/clinical/application/common"
 	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/application/utils"
 	"github.com/savannahghi/clinical/pkg/clinical/domain"
 	"github.com/savannahghi/scalarutils"
 )
@@ -30,6 +31,11 @@ func (u *UseCasesClinicalImpl) CreateQuestionnaireResponse(ctx context.Context,
 		return "", fmt.Errorf("cannot create a questionnaire response in a finished encounter")
 	}
 
+	err = u.ensureConsentPermits(ctx, *encounter.Resource.Subject.ID, dto.ConsentCategoryScreening)
+	if err != nil {
+		return "", err
+	}
+
 	// TODO: Ensure user cannot submit the same risk assessment twice in the same encounter
 
 	patientID := encounter.Resource.Subject.ID
@@ -72,10 +78,15 @@ func (u *UseCasesClinicalImpl) CreateQuestionnaireResponse(ctx context.Context,
 		return "", err
 	}
 
+	questionnaire, err := u.infrastructure.FHIR.GetFHIRQuestionnaire(ctx, questionnaireID)
+	if err != nil {
+		return "", err
+	}
+
 	// TODO: This will affect the API performance. Optimize it
 	riskLevel, err := u.generateQuestionnaireReviewSummary(
 		ctx,
-		questionnaireID,
+		questionnaire.Resource,
 		*resp.ID,
 		encounter,
 		output,
@@ -84,6 +95,12 @@ func (u *UseCasesClinicalImpl) CreateQuestionnaireResponse(ctx context.Context,
 		return "", err
 	}
 
+	// The response has been recorded so failing to extract the family history from it should not fail the request
+	err = u.extractFamilyMemberHistories(ctx, questionnaire.Resource, encounter, *resp.ID, output)
+	if err != nil {
+		utils.ReportErrorToSentry(fmt.Errorf("unable to extract family member histories from questionnaire response %s: %w", *resp.ID, err))
+	}
+
 	return riskLevel, nil
 }
 
@@ -96,24 +113,19 @@ func (u *UseCasesClinicalImpl) CreateQuestionnaireResponse(ctx context.Context,
 // whether the individual is high risk, low risk, or average risk.
 func (u *UseCasesClinicalImpl) generateQuestionnaireReviewSummary(
 	ctx context.Context,
-	questionnaireID,
+	questionnaire *domain.FHIRQuestionnaire,
 	questionnaireResponseID string,
 	encounter *domain.FHIREncounterRelayPayload,
 	questionnaireResponse *dto.QuestionnaireResponse,
 ) (string, error) {
 	riskLevel := ""
 
-	questionnaire, err := u.infrastructure.FHIR.GetFHIRQuestionnaire(ctx, questionnaireID)
-	if err != nil {
-		return "", err
-	}
-
 	patient, err := u.infrastructure.FHIR.GetFHIRPatient(ctx, *encounter.Resource.Subject.ID)
 	if err != nil {
 		return "", err
 	}
 
-	switch *questionnaire.Resource.Title {
+	switch *questionnaire.Title {
 	// TODO: Make this a controlled enum?
 	case "Cervical Cancer Screening":
 		var symptomsScore, riskFactorsScore, totalScore int
@@ -16,6 +16,7 @@ import (
 	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
 	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
 	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
 )
 
 func setupMockFHIRFunctions(fakeFHIR *fakeFHIRMock.FHIRMock, score int) {
@@ -70,6 +71,173 @@ func setupMockFHIRFunctions(fakeFHIR *fakeFHIRMock.FHIRMock, score int) {
 	}
 }
 
+// breastCancerScreeningQuestionnaire returns a breast cancer screening questionnaire whose family history items declare the family member
+// history element they capture
+func breastCancerScreeningQuestionnaire(id string) *domain.FHIRQuestionnaire {
+	title := "Breast Cancer Screening"
+	riskAssessmentLinkID := "risk-assessment"
+	familyHistoryLinkID := "family_history"
+	memberLinkID := "family-member"
+	relationshipLinkID := "relationship"
+	conditionLinkID := "condition"
+	onsetAgeLinkID := "onset-age"
+	relationshipDefinition := scalarutils.URI("http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory#FamilyMemberHistory.relationship")
+	conditionDefinition := scalarutils.URI("http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory#FamilyMemberHistory.condition.code")
+	onsetAgeDefinition := scalarutils.URI("http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory#FamilyMemberHistory.condition.onsetAge")
+
+	return &domain.FHIRQuestionnaire{
+		ID:    &id,
+		Name:  &title,
+		Title: &title,
+		Item: []*domain.FHIRQuestionnaireItem{
+			{
+				LinkID: &riskAssessmentLinkID,
+				Item: []*domain.FHIRQuestionnaireItem{
+					{
+						LinkID: &familyHistoryLinkID,
+						Item: []*domain.FHIRQuestionnaireItem{
+							{
+								LinkID: &memberLinkID,
+								Item: []*domain.FHIRQuestionnaireItem{
+									{
+										LinkID:     &relationshipLinkID,
+										Definition: &relationshipDefinition,
+									},
+									{
+										LinkID:     &conditionLinkID,
+										Definition: &conditionDefinition,
+										Item: []*domain.FHIRQuestionnaireItem{
+											{
+												LinkID:     &onsetAgeLinkID,
+												Definition: &onsetAgeDefinition,
+											},
+										},
+									},
+									{
+										LinkID:     &onsetAgeLinkID,
+										Definition: &onsetAgeDefinition,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// breastCancerScreeningWithFamilyHistory returns the answers of a breast cancer screening where the patient's mother had breast
+// cancer at 45 and an aunt had ovarian cancer at 50, nested within the risk assessment like in the screening questionnaire
+func breastCancerScreeningWithFamilyHistory(id string) *domain.FHIRQuestionnaireResponse {
+	score := 1
+	motherOnsetAge := 45
+	auntOnsetAge := 50
+	roleCodeSystem := scalarutils.URI("http://terminology.hl7.org/CodeSystem/v3-RoleCode")
+	motherCode := scalarutils.Code("MTH")
+	cielSystem := scalarutils.URI("/orgs/CIEL/sources/CIEL/concepts/116026/")
+	breastCancerCode := scalarutils.Code("116026")
+	aunt := "aunt"
+	ovarianCancer := "Ovarian cancer"
+
+	return &domain.FHIRQuestionnaireResponse{
+		ID: &id,
+		Item: []domain.FHIRQuestionnaireResponseItem{
+			{
+				LinkID: "risk-assessment",
+				Item: []domain.FHIRQuestionnaireResponseItem{
+					{
+						LinkID: "high-risk",
+						Item: []domain.FHIRQuestionnaireResponseItem{
+							{
+								LinkID: "high-risk-score",
+								Answer: []domain.FHIRQuestionnaireResponseItemAnswer{
+									{
+										ValueInteger: &score,
+									},
+								},
+							},
+						},
+					},
+					{
+						LinkID: "family_history",
+						Item: []domain.FHIRQuestionnaireResponseItem{
+							{
+								LinkID: "family-member",
+								Item: []domain.FHIRQuestionnaireResponseItem{
+									{
+										LinkID: "relationship",
+										Answer: []domain.FHIRQuestionnaireResponseItemAnswer{
+											{
+												ValueCoding: &domain.FHIRCoding{
+													System:  &roleCodeSystem,
+													Code:    &motherCode,
+													Display: "Mother",
+												},
+											},
+										},
+									},
+									{
+										LinkID: "condition",
+										Answer: []domain.FHIRQuestionnaireResponseItemAnswer{
+											{
+												ValueCoding: &domain.FHIRCoding{
+													System:  &cielSystem,
+													Code:    &breastCancerCode,
+													Display: "Breast cancer",
+												},
+												Item: []domain.FHIRQuestionnaireResponseItem{
+													{
+														LinkID: "onset-age",
+														Answer: []domain.FHIRQuestionnaireResponseItemAnswer{
+															{
+																ValueInteger: &motherOnsetAge,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								LinkID: "family-member",
+								Item: []domain.FHIRQuestionnaireResponseItem{
+									{
+										LinkID: "relationship",
+										Answer: []domain.FHIRQuestionnaireResponseItemAnswer{
+											{
+												ValueString: &aunt,
+											},
+										},
+									},
+									{
+										LinkID: "condition",
+										Answer: []domain.FHIRQuestionnaireResponseItemAnswer{
+											{
+												ValueString: &ovarianCancer,
+											},
+										},
+									},
+									{
+										LinkID: "onset-age",
+										Answer: []domain.FHIRQuestionnaireResponseItemAnswer{
+											{
+												ValueInteger: &auntOnsetAge,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func TestUseCasesClinicalImpl_CreateQuestionnaireResponse(t *testing.T) {
 	ID := gofakeit.UUID()
 	type args struct {
@@ -122,6 +290,16 @@ func TestUseCasesClinicalImpl_CreateQuestionnaireResponse(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Sad Case - patient denied screening consent",
+			args: args{
+				ctx:             addTenantIdentifierContext(context.Background()),
+				input:           dto.QuestionnaireResponse{},
+				questionnaireID: ID,
+				encounterID:     ID,
+			},
+			wantErr: true,
+		},
 		{
 			name: "Sad Case - Fail to get fhir questionnaire",
 			args: args{
@@ -203,6 +381,42 @@ func TestUseCasesClinicalImpl_CreateQuestionnaireResponse(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Happy Case - Extract family history from a breast cancer screening",
+			args: args{
+				ctx:             context.Background(),
+				encounterID:     gofakeit.UUID(),
+				questionnaireID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - Update family history extracted from a questionnaire response again",
+			args: args{
+				ctx:             context.Background(),
+				encounterID:     gofakeit.UUID(),
+				questionnaireID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - Questionnaire without family history definitions",
+			args: args{
+				ctx:             context.Background(),
+				encounterID:     gofakeit.UUID(),
+				questionnaireID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy Case - Fail to record family history from a breast cancer screening",
+			args: args{
+				ctx:             context.Background(),
+				encounterID:     gofakeit.UUID(),
+				questionnaireID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
 		{
 			name: "Sad Case - fail to get patient",
 			args: args{
@@ -251,6 +465,12 @@ func TestUseCasesClinicalImpl_CreateQuestionnaireResponse(t *testing.T) {
 				}
 			}
 
+			if tt.name == "Sad Case - patient denied screening consent" {
+				fakeFHIR.MockSearchFHIRConsentFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRConsent, error) {
+					return fakeDenyConsents(), nil
+				}
+			}
+
 			if tt.name == "Sad Case - Attempt to record questionnaire response in a finished encounter" {
 				fakeFHIR.MockGetFHIREncounterFn = func(ctx context.Context, id string) (*domain.FHIREncounterRelayPayload, error) {
 					return &domain.FHIREncounterRelayPayload{
@@ -483,11 +703,120 @@ func TestUseCasesClinicalImpl_CreateQuestionnaireResponse(t *testing.T) {
 				}
 			}
 
+			histories := []domain.FHIRFamilyMemberHistory{}
+
+			if tt.name == "Happy Case - Extract family history from a breast cancer screening" ||
+				tt.name == "Happy Case - Update family history extracted from a questionnaire response again" ||
+				tt.name == "Happy Case - Questionnaire without family history definitions" ||
+				tt.name == "Happy Case - Fail to record family history from a breast cancer screening" {
+				fakeFHIR.MockGetFHIRQuestionnaireFn = func(ctx context.Context, id string) (*domain.FHIRQuestionnaireRelayPayload, error) {
+					return &domain.FHIRQuestionnaireRelayPayload{
+						Resource: breastCancerScreeningQuestionnaire(ID),
+					}, nil
+				}
+
+				fakeFHIR.MockCreateFHIRQuestionnaireResponseFn = func(ctx context.Context, input *domain.FHIRQuestionnaireResponse) (*domain.FHIRQuestionnaireResponse, error) {
+					return breastCancerScreeningWithFamilyHistory(ID), nil
+				}
+
+				fakeFHIR.MockSearchFHIRFamilyMemberHistoryFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRFamilyMemberHistory, error) {
+					return &domain.PagedFHIRFamilyMemberHistory{
+						FamilyMemberHistories: []domain.FHIRFamilyMemberHistory{},
+					}, nil
+				}
+
+				fakeFHIR.MockCreateFHIRFamilyMemberHistoryFn = func(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error) {
+					histories = append(histories, input)
+
+					return &input, nil
+				}
+			}
+
+			updated := []domain.FHIRFamilyMemberHistory{}
+
+			if tt.name == "Happy Case - Update family history extracted from a questionnaire response again" {
+				fakeFHIR.MockSearchFHIRFamilyMemberHistoryFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRFamilyMemberHistory, error) {
+					existingID := params["identifier"].(string)
+
+					return &domain.PagedFHIRFamilyMemberHistory{
+						FamilyMemberHistories: []domain.FHIRFamilyMemberHistory{
+							{
+								ID: &existingID,
+							},
+						},
+						TotalCount: 1,
+					}, nil
+				}
+
+				fakeFHIR.MockUpdateFHIRFamilyMemberHistoryFn = func(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error) {
+					updated = append(updated, input)
+
+					return &input, nil
+				}
+			}
+
+			if tt.name == "Happy Case - Questionnaire without family history definitions" {
+				fakeFHIR.MockGetFHIRQuestionnaireFn = func(ctx context.Context, id string) (*domain.FHIRQuestionnaireRelayPayload, error) {
+					questionnaireName := "Breast Cancer Screening"
+					return &domain.FHIRQuestionnaireRelayPayload{
+						Resource: &domain.FHIRQuestionnaire{
+							ID:    &ID,
+							Name:  &questionnaireName,
+							Title: &questionnaireName,
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Happy Case - Fail to record family history from a breast cancer screening" {
+				fakeFHIR.MockCreateFHIRFamilyMemberHistoryFn = func(ctx context.Context, input domain.FHIRFamilyMemberHistory) (*domain.FHIRFamilyMemberHistory, error) {
+					return nil, fmt.Errorf("failed to create family member history")
+				}
+			}
+
 			_, err := q.CreateQuestionnaireResponse(tt.args.ctx, tt.args.questionnaireID, tt.args.encounterID, tt.args.input)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesClinicalImpl.CreateQuestionnaireResponse() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+
+			if tt.name == "Happy Case - Extract family history from a breast cancer screening" {
+				if len(histories) != 2 {
+					t.Fatalf("expected the mother and aunt to be recorded, got %d family member histories", len(histories))
+				}
+
+				mother := histories[0]
+				if *mother.Relationship.Coding[0].Code != "MTH" || mother.Condition[0].Code.Text != "Breast cancer" || mother.Condition[0].OnsetAge.Value != 45 {
+					t.Errorf("expected a mother who had breast cancer at 45, got %v", mother)
+				}
+
+				aunt := histories[1]
+				if *aunt.Relationship.Coding[0].Code != "AUNT" || aunt.Condition[0].Code.Text != "Ovarian cancer" || aunt.Condition[0].OnsetAge.Value != 50 {
+					t.Errorf("expected an aunt who had ovarian cancer at 50, got %v", aunt)
+				}
+
+				if *mother.ReasonReference[0].ID != ID || *mother.Status != "partial" {
+					t.Errorf("expected a partial history extracted from questionnaire response %s, got %v", ID, mother)
+				}
+
+				if mother.Identifier[0].Value != ID+"/family-member" || aunt.Identifier[0].Value != ID+"/family-member/2" {
+					t.Errorf("expected the histories to be identified by the questionnaire response items, got %v and %v", mother.Identifier, aunt.Identifier)
+				}
+			}
+
+			if tt.name == "Happy Case - Update family history extracted from a questionnaire response again" {
+				if len(histories) != 0 || len(updated) != 2 {
+					t.Fatalf("expected the mother and aunt to be updated, got %d created and %d updated", len(histories), len(updated))
+				}
+
+				if *updated[0].ID != "mycarehub.questionnaire-response.item|"+ID+"/family-member" {
+					t.Errorf("expected the existing history of the mother to be updated, got %v", *updated[0].ID)
+				}
+			}
+
+			if tt.name == "Happy Case - Questionnaire without family history definitions" && len(histories) != 0 {
+				t.Errorf("expected no family member histories to be recorded, got %d", len(histories))
+			}
 		})
 	}
 }
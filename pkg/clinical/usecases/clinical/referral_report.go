@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"github.com/SebastiaanKlippert/go-wkhtmltopdf"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
 	"github.com/savannahghi/clinical/pkg/clinical/application/utils"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
 )
 
 type Patient struct {
@@ -137,6 +139,16 @@ func (c *UseCasesClinicalImpl) GenerateReferralReportPDF(ctx context.Context, se
 		}
 	}
 
+	referredBy, err := c.referralRequester(ctx, serviceRequest.Resource.Requester)
+	if err != nil {
+		utils.ReportErrorToSentry(err)
+		return nil, err
+	}
+
+	if referredBy.Name == "" {
+		referredBy.Name = referredSpecialistName
+	}
+
 	data := TemplateData{
 		Date:      time.Now().Format("Monday Jan 2"),
 		Time:      time.Now().Format("15:04"),
@@ -150,13 +162,8 @@ func (c *UseCasesClinicalImpl) GenerateReferralReportPDF(ctx context.Context, se
 			Reason: "Further Testing",
 		},
 		MedicalHistory: MedicalHistory{Procedure: "Screening", Medication: "None", ReferralNotes: "Patient complains of severe abdominal pain and intermittent bleeding.", Tests: []Test{{Name: "VIA", Results: "Positive", Date: "13th May 2024"}}},
-		ReferredBy: ReferredBy{
-			Name:        referredSpecialistName,
-			Designation: "Doctor",
-			Phone:       "+254711990990",
-			Signature:   "",
-		},
-		Footer: Footer{},
+		ReferredBy:     referredBy,
+		Footer:         Footer{},
 	}
 
 	tmpl, err := template.ParseFiles("templates/referral_report_template.html")
@@ -198,3 +205,47 @@ func (c *UseCasesClinicalImpl) GenerateReferralReportPDF(ctx context.Context, se
 
 	return pdfBytes, nil
 }
+
+// referralRequester reads the name, designation and phone number of the practitioner who made a referral.
+// The designation is the role the practitioner is currently assigned at a facility
+func (c *UseCasesClinicalImpl) referralRequester(ctx context.Context, requester *domain.FHIRReference) (ReferredBy, error) {
+	if !isPractitionerReference(requester) {
+		return ReferredBy{}, nil
+	}
+
+	practitioner, err := c.infrastructure.FHIR.GetFHIRPractitioner(ctx, *requester.ID)
+	if err != nil {
+		return ReferredBy{}, err
+	}
+
+	referredBy := ReferredBy{
+		Name:  practitioner.Resource.Names(),
+		Phone: practitionerContact(*practitioner.Resource, domain.ContactPointSystemEnumPhone),
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return ReferredBy{}, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"practitioner": fmt.Sprintf("Practitioner/%s", *requester.ID),
+		"active":       "true",
+	}
+
+	// The practitioner's roles are in the facilities they are assigned to, which need not be the current one
+	roles, err := c.infrastructure.FHIR.SearchFHIROrganisationPractitionerRole(ctx, params, identifiers.OrganizationID, dto.Pagination{Skip: true})
+	if err != nil {
+		return ReferredBy{}, err
+	}
+
+	for _, role := range roles.PractitionerRoles {
+		if len(role.Code) > 0 && role.Code[0] != nil && role.Code[0].Text != "" {
+			referredBy.Designation = role.Code[0].Text
+
+			break
+		}
+	}
+
+	return referredBy, nil
+}
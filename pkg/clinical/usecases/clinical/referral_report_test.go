@@ -52,6 +52,14 @@ func TestUseCasesClinicalImpl_GenerateReferralReportPDF(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Sad Case - Fail to get referring practitioner",
+			args: args{
+				ctx:              ctx,
+				serviceRequestID: uuid.New().String(),
+			},
+			wantErr: true,
+		},
 		{
 			name: "Sad Case - Fail to get patient",
 			args: args{
@@ -86,6 +94,24 @@ func TestUseCasesClinicalImpl_GenerateReferralReportPDF(t *testing.T) {
 				}
 			}
 
+			if tt.name == "Sad Case - Fail to get referring practitioner" {
+				practitionerID := uuid.New().String()
+				practitionerReference := "Practitioner/" + practitionerID
+
+				serviceRequest, _ := fakeFHIR.MockGetFHIRServiceRequestFn(tt.args.ctx, tt.args.serviceRequestID)
+				serviceRequest.Resource.Requester = &domain.FHIRReference{
+					ID:        &practitionerID,
+					Reference: &practitionerReference,
+				}
+
+				fakeFHIR.MockGetFHIRServiceRequestFn = func(_ context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error) {
+					return serviceRequest, nil
+				}
+				fakeFHIR.MockGetFHIRPractitionerFn = func(ctx context.Context, id string) (*domain.FHIRPractitionerRelayPayload, error) {
+					return nil, fmt.Errorf("failed to get practitioner")
+				}
+			}
+
 			if _, err := c.GenerateReferralReportPDF(tt.args.ctx, tt.args.serviceRequestID); (err != nil) != tt.wantErr {
 				t.Errorf("UseCasesClinicalImpl.GenerateReferralReportPDF() error = %v, wantErr %v", err, tt.wantErr)
 			}
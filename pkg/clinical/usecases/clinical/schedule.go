@@ -0,0 +1,243 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// CreateSchedule creates the schedule of a facility, or of a practitioner at a facility, in which patients are booked for appointments.
+// The schedule is created for the current facility unless a facility is given
+func (c *UseCasesClinicalImpl) CreateSchedule(ctx context.Context, input dto.ScheduleInput) (*dto.Schedule, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	facilityID := identifiers.FacilityID
+	if input.FacilityID != "" {
+		facilityID = input.FacilityID
+	}
+
+	facility, err := c.infrastructure.FHIR.GetFHIROrganization(ctx, facilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	facilityReference := organizationReference(facilityID)
+	if facility.Resource.Name != nil {
+		facilityReference.Display = *facility.Resource.Name
+	}
+
+	active := true
+	schedule := domain.FHIRSchedule{
+		Active: &active,
+		Actor:  []*domain.FHIRReference{facilityReference},
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+	}
+
+	if input.PractitionerName != "" {
+		schedule.Actor = append(schedule.Actor, &domain.FHIRReference{
+			Display: input.PractitionerName,
+		})
+	}
+
+	if input.ServiceType != "" {
+		schedule.ServiceType = []*domain.FHIRCodeableConcept{
+			{
+				Text: input.ServiceType,
+			},
+		}
+	}
+
+	if input.Comment != "" {
+		schedule.Comment = &input.Comment
+	}
+
+	if input.StartDate != nil || input.EndDate != nil {
+		schedule.PlanningHorizon = &domain.FHIRPeriod{}
+
+		if input.StartDate != nil {
+			schedule.PlanningHorizon.Start = scalarutils.DateTime(input.StartDate.AsTime().Format(time.DateOnly))
+		}
+
+		if input.EndDate != nil {
+			schedule.PlanningHorizon.End = scalarutils.DateTime(input.EndDate.AsTime().Format(time.DateOnly))
+		}
+	}
+
+	resource, err := c.infrastructure.FHIR.CreateFHIRSchedule(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRScheduleToDTO(*resource), nil
+}
+
+// ListFacilitySchedules lists the active schedules of a facility and of the practitioners at the facility
+func (c *UseCasesClinicalImpl) ListFacilitySchedules(ctx context.Context, facilityID string, pagination dto.Pagination) (*dto.ScheduleConnection, error) {
+	_, err := uuid.Parse(facilityID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid facility id: %s", facilityID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"actor":  fmt.Sprintf("Organization/%s", facilityID),
+		"active": "true",
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRSchedule(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := []*dto.Schedule{}
+
+	for _, resource := range resources.Schedules {
+		schedules = append(schedules, mapFHIRScheduleToDTO(resource))
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreateScheduleConnection(schedules, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
+
+// CreateSlots divides a period of a schedule into free slots of the given duration e.g a morning into 30 minute slots
+func (c *UseCasesClinicalImpl) CreateSlots(ctx context.Context, input dto.SlotsInput) ([]*dto.Slot, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := c.infrastructure.FHIR.GetFHIRSchedule(ctx, input.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.Resource.Active != nil && !*schedule.Resource.Active {
+		return nil, fmt.Errorf("cannot create slots in an inactive schedule")
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, err := input.Period()
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleReference := fmt.Sprintf("Schedule/%s", input.ScheduleID)
+	slots := []*dto.Slot{}
+
+	for _, period := range scheduleSlotPeriods(start, end, time.Duration(input.DurationMinutes)*time.Minute) {
+		status := scalarutils.Code(dto.SlotStatusFree.Code())
+		slotStart := period.start.Format(time.RFC3339)
+		slotEnd := period.end.Format(time.RFC3339)
+
+		slot := domain.FHIRSlot{
+			ServiceType: schedule.Resource.ServiceType,
+			Schedule: &domain.FHIRReference{
+				ID:        &input.ScheduleID,
+				Reference: &scheduleReference,
+			},
+			Status: &status,
+			Start:  &slotStart,
+			End:    &slotEnd,
+			Meta: &domain.FHIRMetaInput{
+				Tag: tags,
+			},
+		}
+
+		resource, err := c.infrastructure.FHIR.CreateFHIRSlot(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+
+		slots = append(slots, mapFHIRSlotToDTO(*resource))
+	}
+
+	return slots, nil
+}
+
+// ListAvailableSlots lists the free slots of a schedule that have not started, earliest first
+func (c *UseCasesClinicalImpl) ListAvailableSlots(ctx context.Context, scheduleID string, pagination dto.Pagination) (*dto.SlotConnection, error) {
+	_, err := uuid.Parse(scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule id: %s", scheduleID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"schedule": fmt.Sprintf("Schedule/%s", scheduleID),
+		"status":   dto.SlotStatusFree.Code(),
+		"start":    fmt.Sprintf("gt%s", time.Now().Format(time.RFC3339)),
+		"_sort":    "start",
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRSlot(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := []*dto.Slot{}
+
+	for _, resource := range resources.Slots {
+		slots = append(slots, mapFHIRSlotToDTO(resource))
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreateSlotConnection(slots, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
@@ -0,0 +1,504 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+func TestUseCasesClinicalImpl_CreateSchedule(t *testing.T) {
+	startDate := scalarutils.Date{Year: 2026, Month: 11, Day: 1}
+	endDate := scalarutils.Date{Year: 2027, Month: 1, Day: 31}
+
+	type args struct {
+		ctx   context.Context
+		input dto.ScheduleInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create practitioner schedule",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ScheduleInput{
+					FacilityID:       gofakeit.UUID(),
+					PractitionerName: gofakeit.Name(),
+					ServiceType:      "HIV clinic",
+					Comment:          "Weekday mornings",
+					StartDate:        &startDate,
+					EndDate:          &endDate,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: create schedule of the current facility",
+			args: args{
+				ctx:   context.Background(),
+				input: dto.ScheduleInput{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid facility id",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ScheduleInput{
+					FacilityID: "facility",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: schedule ends before it starts",
+			args: args{
+				ctx: context.Background(),
+				input: dto.ScheduleInput{
+					StartDate: &endDate,
+					EndDate:   &startDate,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get facility",
+			args: args{
+				ctx:   context.Background(),
+				input: dto.ScheduleInput{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create schedule",
+			args: args{
+				ctx:   context.Background(),
+				input: dto.ScheduleInput{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			facilityID := gofakeit.UUID()
+
+			fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+				return &dto.TenantIdentifiers{
+					OrganizationID: gofakeit.UUID(),
+					FacilityID:     facilityID,
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to get facility" {
+				fakeFHIR.MockGetFHIROrganizationFn = func(ctx context.Context, organisationID string) (*domain.FHIROrganizationRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create schedule" {
+				fakeFHIR.MockCreateFHIRScheduleFn = func(ctx context.Context, input domain.FHIRSchedule) (*domain.FHIRSchedule, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.CreateSchedule(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.CreateSchedule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			wantFacilityID := facilityID
+			if tt.args.input.FacilityID != "" {
+				wantFacilityID = tt.args.input.FacilityID
+			}
+
+			if !got.Active || got.FacilityID != wantFacilityID || got.PractitionerName != tt.args.input.PractitionerName {
+				t.Errorf("expected an active schedule at facility %s for %q, got %v", wantFacilityID, tt.args.input.PractitionerName, got)
+			}
+
+			if tt.args.input.StartDate != nil && (got.StartDate == nil || *got.EndDate != endDate) {
+				t.Errorf("expected the schedule's planning horizon, got %v to %v", got.StartDate, got.EndDate)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListFacilitySchedules(t *testing.T) {
+	first := 10
+	invalidFirst := -1
+
+	type args struct {
+		ctx        context.Context
+		facilityID string
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list facility schedules",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid facility id",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: "facility",
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid pagination",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &invalidFirst},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search schedules",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to search schedules" {
+				fakeFHIR.MockSearchFHIRScheduleFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSchedule, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.ListFacilitySchedules(tt.args.ctx, tt.args.facilityID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListFacilitySchedules() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (len(got.Edges) != 1 || got.Edges[0].Node.PractitionerName == "") {
+				t.Errorf("expected a practitioner's schedule, got %v", got.Edges)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_CreateSlots(t *testing.T) {
+	start := time.Date(2026, 11, 2, 8, 0, 0, 0, time.UTC)
+
+	type args struct {
+		ctx   context.Context
+		input dto.SlotsInput
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantSlots int
+		wantErr   bool
+	}{
+		{
+			name: "Happy case: create slots",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SlotsInput{
+					ScheduleID:      gofakeit.UUID(),
+					Start:           scalarutils.DateTime(start.Format(time.RFC3339)),
+					End:             scalarutils.DateTime(start.Add(2*time.Hour + 15*time.Minute).Format(time.RFC3339)),
+					DurationMinutes: 30,
+				},
+			},
+			wantSlots: 4,
+			wantErr:   false,
+		},
+		{
+			name: "Sad case: invalid input",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SlotsInput{
+					ScheduleID:      gofakeit.UUID(),
+					Start:           scalarutils.DateTime(start.Format(time.RFC3339)),
+					End:             scalarutils.DateTime(start.Add(time.Hour).Format(time.RFC3339)),
+					DurationMinutes: 1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid start",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SlotsInput{
+					ScheduleID:      gofakeit.UUID(),
+					Start:           "tomorrow",
+					End:             scalarutils.DateTime(start.Format(time.RFC3339)),
+					DurationMinutes: 30,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: slots end before they start",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SlotsInput{
+					ScheduleID:      gofakeit.UUID(),
+					Start:           scalarutils.DateTime(start.Format(time.RFC3339)),
+					End:             scalarutils.DateTime(start.Add(-time.Hour).Format(time.RFC3339)),
+					DurationMinutes: 30,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: too many slots",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SlotsInput{
+					ScheduleID:      gofakeit.UUID(),
+					Start:           scalarutils.DateTime(start.Format(time.RFC3339)),
+					End:             scalarutils.DateTime(start.AddDate(0, 0, 7).Format(time.RFC3339)),
+					DurationMinutes: 15,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get schedule",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SlotsInput{
+					ScheduleID:      gofakeit.UUID(),
+					Start:           scalarutils.DateTime(start.Format(time.RFC3339)),
+					End:             scalarutils.DateTime(start.Add(time.Hour).Format(time.RFC3339)),
+					DurationMinutes: 30,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: schedule is inactive",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SlotsInput{
+					ScheduleID:      gofakeit.UUID(),
+					Start:           scalarutils.DateTime(start.Format(time.RFC3339)),
+					End:             scalarutils.DateTime(start.Add(time.Hour).Format(time.RFC3339)),
+					DurationMinutes: 30,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create slot",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SlotsInput{
+					ScheduleID:      gofakeit.UUID(),
+					Start:           scalarutils.DateTime(start.Format(time.RFC3339)),
+					End:             scalarutils.DateTime(start.Add(time.Hour).Format(time.RFC3339)),
+					DurationMinutes: 30,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to get schedule" {
+				fakeFHIR.MockGetFHIRScheduleFn = func(ctx context.Context, id string) (*domain.FHIRScheduleRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: schedule is inactive" {
+				fakeFHIR.MockGetFHIRScheduleFn = func(ctx context.Context, id string) (*domain.FHIRScheduleRelayPayload, error) {
+					active := false
+
+					return &domain.FHIRScheduleRelayPayload{
+						Resource: &domain.FHIRSchedule{
+							ID:     &id,
+							Active: &active,
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to create slot" {
+				fakeFHIR.MockCreateFHIRSlotFn = func(ctx context.Context, input domain.FHIRSlot) (*domain.FHIRSlot, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.CreateSlots(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.CreateSlots() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if len(got) != tt.wantSlots {
+				t.Fatalf("expected %d slots, got %d", tt.wantSlots, len(got))
+			}
+
+			last := got[len(got)-1]
+			if last.Status != dto.SlotStatusFree || last.ScheduleID != tt.args.input.ScheduleID || last.End != scalarutils.DateTime(start.Add(2*time.Hour).Format(time.RFC3339)) {
+				t.Errorf("expected the last free slot to end at 10:00, got %v", last)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListAvailableSlots(t *testing.T) {
+	first := 10
+	invalidFirst := -1
+
+	type args struct {
+		ctx        context.Context
+		scheduleID string
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list available slots",
+			args: args{
+				ctx:        context.Background(),
+				scheduleID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid schedule id",
+			args: args{
+				ctx:        context.Background(),
+				scheduleID: "schedule",
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid pagination",
+			args: args{
+				ctx:        context.Background(),
+				scheduleID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &invalidFirst},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search slots",
+			args: args{
+				ctx:        context.Background(),
+				scheduleID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			var searched map[string]interface{}
+
+			fakeFHIR.MockSearchFHIRSlotFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSlot, error) {
+				searched = params
+
+				return &domain.PagedFHIRSlot{}, nil
+			}
+
+			if tt.name == "Sad case: failed to search slots" {
+				fakeFHIR.MockSearchFHIRSlotFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSlot, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			_, err := c.ListAvailableSlots(tt.args.ctx, tt.args.scheduleID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListAvailableSlots() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (searched["status"] != "free" || searched["schedule"] != "Schedule/"+tt.args.scheduleID) {
+				t.Errorf("expected the schedule's free slots to be searched, got %v", searched)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package clinical
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/application/utils"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// CreateServiceAccount creates a service account bound to the tenant in the context.
+// A service account can only be granted the registered GraphQL operations and REST routes as its scopes.
+// The returned key is only available at this point since only its hash is stored
+func (c *UseCasesClinicalImpl) CreateServiceAccount(ctx context.Context, input dto.ServiceAccountInput) (*dto.ServiceAccountCredential, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateServiceAccountScopes(input.Scopes, c.serviceAccountOperations)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := generateServiceAccountSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	created := now.Format(time.DateOnly)
+	system := scalarutils.URI(serviceAccountCodeSystem)
+	code := scalarutils.Code(serviceAccountCode)
+
+	basic := domain.FHIRBasic{
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+		Code: &domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &system,
+					Code:    &code,
+					Display: "Service Account",
+				},
+			},
+			Text: "Service Account",
+		},
+		Created:   &created,
+		Extension: composeServiceAccountExtensions(input.Name, input.Scopes, hashServiceAccountSecret(secret), serviceAccountStatusActive, now),
+	}
+
+	resource, err := c.infrastructure.FHIR.CreateFHIRBasic(ctx, basic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ServiceAccountCredential{
+		ServiceAccount: *mapFHIRBasicToServiceAccountDTO(*resource),
+		Key:            formatServiceAccountKey(*resource.ID, secret),
+	}, nil
+}
+
+// ListServiceAccounts lists the service accounts of the tenant in the context
+func (c *UseCasesClinicalImpl) ListServiceAccounts(ctx context.Context) ([]*dto.ServiceAccount, error) {
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"code": fmt.Sprintf("%s|%s", serviceAccountCodeSystem, serviceAccountCode),
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRBasic(ctx, params, *identifiers, dto.Pagination{Skip: true})
+	if err != nil {
+		utils.ReportErrorToSentry(err)
+		return nil, err
+	}
+
+	accounts := []*dto.ServiceAccount{}
+
+	for _, resource := range resources.Basics {
+		accounts = append(accounts, mapFHIRBasicToServiceAccountDTO(resource))
+	}
+
+	return accounts, nil
+}
+
+// RotateServiceAccountKey issues a new key for a service account. The previous key stops working immediately
+func (c *UseCasesClinicalImpl) RotateServiceAccountKey(ctx context.Context, id string) (*dto.ServiceAccountCredential, error) {
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	resource, err := c.getServiceAccount(ctx, id, *identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	account := mapFHIRBasicToServiceAccountDTO(*resource)
+	if !account.Active {
+		return nil, fmt.Errorf("cannot rotate the key of a revoked service account")
+	}
+
+	secret, err := generateServiceAccountSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	resource.Extension = composeServiceAccountExtensions(account.Name, account.Scopes, hashServiceAccountSecret(secret), serviceAccountStatusActive, time.Now())
+
+	updated, err := c.infrastructure.FHIR.UpdateFHIRBasic(ctx, *resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ServiceAccountCredential{
+		ServiceAccount: *mapFHIRBasicToServiceAccountDTO(*updated),
+		Key:            formatServiceAccountKey(id, secret),
+	}, nil
+}
+
+// RevokeServiceAccount deactivates a service account so that its key can no longer be used
+func (c *UseCasesClinicalImpl) RevokeServiceAccount(ctx context.Context, id string) (*dto.ServiceAccount, error) {
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	resource, err := c.getServiceAccount(ctx, id, *identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	account := mapFHIRBasicToServiceAccountDTO(*resource)
+
+	resource.Extension = composeServiceAccountExtensions(account.Name, account.Scopes, serviceAccountKeyHash(*resource), serviceAccountStatusRevoked, account.KeyRotatedAt)
+
+	updated, err := c.infrastructure.FHIR.UpdateFHIRBasic(ctx, *resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRBasicToServiceAccountDTO(*updated), nil
+}
+
+// AuthenticateServiceAccount verifies a service account key against the stored hash.
+// The service account must belong to the supplied tenant and be active
+func (c *UseCasesClinicalImpl) AuthenticateServiceAccount(ctx context.Context, key string, tenant dto.TenantIdentifiers) (*dto.ServiceAccount, error) {
+	id, secret, err := parseServiceAccountKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := c.getServiceAccount(ctx, id, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("invalid service account key")
+	}
+
+	account := mapFHIRBasicToServiceAccountDTO(*resource)
+	if !account.Active {
+		return nil, fmt.Errorf("the service account has been revoked")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(serviceAccountKeyHash(*resource)), []byte(hashServiceAccountSecret(secret))) != 1 {
+		return nil, fmt.Errorf("invalid service account key")
+	}
+
+	return account, nil
+}
+
+// RecordServiceAccountAudit records an audit event for an operation performed using a service account
+func (c *UseCasesClinicalImpl) RecordServiceAccountAudit(ctx context.Context, accountID string, tenant dto.TenantIdentifiers, operation string, authorized bool) error {
+	tags, err := c.CreateTenantMetaTags(ctx, tenant.OrganizationID, tenant.FacilityID)
+	if err != nil {
+		return err
+	}
+
+	typeSystem := scalarutils.URI("http://terminology.hl7.org/CodeSystem/audit-event-type")
+	typeCode := scalarutils.Code("rest")
+	agentReference := fmt.Sprintf("Basic/%s", accountID)
+
+	outcome, outcomeDescription := "0", "authorized"
+	if !authorized {
+		outcome, outcomeDescription = "4", "the service account is not allowed to perform this operation"
+	}
+
+	event := domain.FHIRAuditEvent{
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+		Type: domain.FHIRCoding{
+			System:  &typeSystem,
+			Code:    &typeCode,
+			Display: "RESTful Operation",
+		},
+		Action:      "E",
+		Recorded:    time.Now().Format(time.RFC3339),
+		Outcome:     outcome,
+		OutcomeDesc: outcomeDescription,
+		Agent: []domain.FHIRAuditEventAgent{
+			{
+				Who: &domain.FHIRReference{
+					ID:        &accountID,
+					Reference: &agentReference,
+				},
+				Requestor: true,
+			},
+		},
+		Source: domain.FHIRAuditEventSource{
+			Observer: domain.FHIRReference{
+				Display: common.ClinicalServiceName,
+			},
+		},
+		Entity: []domain.FHIRAuditEventEntity{
+			{
+				Name: operation,
+			},
+		},
+	}
+
+	_, err = c.infrastructure.FHIR.CreateFHIRAuditEvent(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getServiceAccount retrieves a service account within a tenant
+func (c *UseCasesClinicalImpl) getServiceAccount(ctx context.Context, id string, tenant dto.TenantIdentifiers) (*domain.FHIRBasic, error) {
+	params := map[string]interface{}{
+		"_id":  id,
+		"code": fmt.Sprintf("%s|%s", serviceAccountCodeSystem, serviceAccountCode),
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRBasic(ctx, params, tenant, dto.Pagination{Skip: true})
+	if err != nil {
+		utils.ReportErrorToSentry(err)
+		return nil, err
+	}
+
+	if len(resources.Basics) != 1 {
+		return nil, fmt.Errorf("service account %s not found", id)
+	}
+
+	return &resources.Basics[0], nil
+}
+
+// RegisterServiceAccountOperations registers the GraphQL queries and mutations and the REST operations e.g `POST:/api/v1/media`
+// that service accounts can be granted as scopes
+func (c *UseCasesClinicalImpl) RegisterServiceAccountOperations(operations ...string) {
+	c.serviceAccountOperations.add(operations...)
+}
+
+// validateServiceAccountScopes ensures that the scopes are registered operations and that service accounts
+// cannot be granted access to manage other service accounts
+func validateServiceAccountScopes(scopes []string, operations *serviceAccountOperations) error {
+	for _, scope := range scopes {
+		if strings.ContainsAny(scope, " \t\n") {
+			return fmt.Errorf("invalid scope %q: scopes cannot contain whitespace", scope)
+		}
+
+		if strings.Contains(scope, serviceAccountsPath) || scope == common.ServiceAccountAdminScope {
+			return fmt.Errorf("invalid scope %q: service accounts cannot manage service accounts", scope)
+		}
+
+		if !operations.has(scope) {
+			return fmt.Errorf("invalid scope %q: the scope must be a GraphQL operation or a REST route e.g POST:/api/v1/media", scope)
+		}
+	}
+
+	return nil
+}
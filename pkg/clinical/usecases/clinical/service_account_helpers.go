@@ -0,0 +1,182 @@
+package clinical
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/common"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+)
+
+const (
+	serviceAccountCodeSystem = "http://savannahghi.org/fhir/CodeSystem/basic-resource-type"
+	serviceAccountCode       = "service-account"
+	serviceAccountsPath      = "/service-accounts"
+
+	serviceAccountNameExtensionURL       = "http://savannahghi.org/fhir/StructureDefinition/service-account-name"
+	serviceAccountScopeExtensionURL      = "http://savannahghi.org/fhir/StructureDefinition/service-account-scope"
+	serviceAccountKeyHashExtensionURL    = "http://savannahghi.org/fhir/StructureDefinition/service-account-key-hash"
+	serviceAccountStatusExtensionURL     = "http://savannahghi.org/fhir/StructureDefinition/service-account-status"
+	serviceAccountKeyRotatedExtensionURL = "http://savannahghi.org/fhir/StructureDefinition/service-account-key-rotated"
+
+	serviceAccountStatusActive  = "active"
+	serviceAccountStatusRevoked = "revoked"
+
+	serviceAccountSecretLength = 32
+)
+
+// serviceAccountOperations are the operations that service accounts can be granted as scopes
+type serviceAccountOperations struct {
+	mu         sync.RWMutex
+	operations map[string]bool
+}
+
+func newServiceAccountOperations() *serviceAccountOperations {
+	return &serviceAccountOperations{
+		operations: map[string]bool{},
+	}
+}
+
+func (o *serviceAccountOperations) add(operations ...string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, operation := range operations {
+		o.operations[operation] = true
+	}
+}
+
+func (o *serviceAccountOperations) has(operation string) bool {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return o.operations[operation]
+}
+
+// generateServiceAccountSecret generates a random url safe secret
+func generateServiceAccountSecret() (string, error) {
+	bs := make([]byte, serviceAccountSecretLength)
+
+	_, err := rand.Read(bs)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate service account secret: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
+
+// hashServiceAccountSecret hashes a secret for storage. The secrets are random and long enough for a
+// plain SHA-256 hash to be sufficient
+func hashServiceAccountSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// formatServiceAccountKey combines the service account ID and secret into the key handed to the client
+func formatServiceAccountKey(id, secret string) string {
+	return fmt.Sprintf("%s.%s", id, secret)
+}
+
+// parseServiceAccountKey splits a key into the service account ID and secret
+func parseServiceAccountKey(key string) (string, string, error) {
+	id, secret, found := strings.Cut(key, ".")
+	if !found || id == "" || secret == "" {
+		return "", "", fmt.Errorf("invalid service account key format")
+	}
+
+	return id, secret, nil
+}
+
+// composeServiceAccountExtensions builds the extensions used to store a service account's details in a Basic resource
+func composeServiceAccountExtensions(name string, scopes []string, keyHash, status string, keyRotatedAt time.Time) []domain.Extension {
+	extensions := []domain.Extension{
+		{
+			URL:         serviceAccountNameExtensionURL,
+			ValueString: name,
+		},
+		{
+			URL:         serviceAccountKeyHashExtensionURL,
+			ValueString: keyHash,
+		},
+		{
+			URL:       serviceAccountStatusExtensionURL,
+			ValueCode: status,
+		},
+		{
+			URL:           serviceAccountKeyRotatedExtensionURL,
+			ValueDateTime: keyRotatedAt.Format(time.RFC3339),
+		},
+	}
+
+	for _, scope := range scopes {
+		extensions = append(extensions, domain.Extension{
+			URL:         serviceAccountScopeExtensionURL,
+			ValueString: scope,
+		})
+	}
+
+	return extensions
+}
+
+// serviceAccountKeyHash reads the stored key hash of a service account
+func serviceAccountKeyHash(resource domain.FHIRBasic) string {
+	for _, extension := range resource.Extension {
+		if extension.URL == serviceAccountKeyHashExtensionURL {
+			return extension.ValueString
+		}
+	}
+
+	return ""
+}
+
+// mapFHIRBasicToServiceAccountDTO maps a Basic resource to a service account. The key hash is never exposed
+func mapFHIRBasicToServiceAccountDTO(resource domain.FHIRBasic) *dto.ServiceAccount {
+	account := &dto.ServiceAccount{
+		Scopes: []string{},
+	}
+
+	if resource.ID != nil {
+		account.ID = *resource.ID
+	}
+
+	for _, extension := range resource.Extension {
+		switch extension.URL {
+		case serviceAccountNameExtensionURL:
+			account.Name = extension.ValueString
+		case serviceAccountScopeExtensionURL:
+			account.Scopes = append(account.Scopes, extension.ValueString)
+		case serviceAccountStatusExtensionURL:
+			account.Active = extension.ValueCode == serviceAccountStatusActive
+		case serviceAccountKeyRotatedExtensionURL:
+			rotatedAt, err := time.Parse(time.RFC3339, extension.ValueDateTime)
+			if err == nil {
+				account.KeyRotatedAt = rotatedAt
+			}
+		}
+	}
+
+	if resource.Meta != nil {
+		for _, tag := range resource.Meta.Tag {
+			if tag.System == nil {
+				continue
+			}
+
+			switch string(*tag.System) {
+			case common.OrganizationTagSystem:
+				account.OrganizationID = string(tag.Code)
+			case common.FacilityTagSystem:
+				account.FacilityID = string(tag.Code)
+			}
+		}
+	}
+
+	return account
+}
@@ -0,0 +1,537 @@
+package clinical_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/authutils"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+)
+
+// fakeServiceAccount returns a service account basic resource whose key secret is `secret`
+func fakeServiceAccount(id, secret, status string) domain.FHIRBasic {
+	sum := sha256.Sum256([]byte(secret))
+
+	return domain.FHIRBasic{
+		ID: &id,
+		Extension: []domain.Extension{
+			{
+				URL:         "http://savannahghi.org/fhir/StructureDefinition/service-account-name",
+				ValueString: "Lab system",
+			},
+			{
+				URL:         "http://savannahghi.org/fhir/StructureDefinition/service-account-key-hash",
+				ValueString: hex.EncodeToString(sum[:]),
+			},
+			{
+				URL:       "http://savannahghi.org/fhir/StructureDefinition/service-account-status",
+				ValueCode: status,
+			},
+			{
+				URL:         "http://savannahghi.org/fhir/StructureDefinition/service-account-scope",
+				ValueString: "recordTemperature",
+			},
+		},
+	}
+}
+
+func TestUseCasesClinicalImpl_CreateServiceAccount(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.ServiceAccountInput
+	}
+
+	adminCtx := context.WithValue(context.Background(), authutils.AuthTokenContextKey, &authutils.TokenIntrospectionResponse{
+		Scope: "openid profile clinical.service-accounts.admin",
+	})
+
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: create service account",
+			args: args{
+				ctx: adminCtx,
+				input: dto.ServiceAccountInput{
+					Name:   "Lab system",
+					Scopes: []string{"recordViralLoad", "POST:/api/v1/media"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: missing scopes",
+			args: args{
+				ctx: adminCtx,
+				input: dto.ServiceAccountInput{
+					Name: "Lab system",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: scope to manage service accounts",
+			args: args{
+				ctx: adminCtx,
+				input: dto.ServiceAccountInput{
+					Name:   "Lab system",
+					Scopes: []string{"POST:/api/v1/service-accounts"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: scope to administer service accounts",
+			args: args{
+				ctx: adminCtx,
+				input: dto.ServiceAccountInput{
+					Name:   "Lab system",
+					Scopes: []string{"clinical.service-accounts.admin"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unknown operation",
+			args: args{
+				ctx: adminCtx,
+				input: dto.ServiceAccountInput{
+					Name:   "Lab system",
+					Scopes: []string{"recordViralLoad", "deletePatient"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: unknown route",
+			args: args{
+				ctx: adminCtx,
+				input: dto.ServiceAccountInput{
+					Name:   "Lab system",
+					Scopes: []string{"DELETE:/api/v1/media"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get tenant tags",
+			args: args{
+				ctx: adminCtx,
+				input: dto.ServiceAccountInput{
+					Name:   "Lab system",
+					Scopes: []string{"recordViralLoad"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create service account",
+			args: args{
+				ctx: adminCtx,
+				input: dto.ServiceAccountInput{
+					Name:   "Lab system",
+					Scopes: []string{"recordViralLoad"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+			c.RegisterServiceAccountOperations("recordViralLoad", "recordTemperature", "POST:/api/v1/media", "POST:/api/v1/service-accounts")
+
+			if tt.name == "Sad case: failed to get tenant tags" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create service account" {
+				fakeFHIR.MockCreateFHIRBasicFn = func(ctx context.Context, input domain.FHIRBasic) (*domain.FHIRBasic, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.CreateServiceAccount(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.CreateServiceAccount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				if got.Key == "" {
+					t.Errorf("expected a service account key")
+					return
+				}
+
+				if !got.ServiceAccount.Active || len(got.ServiceAccount.Scopes) != len(tt.args.input.Scopes) {
+					t.Errorf("expected an active service account with the supplied scopes, got %v", got.ServiceAccount)
+				}
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListServiceAccounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: list service accounts",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: failed to get tenant identifiers",
+			ctx:     context.Background(),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to search service accounts",
+			ctx:     context.Background(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to get tenant identifiers" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to search service accounts" {
+				fakeFHIR.MockSearchFHIRBasicFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRBasic, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			_, err := c.ListServiceAccounts(tt.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListServiceAccounts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_RotateServiceAccountKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: rotate service account key",
+			id:      gofakeit.UUID(),
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: service account not found",
+			id:      gofakeit.UUID(),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: revoked service account",
+			id:      gofakeit.UUID(),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to update service account",
+			id:      gofakeit.UUID(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			status := "active"
+			if tt.name == "Sad case: revoked service account" {
+				status = "revoked"
+			}
+
+			fakeFHIR.MockSearchFHIRBasicFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRBasic, error) {
+				return &domain.PagedFHIRBasic{
+					Basics: []domain.FHIRBasic{fakeServiceAccount(tt.id, gofakeit.UUID(), status)},
+				}, nil
+			}
+
+			if tt.name == "Sad case: service account not found" {
+				fakeFHIR.MockSearchFHIRBasicFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRBasic, error) {
+					return &domain.PagedFHIRBasic{}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to update service account" {
+				fakeFHIR.MockUpdateFHIRBasicFn = func(ctx context.Context, input domain.FHIRBasic) (*domain.FHIRBasic, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.RotateServiceAccountKey(context.Background(), tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RotateServiceAccountKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got.Key == "" {
+				t.Errorf("expected a new service account key")
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_RevokeServiceAccount(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: revoke service account",
+			id:      gofakeit.UUID(),
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: failed to search service account",
+			id:      gofakeit.UUID(),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: failed to update service account",
+			id:      gofakeit.UUID(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			fakeFHIR.MockSearchFHIRBasicFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRBasic, error) {
+				return &domain.PagedFHIRBasic{
+					Basics: []domain.FHIRBasic{fakeServiceAccount(tt.id, gofakeit.UUID(), "active")},
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to search service account" {
+				fakeFHIR.MockSearchFHIRBasicFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRBasic, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to update service account" {
+				fakeFHIR.MockUpdateFHIRBasicFn = func(ctx context.Context, input domain.FHIRBasic) (*domain.FHIRBasic, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.RevokeServiceAccount(context.Background(), tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RevokeServiceAccount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got.Active {
+				t.Errorf("expected the service account to be revoked")
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_AuthenticateServiceAccount(t *testing.T) {
+	id := gofakeit.UUID()
+	secret := gofakeit.UUID()
+
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{
+			name:    "Happy case: valid service account key",
+			key:     fmt.Sprintf("%s.%s", id, secret),
+			wantErr: false,
+		},
+		{
+			name:    "Sad case: malformed key",
+			key:     secret,
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: wrong secret",
+			key:     fmt.Sprintf("%s.%s", id, gofakeit.UUID()),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: revoked service account",
+			key:     fmt.Sprintf("%s.%s", id, secret),
+			wantErr: true,
+		},
+		{
+			name:    "Sad case: service account not in tenant",
+			key:     fmt.Sprintf("%s.%s", id, secret),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			status := "active"
+			if tt.name == "Sad case: revoked service account" {
+				status = "revoked"
+			}
+
+			fakeFHIR.MockSearchFHIRBasicFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRBasic, error) {
+				return &domain.PagedFHIRBasic{
+					Basics: []domain.FHIRBasic{fakeServiceAccount(id, secret, status)},
+				}, nil
+			}
+
+			if tt.name == "Sad case: service account not in tenant" {
+				fakeFHIR.MockSearchFHIRBasicFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRBasic, error) {
+					return &domain.PagedFHIRBasic{}, nil
+				}
+			}
+
+			tenant := dto.TenantIdentifiers{
+				OrganizationID: gofakeit.UUID(),
+				FacilityID:     gofakeit.UUID(),
+			}
+
+			got, err := c.AuthenticateServiceAccount(context.Background(), tt.key, tenant)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.AuthenticateServiceAccount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got.ID != id {
+				t.Errorf("expected service account %s, got %s", id, got.ID)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_RecordServiceAccountAudit(t *testing.T) {
+	tests := []struct {
+		name       string
+		authorized bool
+		wantErr    bool
+	}{
+		{
+			name:       "Happy case: record authorized operation",
+			authorized: true,
+			wantErr:    false,
+		},
+		{
+			name:       "Happy case: record unauthorized operation",
+			authorized: false,
+			wantErr:    false,
+		},
+		{
+			name:       "Sad case: failed to get tenant",
+			authorized: true,
+			wantErr:    true,
+		},
+		{
+			name:       "Sad case: failed to create audit event",
+			authorized: true,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			if tt.name == "Sad case: failed to get tenant" {
+				fakeFHIR.MockGetFHIROrganizationFn = func(ctx context.Context, organisationID string) (*domain.FHIROrganizationRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create audit event" {
+				fakeFHIR.MockCreateFHIRAuditEventFn = func(ctx context.Context, input domain.FHIRAuditEvent) (*domain.FHIRAuditEvent, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			tenant := dto.TenantIdentifiers{
+				OrganizationID: gofakeit.UUID(),
+				FacilityID:     gofakeit.UUID(),
+			}
+
+			err := c.RecordServiceAccountAudit(context.Background(), gofakeit.UUID(), tenant, "recordTemperature", tt.authorized)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.RecordServiceAccountAudit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -32,6 +32,11 @@ func (c *UseCasesClinicalImpl) ReferPatient(
 		return nil, fmt.Errorf("cannot record a referral in a finished encounter")
 	}
 
+	err = c.ensureConsentPermits(ctx, *encounter.Resource.Subject.ID, dto.ConsentCategoryDataSharing)
+	if err != nil {
+		return nil, err
+	}
+
 	patientReference := fmt.Sprintf("Patient/%s", *encounter.Resource.Subject.ID)
 	encounterReference := fmt.Sprintf("Encounter/%s", *encounter.Resource.ID)
 	startTime := scalarutils.DateTime(time.Now().Format("2006-01-02T15:04:05+03:00"))
@@ -84,6 +89,13 @@ func (c *UseCasesClinicalImpl) ReferPatient(
 		serviceRequest.Extension = append(serviceRequest.Extension, specialistExtension)
 	}
 
+	requester, err := c.currentPractitionerReference(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceRequest.Requester = requester
+
 	tags, err := c.GetTenantMetaTags(ctx)
 	if err != nil {
 		return nil, err
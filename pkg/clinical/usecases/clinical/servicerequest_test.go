@@ -16,6 +16,7 @@ import (
 	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
 	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
 	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
 )
 
 func TestUseCasesClinicalImpl_ReferPatient(t *testing.T) {
@@ -91,6 +92,42 @@ func TestUseCasesClinicalImpl_ReferPatient(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Sad Case: Patient denied data sharing consent",
+			args: args{
+				ctx: context.Background(),
+				input: &dto.ReferralInput{
+					EncounterID:  gofakeit.UUID(),
+					ReferralType: "DIAGNOSTICS",
+					Tests:        []string{"VIA"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Happy Case: Patient denied consent in another category",
+			args: args{
+				ctx: context.Background(),
+				input: &dto.ReferralInput{
+					EncounterID:  gofakeit.UUID(),
+					ReferralType: "DIAGNOSTICS",
+					Tests:        []string{"VIA"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case: Fail to search patient consents",
+			args: args{
+				ctx: context.Background(),
+				input: &dto.ReferralInput{
+					EncounterID:  gofakeit.UUID(),
+					ReferralType: "DIAGNOSTICS",
+					Tests:        []string{"VIA"},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Sad Case: Input validation - missing encounter ID",
 			args: args{
@@ -138,6 +175,31 @@ func TestUseCasesClinicalImpl_ReferPatient(t *testing.T) {
 				}
 			}
 
+			if tt.name == "Sad Case: Patient denied data sharing consent" {
+				fakeFHIR.MockSearchFHIRConsentFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRConsent, error) {
+					return fakeDenyConsents(), nil
+				}
+			}
+
+			if tt.name == "Happy Case: Patient denied consent in another category" {
+				fakeFHIR.MockSearchFHIRConsentFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRConsent, error) {
+					consents := fakeDenyConsents()
+					system := scalarutils.URI("http://savannahghi.org/fhir/CodeSystem/consent-category")
+					code := scalarutils.Code("research")
+					consents.Consents[0].Category = []*domain.FHIRCodeableConcept{
+						{Coding: []*domain.FHIRCoding{{System: &system, Code: &code}}},
+					}
+
+					return consents, nil
+				}
+			}
+
+			if tt.name == "Sad Case: Fail to search patient consents" {
+				fakeFHIR.MockSearchFHIRConsentFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRConsent, error) {
+					return nil, fmt.Errorf("failed to search consents")
+				}
+			}
+
 			if tt.name == "Sad Case: Fail to create service request" {
 				fakeFHIR.MockCreateFHIRServiceRequestFn = func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
 					return nil, fmt.Errorf("failed to record service request")
@@ -0,0 +1,234 @@
+package clinical
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+// CollectSpecimen records a specimen collected for an active lab order and links it to the order.
+// The collection starts the specimen's chain of custody at the facility it was collected in
+func (c *UseCasesClinicalImpl) CollectSpecimen(ctx context.Context, input dto.SpecimenInput) (*dto.Specimen, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	serviceRequest, err := c.infrastructure.FHIR.GetFHIRServiceRequest(ctx, input.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	order := *serviceRequest.Resource
+
+	if !isLabOrder(order) {
+		return nil, fmt.Errorf("service request %s is not a lab order", input.OrderID)
+	}
+
+	if labOrderStatus(order) != dto.LabOrderStatusActive {
+		return nil, fmt.Errorf("specimens can only be collected for active lab orders")
+	}
+
+	specimenType, err := c.GetConcept(ctx, dto.TerminologySourceCIEL, input.TypeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	tags, err := c.GetTenantMetaTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	collectedAt := time.Now()
+	if input.CollectedAt != nil {
+		collectedAt, err = time.Parse(time.RFC3339, string(*input.CollectedAt))
+		if err != nil {
+			return nil, fmt.Errorf("invalid collection time: %w", err)
+		}
+	}
+
+	status := specimenStatusCode(dto.SpecimenCustodyStatusCollected)
+	collectedDateTime := collectedAt.Format(time.RFC3339)
+	orderReference := fmt.Sprintf("ServiceRequest/%s", input.OrderID)
+
+	specimen := domain.FHIRSpecimen{
+		Status:  &status,
+		Type:    conceptCodeableConcept(specimenType),
+		Subject: order.Subject,
+		Request: []*domain.FHIRReference{
+			{
+				ID:        &input.OrderID,
+				Reference: &orderReference,
+			},
+		},
+		Collection: &domain.FHIRSpecimenCollection{
+			CollectedDateTime: &collectedDateTime,
+		},
+		Meta: &domain.FHIRMetaInput{
+			Tag: tags,
+		},
+		Extension: []*domain.FHIRExtension{
+			specimenCustodyEvent(dto.SpecimenCustodyStatusCollected, collectedAt, identifiers.FacilityID, ""),
+		},
+	}
+
+	if input.BodySiteCode != "" {
+		bodySite, err := c.GetConcept(ctx, dto.TerminologySourceCIEL, input.BodySiteCode)
+		if err != nil {
+			return nil, err
+		}
+
+		specimen.Collection.BodySite = conceptCodeableConcept(bodySite)
+	}
+
+	if input.Container != "" {
+		specimen.Container = []*domain.FHIRSpecimenContainer{
+			{
+				Description: &input.Container,
+			},
+		}
+	}
+
+	if input.AccessionNumber != "" {
+		specimen.AccessionIdentifier = accessionIdentifier(input.AccessionNumber)
+	}
+
+	if input.Note != "" {
+		specimen.Note = []*domain.FHIRAnnotation{
+			{
+				Text: (*scalarutils.Markdown)(&input.Note),
+			},
+		}
+	}
+
+	resource, err := c.infrastructure.FHIR.CreateFHIRSpecimen(ctx, specimen)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := serviceRequestInput(order)
+	if err != nil {
+		return nil, err
+	}
+
+	specimenReference := fmt.Sprintf("Specimen/%s", *resource.ID)
+	request.Specimen = append(request.Specimen, &domain.FHIRReferenceInput{
+		ID:        resource.ID,
+		Reference: &specimenReference,
+	})
+
+	_, err = c.infrastructure.FHIR.UpdateFHIRServiceRequest(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRSpecimenToDTO(*resource), nil
+}
+
+// UpdateSpecimenCustody records the next step in the chain of custody of a specimen e.g its dispatch to or receipt at the lab.
+// The step is recorded against the facility the request is made from
+func (c *UseCasesClinicalImpl) UpdateSpecimenCustody(ctx context.Context, input dto.SpecimenCustodyInput) (*dto.Specimen, error) {
+	err := input.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := c.infrastructure.FHIR.GetFHIRSpecimen(ctx, input.SpecimenID)
+	if err != nil {
+		return nil, err
+	}
+
+	specimen := *payload.Resource
+
+	current := specimenCustodyStatus(specimen)
+	if !canMoveSpecimenCustody(current, input.Status) {
+		return nil, fmt.Errorf("a specimen that is %s can not be marked as %s", current, input.Status)
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	now := time.Now()
+	status := specimenStatusCode(input.Status)
+
+	specimen.Status = &status
+	specimen.Extension = append(specimen.Extension, specimenCustodyEvent(input.Status, now, identifiers.FacilityID, input.Note))
+
+	if input.Status == dto.SpecimenCustodyStatusReceived {
+		receivedTime := now.Format(time.RFC3339)
+		specimen.ReceivedTime = &receivedTime
+	}
+
+	if input.AccessionNumber != "" {
+		specimen.AccessionIdentifier = accessionIdentifier(input.AccessionNumber)
+	}
+
+	resource, err := c.infrastructure.FHIR.UpdateFHIRSpecimen(ctx, specimen)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFHIRSpecimenToDTO(*resource), nil
+}
+
+// ListOutstandingSpecimens lists a facility's specimens that are yet to be processed or rejected, oldest collection first
+func (c *UseCasesClinicalImpl) ListOutstandingSpecimens(ctx context.Context, facilityID string, pagination dto.Pagination) (*dto.SpecimenConnection, error) {
+	_, err := uuid.Parse(facilityID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid facility id: %s", facilityID)
+	}
+
+	err = pagination.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers, err := c.infrastructure.BaseExtension.GetTenantIdentifiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant identifiers from context: %w", err)
+	}
+
+	if identifiers.FacilityID != facilityID {
+		return nil, fmt.Errorf("the outstanding specimens of facility %s can only be listed from the facility", facilityID)
+	}
+
+	// Specimens stay available until they are processed or rejected
+	params := map[string]interface{}{
+		"status": string(specimenStatusCode(dto.SpecimenCustodyStatusCollected)),
+		"_sort":  "collected",
+	}
+
+	resources, err := c.infrastructure.FHIR.SearchFHIRSpecimen(ctx, params, *identifiers, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	specimens := []*dto.Specimen{}
+
+	for _, resource := range resources.Specimens {
+		specimens = append(specimens, mapFHIRSpecimenToDTO(resource))
+	}
+
+	pageInfo := dto.PageInfo{
+		HasNextPage:     resources.HasNextPage,
+		EndCursor:       &resources.NextCursor,
+		HasPreviousPage: resources.HasPreviousPage,
+		StartCursor:     &resources.PreviousCursor,
+	}
+
+	connection := dto.CreateSpecimenConnection(specimens, pageInfo, resources.TotalCount)
+
+	return &connection, nil
+}
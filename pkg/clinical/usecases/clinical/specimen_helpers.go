@@ -0,0 +1,223 @@
+package clinical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/scalarutils"
+)
+
+const (
+	// specimenCustodyEventExtensionURL is the extension each change in the custody of a specimen is recorded with
+	specimenCustodyEventExtensionURL = "http://savannahghi.org/fhir/StructureDefinition/specimen-custody-event"
+
+	// accessionIdentifierTypeSystem is the code system of the accession ID identifier type
+	accessionIdentifierTypeSystem = "http://terminology.hl7.org/CodeSystem/v2-0203"
+	accessionIdentifierTypeCode   = "ACSN"
+)
+
+// specimenCustodyTransitions lists the custody statuses a specimen in each custody status can move to.
+// Processed and rejected specimens are no longer in custody and can not move on
+var specimenCustodyTransitions = map[dto.SpecimenCustodyStatusEnum][]dto.SpecimenCustodyStatusEnum{
+	dto.SpecimenCustodyStatusCollected: {dto.SpecimenCustodyStatusInTransit, dto.SpecimenCustodyStatusReceived, dto.SpecimenCustodyStatusRejected},
+	dto.SpecimenCustodyStatusInTransit: {dto.SpecimenCustodyStatusReceived, dto.SpecimenCustodyStatusRejected},
+	dto.SpecimenCustodyStatusReceived:  {dto.SpecimenCustodyStatusProcessed, dto.SpecimenCustodyStatusRejected},
+}
+
+// canMoveSpecimenCustody checks whether a specimen can move from one custody status to another
+func canMoveSpecimenCustody(from, to dto.SpecimenCustodyStatusEnum) bool {
+	for _, status := range specimenCustodyTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// specimenStatusCode converts a custody status to the FHIR status of the specimen.
+// Specimens are available until they are used up in processing or found to be unsatisfactory for analysis
+func specimenStatusCode(status dto.SpecimenCustodyStatusEnum) scalarutils.Code {
+	switch status {
+	case dto.SpecimenCustodyStatusProcessed:
+		return scalarutils.Code("unavailable")
+	case dto.SpecimenCustodyStatusRejected:
+		return scalarutils.Code("unsatisfactory")
+	default:
+		return scalarutils.Code("available")
+	}
+}
+
+// specimenCustodyEvent composes the extension recording a change in the custody of a specimen
+func specimenCustodyEvent(status dto.SpecimenCustodyStatusEnum, at time.Time, facilityID string, note string) *domain.FHIRExtension {
+	event := &domain.FHIRExtension{
+		URL: specimenCustodyEventExtensionURL,
+		Extension: []domain.Extension{
+			{
+				URL:       "status",
+				ValueCode: status.Code(),
+			},
+			{
+				URL:           "time",
+				ValueDateTime: at.Format(time.RFC3339),
+			},
+		},
+	}
+
+	if facilityID != "" {
+		facilityReference := fmt.Sprintf("Organization/%s", facilityID)
+		event.Extension = append(event.Extension, domain.Extension{
+			URL: "facility",
+			ValueReference: &domain.FHIRReference{
+				ID:        &facilityID,
+				Reference: &facilityReference,
+			},
+		})
+	}
+
+	if note != "" {
+		event.Extension = append(event.Extension, domain.Extension{
+			URL:         "note",
+			ValueString: note,
+		})
+	}
+
+	return event
+}
+
+// specimenCustodyHistory reads the custody events recorded on a specimen in the order they were recorded
+func specimenCustodyHistory(resource domain.FHIRSpecimen) []*dto.SpecimenCustodyEvent {
+	history := []*dto.SpecimenCustodyEvent{}
+
+	for _, extension := range resource.Extension {
+		if extension == nil || extension.URL != specimenCustodyEventExtensionURL {
+			continue
+		}
+
+		event := &dto.SpecimenCustodyEvent{}
+
+		for _, field := range extension.Extension {
+			switch field.URL {
+			case "status":
+				event.Status = dto.SpecimenCustodyStatusEnum(strings.ToUpper(strings.ReplaceAll(field.ValueCode, "-", "_")))
+			case "time":
+				event.Time = scalarutils.DateTime(field.ValueDateTime)
+			case "facility":
+				if field.ValueReference != nil && field.ValueReference.ID != nil {
+					event.FacilityID = *field.ValueReference.ID
+				}
+			case "note":
+				event.Note = field.ValueString
+			}
+		}
+
+		if !event.Status.IsValid() {
+			continue
+		}
+
+		history = append(history, event)
+	}
+
+	return history
+}
+
+// specimenCustodyStatus returns the custody status of the latest custody event recorded on a specimen
+func specimenCustodyStatus(resource domain.FHIRSpecimen) dto.SpecimenCustodyStatusEnum {
+	history := specimenCustodyHistory(resource)
+	if len(history) == 0 {
+		return ""
+	}
+
+	return history[len(history)-1].Status
+}
+
+// conceptCodeableConcept composes the codeable concept of a terminology concept e.g the type of a specimen
+func conceptCodeableConcept(concept *domain.Concept) *domain.FHIRCodeableConcept {
+	system := scalarutils.URI(concept.URL)
+	code := scalarutils.Code(concept.ID)
+
+	return &domain.FHIRCodeableConcept{
+		Coding: []*domain.FHIRCoding{
+			{
+				System:  &system,
+				Code:    &code,
+				Display: concept.DisplayName,
+			},
+		},
+		Text: concept.DisplayName,
+	}
+}
+
+// accessionIdentifier composes the identifier a lab assigns to a specimen it receives
+func accessionIdentifier(accessionNumber string) *domain.FHIRIdentifier {
+	system := scalarutils.URI(accessionIdentifierTypeSystem)
+	code := scalarutils.Code(accessionIdentifierTypeCode)
+
+	return &domain.FHIRIdentifier{
+		Use: domain.IdentifierUseEnumUsual,
+		Type: domain.FHIRCodeableConcept{
+			Coding: []*domain.FHIRCoding{
+				{
+					System:  &system,
+					Code:    &code,
+					Display: "Accession ID",
+				},
+			},
+			Text: "Accession ID",
+		},
+		Value: accessionNumber,
+	}
+}
+
+func mapFHIRSpecimenToDTO(resource domain.FHIRSpecimen) *dto.Specimen {
+	output := &dto.Specimen{
+		Status:         specimenCustodyStatus(resource),
+		CustodyHistory: specimenCustodyHistory(resource),
+		ReceivedAt:     (*scalarutils.DateTime)(resource.ReceivedTime),
+	}
+
+	if resource.ID != nil {
+		output.ID = *resource.ID
+	}
+
+	if resource.AccessionIdentifier != nil {
+		output.AccessionNumber = resource.AccessionIdentifier.Value
+	}
+
+	if resource.Type != nil {
+		output.TypeName = resource.Type.Text
+
+		if len(resource.Type.Coding) > 0 && resource.Type.Coding[0].Code != nil {
+			output.TypeCode = string(*resource.Type.Coding[0].Code)
+		}
+	}
+
+	if resource.Subject != nil && resource.Subject.ID != nil {
+		output.PatientID = *resource.Subject.ID
+	}
+
+	if len(resource.Request) > 0 && resource.Request[0].ID != nil {
+		output.OrderID = *resource.Request[0].ID
+	}
+
+	if resource.Collection != nil {
+		output.CollectedAt = (*scalarutils.DateTime)(resource.Collection.CollectedDateTime)
+
+		if resource.Collection.BodySite != nil {
+			output.BodySite = resource.Collection.BodySite.Text
+		}
+	}
+
+	if len(resource.Container) > 0 && resource.Container[0].Description != nil {
+		output.Container = *resource.Container[0].Description
+	}
+
+	if len(resource.Note) > 0 && resource.Note[0].Text != nil {
+		output.Note = string(*resource.Note[0].Text)
+	}
+
+	return output
+}
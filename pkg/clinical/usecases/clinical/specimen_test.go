@@ -0,0 +1,621 @@
+package clinical_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/savannahghi/clinical/pkg/clinical/application/dto"
+	fakeExtMock "github.com/savannahghi/clinical/pkg/clinical/application/extensions/mock"
+	"github.com/savannahghi/clinical/pkg/clinical/domain"
+	"github.com/savannahghi/clinical/pkg/clinical/infrastructure"
+	fakeFHIRMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/datastore/cloudhealthcare/mock"
+	fakeAdvantageMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/advantage/mock"
+	fakeOCLMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/openconceptlab/mock"
+	fakePubSubMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/pubsub/mock"
+	fakeUploadMock "github.com/savannahghi/clinical/pkg/clinical/infrastructure/services/upload/mock"
+	clinicalUsecase "github.com/savannahghi/clinical/pkg/clinical/usecases/clinical"
+	"github.com/savannahghi/scalarutils"
+)
+
+// fakeCustodySpecimen returns a specimen whose chain of custody went through the given statuses
+func fakeCustodySpecimen(id string, statuses ...dto.SpecimenCustodyStatusEnum) *domain.FHIRSpecimen {
+	patientID := gofakeit.UUID()
+	status := scalarutils.Code("available")
+
+	specimen := &domain.FHIRSpecimen{
+		ID:     &id,
+		Status: &status,
+		Subject: &domain.FHIRReference{
+			ID: &patientID,
+		},
+	}
+
+	for _, custodyStatus := range statuses {
+		specimen.Extension = append(specimen.Extension, &domain.FHIRExtension{
+			URL: "http://savannahghi.org/fhir/StructureDefinition/specimen-custody-event",
+			Extension: []domain.Extension{
+				{
+					URL:       "status",
+					ValueCode: custodyStatus.Code(),
+				},
+				{
+					URL:           "time",
+					ValueDateTime: time.Now().Format(time.RFC3339),
+				},
+			},
+		})
+	}
+
+	return specimen
+}
+
+func TestUseCasesClinicalImpl_CollectSpecimen(t *testing.T) {
+	collectedAt := scalarutils.DateTime(time.Now().Add(-time.Hour).Format(time.RFC3339))
+	invalidCollectedAt := scalarutils.DateTime("yesterday")
+
+	type args struct {
+		ctx   context.Context
+		input dto.SpecimenInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: collect a specimen for a lab order",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenInput{
+					OrderID:         gofakeit.UUID(),
+					TypeCode:        "1000",
+					BodySiteCode:    "159455",
+					Container:       "EDTA tube",
+					AccessionNumber: "LAB-0001",
+					CollectedAt:     &collectedAt,
+					Note:            "Fasting sample",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid input",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenInput{
+					OrderID: "order",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid collection time",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenInput{
+					OrderID:     gofakeit.UUID(),
+					TypeCode:    "1000",
+					CollectedAt: &invalidCollectedAt,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: lab order has been revoked",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenInput{
+					OrderID:  gofakeit.UUID(),
+					TypeCode: "1000",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: service request is a referral",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenInput{
+					OrderID:  gofakeit.UUID(),
+					TypeCode: "1000",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get service request",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenInput{
+					OrderID:  gofakeit.UUID(),
+					TypeCode: "1000",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get specimen type",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenInput{
+					OrderID:  gofakeit.UUID(),
+					TypeCode: "1000",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to create specimen",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenInput{
+					OrderID:  gofakeit.UUID(),
+					TypeCode: "1000",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to link specimen to lab order",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenInput{
+					OrderID:  gofakeit.UUID(),
+					TypeCode: "1000",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			patientID := gofakeit.UUID()
+
+			var linked domain.FHIRServiceRequestInput
+
+			fakeFHIR.MockGetFHIRServiceRequestFn = func(_ context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error) {
+				return &domain.FHIRServiceRequestRelayPayload{
+					Resource: fakeLabOrder(id, patientID, domain.ServiceRequestStatusActive),
+				}, nil
+			}
+
+			fakeFHIR.MockUpdateFHIRServiceRequestFn = func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+				linked = input
+
+				return &domain.FHIRServiceRequestRelayPayload{
+					Resource: fakeLabOrder(*input.ID, patientID, input.Status),
+				}, nil
+			}
+
+			if tt.name == "Sad case: lab order has been revoked" {
+				fakeFHIR.MockGetFHIRServiceRequestFn = func(_ context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error) {
+					return &domain.FHIRServiceRequestRelayPayload{
+						Resource: fakeLabOrder(id, patientID, domain.ServiceRequestStatusRevoked),
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: service request is a referral" {
+				fakeFHIR.MockGetFHIRServiceRequestFn = func(_ context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error) {
+					order := fakeLabOrder(id, patientID, domain.ServiceRequestStatusActive)
+					order.Category = nil
+
+					return &domain.FHIRServiceRequestRelayPayload{
+						Resource: order,
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get service request" {
+				fakeFHIR.MockGetFHIRServiceRequestFn = func(_ context.Context, id string) (*domain.FHIRServiceRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get specimen type" {
+				fakeOCL.MockGetConceptFn = func(ctx context.Context, org, source, concept string, includeMappings, includeInverseMappings bool) (*domain.Concept, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to create specimen" {
+				fakeFHIR.MockCreateFHIRSpecimenFn = func(ctx context.Context, input domain.FHIRSpecimen) (*domain.FHIRSpecimen, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to link specimen to lab order" {
+				fakeFHIR.MockUpdateFHIRServiceRequestFn = func(ctx context.Context, input domain.FHIRServiceRequestInput) (*domain.FHIRServiceRequestRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.CollectSpecimen(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.CollectSpecimen() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Status != dto.SpecimenCustodyStatusCollected || len(got.CustodyHistory) != 1 {
+				t.Errorf("expected the specimen to start its custody as collected, got %v", got.CustodyHistory)
+			}
+
+			if got.PatientID != patientID || got.OrderID != tt.args.input.OrderID {
+				t.Errorf("expected the specimen to be collected from patient %s for order %s, got %s and %s", patientID, tt.args.input.OrderID, got.PatientID, got.OrderID)
+			}
+
+			if got.AccessionNumber != tt.args.input.AccessionNumber || got.Container != tt.args.input.Container {
+				t.Errorf("expected accession number %s in %s, got %s in %s", tt.args.input.AccessionNumber, tt.args.input.Container, got.AccessionNumber, got.Container)
+			}
+
+			if got.CollectedAt == nil || *got.CollectedAt != collectedAt {
+				t.Errorf("expected the specimen to be collected at %s, got %v", collectedAt, got.CollectedAt)
+			}
+
+			if len(linked.Specimen) != 1 || *linked.Specimen[0].ID != got.ID {
+				t.Errorf("expected the lab order to reference specimen %s, got %v", got.ID, linked.Specimen)
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_UpdateSpecimenCustody(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input dto.SpecimenCustodyInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Happy case: send specimen to the lab",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenCustodyInput{
+					SpecimenID: gofakeit.UUID(),
+					Status:     dto.SpecimenCustodyStatusInTransit,
+				},
+			},
+			want:    "available",
+			wantErr: false,
+		},
+		{
+			name: "Happy case: receive specimen at the lab",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenCustodyInput{
+					SpecimenID:      gofakeit.UUID(),
+					Status:          dto.SpecimenCustodyStatusReceived,
+					AccessionNumber: "LAB-0001",
+				},
+			},
+			want:    "available",
+			wantErr: false,
+		},
+		{
+			name: "Happy case: reject specimen",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenCustodyInput{
+					SpecimenID: gofakeit.UUID(),
+					Status:     dto.SpecimenCustodyStatusRejected,
+					Note:       "Haemolysed",
+				},
+			},
+			want:    "unsatisfactory",
+			wantErr: false,
+		},
+		{
+			name: "Sad case: reject specimen without a note",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenCustodyInput{
+					SpecimenID: gofakeit.UUID(),
+					Status:     dto.SpecimenCustodyStatusRejected,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid custody status",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenCustodyInput{
+					SpecimenID: gofakeit.UUID(),
+					Status:     dto.SpecimenCustodyStatusEnum("LOST"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: process a specimen before it is received",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenCustodyInput{
+					SpecimenID: gofakeit.UUID(),
+					Status:     dto.SpecimenCustodyStatusProcessed,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: specimen has already been processed",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenCustodyInput{
+					SpecimenID: gofakeit.UUID(),
+					Status:     dto.SpecimenCustodyStatusRejected,
+					Note:       "Haemolysed",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get specimen",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenCustodyInput{
+					SpecimenID: gofakeit.UUID(),
+					Status:     dto.SpecimenCustodyStatusInTransit,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to get tenant identifiers",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenCustodyInput{
+					SpecimenID: gofakeit.UUID(),
+					Status:     dto.SpecimenCustodyStatusInTransit,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to update specimen",
+			args: args{
+				ctx: context.Background(),
+				input: dto.SpecimenCustodyInput{
+					SpecimenID: gofakeit.UUID(),
+					Status:     dto.SpecimenCustodyStatusInTransit,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			var updated domain.FHIRSpecimen
+
+			fakeFHIR.MockGetFHIRSpecimenFn = func(ctx context.Context, id string) (*domain.FHIRSpecimenRelayPayload, error) {
+				return &domain.FHIRSpecimenRelayPayload{
+					Resource: fakeCustodySpecimen(id, dto.SpecimenCustodyStatusCollected),
+				}, nil
+			}
+
+			fakeFHIR.MockUpdateFHIRSpecimenFn = func(ctx context.Context, input domain.FHIRSpecimen) (*domain.FHIRSpecimen, error) {
+				updated = input
+
+				return &input, nil
+			}
+
+			if tt.name == "Happy case: receive specimen at the lab" {
+				fakeFHIR.MockGetFHIRSpecimenFn = func(ctx context.Context, id string) (*domain.FHIRSpecimenRelayPayload, error) {
+					return &domain.FHIRSpecimenRelayPayload{
+						Resource: fakeCustodySpecimen(id, dto.SpecimenCustodyStatusCollected, dto.SpecimenCustodyStatusInTransit),
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: specimen has already been processed" {
+				fakeFHIR.MockGetFHIRSpecimenFn = func(ctx context.Context, id string) (*domain.FHIRSpecimenRelayPayload, error) {
+					return &domain.FHIRSpecimenRelayPayload{
+						Resource: fakeCustodySpecimen(id, dto.SpecimenCustodyStatusCollected, dto.SpecimenCustodyStatusReceived, dto.SpecimenCustodyStatusProcessed),
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad case: failed to get specimen" {
+				fakeFHIR.MockGetFHIRSpecimenFn = func(ctx context.Context, id string) (*domain.FHIRSpecimenRelayPayload, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to get tenant identifiers" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			if tt.name == "Sad case: failed to update specimen" {
+				fakeFHIR.MockUpdateFHIRSpecimenFn = func(ctx context.Context, input domain.FHIRSpecimen) (*domain.FHIRSpecimen, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.UpdateSpecimenCustody(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.UpdateSpecimenCustody() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Status != tt.args.input.Status {
+				t.Errorf("expected the specimen to be %s, got %s", tt.args.input.Status, got.Status)
+			}
+
+			last := got.CustodyHistory[len(got.CustodyHistory)-1]
+			if last.Status != tt.args.input.Status || last.Note != tt.args.input.Note {
+				t.Errorf("expected the custody history to end with %s, got %v", tt.args.input.Status, last)
+			}
+
+			if updated.Status == nil || string(*updated.Status) != tt.want {
+				t.Errorf("expected the specimen status to be %s, got %v", tt.want, updated.Status)
+			}
+
+			if tt.name == "Happy case: receive specimen at the lab" {
+				if got.ReceivedAt == nil || got.AccessionNumber != tt.args.input.AccessionNumber {
+					t.Errorf("expected the specimen to be received with accession number %s, got %v", tt.args.input.AccessionNumber, got)
+				}
+			}
+		})
+	}
+}
+
+func TestUseCasesClinicalImpl_ListOutstandingSpecimens(t *testing.T) {
+	first := 10
+	invalidFirst := -1
+
+	type args struct {
+		ctx        context.Context
+		facilityID string
+		pagination dto.Pagination
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Happy case: list outstanding specimens",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad case: invalid facility id",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: "facility",
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: invalid pagination",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &invalidFirst},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: specimens of another facility",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Sad case: failed to search specimens",
+			args: args{
+				ctx:        context.Background(),
+				facilityID: gofakeit.UUID(),
+				pagination: dto.Pagination{First: &first},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExt := fakeExtMock.NewFakeBaseExtensionMock()
+			fakeFHIR := fakeFHIRMock.NewFHIRMock()
+			fakeOCL := fakeOCLMock.NewFakeOCLMock()
+			fakePubSub := fakePubSubMock.NewPubSubServiceMock()
+			fakeUpload := fakeUploadMock.NewFakeUploadMock()
+			fakeAdvantage := fakeAdvantageMock.NewFakeAdvantageMock()
+
+			infra := infrastructure.NewInfrastructureInteractor(fakeExt, fakeFHIR, fakeOCL, fakeUpload, fakePubSub, fakeAdvantage)
+			c := clinicalUsecase.NewUseCasesClinicalImpl(infra)
+
+			var searched map[string]interface{}
+			var tenant dto.TenantIdentifiers
+
+			if tt.name != "Sad case: specimens of another facility" {
+				fakeExt.MockGetTenantIdentifiersFn = func(ctx context.Context) (*dto.TenantIdentifiers, error) {
+					return &dto.TenantIdentifiers{
+						OrganizationID: gofakeit.UUID(),
+						FacilityID:     tt.args.facilityID,
+					}, nil
+				}
+			}
+
+			fakeFHIR.MockSearchFHIRSpecimenFn = func(ctx context.Context, params map[string]interface{}, identifiers dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSpecimen, error) {
+				searched = params
+				tenant = identifiers
+
+				return &domain.PagedFHIRSpecimen{
+					Specimens: []domain.FHIRSpecimen{
+						*fakeCustodySpecimen(gofakeit.UUID(), dto.SpecimenCustodyStatusCollected),
+						*fakeCustodySpecimen(gofakeit.UUID(), dto.SpecimenCustodyStatusCollected, dto.SpecimenCustodyStatusInTransit),
+					},
+					TotalCount: 2,
+				}, nil
+			}
+
+			if tt.name == "Sad case: failed to search specimens" {
+				fakeFHIR.MockSearchFHIRSpecimenFn = func(ctx context.Context, params map[string]interface{}, identifiers dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRSpecimen, error) {
+					return nil, fmt.Errorf("an error occurred")
+				}
+			}
+
+			got, err := c.ListOutstandingSpecimens(tt.args.ctx, tt.args.facilityID, tt.args.pagination)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseCasesClinicalImpl.ListOutstandingSpecimens() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if searched["status"] != "available" || tenant.FacilityID != tt.args.facilityID {
+				t.Errorf("expected available specimens of facility %s to be searched, got %v in %s", tt.args.facilityID, searched, tenant.FacilityID)
+			}
+
+			if len(got.Edges) != 2 || got.Edges[1].Node.Status != dto.SpecimenCustodyStatusInTransit {
+				t.Errorf("expected two outstanding specimens, got %v", got.Edges)
+			}
+		})
+	}
+}
@@ -16,7 +16,7 @@ import (
 )
 
 // PatientTimeline return's the patient's historical timeline sorted in descending order i.e when it was first recorded
-// The timeline consists of Allergies, Observations, Medication statement and Test results
+// The timeline consists of Allergies, Observations, Medication statement, Test results and Procedures
 func (c *UseCasesClinicalImpl) PatientTimeline(ctx context.Context, patientID string) ([]dto.TimelineResource, error) {
 	_, err := uuid.Parse(patientID)
 	if err != nil {
@@ -149,12 +149,16 @@ func (c *UseCasesClinicalImpl) PatientTimeline(ctx context.Context, patientID st
 				ID:           *edge.ID,
 				ResourceType: dto.ResourceTypeObservation,
 				Name:         edge.Code.Text,
-				Value:        *edge.ValueString,
+				Value:        observationValueText(edge),
+				Unit:         observationValueUnit(edge),
 				Status:       string(*edge.Status),
 				Date:         *date,
 				TimeRecorded: instant,
+				Flag:         observationFlag(edge),
 			}
 
+			timelineResource.Abnormal = timelineResource.Flag != nil && timelineResource.Flag.IsAbnormal()
+
 			mut.Lock()
 			timeline = append(timeline, timelineResource)
 			mut.Unlock()
@@ -282,11 +286,75 @@ func (c *UseCasesClinicalImpl) PatientTimeline(ctx context.Context, patientID st
 		}
 	}
 
+	// search procedures
+	procedureResourceFunc := func(wg *sync.WaitGroup, mut *sync.Mutex) {
+		defer wg.Done()
+
+		conn, err := c.infrastructure.FHIR.SearchFHIRProcedure(ctx, patientFilterParams, *identifiers, dto.Pagination{Skip: true})
+		if err != nil {
+			utils.ReportErrorToSentry(err)
+			log.Errorf("Procedure search error: %v", err)
+
+			return
+		}
+
+		for _, edge := range conn.Procedures {
+			if edge.ID == nil {
+				continue
+			}
+
+			if edge.Code == nil {
+				continue
+			}
+
+			if edge.Status == nil {
+				continue
+			}
+
+			if edge.PerformedDateTime == nil {
+				continue
+			}
+
+			instant, err := helpers.ParseFHIRDateTime(*edge.PerformedDateTime)
+			if err != nil {
+				utils.ReportErrorToSentry(err)
+				log.Errorf("date conversion error: %v", err)
+
+				continue
+			}
+
+			date, err := scalarutils.NewDate(instant.Day(), int(instant.Month()), instant.Year())
+			if err != nil {
+				utils.ReportErrorToSentry(err)
+				log.Errorf("date conversion error: %v", err)
+
+				continue
+			}
+
+			timelineResource := dto.TimelineResource{
+				ID:           *edge.ID,
+				ResourceType: dto.ResourceTypeProcedure,
+				Name:         edge.Code.Text,
+				Status:       string(*edge.Status),
+				Date:         *date,
+			}
+
+			if edge.Outcome != nil {
+				timelineResource.Value = edge.Outcome.Text
+			}
+
+			mut.Lock()
+			timeline = append(timeline, timelineResource)
+			mut.Unlock()
+		}
+	}
+
 	resources := []timelineResourceFunc{
 		allergyIntoleranceResourceFunc,
 		observationResourceFunc,
 		medicationStatementResourceFunc,
 		conditionResourceFunc,
+		procedureResourceFunc,
 	}
 
 	for _, resource := range resources {
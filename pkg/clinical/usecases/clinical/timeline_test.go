@@ -193,6 +193,22 @@ func TestClinicalUseCaseImpl_PatientTimeline(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Happy case: observation recorded as a quantity",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: blood pressure panel with a high pressure",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
 
 		{
 			name: "Sad Case - Fail to search medication statement",
@@ -330,6 +346,30 @@ func TestClinicalUseCaseImpl_PatientTimeline(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Sad Case - Fail to search procedure",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Happy case: procedure performed on a partial date",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sad Case - Fail to get procedure - invalid date",
+			args: args{
+				ctx:       context.Background(),
+				patientID: gofakeit.UUID(),
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1093,6 +1133,26 @@ func TestClinicalUseCaseImpl_PatientTimeline(t *testing.T) {
 				}
 			}
 
+			if tt.name == "Happy case: observation recorded as a quantity" {
+				fakeFHIR.MockSearchFHIRObservationFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+					observation := fakeWeightObservation(gofakeit.UUID(), "70")
+					observation.ValueString = nil
+					observation.ValueQuantity = &domain.FHIRQuantity{Value: 70, Unit: "kg", Code: "kg"}
+
+					return &domain.PagedFHIRObservations{
+						Observations: []domain.FHIRObservation{observation},
+					}, nil
+				}
+			}
+
+			if tt.name == "Happy case: blood pressure panel with a high pressure" {
+				fakeFHIR.MockSearchFHIRObservationFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRObservations, error) {
+					return &domain.PagedFHIRObservations{
+						Observations: []domain.FHIRObservation{fakeBloodPressurePanel(gofakeit.UUID(), 150, 85, time.Now())},
+					}, nil
+				}
+			}
+
 			if tt.name == "Sad Case - Fail to search medication statement" {
 				fakeFHIR.MockSearchFHIRMedicationStatementFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.FHIRMedicationStatementRelayConnection, error) {
 					return &domain.FHIRMedicationStatementRelayConnection{}, fmt.Errorf("failed to get medication statement")
@@ -1309,6 +1369,50 @@ func TestClinicalUseCaseImpl_PatientTimeline(t *testing.T) {
 				}
 			}
 
+			if tt.name == "Sad Case - Fail to search procedure" {
+				fakeFHIR.MockSearchFHIRProcedureFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRProcedure, error) {
+					return nil, fmt.Errorf("failed to search procedure")
+				}
+			}
+
+			if tt.name == "Happy case: procedure performed on a partial date" {
+				fakeFHIR.MockSearchFHIRProcedureFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRProcedure, error) {
+					id := gofakeit.UUID()
+					status := scalarutils.Code("completed")
+					performed := "2023-04"
+
+					return &domain.PagedFHIRProcedure{
+						Procedures: []domain.FHIRProcedure{
+							{
+								ID:                &id,
+								Status:            &status,
+								Code:              &domain.FHIRCodeableConcept{Text: "Cryotherapy of cervix"},
+								PerformedDateTime: &performed,
+							},
+						},
+					}, nil
+				}
+			}
+
+			if tt.name == "Sad Case - Fail to get procedure - invalid date" {
+				fakeFHIR.MockSearchFHIRProcedureFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRProcedure, error) {
+					id := gofakeit.UUID()
+					status := scalarutils.Code("completed")
+					performed := "yesterday"
+
+					return &domain.PagedFHIRProcedure{
+						Procedures: []domain.FHIRProcedure{
+							{
+								ID:                &id,
+								Status:            &status,
+								Code:              &domain.FHIRCodeableConcept{Text: "Cryotherapy of cervix"},
+								PerformedDateTime: &performed,
+							},
+						},
+					}, nil
+				}
+			}
+
 			if tt.name == "Sad Case - Fail to search condition" {
 				fakeFHIR.MockSearchFHIRConditionFn = func(ctx context.Context, params map[string]interface{}, tenant dto.TenantIdentifiers, pagination dto.Pagination) (*domain.PagedFHIRCondition, error) {
 					return &domain.PagedFHIRCondition{}, fmt.Errorf("failed to get condition")
@@ -1547,6 +1651,49 @@ func TestClinicalUseCaseImpl_PatientTimeline(t *testing.T) {
 				t.Errorf("expected patient timeline not to be nil for %v", tt.name)
 				return
 			}
+
+			if tt.name == "Happy case: blood pressure panel with a high pressure" {
+				found := false
+
+				for _, resource := range got {
+					if resource.ResourceType == dto.ResourceTypeObservation && resource.Value == "150/85" && resource.Unit == "mm[Hg]" &&
+						resource.Flag != nil && *resource.Flag == dto.ObservationInterpretationHigh && resource.Abnormal {
+						found = true
+					}
+				}
+
+				if !found {
+					t.Errorf("expected the blood pressure to be on the timeline as an abnormal 150/85 mm[Hg]")
+				}
+			}
+
+			if tt.name == "Happy case: observation recorded as a quantity" {
+				found := false
+
+				for _, resource := range got {
+					if resource.ResourceType == dto.ResourceTypeObservation && resource.Value == "70" && resource.Unit == "kg" {
+						found = true
+					}
+				}
+
+				if !found {
+					t.Errorf("expected the weight to be on the timeline as 70 kg")
+				}
+			}
+
+			if tt.name == "Happy case: procedure performed on a partial date" {
+				found := false
+
+				for _, resource := range got {
+					if resource.ResourceType == dto.ResourceTypeProcedure && resource.Date.Year == 2023 && resource.Date.Month == 4 && resource.Date.Day == 1 {
+						found = true
+					}
+				}
+
+				if !found {
+					t.Errorf("expected the procedure to be on the timeline on 2023-04-01")
+				}
+			}
 		})
 	}
 
@@ -40,10 +40,10 @@ func (c *UseCasesClinicalImpl) CreateTenantMetaTags(ctx context.Context, organis
 	userSelected := false
 
 	organisationTagVersion := "1.0"
-	organisationTagSystem := scalarutils.URI("http://mycarehub/tenant-identification/organisation")
+	organisationTagSystem := scalarutils.URI(common.OrganizationTagSystem)
 
 	facilityTagVersion := "1.0"
-	facilityTagSystem := scalarutils.URI("http://mycarehub/tenant-identification/facility")
+	facilityTagSystem := scalarutils.URI(common.FacilityTagSystem)
 
 	tags := []domain.FHIRCodingInput{
 		{
@@ -65,6 +65,36 @@ func (c *UseCasesClinicalImpl) CreateTenantMetaTags(ctx context.Context, organis
 	return tags, nil
 }
 
+// resourceOrganizationID returns the ID of the organisation a resource is tagged as belonging to
+func resourceOrganizationID(meta *domain.FHIRMeta) string {
+	if meta == nil {
+		return ""
+	}
+
+	for _, tag := range meta.Tag {
+		if tag.System != nil && tag.Code != nil && string(*tag.System) == common.OrganizationTagSystem {
+			return string(*tag.Code)
+		}
+	}
+
+	return ""
+}
+
+// resourceInputOrganizationID returns the ID of the organisation a resource whose meta is an input is tagged as belonging to
+func resourceInputOrganizationID(meta *domain.FHIRMetaInput) string {
+	if meta == nil {
+		return ""
+	}
+
+	for _, tag := range meta.Tag {
+		if tag.System != nil && string(*tag.System) == common.OrganizationTagSystem {
+			return string(tag.Code)
+		}
+	}
+
+	return ""
+}
+
 // CheckPatientExistenceUsingPhoneNumber checks whether a patient with the phone number they're trying to register with exists
 func (c *UseCasesClinicalImpl) CheckPatientExistenceUsingPhoneNumber(ctx context.Context, patientInput domain.SimplePatientRegistrationInput) (bool, error) {
 	exists := false